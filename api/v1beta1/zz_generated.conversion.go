@@ -565,7 +565,14 @@ func autoConvert_v1beta2_IBMPowerVSClusterSpec_To_v1beta1_IBMPowerVSClusterSpec(
 	// WARNING: in.TransitGateway requires manual conversion: does not exist in peer-type
 	// WARNING: in.LoadBalancers requires manual conversion: does not exist in peer-type
 	// WARNING: in.CosInstance requires manual conversion: does not exist in peer-type
+	// WARNING: in.PlacementGroups requires manual conversion: does not exist in peer-type
+	// WARNING: in.SharedProcessorPools requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalNetworks requires manual conversion: does not exist in peer-type
+	// WARNING: in.ControlPlaneDNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPEGateways requires manual conversion: does not exist in peer-type
 	// WARNING: in.Ignition requires manual conversion: does not exist in peer-type
+	// WARNING: in.IdentityRef requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -591,6 +598,13 @@ func autoConvert_v1beta2_IBMPowerVSClusterStatus_To_v1beta1_IBMPowerVSClusterSta
 	// WARNING: in.TransitGateway requires manual conversion: does not exist in peer-type
 	// WARNING: in.COSInstance requires manual conversion: does not exist in peer-type
 	// WARNING: in.LoadBalancers requires manual conversion: does not exist in peer-type
+	// WARNING: in.PlacementGroups requires manual conversion: does not exist in peer-type
+	// WARNING: in.SharedProcessorPools requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalNetworks requires manual conversion: does not exist in peer-type
+	// WARNING: in.ControlPlaneDNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPEGateways requires manual conversion: does not exist in peer-type
+	// WARNING: in.FailureDomains requires manual conversion: does not exist in peer-type
 	// WARNING: in.Conditions requires manual conversion: does not exist in peer-type
 	// WARNING: in.V1Beta2 requires manual conversion: does not exist in peer-type
 	return nil
@@ -940,9 +954,20 @@ func autoConvert_v1beta2_IBMPowerVSMachineSpec_To_v1beta1_IBMPowerVSMachineSpec(
 	// WARNING: in.ProcessorType requires manual conversion: does not exist in peer-type
 	// WARNING: in.Processors requires manual conversion: inconvertible types (k8s.io/apimachinery/pkg/util/intstr.IntOrString vs string)
 	// WARNING: in.MemoryGiB requires manual conversion: does not exist in peer-type
+	// WARNING: in.Profile requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResizePolicy requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta2_IBMPowerVSResourceReference_To_v1beta1_IBMPowerVSResourceReference(&in.Network, &out.Network, s); err != nil {
 		return err
 	}
+	// WARNING: in.Networks requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalVolumes requires manual conversion: does not exist in peer-type
+	// WARNING: in.PlacementGroup requires manual conversion: does not exist in peer-type
+	// WARNING: in.SharedProcessorPool requires manual conversion: does not exist in peer-type
+	// WARNING: in.DeploymentTarget requires manual conversion: does not exist in peer-type
+	// WARNING: in.StorageType requires manual conversion: does not exist in peer-type
+	// WARNING: in.StoragePool requires manual conversion: does not exist in peer-type
+	// WARNING: in.StorageAffinity requires manual conversion: does not exist in peer-type
+	// WARNING: in.Diagnostics requires manual conversion: does not exist in peer-type
 	out.ProviderID = (*string)(unsafe.Pointer(in.ProviderID))
 	return nil
 }
@@ -979,6 +1004,9 @@ func autoConvert_v1beta2_IBMPowerVSMachineStatus_To_v1beta1_IBMPowerVSMachineSta
 	out.Conditions = *(*corev1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	// WARNING: in.AdditionalVolumes requires manual conversion: does not exist in peer-type
+	// WARNING: in.Diagnostics requires manual conversion: does not exist in peer-type
+	// WARNING: in.Resize requires manual conversion: does not exist in peer-type
 	// WARNING: in.V1Beta2 requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -1263,6 +1291,9 @@ func autoConvert_v1beta2_IBMVPCClusterSpec_To_v1beta1_IBMVPCClusterSpec(in *v1be
 	}
 	// WARNING: in.Image requires manual conversion: does not exist in peer-type
 	// WARNING: in.Network requires manual conversion: does not exist in peer-type
+	// WARNING: in.ControlPlaneDNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.IdentityRef requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1295,6 +1326,8 @@ func autoConvert_v1beta2_IBMVPCClusterStatus_To_v1beta1_IBMVPCClusterStatus(in *
 	// WARNING: in.Network requires manual conversion: does not exist in peer-type
 	out.Ready = in.Ready
 	// WARNING: in.ResourceGroup requires manual conversion: does not exist in peer-type
+	// WARNING: in.ControlPlaneDNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta2_Subnet_To_v1beta1_Subnet(&in.Subnet, &out.Subnet, s); err != nil {
 		return err
 	}
@@ -1302,6 +1335,7 @@ func autoConvert_v1beta2_IBMVPCClusterStatus_To_v1beta1_IBMVPCClusterStatus(in *
 		return err
 	}
 	out.ControlPlaneLoadBalancerState = VPCLoadBalancerState(in.ControlPlaneLoadBalancerState)
+	// WARNING: in.FailureDomains requires manual conversion: does not exist in peer-type
 	out.Conditions = *(*corev1beta1.Conditions)(unsafe.Pointer(&in.Conditions))
 	// WARNING: in.V1Beta2 requires manual conversion: does not exist in peer-type
 	return nil
@@ -103,15 +103,41 @@ const (
 	// IBMPowerVSMachineInstanceWaitingForNetworkAddressV1Beta2Reason surfaces when the PowerVS instance that is controlled
 	// by the IBMPowerVSMachine waiting for the machine network settings to be reported after machine being powered on.
 	IBMPowerVSMachineInstanceWaitingForNetworkAddressV1Beta2Reason = "WaitingForNetworkAddress"
+
+	// IBMPowerVSMachineInstanceWaitingForVolumesV1Beta2Reason surfaces when the additional volumes of the PowerVS instance that is controlled
+	// by the IBMPowerVSMachine are not yet created and attached.
+	IBMPowerVSMachineInstanceWaitingForVolumesV1Beta2Reason = "WaitingForVolumes"
+
+	// IBMPowerVSMachineInstanceVolumesConfigurationFailedV1Beta2Reason surfaces when creating or attaching the additional volumes of the virtual machine fails.
+	IBMPowerVSMachineInstanceVolumesConfigurationFailedV1Beta2Reason = "VolumesConfigurationFailed"
+
+	// IBMPowerVSMachineInstanceResizedV1Beta2Condition documents the in-place resize of the processors and memory of the instance
+	// that is controlled by an IBMPowerVSMachine with the InPlace resize policy.
+	IBMPowerVSMachineInstanceResizedV1Beta2Condition = "InstanceResized"
+
+	// IBMPowerVSMachineInstanceResizedV1Beta2Reason surfaces when the processors and memory of the instance match the IBMPowerVSMachine.
+	IBMPowerVSMachineInstanceResizedV1Beta2Reason = "InstanceResized"
+
+	// IBMPowerVSMachineInstanceResizingV1Beta2Reason surfaces when the instance is being resized to the processors and memory of the IBMPowerVSMachine.
+	IBMPowerVSMachineInstanceResizingV1Beta2Reason = "InstanceResizing"
+
+	// IBMPowerVSMachineInstanceResizeFailedV1Beta2Reason surfaces when resizing the instance fails.
+	IBMPowerVSMachineInstanceResizeFailedV1Beta2Reason = "InstanceResizeFailed"
 )
 
 const (
 	// InstanceProvisionFailedReason used for failures during instance provisioning.
 	InstanceProvisionFailedReason = "InstanceProvisionFailed"
+	// InstancePreflightFailedReason used when the zone or the service instance cannot accommodate the instance to be provisioned.
+	InstancePreflightFailedReason = "InstancePreflightFailed"
 	// WaitingForClusterInfrastructureReason used when machine is waiting for cluster infrastructure to be ready before proceeding.
 	WaitingForClusterInfrastructureReason = "WaitingForClusterInfrastructure"
 	// WaitingForBootstrapDataReason used when machine is waiting for bootstrap data to be ready before proceeding.
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
+	// WaitingForVolumesReason used when the additional volumes of the machine are not yet created and attached.
+	WaitingForVolumesReason = "WaitingForVolumes"
+	// VolumesConfigurationFailedReason used for failures while creating or attaching the additional volumes of the machine.
+	VolumesConfigurationFailedReason = "VolumesConfigurationFailed"
 )
 
 const (
@@ -187,6 +213,12 @@ const (
 	// VPCSecurityGroupReconciliationFailedReason used when an error occurs during VPC reconciliation.
 	VPCSecurityGroupReconciliationFailedReason = "VPCSecurityGroupReconciliationFailed"
 
+	// VPCSecurityGroupRulesSyncedCondition reports whether the VPC security groups only have the rules defined for them,
+	// when their rules are reconciled in Enforce mode.
+	VPCSecurityGroupRulesSyncedCondition clusterv1beta1.ConditionType = "VPCSecurityGroupRulesSynced"
+	// VPCSecurityGroupRulesDriftedReason used when security groups not created by the controller have rules which are not defined for them.
+	VPCSecurityGroupRulesDriftedReason = "VPCSecurityGroupRulesDrifted"
+
 	// VPCReadyCondition reports on the successful reconciliation of a VPC.
 	VPCReadyCondition clusterv1beta1.ConditionType = "VPCReady"
 	// VPCReconciliationFailedReason used when an error occurs during VPC reconciliation.
@@ -217,6 +249,10 @@ const (
 	// CreateInfrastructureAnnotation is the name of an annotation that indicates if
 	// Power VS infrastructure should be created as a part of cluster creation.
 	CreateInfrastructureAnnotation = "powervs.cluster.x-k8s.io/create-infra"
+
+	// CollectDiagnosticsAnnotation is the name of an annotation that requests the diagnostics of the instance of an
+	// IBMPowerVSMachine to be collected. The annotation is removed once they are.
+	CollectDiagnosticsAnnotation = "powervs.cluster.x-k8s.io/collect-diagnostics"
 )
 
 // IBMPowerVSCluster's Ready condition and corresponding reasons that will be used in v1Beta2 API version.
@@ -314,6 +350,17 @@ const (
 	// VPCSecurityGroupDeletingV1Beta2Reason surfaces when the VPC security group is being deleted.
 	VPCSecurityGroupDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
 
+	// VPCSecurityGroupRulesSyncedV1Beta2Condition reports whether the VPC security groups only have the rules defined for them,
+	// when their rules are reconciled in Enforce mode.
+	VPCSecurityGroupRulesSyncedV1Beta2Condition = "VPCSecurityGroupRulesSynced"
+
+	// VPCSecurityGroupRulesSyncedV1Beta2Reason surfaces when the VPC security groups only have the rules defined for them.
+	VPCSecurityGroupRulesSyncedV1Beta2Reason = "Synced"
+
+	// VPCSecurityGroupRulesDriftedV1Beta2Reason surfaces when VPC security groups not created by the controller have rules
+	// which are not defined for them.
+	VPCSecurityGroupRulesDriftedV1Beta2Reason = "Drifted"
+
 	// TransitGatewayReadyV1Beta2Condition reports on the successful reconciliation of a transit gateway.
 	TransitGatewayReadyV1Beta2Condition = "TransitGatewayReady"
 
@@ -347,6 +394,9 @@ const (
 	// VPCImageNotReadyV1Beta2Reason surfaces when the VPC custom image is not ready.
 	VPCImageNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
 
+	// VPCImageDeletingV1Beta2Reason surfaces when the VPC custom image is being deleted.
+	VPCImageDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+
 	// COSInstanceReadyV1Beta2Condition reports on the successful reconciliation of a COS instance.
 	COSInstanceReadyV1Beta2Condition = "COSInstanceReady"
 
@@ -358,6 +408,78 @@ const (
 
 	// COSInstanceDeletingV1Beta2Reason surfaces when the COS instance is being deleted.
 	COSInstanceDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+
+	// PlacementGroupsReadyV1Beta2Condition reports on the successful reconciliation of the PowerVS server placement groups.
+	PlacementGroupsReadyV1Beta2Condition = "PlacementGroupsReady"
+
+	// PlacementGroupsReadyV1Beta2Reason surfaces when the PowerVS server placement groups are ready.
+	PlacementGroupsReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// PlacementGroupsNotReadyV1Beta2Reason surfaces when the PowerVS server placement groups are not ready.
+	PlacementGroupsNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// PlacementGroupsDeletingV1Beta2Reason surfaces when the PowerVS server placement groups are being deleted.
+	PlacementGroupsDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+
+	// SharedProcessorPoolsReadyV1Beta2Condition reports on the successful reconciliation of the PowerVS shared processor pools.
+	SharedProcessorPoolsReadyV1Beta2Condition = "SharedProcessorPoolsReady"
+
+	// SharedProcessorPoolsReadyV1Beta2Reason surfaces when the PowerVS shared processor pools are ready.
+	SharedProcessorPoolsReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// SharedProcessorPoolsNotReadyV1Beta2Reason surfaces when the PowerVS shared processor pools are not ready.
+	SharedProcessorPoolsNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// SharedProcessorPoolsDeletingV1Beta2Reason surfaces when the PowerVS shared processor pools are being deleted.
+	SharedProcessorPoolsDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+
+	// AdditionalNetworksReadyV1Beta2Condition reports on the successful reconciliation of the additional PowerVS networks.
+	AdditionalNetworksReadyV1Beta2Condition = "AdditionalNetworksReady"
+
+	// AdditionalNetworksReadyV1Beta2Reason surfaces when the additional PowerVS networks are ready.
+	AdditionalNetworksReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// AdditionalNetworksNotReadyV1Beta2Reason surfaces when the additional PowerVS networks are not ready.
+	AdditionalNetworksNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// AdditionalNetworksDeletingV1Beta2Reason surfaces when the additional PowerVS networks are being deleted.
+	AdditionalNetworksDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+
+	// ControlPlaneDNSReadyV1Beta2Condition reports on the successful reconciliation of the DNS Services zone and records of the control-plane endpoint.
+	ControlPlaneDNSReadyV1Beta2Condition = "ControlPlaneDNSReady"
+
+	// ControlPlaneDNSReadyV1Beta2Reason surfaces when the DNS Services zone and records of the control-plane endpoint are ready.
+	ControlPlaneDNSReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// ControlPlaneDNSNotReadyV1Beta2Reason surfaces when the DNS Services zone and records of the control-plane endpoint are not ready.
+	ControlPlaneDNSNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// ControlPlaneDNSDeletingV1Beta2Reason surfaces when the DNS Services zone and records of the control-plane endpoint are being deleted.
+	ControlPlaneDNSDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+
+	// PublicDNSReadyV1Beta2Condition reports on the successful reconciliation of the public DNS records in IBM Cloud Internet Services.
+	PublicDNSReadyV1Beta2Condition = "PublicDNSReady"
+
+	// PublicDNSReadyV1Beta2Reason surfaces when the public DNS records are ready.
+	PublicDNSReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// PublicDNSNotReadyV1Beta2Reason surfaces when the public DNS records are not ready.
+	PublicDNSNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// PublicDNSDeletingV1Beta2Reason surfaces when the public DNS records are being deleted.
+	PublicDNSDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+
+	// VPEGatewaysReadyV1Beta2Condition reports on the successful reconciliation of the VPC VPE gateways.
+	VPEGatewaysReadyV1Beta2Condition = "VPEGatewaysReady"
+
+	// VPEGatewaysReadyV1Beta2Reason surfaces when the VPC VPE gateways are ready.
+	VPEGatewaysReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// VPEGatewaysNotReadyV1Beta2Reason surfaces when the VPC VPE gateways are not ready.
+	VPEGatewaysNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// VPEGatewaysDeletingV1Beta2Reason surfaces when the VPC VPE gateways are being deleted.
+	VPEGatewaysDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
 )
 
 // IBMPowerVSImage's Ready condition and corresponding reasons that will be used in v1Beta2 API version.
@@ -378,3 +500,92 @@ const (
 	// and none of the IBMPowerVSImage readiness criteria is met.
 	IBMPowerVSImageReadyUnknownV1Beta2Reason = clusterv1beta1.ReadyUnknownV1Beta2Reason
 )
+
+const (
+	// InstanceGroupReadyCondition reports on current status of the VPC instance group of an IBMVPCMachinePool.
+	InstanceGroupReadyCondition clusterv1beta1.ConditionType = "InstanceGroupReady"
+	// InstanceGroupReconciliationFailedReason used when an error occurs during instance group reconciliation.
+	InstanceGroupReconciliationFailedReason = "InstanceGroupReconciliationFailed"
+
+	// InstancesReadyCondition reports on current status of the instances of a machine pool.
+	// Ready indicates all the desired instances are up to date and running.
+	InstancesReadyCondition clusterv1beta1.ConditionType = "InstancesReady"
+	// InstancesReconciliationFailedReason used when an error occurs during reconciliation of the instances of a machine pool.
+	InstancesReconciliationFailedReason = "InstancesReconciliationFailed"
+	// InstancesScalingReason used when the number of instances of a machine pool does not match the desired replicas.
+	InstancesScalingReason = "InstancesScaling"
+	// InstancesRollingUpdateReason used when instances of a machine pool are being replaced with the current template.
+	InstancesRollingUpdateReason = "InstancesRollingUpdate"
+)
+
+// IBMVPCMachinePool's and IBMPowerVSMachinePool's Ready condition and corresponding reasons that will be used in v1Beta2 API version.
+const (
+	// MachinePoolReadyV1Beta2Condition is true if the machine pool's deletionTimestamp is not set and all the desired instances are up to date and running.
+	MachinePoolReadyV1Beta2Condition = clusterv1beta1.ReadyV1Beta2Condition
+
+	// MachinePoolReadyV1Beta2Reason surfaces when the machine pool readiness criteria is met.
+	MachinePoolReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// MachinePoolNotReadyV1Beta2Reason surfaces when the machine pool readiness criteria is not met.
+	MachinePoolNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// MachinePoolDeletingV1Beta2Reason surfaces when the machine pool is being deleted.
+	MachinePoolDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+)
+
+const (
+	// CredentialsReadyCondition reports on the IBM Cloud credentials used to reconcile a cluster.
+	// Ready indicates an IAM access token was obtained with them.
+	CredentialsReadyCondition clusterv1beta1.ConditionType = "CredentialsReady"
+	// CredentialsReconciliationFailedReason used when no IAM access token can be obtained with the credentials.
+	CredentialsReconciliationFailedReason = "CredentialsReconciliationFailed"
+	// TrustedProfileNotAssumedReason used when the IAM trusted profile cannot be assumed with the compute resource token.
+	TrustedProfileNotAssumedReason = "TrustedProfileNotAssumed"
+)
+
+// IBMVPCCluster's and IBMPowerVSCluster's CredentialsReady condition and corresponding reasons that will be used in v1Beta2 API version.
+const (
+	// CredentialsReadyV1Beta2Condition reports on the IBM Cloud credentials used to reconcile a cluster.
+	CredentialsReadyV1Beta2Condition = "CredentialsReady"
+
+	// CredentialsReadyV1Beta2Reason surfaces when an IAM access token was obtained with the credentials.
+	CredentialsReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// CredentialsNotReadyV1Beta2Reason surfaces when no IAM access token can be obtained with the credentials.
+	CredentialsNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// CredentialsTrustedProfileNotAssumedV1Beta2Reason surfaces when the IAM trusted profile cannot be assumed
+	// with the compute resource token.
+	CredentialsTrustedProfileNotAssumedV1Beta2Reason = TrustedProfileNotAssumedReason
+)
+
+const (
+	// SnapshotReadyCondition reports on current status of the snapshot of an IBMPowerVSMachineSnapshot.
+	// Ready indicates the snapshot of the volumes is available and, when requested, the instance is captured to an image.
+	SnapshotReadyCondition clusterv1beta1.ConditionType = "SnapshotReady"
+	// WaitingForIBMPowerVSMachineReason used when the snapshot is waiting for the instance of the IBMPowerVSMachine to be created.
+	WaitingForIBMPowerVSMachineReason = "WaitingForIBMPowerVSMachine"
+	// SnapshotInProgressReason used when the snapshot of the volumes or the capture of the instance is in progress.
+	SnapshotInProgressReason = "SnapshotInProgress"
+	// SnapshotReconciliationFailedReason used when an error occurs during snapshot reconciliation.
+	SnapshotReconciliationFailedReason = "SnapshotReconciliationFailed"
+	// SnapshotFailedReason used when the snapshot of the volumes failed.
+	SnapshotFailedReason = "SnapshotFailed"
+	// ImageCaptureFailedReason used when the capture of the instance to the image catalog failed.
+	ImageCaptureFailedReason = "ImageCaptureFailed"
+)
+
+// IBMPowerVSMachineSnapshot's Ready condition and corresponding reasons that will be used in v1Beta2 API version.
+const (
+	// MachineSnapshotReadyV1Beta2Condition is true if the IBMPowerVSMachineSnapshot's deletionTimestamp is not set and the snapshot is available.
+	MachineSnapshotReadyV1Beta2Condition = clusterv1beta1.ReadyV1Beta2Condition
+
+	// MachineSnapshotReadyV1Beta2Reason surfaces when the snapshot readiness criteria is met.
+	MachineSnapshotReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// MachineSnapshotNotReadyV1Beta2Reason surfaces when the snapshot readiness criteria is not met.
+	MachineSnapshotNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// MachineSnapshotDeletingV1Beta2Reason surfaces when the snapshot is being deleted.
+	MachineSnapshotDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+)
@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1beta2
 
+func (*IBMCloudClusterIdentity) Hub()       {}
+func (*IBMCloudClusterIdentityList) Hub()   {}
 func (*IBMPowerVSCluster) Hub()             {}
 func (*IBMPowerVSClusterList) Hub()         {}
 func (*IBMPowerVSClusterTemplate) Hub()     {}
@@ -26,9 +28,15 @@ func (*IBMPowerVSMachineTemplate) Hub()     {}
 func (*IBMPowerVSMachineTemplateList) Hub() {}
 func (*IBMPowerVSImage) Hub()               {}
 func (*IBMPowerVSImageList) Hub()           {}
+func (*IBMPowerVSMachinePool) Hub()         {}
+func (*IBMPowerVSMachinePoolList) Hub()     {}
+func (*IBMPowerVSMachineSnapshot) Hub()     {}
+func (*IBMPowerVSMachineSnapshotList) Hub() {}
 func (*IBMVPCCluster) Hub()                 {}
 func (*IBMVPCClusterList) Hub()             {}
 func (*IBMVPCMachine) Hub()                 {}
 func (*IBMVPCMachineList) Hub()             {}
 func (*IBMVPCMachineTemplate) Hub()         {}
 func (*IBMVPCMachineTemplateList) Hub()     {}
+func (*IBMVPCMachinePool) Hub()             {}
+func (*IBMVPCMachinePoolList) Hub()         {}
@@ -0,0 +1,128 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IBMCloudIdentityType is the type of the credentials of an IBMCloudClusterIdentity.
+type IBMCloudIdentityType string
+
+const (
+	// IBMCloudIdentityTypeAPIKey authenticates with an IAM API key.
+	IBMCloudIdentityTypeAPIKey = IBMCloudIdentityType("APIKey")
+	// IBMCloudIdentityTypeTrustedProfile authenticates with an IAM trusted profile, assumed with the compute resource
+	// token of the manager, e.g. a projected service account token.
+	IBMCloudIdentityTypeTrustedProfile = IBMCloudIdentityType("TrustedProfile")
+	// IBMCloudIdentityTypeComputeResourceToken authenticates with the compute resource token of the VPC instance
+	// running the manager, fetched from the VPC instance metadata service.
+	IBMCloudIdentityTypeComputeResourceToken = IBMCloudIdentityType("ComputeResourceToken")
+)
+
+// IBMCloudIdentityAPIKeyKey is the key of the API key in the Secret of an identity.
+const IBMCloudIdentityAPIKeyKey = "IBMCLOUD_APIKEY"
+
+// IBMCloudClusterIdentitySpec defines the credentials of an IBMCloudClusterIdentity.
+// +kubebuilder:validation:XValidation:rule="self.type != 'APIKey' || (has(self.secretRef) && has(self.secretRef.__namespace__))",message="secretRef with a namespace is required for the APIKey type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'TrustedProfile' || has(self.trustedProfile)",message="trustedProfile is required for the TrustedProfile type"
+type IBMCloudClusterIdentitySpec struct {
+	// type of the credentials.
+	// +kubebuilder:validation:Enum=APIKey;TrustedProfile;ComputeResourceToken
+	Type IBMCloudIdentityType `json:"type"`
+
+	// secretRef is the Secret holding the API key under the IBMCLOUD_APIKEY key, for the APIKey type.
+	// The namespace of the Secret is required.
+	// +optional
+	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
+
+	// trustedProfile is the IAM trusted profile to assume, for the TrustedProfile and ComputeResourceToken types.
+	// For the ComputeResourceToken type, the default trusted profile of the VPC instance is used when not set.
+	// +optional
+	TrustedProfile *IBMCloudTrustedProfile `json:"trustedProfile,omitempty"`
+
+	// authURL is the URL of the IAM token service, defaults to https://iam.cloud.ibm.com.
+	// For the ComputeResourceToken type, it is the URL of the VPC instance metadata service, defaults to http://169.254.169.254.
+	// +optional
+	AuthURL string `json:"authURL,omitempty"`
+
+	// allowedNamespaces restricts the namespaces of the clusters that can use the identity.
+	// An empty allowedNamespaces allows all the namespaces, a nil allowedNamespaces allows none.
+	// +optional
+	AllowedNamespaces *AllowedNamespaces `json:"allowedNamespaces,omitempty"`
+}
+
+// IBMCloudTrustedProfile identifies an IAM trusted profile.
+// +kubebuilder:validation:XValidation:rule="has(self.id) || has(self.name) || has(self.crn)",message="one of id, name or crn must be set"
+type IBMCloudTrustedProfile struct {
+	// id of the trusted profile.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// name of the trusted profile, only supported by the TrustedProfile type.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// crn of the trusted profile, only supported by the ComputeResourceToken type.
+	// +optional
+	CRN string `json:"crn,omitempty"`
+
+	// crTokenFilename is the file holding the compute resource token of the manager, for the TrustedProfile type.
+	// Defaults to the projected service account token of the IBM Cloud Kubernetes and Red Hat OpenShift services.
+	// +optional
+	CRTokenFilename string `json:"crTokenFilename,omitempty"`
+}
+
+// AllowedNamespaces selects namespaces.
+type AllowedNamespaces struct {
+	// list of namespaces.
+	// +optional
+	// +listType=set
+	NamespaceList []string `json:"list,omitempty"`
+
+	// selector is a label selector of namespaces.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=ibmcloudclusteridentities,scope=Cluster,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Type of the credentials"
+
+// IBMCloudClusterIdentity is the Schema for the ibmcloudclusteridentities API.
+// It holds IBM Cloud credentials that the clusters of the allowed namespaces can use through their identityRef.
+type IBMCloudClusterIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IBMCloudClusterIdentitySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IBMCloudClusterIdentityList contains a list of IBMCloudClusterIdentity.
+type IBMCloudClusterIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IBMCloudClusterIdentity `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &IBMCloudClusterIdentity{}, &IBMCloudClusterIdentityList{})
+}
@@ -139,9 +139,64 @@ type IBMPowerVSClusterSpec struct {
 	// +optional
 	CosInstance *CosInstance `json:"cosInstance,omitempty"`
 
+	// placementGroups is the list of server placement groups owned by the cluster, at most one per policy.
+	// The placement groups are created in the Power VS workspace and deleted with the cluster,
+	// and are referenced by the machines of the cluster through their placementGroup.policy.
+	// They are only reconciled when powervs.cluster.x-k8s.io/create-infra=true annotation is set on IBMPowerVSCluster resource.
+	// +listType=map
+	// +listMapKey=policy
+	// +optional
+	PlacementGroups []PowerVSPlacementGroup `json:"placementGroups,omitempty"`
+
+	// sharedProcessorPools is the list of shared processor pools owned by the cluster.
+	// The shared processor pools are created in the Power VS workspace and deleted with the cluster,
+	// and are referenced by the machines of the cluster through their sharedProcessorPool.name.
+	// They are only reconciled when powervs.cluster.x-k8s.io/create-infra=true annotation is set on IBMPowerVSCluster resource.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	SharedProcessorPools []PowerVSSharedProcessorPool `json:"sharedProcessorPools,omitempty"`
+
+	// additionalNetworks is the list of Power VS networks owned by the cluster in addition to its network, e.g. a workload network and a storage network.
+	// The networks are created in the Power VS workspace and deleted with the cluster,
+	// and are attached to the machines of the cluster through the name of their networks.
+	// They are only reconciled when powervs.cluster.x-k8s.io/create-infra=true annotation is set on IBMPowerVSCluster resource.
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	AdditionalNetworks []PowerVSNetwork `json:"additionalNetworks,omitempty"`
+
+	// controlPlaneDNS is the IBM Cloud DNS Services private zone serving the name of the control-plane endpoint.
+	// When set, the control-plane endpoint is set to api.<zone> instead of the hostname of the load balancer.
+	// It is only reconciled when powervs.cluster.x-k8s.io/create-infra=true annotation is set on IBMPowerVSCluster resource.
+	// It is immutable, the resources created in the zone are deleted with the cluster.
+	// +optional
+	ControlPlaneDNS *ControlPlaneDNS `json:"controlPlaneDNS,omitempty"`
+
+	// dns is the IBM Cloud Internet Services domain serving the public DNS records of the cluster.
+	// When set, the control-plane endpoint is set to the name of the api record instead of the hostname of the public load balancer,
+	// one of the load balancers must be public.
+	// It is only reconciled when powervs.cluster.x-k8s.io/create-infra=true annotation is set on IBMPowerVSCluster resource.
+	// +optional
+	DNS *DNS `json:"dns,omitempty"`
+
+	// vpeGateways is the list of VPC Virtual Private Endpoint gateways giving the cluster private access to IBM Cloud services.
+	// The VPE gateways are created in the VPC, bound to the vpcSubnets and vpcSecurityGroups, and deleted with the cluster.
+	// They are only reconciled when powervs.cluster.x-k8s.io/create-infra=true annotation is set on IBMPowerVSCluster resource.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	// +optional
+	VPEGateways []VPEGateway `json:"vpeGateways,omitempty"`
+
 	// Ignition defined options related to the bootstrapping systems where Ignition is used.
 	// +optional
 	Ignition *Ignition `json:"ignition,omitempty"`
+
+	// identityRef references the credentials used to manage the cloud resources of the cluster.
+	// When not set, the credentials of the manager are used.
+	// +optional
+	IdentityRef *IBMCloudIdentityReference `json:"identityRef,omitempty"`
 }
 
 // Ignition defines options related to the bootstrapping systems where Ignition is used.
@@ -174,6 +229,105 @@ type DHCPServer struct {
 	Snat *bool `json:"snat,omitempty"`
 }
 
+// PowerVSPlacementGroup defines a server placement group owned by an IBMPowerVSCluster.
+type PowerVSPlacementGroup struct {
+	// name of the placement group.
+	// when omitted, the name is set to CLUSTER_NAME-POLICY.
+	// when a placement group with name exists in the Power VS workspace, it is used instead of creating a new one, and is not deleted with the cluster.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// policy of the placement group.
+	// anti-affinity places the instances of the placement group on different hosts, affinity on the same host.
+	// +kubebuilder:validation:Enum=affinity;anti-affinity
+	// +required
+	Policy PowerVSPlacementGroupPolicy `json:"policy"`
+}
+
+// PowerVSSharedProcessorPool defines a shared processor pool owned by an IBMPowerVSCluster.
+type PowerVSSharedProcessorPool struct {
+	// name of the shared processor pool.
+	// when a shared processor pool with name exists in the Power VS workspace, it is used instead of creating a new one, and is not deleted with the cluster.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=12
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_]+$`
+	// +required
+	Name string `json:"name"`
+
+	// reservedCores is the number of cores reserved for the shared processor pool.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	ReservedCores int64 `json:"reservedCores"`
+
+	// hostGroup is the system type of the host on which the shared processor pool is created.
+	// The instances of the shared processor pool must have the same systemType.
+	// When omitted, the shared processor pool is created on s922 hosts.
+	// +kubebuilder:validation:Enum:="s922";"e980";"s1022";"e1050";"e1080";"s1122"
+	// +optional
+	HostGroup string `json:"hostGroup,omitempty"`
+}
+
+// PowerVSNetwork defines a Power VS network owned by an IBMPowerVSCluster.
+// +kubebuilder:validation:XValidation:rule="self.type != 'private' || has(self.cidr)",message="cidr is required for private networks"
+// +kubebuilder:validation:XValidation:rule="self.type != 'public' || !has(self.cidr)",message="cidr is not supported for public networks"
+// +kubebuilder:validation:XValidation:rule="self.type != 'dhcp' || !has(self.dnsServers) || size(self.dnsServers) <= 1",message="dhcp networks support a single DNS server"
+// +kubebuilder:validation:XValidation:rule="self.type != 'dhcp' || self.name.matches('^[a-zA-Z0-9-]+$')",message="the name of a dhcp network can only contain alphanumeric characters and dashes"
+// +kubebuilder:validation:XValidation:rule="self.type == 'dhcp' || !has(self.snat)",message="snat is only supported for dhcp networks"
+// +kubebuilder:validation:XValidation:rule="self.type == 'private' || !has(self.mtu)",message="mtu is only supported for private networks"
+type PowerVSNetwork struct {
+	// name of the network, by which the machines of the cluster attach to it.
+	// a dhcp network is created by a DHCP server with name, and is named DHCPSERVER<name>_Private in the Power VS workspace.
+	// when a network with name exists in the Power VS workspace, it is used instead of creating a new one, and is not deleted with the cluster.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +required
+	Name string `json:"name"`
+
+	// type of the network.
+	// public networks are connected to the internet, private networks are not,
+	// and dhcp networks are private networks whose addresses are served by a DHCP server, optionally reaching the internet through SNAT.
+	// +kubebuilder:validation:Enum=public;private;dhcp
+	// +kubebuilder:default=private
+	// +optional
+	Type PowerVSNetworkType `json:"type,omitempty"`
+
+	// cidr of the network, required for private networks.
+	// when omitted for a dhcp network, the DHCP server picks the cidr.
+	// +kubebuilder:validation:Format=cidr
+	// +optional
+	CIDR *string `json:"cidr,omitempty"`
+
+	// dnsServers is the list of DNS servers of the network.
+	// dhcp networks support a single DNS server, which defaults to 1.1.1.1.
+	// +kubebuilder:validation:MaxItems=4
+	// +listType=atomic
+	// +optional
+	DNSServers []string `json:"dnsServers,omitempty"`
+
+	// snat indicates if SNAT is enabled on the DHCP server of a dhcp network, defaults to true.
+	// +optional
+	SNAT *bool `json:"snat,omitempty"`
+
+	// mtu is the maximum transmission unit of a private network, 9000 enables jumbo frames.
+	// when omitted, the network is created with an mtu of 1450.
+	// +kubebuilder:validation:Minimum=1450
+	// +kubebuilder:validation:Maximum=9000
+	// +optional
+	MTU *int64 `json:"mtu,omitempty"`
+}
+
+// PowerVSNetworkStatus defines the status of a Power VS network owned by an IBMPowerVSCluster.
+type PowerVSNetworkStatus struct {
+	// id represents the id of the network.
+	ID *string `json:"id,omitempty"`
+	// +kubebuilder:default=false
+	// controllerCreated indicates whether the network is created by the controller.
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
+	// dhcpServerID is the id of the DHCP server of a dhcp network.
+	DHCPServerID *string `json:"dhcpServerID,omitempty"`
+}
+
 // ResourceReference identifies a resource with id.
 type ResourceReference struct {
 	// id represents the id of the resource.
@@ -232,6 +386,28 @@ type IBMPowerVSClusterStatus struct {
 	// loadBalancers reference to IBM Cloud VPC Loadbalancer.
 	LoadBalancers map[string]VPCLoadBalancerStatus `json:"loadBalancers,omitempty"`
 
+	// placementGroups is reference to the Power VS server placement groups, keyed by policy.
+	PlacementGroups map[string]ResourceReference `json:"placementGroups,omitempty"`
+
+	// sharedProcessorPools is reference to the Power VS shared processor pools, keyed by name.
+	SharedProcessorPools map[string]ResourceReference `json:"sharedProcessorPools,omitempty"`
+
+	// additionalNetworks is reference to the additional Power VS networks, keyed by name.
+	AdditionalNetworks map[string]PowerVSNetworkStatus `json:"additionalNetworks,omitempty"`
+
+	// controlPlaneDNS is the status of the DNS Services resources of the control-plane endpoint.
+	ControlPlaneDNS *ControlPlaneDNSStatus `json:"controlPlaneDNS,omitempty"`
+
+	// dns is the status of the public DNS records of the cluster.
+	DNS *DNSStatus `json:"dns,omitempty"`
+
+	// vpeGateways is reference to IBM Cloud VPC VPE gateways, keyed by name.
+	VPEGateways map[string]ResourceReference `json:"vpeGateways,omitempty"`
+
+	// failureDomains is a list of failure domains for the cluster, containing the zone of the Power VS workspace.
+	// +optional
+	FailureDomains clusterv1beta1.FailureDomains `json:"failureDomains,omitempty"`
+
 	// Conditions defines current service state of the IBMPowerVSCluster.
 	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
 
@@ -294,6 +470,55 @@ type TransitGateway struct {
 	// when the field is omitted,  based on PowerVS region (region associated with IBMPowerVSCluster.Spec.Zone) and VPC region(IBMPowerVSCluster.Spec.VPC.Region) system will decide whether to enable globalRouting or not.
 	// +optional
 	GlobalRouting *bool `json:"globalRouting,omitempty"`
+	// shared indicates that the transit gateway is shared with other clusters and is not managed by the controller.
+	// when set to true, the transit gateway referenced by id or name must already exist. The controller only attaches
+	// the cluster's PowerVS and VPC connections to it and detaches them when the cluster is deleted, other connections
+	// are left untouched and the transit gateway itself is never created or deleted.
+	// +optional
+	Shared *bool `json:"shared,omitempty"`
+	// powerVSConnection defines the PowerVS connection created by the controller in the transit gateway.
+	// +optional
+	PowerVSConnection *TransitGatewayConnection `json:"powerVSConnection,omitempty"`
+	// vpcConnection defines the VPC connection created by the controller in the transit gateway.
+	// +optional
+	VPCConnection *TransitGatewayConnection `json:"vpcConnection,omitempty"`
+}
+
+// TransitGatewayConnection holds the options of a transit gateway connection created by the controller.
+// The options are only applied when the connection is created, changing them afterwards has no effect on an existing connection.
+type TransitGatewayConnection struct {
+	// prefixFilters is the ordered list of prefix filters applied to the routes learned over the connection.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=atomic
+	// +optional
+	PrefixFilters []TransitGatewayPrefixFilter `json:"prefixFilters,omitempty"`
+	// prefixFiltersDefault is the action applied to the routes not matching any of the prefix filters.
+	// when omitted, the routes are permitted.
+	// +optional
+	PrefixFiltersDefault TransitGatewayPrefixFilterAction `json:"prefixFiltersDefault,omitempty"`
+}
+
+// TransitGatewayPrefixFilter defines a prefix filter of a transit gateway connection.
+// +kubebuilder:validation:XValidation:rule="!has(self.ge) || !has(self.le) || self.ge <= self.le",message="ge must be less than or equal to le"
+type TransitGatewayPrefixFilter struct {
+	// action is whether the routes matching the filter are permitted or denied.
+	// +required
+	Action TransitGatewayPrefixFilterAction `json:"action"`
+	// prefix is the IPv4 network prefix, in CIDR notation, the routes are matched against.
+	// +kubebuilder:validation:MinLength=9
+	// +kubebuilder:validation:MaxLength=18
+	// +required
+	Prefix string `json:"prefix"`
+	// ge matches the routes whose prefix length is greater than or equal to the value.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	// +optional
+	Ge *int64 `json:"ge,omitempty"`
+	// le matches the routes whose prefix length is less than or equal to the value.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	// +optional
+	Le *int64 `json:"le,omitempty"`
 }
 
 // VPCResourceReference is a reference to a specific VPC resource by ID or Name
@@ -29,6 +29,9 @@ import (
 // PowerVSProcessorType enum attribute to identify the PowerVS instance processor type.
 type PowerVSProcessorType string
 
+// PowerVSResizePolicy enum attribute to identify how changes to the processors and memory of a machine are applied to its instance.
+type PowerVSResizePolicy string
+
 const (
 	// IBMPowerVSMachineFinalizer allows IBMPowerVSMachineReconciler to clean up resources associated with IBMPowerVSMachine before
 	// removing it from the apiserver.
@@ -39,6 +42,10 @@ const (
 	PowerVSProcessorTypeShared PowerVSProcessorType = "Shared"
 	// PowerVSProcessorTypeCapped enum property to identify a Capped Power VS processor type.
 	PowerVSProcessorTypeCapped PowerVSProcessorType = "Capped"
+	// PowerVSResizePolicyImmutable enum property to identify that the processors and memory of a machine can't be changed.
+	PowerVSResizePolicyImmutable PowerVSResizePolicy = "Immutable"
+	// PowerVSResizePolicyInPlace enum property to identify that the instance of a machine is resized in place when its processors or memory are changed.
+	PowerVSResizePolicyInPlace PowerVSResizePolicy = "InPlace"
 	// DefaultIgnitionVersion represents default Ignition version generated for machine userdata.
 	DefaultIgnitionVersion = "2.3"
 )
@@ -119,15 +126,269 @@ type IBMPowerVSMachineSpec struct {
 	// +optional
 	MemoryGiB int32 `json:"memoryGiB,omitempty"`
 
+	// profile is the ID of the SAP profile of the instance, e.g. ush1-4x128, which determines its dedicated cores and its memory.
+	// SAP profiles are named <family>-<cores>x<memory in GiB>, custom profiles included, and must be available in the zone of the service instance.
+	// The instance is created with the Power VS SAP API when profile is set.
+	// profile is mutually exclusive with systemType, processorType, processors and memoryGiB, which are set by the profile,
+	// and with sharedProcessorPool.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+-[0-9]+x[0-9]+$`
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// resizePolicy defines how changes to processors and memoryGiB are applied to the instance of the machine.
+	// When set to InPlace, processors and memoryGiB can be changed and the running instance is resized in place (DLPAR),
+	// within the maximum processors and memory the instance was deployed with.
+	// When omitted or set to Immutable, processors and memoryGiB can't be changed once the machine is created.
+	// +kubebuilder:validation:Enum=Immutable;InPlace
+	// +optional
+	ResizePolicy PowerVSResizePolicy `json:"resizePolicy,omitempty"`
+
 	// Network is the reference to the Network to use for this instance.
 	// supported network identifier in IBMPowerVSResourceReference are Name, ID and RegEx and that can be obtained from IBM Cloud UI or IBM Cloud cli.
-	Network IBMPowerVSResourceReference `json:"network"`
+	// When none of them is set, the network of the IBMPowerVSCluster is used.
+	// +optional
+	Network IBMPowerVSResourceReference `json:"network,omitempty"`
+
+	// networks is the list of networks attached to the instance, e.g. storage or replication networks in addition to the network of the cluster.
+	// The first network is the primary network of the instance, whose address is its internal IP.
+	// When set, networks replaces network, which must then be empty.
+	// Fixed IP addresses are not supported by the instances of an IBMPowerVSMachinePool and are ignored.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=atomic
+	// +optional
+	Networks []PowerVSNetworkAttachment `json:"networks,omitempty"`
+
+	// additionalVolumes is the list of data volumes to create and attach to the instance, in addition to its boot volume.
+	// The volumes are created once the instance is active, and are deleted with the machine unless their deletePolicy is retain.
+	// additionalVolumes are not supported by the instances of an IBMPowerVSMachinePool and are rejected there.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	AdditionalVolumes []PowerVSAdditionalVolume `json:"additionalVolumes,omitempty"`
+
+	// placementGroup is the reference to the Power VS server placement group the instance is added to when it is created.
+	// The instances of an anti-affinity placement group are placed on different hosts, and those of an affinity placement group on the same host.
+	// when placementGroup.ID or placementGroup.Name is set, its expected that there exist a placement group in the Power VS workspace or else system will give error.
+	// when placementGroup.Policy is set, the placement group with this policy owned by the IBMPowerVSCluster is used,
+	// which must be listed in the placementGroups of the IBMPowerVSCluster.
+	// +optional
+	PlacementGroup *PowerVSPlacementGroupReference `json:"placementGroup,omitempty"`
+
+	// sharedProcessorPool is the reference to the Power VS shared processor pool the instance is created in.
+	// A shared processor pool caps the cores used by its instances to the cores reserved for the pool.
+	// supported sharedProcessorPool identifier in IBMPowerVSResourceReference are Name and ID and that can be obtained from IBM Cloud UI or IBM Cloud cli.
+	// the shared processor pools owned by the IBMPowerVSCluster are referenced by their name.
+	// sharedProcessorPool can only be set when processorType is Shared or Capped.
+	// SharedProcessorPool.RegEx is not yet supported and system will ignore the value.
+	// +optional
+	SharedProcessorPool *IBMPowerVSResourceReference `json:"sharedProcessorPool,omitempty"`
+
+	// deploymentTarget pins the instance to a dedicated host or to a host of a dedicated host group.
+	// deploymentTarget and placementGroup are mutually exclusive.
+	// +optional
+	DeploymentTarget *PowerVSDeploymentTarget `json:"deploymentTarget,omitempty"`
+
+	// storageType is the storage tier of the boot volume of the instance.
+	// When omitted, the boot volume is created on the storage tier of the image.
+	// +kubebuilder:validation:Enum=tier0;tier1;tier3;tier5k
+	// +optional
+	StorageType string `json:"storageType,omitempty"`
+
+	// storagePool is the name of the storage pool in which the boot volume of the instance is created.
+	// When omitted, the boot volume is created in the storage pool of the image, or in the storage pool selected by storageAffinity.
+	// storagePool and storageAffinity are mutually exclusive.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	StoragePool string `json:"storagePool,omitempty"`
+
+	// storageAffinity places the boot volume of the instance in the same storage pool as other instances, with affinity,
+	// or in a different storage pool, with anti-affinity.
+	// storagePool and storageAffinity are mutually exclusive.
+	// +optional
+	StorageAffinity *PowerVSStorageAffinity `json:"storageAffinity,omitempty"`
+
+	// diagnostics configures the diagnostics collected for the instance when it fails.
+	// A summary of the diagnostics is always kept in the status of the machine.
+	// +optional
+	Diagnostics *PowerVSDiagnostics `json:"diagnostics,omitempty"`
 
 	// ProviderID is the unique identifier as specified by the cloud provider.
 	// +optional
 	ProviderID *string `json:"providerID,omitempty"`
 }
 
+// PowerVSAdditionalVolume defines a data volume attached to a Power VS instance.
+type PowerVSAdditionalVolume struct {
+	// name identifies the volume within the machine.
+	// The Power VS volume is named after the machine and this name, <machine name>-<name>.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +required
+	Name string `json:"name"`
+
+	// sizeGiB is the size of the volume, in GiB.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	SizeGiB int64 `json:"sizeGiB"`
+
+	// tier is the storage tier of the volume.
+	// When omitted, the volume is created on tier3 storage.
+	// +kubebuilder:validation:Enum=tier0;tier1;tier3;tier5k
+	// +optional
+	Tier string `json:"tier,omitempty"`
+
+	// storagePool is the name of the storage pool in which the volume is created.
+	// When set, affinityPolicy is ignored.
+	// +optional
+	StoragePool string `json:"storagePool,omitempty"`
+
+	// shareable indicates whether the volume can be attached to other instances.
+	// +optional
+	Shareable bool `json:"shareable,omitempty"`
+
+	// affinityPolicy places the volume on the same storage as the volumes of the instance, with affinity,
+	// or on a different storage, with anti-affinity.
+	// When omitted, the volume is placed in the storage pool with the most available space for its tier.
+	// +kubebuilder:validation:Enum=affinity;anti-affinity
+	// +optional
+	AffinityPolicy PowerVSVolumeAffinityPolicy `json:"affinityPolicy,omitempty"`
+
+	// deletePolicy defines whether the volume is deleted with the machine, or retained.
+	// +kubebuilder:default=delete
+	// +kubebuilder:validation:Enum=delete;retain
+	// +optional
+	DeletePolicy string `json:"deletePolicy,omitempty"`
+}
+
+// PowerVSDeploymentTarget is a dedicated host or dedicated host group a Power VS instance is deployed on.
+type PowerVSDeploymentTarget struct {
+	// type is the type of the deployment target.
+	// host deploys the instance on the dedicated host, hostGroup on any host of the dedicated host group.
+	// +kubebuilder:validation:Enum=host;hostGroup
+	// +required
+	Type PowerVSDeploymentTargetType `json:"type"`
+
+	// id is the ID of the dedicated host or dedicated host group.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ID string `json:"id"`
+}
+
+// PowerVSStorageAffinity defines the storage pool of the boot volume of a Power VS instance relative to other instances.
+type PowerVSStorageAffinity struct {
+	// policy is the storage affinity policy.
+	// affinity places the boot volume in the storage pool of the instance listed in instances, which must have exactly one entry.
+	// anti-affinity places the boot volume in a storage pool other than those of the instances listed in instances.
+	// +kubebuilder:validation:Enum=affinity;anti-affinity
+	// +required
+	Policy PowerVSVolumeAffinityPolicy `json:"policy"`
+
+	// instances is the list of names or IDs of the Power VS instances the policy applies to.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:MinLength=1
+	// +listType=set
+	// +required
+	Instances []string `json:"instances"`
+}
+
+// PowerVSDiagnostics configures the diagnostics collected for a Power VS instance.
+type PowerVSDiagnostics struct {
+	// uploadToCOS writes the full diagnostics of the instance, its fault and Power VS events,
+	// to the COS bucket of the IBMPowerVSCluster, under diagnostics/<machine name>/.
+	// The objects are kept when the machine is deleted.
+	// +optional
+	UploadToCOS bool `json:"uploadToCOS,omitempty"`
+}
+
+// PowerVSInstanceDiagnostics is the summary of the diagnostics collected for a Power VS instance.
+type PowerVSInstanceDiagnostics struct {
+	// trigger is what the diagnostics were collected for.
+	// +required
+	Trigger PowerVSDiagnosticsTrigger `json:"trigger"`
+
+	// collectedAt is the time the diagnostics were collected.
+	// +required
+	CollectedAt metav1.Time `json:"collectedAt"`
+
+	// instanceState is the state of the instance when the diagnostics were collected.
+	// +optional
+	InstanceState PowerVSInstanceState `json:"instanceState,omitempty"`
+
+	// fault is the fault reported by Power VS for the instance, truncated to 1024 characters.
+	// +optional
+	Fault string `json:"fault,omitempty"`
+
+	// events are the most recent Power VS events of the instance, oldest first, each truncated to 256 characters.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=atomic
+	// +optional
+	Events []string `json:"events,omitempty"`
+
+	// objectKey is the key of the object with the full diagnostics in the COS bucket of the IBMPowerVSCluster,
+	// when diagnostics.uploadToCOS is set.
+	// +optional
+	ObjectKey string `json:"objectKey,omitempty"`
+}
+
+// PowerVSInstanceResize is an in-place resize of a Power VS instance requested by the controller.
+type PowerVSInstanceResize struct {
+	// processors is the number of processors the instance is being resized to.
+	// +required
+	Processors intstr.IntOrString `json:"processors"`
+
+	// memoryGiB is the memory in GiB the instance is being resized to.
+	// +required
+	MemoryGiB int32 `json:"memoryGiB"`
+
+	// requestedAt is the time the resize was requested.
+	// +required
+	RequestedAt metav1.Time `json:"requestedAt"`
+}
+
+// PowerVSPlacementGroupReference is a reference to a Power VS server placement group by ID, Name or Policy.
+// Only one of ID, Name or Policy may be specified.
+// +kubebuilder:validation:XValidation:rule="[has(self.id), has(self.name), has(self.policy)].filter(x, x).size() == 1",message="exactly one of id, name or policy must be set"
+type PowerVSPlacementGroupReference struct {
+	// id is the ID of an existing placement group.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// name is the name of an existing placement group.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// policy selects the placement group with this policy owned by the IBMPowerVSCluster.
+	// +kubebuilder:validation:Enum=affinity;anti-affinity
+	// +optional
+	Policy *PowerVSPlacementGroupPolicy `json:"policy,omitempty"`
+}
+
+// PowerVSVolumeStatus defines the observed state of a data volume of a Power VS instance.
+type PowerVSVolumeStatus struct {
+	// name is the name of the volume in the machine's additionalVolumes.
+	// +required
+	Name string `json:"name"`
+
+	// id is the ID of the Power VS volume.
+	// +required
+	ID string `json:"id"`
+
+	// state is the state of the Power VS volume.
+	// +optional
+	State PowerVSVolumeState `json:"state,omitempty"`
+
+	// attached is true when the volume is attached to the instance.
+	// +optional
+	Attached bool `json:"attached,omitempty"`
+
+	// deletePolicy is the deletePolicy of the volume when it was created.
+	// +optional
+	DeletePolicy string `json:"deletePolicy,omitempty"`
+}
+
 // IBMPowerVSResourceReference is a reference to a specific PowerVS resource by ID, Name or RegEx
 // Only one of ID, Name or RegEx may be specified. Specifying more than one will result in
 // a validation error.
@@ -149,6 +410,19 @@ type IBMPowerVSResourceReference struct {
 	RegEx *string `json:"regex,omitempty"`
 }
 
+// PowerVSNetworkAttachment is a network attached to a PowerVS instance.
+// The network is referenced by ID, Name or RegEx, and the network of the IBMPowerVSCluster is used when none of them is set.
+// A Name matching one of the additionalNetworks of the IBMPowerVSCluster refers to that network.
+type PowerVSNetworkAttachment struct {
+	IBMPowerVSResourceReference `json:",inline"`
+
+	// ipAddress is the fixed IPv4 address of the instance on the network.
+	// When omitted, the address is allocated by the network.
+	// +kubebuilder:validation:Format=ipv4
+	// +optional
+	IPAddress *string `json:"ipAddress,omitempty"`
+}
+
 // IBMPowerVSMachineStatus defines the observed state of IBMPowerVSMachine.
 type IBMPowerVSMachineStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -229,6 +503,22 @@ type IBMPowerVSMachineStatus struct {
 	// Zone specifies the Power VS Service instance zone.
 	Zone *string `json:"zone,omitempty"`
 
+	// additionalVolumes references the data volumes created for the instance.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	AdditionalVolumes []PowerVSVolumeStatus `json:"additionalVolumes,omitempty"`
+
+	// diagnostics is the summary of the diagnostics last collected for the instance,
+	// when it failed, when it did not get a network address in time, or on request with the collect-diagnostics annotation.
+	// +optional
+	Diagnostics *PowerVSInstanceDiagnostics `json:"diagnostics,omitempty"`
+
+	// resize is the in-place resize of the instance requested from Power VS,
+	// cleared once the instance reports the requested processors and memory.
+	// +optional
+	Resize *PowerVSInstanceResize `json:"resize,omitempty"`
+
 	// v1beta2 groups all the fields that will be added or modified in IBMPowerVSMachine's status with the V1Beta2 version.
 	// +optional
 	V1Beta2 *IBMPowerVSMachineV1Beta2Status `json:"v1beta2,omitempty"`
@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
+)
+
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+const (
+	// IBMPowerVSMachinePoolFinalizer allows IBMPowerVSMachinePoolReconciler to clean up resources associated with IBMPowerVSMachinePool before
+	// removing it from the apiserver.
+	IBMPowerVSMachinePoolFinalizer = "ibmpowervsmachinepool.infrastructure.cluster.x-k8s.io"
+)
+
+// IBMPowerVSMachinePoolSpec defines the desired state of IBMPowerVSMachinePool.
+type IBMPowerVSMachinePoolSpec struct {
+	// providerIDList are the identification IDs of the instances provided by the provider.
+	// This field is populated by the controller and must match the nodes' providerIDs.
+	// +optional
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// template is the specification used to create each Power VS instance in the pool.
+	// A change to the template replaces the existing instances, one at a time.
+	// The template's providerID is ignored.
+	// The bootstrap data is passed to the instances as user data, uploading ignition to COS is not supported for machine pools.
+	Template IBMPowerVSMachineTemplateResource `json:"template"`
+}
+
+// IBMPowerVSMachinePoolInstanceStatus defines the observed state of a single instance in the IBMPowerVSMachinePool.
+type IBMPowerVSMachinePoolInstanceStatus struct {
+	// instanceID is the id of the Power VS instance.
+	InstanceID string `json:"instanceID"`
+
+	// name of the instance.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// providerID is the unique identifier of the instance as specified by the cloud provider.
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+
+	// instanceState is the status of the instance.
+	// +optional
+	InstanceState PowerVSInstanceState `json:"instanceState,omitempty"`
+
+	// health is the health of the instance.
+	// +optional
+	Health string `json:"health,omitempty"`
+
+	// addresses contains the instance associated addresses.
+	// +optional
+	Addresses []corev1.NodeAddress `json:"addresses,omitempty"`
+
+	// upToDate is true when the instance was created from the current template.
+	// +optional
+	UpToDate bool `json:"upToDate"`
+
+	// deleting is true once the deletion of the instance has been requested.
+	// +optional
+	Deleting bool `json:"deleting,omitempty"`
+}
+
+// IBMPowerVSMachinePoolStatus defines the observed state of IBMPowerVSMachinePool.
+type IBMPowerVSMachinePoolStatus struct {
+	// ready is true when the provider resource is ready.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// replicas is the most recently observed number of replicas.
+	// +optional
+	Replicas int32 `json:"replicas"`
+
+	// instances contains the status for each instance in the pool.
+	// +optional
+	Instances []IBMPowerVSMachinePoolInstanceStatus `json:"instances,omitempty"`
+
+	// region specifies the Power VS Service instance region.
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// zone specifies the Power VS Service instance zone.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// conditions defines current service state of the IBMPowerVSMachinePool.
+	// +optional
+	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
+
+	// failureReason will be set in the event that there is a terminal problem
+	// reconciling the MachinePool and will contain a succinct value suitable
+	// for machine interpretation.
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// failureMessage will be set in the event that there is a terminal problem
+	// reconciling the MachinePool and will contain a more verbose string suitable
+	// for logging and human consumption.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// v1beta2 groups all the fields that will be added or modified in IBMPowerVSMachinePool's status with the V1Beta2 version.
+	// +optional
+	V1Beta2 *IBMPowerVSMachinePoolV1Beta2Status `json:"v1beta2,omitempty"`
+}
+
+// IBMPowerVSMachinePoolV1Beta2Status groups all the fields that will be added or modified in IBMPowerVSMachinePoolStatus with the V1Beta2 version.
+// See https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20240916-improve-status-in-CAPI-resources.md for more context.
+type IBMPowerVSMachinePoolV1Beta2Status struct {
+	// conditions represents the observations of a IBMPowerVSMachinePool's current state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this IBMPowerVSMachinePool belongs"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Number of instances in the pool"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Machine pool ready status"
+
+// IBMPowerVSMachinePool is the Schema for the ibmpowervsmachinepools API.
+type IBMPowerVSMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IBMPowerVSMachinePoolSpec   `json:"spec,omitempty"`
+	Status IBMPowerVSMachinePoolStatus `json:"status,omitempty"`
+}
+
+// GetConditions returns the observations of the operational state of the IBMPowerVSMachinePool resource.
+func (r *IBMPowerVSMachinePool) GetConditions() clusterv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the IBMPowerVSMachinePool to the predescribed clusterv1beta1.Conditions.
+func (r *IBMPowerVSMachinePool) SetConditions(conditions clusterv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// GetV1Beta2Conditions returns the set of conditions for this object.
+func (r *IBMPowerVSMachinePool) GetV1Beta2Conditions() []metav1.Condition {
+	if r.Status.V1Beta2 == nil {
+		return nil
+	}
+	return r.Status.V1Beta2.Conditions
+}
+
+// SetV1Beta2Conditions sets conditions for an API object.
+func (r *IBMPowerVSMachinePool) SetV1Beta2Conditions(conditions []metav1.Condition) {
+	if r.Status.V1Beta2 == nil {
+		r.Status.V1Beta2 = &IBMPowerVSMachinePoolV1Beta2Status{}
+	}
+	r.Status.V1Beta2.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// IBMPowerVSMachinePoolList contains a list of IBMPowerVSMachinePool.
+type IBMPowerVSMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IBMPowerVSMachinePool `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &IBMPowerVSMachinePool{}, &IBMPowerVSMachinePoolList{})
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
+)
+
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+const (
+	// IBMPowerVSMachineSnapshotFinalizer allows IBMPowerVSMachineSnapshotReconciler to clean up resources associated with IBMPowerVSMachineSnapshot before
+	// removing it from the apiserver.
+	IBMPowerVSMachineSnapshotFinalizer = "ibmpowervsmachinesnapshot.infrastructure.cluster.x-k8s.io"
+)
+
+// IBMPowerVSMachineSnapshotSpec defines the desired state of IBMPowerVSMachineSnapshot.
+type IBMPowerVSMachineSnapshotSpec struct {
+	// machineName is the name of the IBMPowerVSMachine, in the namespace of the snapshot, whose instance volumes are snapshotted.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="machineName is immutable"
+	MachineName string `json:"machineName"`
+
+	// volumeIDs are the ids of the volumes of the instance to snapshot.
+	// When omitted, all the volumes attached to the instance are snapshotted.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="volumeIDs is immutable"
+	// +optional
+	VolumeIDs []string `json:"volumeIDs,omitempty"`
+
+	// description of the snapshot.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// retentionPeriod is how long the snapshot is kept after it is created.
+	// Once the period expires, the IBMPowerVSMachineSnapshot and its Power VS snapshot are deleted.
+	// When omitted, the snapshot is kept until the IBMPowerVSMachineSnapshot is deleted.
+	// +optional
+	RetentionPeriod *metav1.Duration `json:"retentionPeriod,omitempty"`
+
+	// captureImage captures the instance to an image in the image catalog of the Power VS workspace once the snapshot is available.
+	// The image contains the boot volume and the data volumes listed in volumeIDs.
+	// The id of the image is reported in status.imageID and can be used as the image of a new IBMPowerVSMachine.
+	// The image is not deleted with the IBMPowerVSMachineSnapshot.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="captureImage is immutable"
+	// +optional
+	CaptureImage bool `json:"captureImage,omitempty"`
+}
+
+// IBMPowerVSMachineSnapshotStatus defines the observed state of IBMPowerVSMachineSnapshot.
+type IBMPowerVSMachineSnapshotStatus struct {
+	// ready is true when the snapshot is available and, when requested, the instance is captured to an image.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// serviceInstanceID is the id of the Power VS workspace of the snapshot.
+	// +optional
+	ServiceInstanceID string `json:"serviceInstanceID,omitempty"`
+
+	// instanceID is the id of the Power VS instance that was snapshotted.
+	// +optional
+	InstanceID string `json:"instanceID,omitempty"`
+
+	// snapshotID is the id of the Power VS snapshot.
+	// +optional
+	SnapshotID string `json:"snapshotID,omitempty"`
+
+	// state is the state of the Power VS snapshot.
+	// +optional
+	State PowerVSSnapshotState `json:"state,omitempty"`
+
+	// percentComplete is the progress of the snapshot.
+	// +optional
+	PercentComplete int64 `json:"percentComplete,omitempty"`
+
+	// volumeSnapshots maps the ids of the snapshotted volumes to the ids of their snapshots.
+	// +optional
+	VolumeSnapshots map[string]string `json:"volumeSnapshots,omitempty"`
+
+	// creationTime is the time the snapshot was created.
+	// +optional
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// expirationTime is the time after which the snapshot is deleted as per spec.retentionPeriod.
+	// +optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+
+	// captureJobID is the id of the job capturing the instance to the image catalog.
+	// +optional
+	CaptureJobID string `json:"captureJobID,omitempty"`
+
+	// imageID is the id of the image the instance was captured to.
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
+
+	// conditions defines current service state of the IBMPowerVSMachineSnapshot.
+	// +optional
+	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
+
+	// v1beta2 groups all the fields that will be added or modified in IBMPowerVSMachineSnapshot's status with the V1Beta2 version.
+	// +optional
+	V1Beta2 *IBMPowerVSMachineSnapshotV1Beta2Status `json:"v1beta2,omitempty"`
+}
+
+// IBMPowerVSMachineSnapshotV1Beta2Status groups all the fields that will be added or modified in IBMPowerVSMachineSnapshot with the V1Beta2 version.
+// See https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20240916-improve-status-in-CAPI-resources.md for more context.
+type IBMPowerVSMachineSnapshotV1Beta2Status struct {
+	// conditions represents the observations of an IBMPowerVSMachineSnapshot's current state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=ibmpowervsmachinesnapshots,scope=Namespaced,categories=cluster-api
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Machine",type="string",JSONPath=".spec.machineName",description="IBMPowerVSMachine whose volumes are snapshotted"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="PowerVS snapshot state"
+// +kubebuilder:printcolumn:name="Progress",type="integer",JSONPath=".status.percentComplete",description="PowerVS snapshot progress in percent"
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".status.imageID",description="Image the instance was captured to"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Snapshot ready status"
+// +kubebuilder:printcolumn:name="Expiration",type="string",JSONPath=".status.expirationTime",description="Time after which the snapshot is deleted"
+
+// IBMPowerVSMachineSnapshot is the Schema for the ibmpowervsmachinesnapshots API.
+type IBMPowerVSMachineSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IBMPowerVSMachineSnapshotSpec   `json:"spec,omitempty"`
+	Status IBMPowerVSMachineSnapshotStatus `json:"status,omitempty"`
+}
+
+// GetConditions returns the observations of the operational state of the IBMPowerVSMachineSnapshot resource.
+func (r *IBMPowerVSMachineSnapshot) GetConditions() clusterv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the IBMPowerVSMachineSnapshot to the predescribed clusterv1beta1.Conditions.
+func (r *IBMPowerVSMachineSnapshot) SetConditions(conditions clusterv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// GetV1Beta2Conditions returns the set of conditions for this object.
+func (r *IBMPowerVSMachineSnapshot) GetV1Beta2Conditions() []metav1.Condition {
+	if r.Status.V1Beta2 == nil {
+		return nil
+	}
+	return r.Status.V1Beta2.Conditions
+}
+
+// SetV1Beta2Conditions sets conditions for an API object.
+func (r *IBMPowerVSMachineSnapshot) SetV1Beta2Conditions(conditions []metav1.Condition) {
+	if r.Status.V1Beta2 == nil {
+		r.Status.V1Beta2 = &IBMPowerVSMachineSnapshotV1Beta2Status{}
+	}
+	r.Status.V1Beta2.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// IBMPowerVSMachineSnapshotList contains a list of IBMPowerVSMachineSnapshot.
+type IBMPowerVSMachineSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IBMPowerVSMachineSnapshot `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &IBMPowerVSMachineSnapshot{}, &IBMPowerVSMachineSnapshotList{})
+}
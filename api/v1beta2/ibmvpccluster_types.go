@@ -63,6 +63,24 @@ type IBMVPCClusterSpec struct {
 	// network represents the VPC network to use for the cluster.
 	// +optional
 	Network *VPCNetworkSpec `json:"network,omitempty"`
+
+	// controlPlaneDNS is the IBM Cloud DNS Services private zone serving the name of the control-plane endpoint.
+	// When set, the control-plane endpoint is set to api.<zone> instead of the hostname of the load balancer.
+	// It is only reconciled when network is set.
+	// It is immutable, the resources created in the zone are deleted with the cluster.
+	// +optional
+	ControlPlaneDNS *ControlPlaneDNS `json:"controlPlaneDNS,omitempty"`
+
+	// dns is the IBM Cloud Internet Services domain serving the public DNS records of the cluster.
+	// When set, the control-plane endpoint is set to the name of the api record instead of the hostname of the load balancer.
+	// It is only reconciled when network is set.
+	// +optional
+	DNS *DNS `json:"dns,omitempty"`
+
+	// identityRef references the credentials used to manage the cloud resources of the cluster.
+	// When not set, the credentials of the manager are used.
+	// +optional
+	IdentityRef *IBMCloudIdentityReference `json:"identityRef,omitempty"`
 }
 
 // VPCLoadBalancerSpec defines the desired state of an VPC load balancer.
@@ -252,6 +270,14 @@ type VPCNetworkSpec struct {
 	// +optional
 	SecurityGroups []VPCSecurityGroup `json:"securityGroups,omitempty"`
 
+	// securityGroupRuleReconcileMode defines how the Security Group Rules of the securityGroups are reconciled.
+	// With Additive, the default, the missing Security Group Rules are created and any other Security Group Rule is left untouched.
+	// With Enforce, the Security Group Rules not defined in securityGroups are also deleted from the Security Groups created
+	// by the controller, and reported through the VPCSecurityGroupRulesSynced condition for the other Security Groups.
+	// +kubebuilder:default=Additive
+	// +optional
+	SecurityGroupRuleReconcileMode VPCSecurityGroupRuleReconcileMode `json:"securityGroupRuleReconcileMode,omitempty"`
+
 	// workerSubnets is a set of Subnet's which define the Worker subnets.
 	// +optional
 	WorkerSubnets []Subnet `json:"workerSubnets,omitempty"`
@@ -259,6 +285,13 @@ type VPCNetworkSpec struct {
 	// vpc defines the IBM Cloud VPC for extended VPC Infrastructure support.
 	// +optional
 	VPC *VPCResource `json:"vpc,omitempty"`
+
+	// vpeGateways is a set of VPC Virtual Private Endpoint gateways giving the cluster private access to IBM Cloud services.
+	// The VPE gateways are bound to the Control Plane and Worker subnets, and to the securityGroups, and deleted with the cluster.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=atomic
+	// +optional
+	VPEGateways []VPEGateway `json:"vpeGateways,omitempty"`
 }
 
 // VPCSecurityGroupStatus defines a vpc security group resource status with its id and respective rule's ids.
@@ -310,6 +343,14 @@ type IBMVPCClusterStatus struct {
 	// +optional
 	ResourceGroup *ResourceStatus `json:"resourceGroup,omitempty"`
 
+	// controlPlaneDNS is the status of the DNS Services resources of the control-plane endpoint.
+	// +optional
+	ControlPlaneDNS *ControlPlaneDNSStatus `json:"controlPlaneDNS,omitempty"`
+
+	// dns is the status of the public DNS records of the cluster.
+	// +optional
+	DNS *DNSStatus `json:"dns,omitempty"`
+
 	Subnet      Subnet      `json:"subnet,omitempty"`
 	VPCEndpoint VPCEndpoint `json:"vpcEndpoint,omitempty"`
 
@@ -317,6 +358,10 @@ type IBMVPCClusterStatus struct {
 	// +optional
 	ControlPlaneLoadBalancerState VPCLoadBalancerState `json:"controlPlaneLoadBalancerState,omitempty"`
 
+	// failureDomains is a list of failure domains for the cluster, one per zone containing a Control Plane or Worker subnet.
+	// +optional
+	FailureDomains clusterv1beta1.FailureDomains `json:"failureDomains,omitempty"`
+
 	// Conditions defines current service state of the load balancer.
 	// +optional
 	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
@@ -364,6 +409,11 @@ type VPCNetworkStatus struct {
 	// +optional
 	SecurityGroups map[string]*ResourceStatus `json:"securityGroups,omitempty"`
 
+	// securityGroupRules references the VPC Security Group Rules matching the rules of the cluster's Security Groups,
+	// keyed by the Security Group name, or id when it has no name.
+	// +optional
+	SecurityGroupRules map[string]*VPCSecurityGroupStatus `json:"securityGroupRules,omitempty"`
+
 	// workerSubnets references the VPC Subnets for the cluster's Data Plane.
 	// The map simplifies lookups.
 	// +optional
@@ -372,6 +422,10 @@ type VPCNetworkStatus struct {
 	// vpc references the status of the IBM Cloud VPC as part of the extended VPC Infrastructure support.
 	// +optional
 	VPC *ResourceStatus `json:"vpc,omitempty"`
+
+	// vpeGateways references the VPC VPE gateways for the cluster, keyed by name.
+	// +optional
+	VPEGateways map[string]*ResourceStatus `json:"vpeGateways,omitempty"`
 }
 
 // VPC holds the VPC information.
@@ -56,8 +56,10 @@ type IBMVPCMachineSpec struct {
 	LoadBalancerPoolMembers []VPCLoadBalancerBackendPoolMember `json:"loadBalancerPoolMembers,omitempty"`
 
 	// Zone is the place where the instance should be created. Example: us-south-3
+	// When not set, the zone is taken from the Machine's failure domain.
 	// TODO: Actually zone is transparent to user. The field user can access is location. Example: Dallas 2
-	Zone string `json:"zone"`
+	// +optional
+	Zone string `json:"zone,omitempty"`
 
 	// Profile indicates the flavor of instance. Example: bx2-8x32	means 8 vCPUs	32 GB RAM	16 Gbps
 	// TODO: add a reference link of profile
@@ -0,0 +1,202 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
+)
+
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+const (
+	// IBMVPCMachinePoolFinalizer allows IBMVPCMachinePoolReconciler to clean up resources associated with IBMVPCMachinePool before
+	// removing it from the apiserver.
+	IBMVPCMachinePoolFinalizer = "ibmvpcmachinepool.infrastructure.cluster.x-k8s.io"
+)
+
+// IBMVPCMachinePoolSpec defines the desired state of IBMVPCMachinePool.
+type IBMVPCMachinePoolSpec struct {
+	// providerIDList are the identification IDs of the instances provided by the provider.
+	// This field is populated by the controller and must match the nodes' providerIDs.
+	// +optional
+	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// image is the OS image which would be installed on the instances.
+	// ID will take higher precedence over Name if both specified.
+	Image *IBMVPCResourceReference `json:"image"`
+
+	// zone is the place where the instances should be created. Example: us-south-3
+	// When not set, the zone is taken from the first failure domain of the MachinePool.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// profile indicates the flavor of the instances. Example: bx2-8x32	means 8 vCPUs	32 GB RAM	16 Gbps
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// bootVolume contains the instances' boot volume configurations like size, iops etc..
+	// +optional
+	BootVolume *VPCVolume `json:"bootVolume,omitempty"`
+
+	// primaryNetworkInterface is required to specify subnet.
+	// When the subnet is not set, a subnet of the cluster in the selected zone is used.
+	// +optional
+	PrimaryNetworkInterface NetworkInterface `json:"primaryNetworkInterface,omitempty"`
+
+	// sshKeys is the SSH pub keys that will be used to access the instances.
+	// ID will take higher precedence over Name if both specified.
+	// +optional
+	SSHKeys []*IBMVPCResourceReference `json:"sshKeys,omitempty"`
+}
+
+// IBMVPCMachinePoolInstanceStatus defines the observed state of a single instance in the IBMVPCMachinePool.
+type IBMVPCMachinePoolInstanceStatus struct {
+	// instanceID defines the IBM Cloud VPC Instance UUID.
+	InstanceID string `json:"instanceID"`
+
+	// name of the instance.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// providerID is the unique identifier of the instance as specified by the cloud provider.
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+
+	// instanceState is the state of the instance.
+	// +optional
+	InstanceState string `json:"instanceState,omitempty"`
+
+	// addresses contains the IBM Cloud instance associated addresses.
+	// +optional
+	Addresses []corev1.NodeAddress `json:"addresses,omitempty"`
+
+	// upToDate is true when the instance was created from the current instance template.
+	// +optional
+	UpToDate bool `json:"upToDate"`
+}
+
+// IBMVPCMachinePoolStatus defines the observed state of IBMVPCMachinePool.
+type IBMVPCMachinePoolStatus struct {
+	// ready is true when the provider resource is ready.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// replicas is the most recently observed number of replicas.
+	// +optional
+	Replicas int32 `json:"replicas"`
+
+	// instanceTemplate is the reference to the VPC instance template used to create the instances.
+	// +optional
+	InstanceTemplate *ResourceStatus `json:"instanceTemplate,omitempty"`
+
+	// instanceGroup is the reference to the VPC instance group which manages the instances.
+	// +optional
+	InstanceGroup *ResourceStatus `json:"instanceGroup,omitempty"`
+
+	// instances contains the status for each instance in the pool.
+	// +optional
+	Instances []IBMVPCMachinePoolInstanceStatus `json:"instances,omitempty"`
+
+	// conditions defines current service state of the IBMVPCMachinePool.
+	// +optional
+	Conditions clusterv1beta1.Conditions `json:"conditions,omitempty"`
+
+	// failureReason will be set in the event that there is a terminal problem
+	// reconciling the MachinePool and will contain a succinct value suitable
+	// for machine interpretation.
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// failureMessage will be set in the event that there is a terminal problem
+	// reconciling the MachinePool and will contain a more verbose string suitable
+	// for logging and human consumption.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// v1beta2 groups all the fields that will be added or modified in IBMVPCMachinePool's status with the V1Beta2 version.
+	// +optional
+	V1Beta2 *IBMVPCMachinePoolV1Beta2Status `json:"v1beta2,omitempty"`
+}
+
+// IBMVPCMachinePoolV1Beta2Status groups all the fields that will be added or modified in IBMVPCMachinePoolStatus with the V1Beta2 version.
+// See https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20240916-improve-status-in-CAPI-resources.md for more context.
+type IBMVPCMachinePoolV1Beta2Status struct {
+	// conditions represents the observations of a IBMVPCMachinePool's current state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".metadata.labels.cluster\\.x-k8s\\.io/cluster-name",description="Cluster to which this IBMVPCMachinePool belongs"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".status.replicas",description="Number of instances in the pool"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Machine pool ready status"
+
+// IBMVPCMachinePool is the Schema for the ibmvpcmachinepools API.
+type IBMVPCMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IBMVPCMachinePoolSpec   `json:"spec,omitempty"`
+	Status IBMVPCMachinePoolStatus `json:"status,omitempty"`
+}
+
+// GetConditions returns the observations of the operational state of the IBMVPCMachinePool resource.
+func (r *IBMVPCMachinePool) GetConditions() clusterv1beta1.Conditions {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the IBMVPCMachinePool to the predescribed clusterv1beta1.Conditions.
+func (r *IBMVPCMachinePool) SetConditions(conditions clusterv1beta1.Conditions) {
+	r.Status.Conditions = conditions
+}
+
+// GetV1Beta2Conditions returns the set of conditions for this object.
+func (r *IBMVPCMachinePool) GetV1Beta2Conditions() []metav1.Condition {
+	if r.Status.V1Beta2 == nil {
+		return nil
+	}
+	return r.Status.V1Beta2.Conditions
+}
+
+// SetV1Beta2Conditions sets conditions for an API object.
+func (r *IBMVPCMachinePool) SetV1Beta2Conditions(conditions []metav1.Condition) {
+	if r.Status.V1Beta2 == nil {
+		r.Status.V1Beta2 = &IBMVPCMachinePoolV1Beta2Status{}
+	}
+	r.Status.V1Beta2.Conditions = conditions
+}
+
+// +kubebuilder:object:root=true
+
+// IBMVPCMachinePoolList contains a list of IBMVPCMachinePool.
+type IBMVPCMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IBMVPCMachinePool `json:"items"`
+}
+
+func init() {
+	objectTypes = append(objectTypes, &IBMVPCMachinePool{}, &IBMVPCMachinePoolList{})
+}
@@ -47,6 +47,9 @@ var (
 
 	// PowerVSInstanceStateERROR is the string representing an instance in a ERROR state.
 	PowerVSInstanceStateERROR = PowerVSInstanceState("ERROR")
+
+	// PowerVSInstanceStateRESIZE is the string representing an instance in a RESIZE state.
+	PowerVSInstanceStateRESIZE = PowerVSInstanceState("RESIZE")
 )
 
 // PowerVSImageState describes the state of an IBM Power VS image.
@@ -69,6 +72,94 @@ var (
 	PowerVSImageStateCompleted = PowerVSImageState("completed")
 )
 
+// PowerVSVolumeState describes the state of an IBM Power VS volume.
+type PowerVSVolumeState string
+
+var (
+	// PowerVSVolumeStateAvailable is the string representing a volume in an available state.
+	PowerVSVolumeStateAvailable = PowerVSVolumeState("available")
+
+	// PowerVSVolumeStateInUse is the string representing a volume attached to an instance.
+	PowerVSVolumeStateInUse = PowerVSVolumeState("in-use")
+
+	// PowerVSVolumeStateCreating is the string representing a volume in a creating state.
+	PowerVSVolumeStateCreating = PowerVSVolumeState("creating")
+
+	// PowerVSVolumeStateError is the string representing a volume in an error state.
+	PowerVSVolumeStateError = PowerVSVolumeState("error")
+)
+
+// PowerVSSnapshotState describes the state of an IBM Power VS instance snapshot.
+type PowerVSSnapshotState string
+
+var (
+	// PowerVSSnapshotStateAvailable is the string representing a snapshot in an available state.
+	PowerVSSnapshotStateAvailable = PowerVSSnapshotState("available")
+
+	// PowerVSSnapshotStateCreating is the string representing a snapshot in a creating state.
+	PowerVSSnapshotStateCreating = PowerVSSnapshotState("creating")
+
+	// PowerVSSnapshotStateRestoring is the string representing a snapshot being restored to its instance.
+	PowerVSSnapshotStateRestoring = PowerVSSnapshotState("restoring")
+
+	// PowerVSSnapshotStateError is the string representing a snapshot in an error state.
+	PowerVSSnapshotStateError = PowerVSSnapshotState("error")
+)
+
+// PowerVSVolumeAffinityPolicy describes the placement of a Power VS volume relative to the storage of an instance.
+type PowerVSVolumeAffinityPolicy string
+
+const (
+	// PowerVSVolumeAffinityPolicyAffinity places the volume on the same storage as the instance.
+	PowerVSVolumeAffinityPolicyAffinity PowerVSVolumeAffinityPolicy = "affinity"
+	// PowerVSVolumeAffinityPolicyAntiAffinity places the volume on a different storage than the instance.
+	PowerVSVolumeAffinityPolicyAntiAffinity PowerVSVolumeAffinityPolicy = "anti-affinity"
+)
+
+// PowerVSDeploymentTargetType describes the type of the dedicated host target of a Power VS instance.
+type PowerVSDeploymentTargetType string
+
+const (
+	// PowerVSDeploymentTargetTypeHost deploys the instance on a dedicated host.
+	PowerVSDeploymentTargetTypeHost PowerVSDeploymentTargetType = "host"
+	// PowerVSDeploymentTargetTypeHostGroup deploys the instance on a host of a dedicated host group.
+	PowerVSDeploymentTargetTypeHostGroup PowerVSDeploymentTargetType = "hostGroup"
+)
+
+// PowerVSDiagnosticsTrigger describes what the diagnostics of a Power VS instance were collected for.
+type PowerVSDiagnosticsTrigger string
+
+const (
+	// PowerVSDiagnosticsTriggerInstanceError is used when the instance is in an error state.
+	PowerVSDiagnosticsTriggerInstanceError PowerVSDiagnosticsTrigger = "InstanceError"
+	// PowerVSDiagnosticsTriggerWaitingForNetworkAddress is used when the instance did not get a network address in time.
+	PowerVSDiagnosticsTriggerWaitingForNetworkAddress PowerVSDiagnosticsTrigger = "WaitingForNetworkAddress"
+	// PowerVSDiagnosticsTriggerRequested is used when the diagnostics were requested with the collect-diagnostics annotation.
+	PowerVSDiagnosticsTriggerRequested PowerVSDiagnosticsTrigger = "Requested"
+)
+
+// PowerVSPlacementGroupPolicy describes the placement of the instances of a Power VS server placement group.
+type PowerVSPlacementGroupPolicy string
+
+const (
+	// PowerVSPlacementGroupPolicyAffinity places the instances of the placement group on the same host.
+	PowerVSPlacementGroupPolicyAffinity PowerVSPlacementGroupPolicy = "affinity"
+	// PowerVSPlacementGroupPolicyAntiAffinity places the instances of the placement group on different hosts.
+	PowerVSPlacementGroupPolicyAntiAffinity PowerVSPlacementGroupPolicy = "anti-affinity"
+)
+
+// PowerVSNetworkType describes the type of a Power VS network.
+type PowerVSNetworkType string
+
+const (
+	// PowerVSNetworkTypePublic is a network connected to the internet.
+	PowerVSNetworkTypePublic PowerVSNetworkType = "public"
+	// PowerVSNetworkTypePrivate is a network not connected to the internet.
+	PowerVSNetworkTypePrivate PowerVSNetworkType = "private"
+	// PowerVSNetworkTypeDHCP is a private network whose addresses are served by a DHCP server.
+	PowerVSNetworkTypeDHCP PowerVSNetworkType = "dhcp"
+)
+
 // ServiceInstanceState describes the state of a service instance.
 type ServiceInstanceState string
 
@@ -120,6 +211,17 @@ var (
 	TransitGatewayConnectionStateDeleting = TransitGatewayConnectionState("deleting")
 )
 
+// TransitGatewayPrefixFilterAction describes whether a transit gateway connection prefix filter permits or denies routes.
+// +kubebuilder:validation:Enum=permit;deny
+type TransitGatewayPrefixFilterAction string
+
+const (
+	// TransitGatewayPrefixFilterActionPermit permits the routes matching the prefix filter.
+	TransitGatewayPrefixFilterActionPermit TransitGatewayPrefixFilterAction = "permit"
+	// TransitGatewayPrefixFilterActionDeny denies the routes matching the prefix filter.
+	TransitGatewayPrefixFilterActionDeny TransitGatewayPrefixFilterAction = "deny"
+)
+
 // VPCLoadBalancerBackendPoolAlgorithm describes the backend pool's load balancing algorithm.
 // +kubebuilder:validation:Enum=least_connections;round_robin;weighted_round_robin
 type VPCLoadBalancerBackendPoolAlgorithm string
@@ -224,6 +326,22 @@ var (
 	VPCStateDeleting = VPCState("deleting")
 )
 
+// VPCPublicGatewayState describes the state of a VPC Public Gateway.
+type VPCPublicGatewayState string
+
+var (
+	// VPCPublicGatewayStateDeleting is the string representing a VPC public gateway in deleting state.
+	VPCPublicGatewayStateDeleting = VPCPublicGatewayState("deleting")
+)
+
+// VPCImageState describes the state of a VPC Custom Image.
+type VPCImageState string
+
+var (
+	// VPCImageStateDeleting is the string representing a VPC custom image in deleting state.
+	VPCImageStateDeleting = VPCImageState("deleting")
+)
+
 // DHCPServerState describes the state of the DHCP Server.
 type DHCPServerState string
 
@@ -317,6 +435,18 @@ const (
 	VPCSecurityGroupRuleDirectionOutbound VPCSecurityGroupRuleDirection = vpcv1.NetworkACLRuleDirectionOutboundConst
 )
 
+// VPCSecurityGroupRuleReconcileMode represents how the Security Group Rules of the Security Groups are reconciled.
+// +kubebuilder:validation:Enum=Additive;Enforce
+type VPCSecurityGroupRuleReconcileMode string
+
+const (
+	// VPCSecurityGroupRuleReconcileModeAdditive only creates the missing Security Group Rules.
+	VPCSecurityGroupRuleReconcileModeAdditive VPCSecurityGroupRuleReconcileMode = "Additive"
+	// VPCSecurityGroupRuleReconcileModeEnforce also deletes the Security Group Rules which are not defined
+	// from the Security Groups created by the controller, and reports them for the other Security Groups.
+	VPCSecurityGroupRuleReconcileModeEnforce VPCSecurityGroupRuleReconcileMode = "Enforce"
+)
+
 // VPCSecurityGroupRuleProtocol represents the protocols for a Security Group Rule.
 // +kubebuilder:validation:Enum=all;icmp;tcp;udp
 type VPCSecurityGroupRuleProtocol string
@@ -571,6 +701,149 @@ type VPCEndpoint struct {
 	LBID *string `json:"loadBalancerIPID,omitempty"`
 }
 
+// ControlPlaneDNS defines the IBM Cloud DNS Services private zone serving the name of the control-plane endpoint.
+// The zone is created in the DNS Services instance when it does not exist, the VPC of the cluster is added to its
+// permitted networks and the api and api-int records of the zone are maintained as CNAME records of the control-plane load balancer.
+type ControlPlaneDNS struct {
+	// instanceID is the GUID of the IBM Cloud DNS Services instance hosting the private zone.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	InstanceID string `json:"instanceID"`
+
+	// zone is the name of the private DNS zone, e.g. mycluster.example.com.
+	// The control-plane endpoint of the cluster is set to api.<zone>.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z]{2,63}$`
+	// +required
+	Zone string `json:"zone"`
+}
+
+// ControlPlaneDNSStatus defines the status of the IBM Cloud DNS Services resources of the control-plane endpoint.
+type ControlPlaneDNSStatus struct {
+	// zone is the reference to the private DNS zone.
+	// +optional
+	Zone *ResourceReference `json:"zone,omitempty"`
+
+	// permittedNetwork is the reference to the permitted network of the cluster VPC in the zone.
+	// +optional
+	PermittedNetwork *ResourceReference `json:"permittedNetwork,omitempty"`
+
+	// apiRecord is the reference to the api record of the zone.
+	// +optional
+	APIRecord *ResourceReference `json:"apiRecord,omitempty"`
+
+	// apiIntRecord is the reference to the api-int record of the zone.
+	// +optional
+	APIIntRecord *ResourceReference `json:"apiIntRecord,omitempty"`
+}
+
+// DNS defines the public DNS records of the cluster managed in a domain of an IBM Cloud Internet Services (CIS) instance.
+// The records are maintained as CNAME records of the hostname of the public control-plane load balancer.
+type DNS struct {
+	// cisInstanceCRN is the CRN of the IBM Cloud Internet Services instance hosting the domain.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	CISInstanceCRN string `json:"cisInstanceCRN"`
+
+	// domain is the name of the domain in the CIS instance, e.g. example.com.
+	// The domain must already be added to the CIS instance.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?\.)+[a-z]{2,63}$`
+	// +required
+	Domain string `json:"domain"`
+
+	// apiRecordName is the name of the record of the control-plane endpoint, relative to the domain.
+	// When omitted, it defaults to api.<cluster name>.
+	// The control-plane endpoint of the cluster is set to <apiRecordName>.<domain>.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	APIRecordName *string `json:"apiRecordName,omitempty"`
+
+	// ingress is the wildcard record of the ingress of the cluster.
+	// When omitted, no ingress record is created.
+	// +optional
+	Ingress *DNSIngress `json:"ingress,omitempty"`
+}
+
+// DNSIngress defines the wildcard record of the ingress of the cluster.
+type DNSIngress struct {
+	// recordName is the name of the wildcard record, relative to the domain.
+	// When omitted, it defaults to *.apps.<cluster name>.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	RecordName *string `json:"recordName,omitempty"`
+
+	// target is the hostname the wildcard record points to, e.g. the hostname of the ingress load balancer.
+	// When omitted, it defaults to the hostname of the public control-plane load balancer.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	Target *string `json:"target,omitempty"`
+}
+
+// DNSStatus defines the status of the public DNS records of the cluster.
+type DNSStatus struct {
+	// zoneID is the ID of the domain in the CIS instance.
+	// +optional
+	ZoneID *string `json:"zoneID,omitempty"`
+
+	// apiRecord is the reference to the record of the control-plane endpoint.
+	// +optional
+	APIRecord *ResourceReference `json:"apiRecord,omitempty"`
+
+	// ingressRecord is the reference to the wildcard record of the ingress.
+	// +optional
+	IngressRecord *ResourceReference `json:"ingressRecord,omitempty"`
+}
+
+// VPEGatewayService is a well-known IBM Cloud service reachable through a VPC Virtual Private Endpoint (VPE) gateway.
+type VPEGatewayService string
+
+const (
+	// VPEGatewayServiceCOS is the direct endpoint of IBM Cloud Object Storage in the region of the VPC.
+	VPEGatewayServiceCOS = VPEGatewayService("cos")
+
+	// VPEGatewayServiceIAM is the private endpoint of IBM Cloud Identity and Access Management.
+	VPEGatewayServiceIAM = VPEGatewayService("iam")
+
+	// VPEGatewayServiceContainerRegistry is the endpoint of IBM Cloud Container Registry serving the region of the VPC,
+	// e.g. vpe.us.icr.io in us-south. It is only resolved in the regions of the registry, set the crn in other regions.
+	VPEGatewayServiceContainerRegistry = VPEGatewayService("container-registry")
+
+	// VPEGatewayServicePowerVS is the endpoint of the IBM Power Virtual Server API in the region of the VPC.
+	VPEGatewayServicePowerVS = VPEGatewayService("powervs")
+)
+
+// VPEGateway defines a VPC Virtual Private Endpoint (VPE) gateway giving the cluster private access to an IBM Cloud service.
+// The VPE gateway is bound to a reserved IP in one subnet of the cluster per zone, and to the security groups of the cluster.
+// +kubebuilder:validation:XValidation:rule="has(self.service) != has(self.crn)",message="exactly one of service or crn must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.service) || has(self.name)",message="name is required when crn is set"
+type VPEGateway struct {
+	// name of the VPE gateway.
+	// When omitted, it defaults to <cluster name>-vpe-<service>.
+	// When a VPE gateway with name exists, it is used instead of creating a new one, and is not deleted with the cluster.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// service is a well-known IBM Cloud service, whose endpoint is resolved in the region of the VPC.
+	// +kubebuilder:validation:Enum=cos;iam;container-registry;powervs
+	// +optional
+	Service *VPEGatewayService `json:"service,omitempty"`
+
+	// crn is the CRN of the endpoint of the IBM Cloud service,
+	// e.g. crn:v1:bluemix:public:cloud-object-storage:global:::endpoint:s3.direct.us-south.cloud-object-storage.appdomain.cloud.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	CRN *string `json:"crn,omitempty"`
+}
+
 // ResourceStatus identifies a resource by id (and name) and whether it is ready.
 type ResourceStatus struct {
 	// id defines the Id of the IBM Cloud resource status.
@@ -584,6 +857,11 @@ type ResourceStatus struct {
 	// ready defines whether the IBM Cloud resource is ready.
 	// +required
 	Ready bool `json:"ready"`
+
+	// controllerCreated indicates whether the resource is created by the controller.
+	// +kubebuilder:default=false
+	// +optional
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
 }
 
 // Set sets the ResourceStatus fields.
@@ -594,6 +872,10 @@ func (s *ResourceStatus) Set(resource ResourceStatus) {
 		s.Name = resource.Name
 	}
 	s.Ready = resource.Ready
+	// Only update controllerCreated when explicitly provided, as later lookups of the resource cannot determine who created it.
+	if resource.ControllerCreated != nil {
+		s.ControllerCreated = resource.ControllerCreated
+	}
 }
 
 // VPCResource represents a VPC resource.
@@ -609,3 +891,24 @@ type VPCResource struct {
 	// +optional
 	Name *string `json:"name,omitempty"`
 }
+
+// IBMCloudIdentityKind is the kind of the credentials referenced by an IBMCloudIdentityReference.
+type IBMCloudIdentityKind string
+
+const (
+	// IBMCloudIdentityKindSecret is a Secret in the namespace of the cluster, holding an API key in the IBMCLOUD_APIKEY key.
+	IBMCloudIdentityKindSecret = IBMCloudIdentityKind("Secret")
+	// IBMCloudIdentityKindClusterIdentity is an IBMCloudClusterIdentity.
+	IBMCloudIdentityKindClusterIdentity = IBMCloudIdentityKind("IBMCloudClusterIdentity")
+)
+
+// IBMCloudIdentityReference references the IBM Cloud credentials used to manage the resources of a cluster.
+type IBMCloudIdentityReference struct {
+	// kind of the identity.
+	// +kubebuilder:validation:Enum=Secret;IBMCloudClusterIdentity
+	Kind IBMCloudIdentityKind `json:"kind"`
+
+	// name of the identity. A Secret must be in the namespace of the cluster.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
@@ -21,8 +21,8 @@ limitations under the License.
 package v1beta2
 
 import (
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/cluster-api/api/core/v1beta1"
 )
@@ -53,6 +53,81 @@ func (in *AdditionalListenerSpec) DeepCopy() *AdditionalListenerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedNamespaces) DeepCopyInto(out *AllowedNamespaces) {
+	*out = *in
+	if in.NamespaceList != nil {
+		in, out := &in.NamespaceList, &out.NamespaceList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedNamespaces.
+func (in *AllowedNamespaces) DeepCopy() *AllowedNamespaces {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedNamespaces)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneDNS) DeepCopyInto(out *ControlPlaneDNS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneDNS.
+func (in *ControlPlaneDNS) DeepCopy() *ControlPlaneDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneDNSStatus) DeepCopyInto(out *ControlPlaneDNSStatus) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PermittedNetwork != nil {
+		in, out := &in.PermittedNetwork, &out.PermittedNetwork
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIRecord != nil {
+		in, out := &in.APIRecord, &out.APIRecord
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIIntRecord != nil {
+		in, out := &in.APIIntRecord, &out.APIIntRecord
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneDNSStatus.
+func (in *ControlPlaneDNSStatus) DeepCopy() *ControlPlaneDNSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneDNSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CosInstance) DeepCopyInto(out *CosInstance) {
 	*out = *in
@@ -108,6 +183,86 @@ func (in *DHCPServer) DeepCopy() *DHCPServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNS) DeepCopyInto(out *DNS) {
+	*out = *in
+	if in.APIRecordName != nil {
+		in, out := &in.APIRecordName, &out.APIRecordName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(DNSIngress)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNS.
+func (in *DNS) DeepCopy() *DNS {
+	if in == nil {
+		return nil
+	}
+	out := new(DNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSIngress) DeepCopyInto(out *DNSIngress) {
+	*out = *in
+	if in.RecordName != nil {
+		in, out := &in.RecordName, &out.RecordName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSIngress.
+func (in *DNSIngress) DeepCopy() *DNSIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSStatus) DeepCopyInto(out *DNSStatus) {
+	*out = *in
+	if in.ZoneID != nil {
+		in, out := &in.ZoneID, &out.ZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.APIRecord != nil {
+		in, out := &in.APIRecord, &out.APIRecord
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IngressRecord != nil {
+		in, out := &in.IngressRecord, &out.IngressRecord
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSStatus.
+func (in *DNSStatus) DeepCopy() *DNSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMCloudCatalogOffering) DeepCopyInto(out *IBMCloudCatalogOffering) {
 	*out = *in
@@ -138,6 +293,109 @@ func (in *IBMCloudCatalogOffering) DeepCopy() *IBMCloudCatalogOffering {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMCloudClusterIdentity) DeepCopyInto(out *IBMCloudClusterIdentity) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMCloudClusterIdentity.
+func (in *IBMCloudClusterIdentity) DeepCopy() *IBMCloudClusterIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMCloudClusterIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMCloudClusterIdentity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMCloudClusterIdentityList) DeepCopyInto(out *IBMCloudClusterIdentityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBMCloudClusterIdentity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMCloudClusterIdentityList.
+func (in *IBMCloudClusterIdentityList) DeepCopy() *IBMCloudClusterIdentityList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMCloudClusterIdentityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMCloudClusterIdentityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMCloudClusterIdentitySpec) DeepCopyInto(out *IBMCloudClusterIdentitySpec) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	if in.TrustedProfile != nil {
+		in, out := &in.TrustedProfile, &out.TrustedProfile
+		*out = new(IBMCloudTrustedProfile)
+		**out = **in
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = new(AllowedNamespaces)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMCloudClusterIdentitySpec.
+func (in *IBMCloudClusterIdentitySpec) DeepCopy() *IBMCloudClusterIdentitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMCloudClusterIdentitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMCloudIdentityReference) DeepCopyInto(out *IBMCloudIdentityReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMCloudIdentityReference.
+func (in *IBMCloudIdentityReference) DeepCopy() *IBMCloudIdentityReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMCloudIdentityReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMCloudResourceReference) DeepCopyInto(out *IBMCloudResourceReference) {
 	*out = *in
@@ -158,6 +416,21 @@ func (in *IBMCloudResourceReference) DeepCopy() *IBMCloudResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMCloudTrustedProfile) DeepCopyInto(out *IBMCloudTrustedProfile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMCloudTrustedProfile.
+func (in *IBMCloudTrustedProfile) DeepCopy() *IBMCloudTrustedProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMCloudTrustedProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMPowerVSCluster) DeepCopyInto(out *IBMPowerVSCluster) {
 	*out = *in
@@ -278,11 +551,52 @@ func (in *IBMPowerVSClusterSpec) DeepCopyInto(out *IBMPowerVSClusterSpec) {
 		*out = new(CosInstance)
 		**out = **in
 	}
+	if in.PlacementGroups != nil {
+		in, out := &in.PlacementGroups, &out.PlacementGroups
+		*out = make([]PowerVSPlacementGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SharedProcessorPools != nil {
+		in, out := &in.SharedProcessorPools, &out.SharedProcessorPools
+		*out = make([]PowerVSSharedProcessorPool, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalNetworks != nil {
+		in, out := &in.AdditionalNetworks, &out.AdditionalNetworks
+		*out = make([]PowerVSNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ControlPlaneDNS != nil {
+		in, out := &in.ControlPlaneDNS, &out.ControlPlaneDNS
+		*out = new(ControlPlaneDNS)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPEGateways != nil {
+		in, out := &in.VPEGateways, &out.VPEGateways
+		*out = make([]VPEGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Ignition != nil {
 		in, out := &in.Ignition, &out.Ignition
 		*out = new(Ignition)
 		**out = **in
 	}
+	if in.IdentityRef != nil {
+		in, out := &in.IdentityRef, &out.IdentityRef
+		*out = new(IBMCloudIdentityReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSClusterSpec.
@@ -354,6 +668,51 @@ func (in *IBMPowerVSClusterStatus) DeepCopyInto(out *IBMPowerVSClusterStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.PlacementGroups != nil {
+		in, out := &in.PlacementGroups, &out.PlacementGroups
+		*out = make(map[string]ResourceReference, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.SharedProcessorPools != nil {
+		in, out := &in.SharedProcessorPools, &out.SharedProcessorPools
+		*out = make(map[string]ResourceReference, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.AdditionalNetworks != nil {
+		in, out := &in.AdditionalNetworks, &out.AdditionalNetworks
+		*out = make(map[string]PowerVSNetworkStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.ControlPlaneDNS != nil {
+		in, out := &in.ControlPlaneDNS, &out.ControlPlaneDNS
+		*out = new(ControlPlaneDNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPEGateways != nil {
+		in, out := &in.VPEGateways, &out.VPEGateways
+		*out = make(map[string]ResourceReference, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make(v1beta1.FailureDomains, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -474,7 +833,7 @@ func (in *IBMPowerVSClusterV1Beta2Status) DeepCopyInto(out *IBMPowerVSClusterV1B
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -617,7 +976,7 @@ func (in *IBMPowerVSImageV1Beta2Status) DeepCopyInto(out *IBMPowerVSImageV1Beta2
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -694,147 +1053,78 @@ func (in *IBMPowerVSMachineList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMPowerVSMachineSpec) DeepCopyInto(out *IBMPowerVSMachineSpec) {
+func (in *IBMPowerVSMachinePool) DeepCopyInto(out *IBMPowerVSMachinePool) {
 	*out = *in
-	if in.ServiceInstance != nil {
-		in, out := &in.ServiceInstance, &out.ServiceInstance
-		*out = new(IBMPowerVSResourceReference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(IBMPowerVSResourceReference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ImageRef != nil {
-		in, out := &in.ImageRef, &out.ImageRef
-		*out = new(corev1.LocalObjectReference)
-		**out = **in
-	}
-	out.Processors = in.Processors
-	in.Network.DeepCopyInto(&out.Network)
-	if in.ProviderID != nil {
-		in, out := &in.ProviderID, &out.ProviderID
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachinePool.
+func (in *IBMPowerVSMachinePool) DeepCopy() *IBMPowerVSMachinePool {
+	if in == nil {
+		return nil
 	}
+	out := new(IBMPowerVSMachinePool)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineSpec.
-func (in *IBMPowerVSMachineSpec) DeepCopy() *IBMPowerVSMachineSpec {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMPowerVSMachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(IBMPowerVSMachineSpec)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMPowerVSMachineStatus) DeepCopyInto(out *IBMPowerVSMachineStatus) {
+func (in *IBMPowerVSMachinePoolInstanceStatus) DeepCopyInto(out *IBMPowerVSMachinePoolInstanceStatus) {
 	*out = *in
 	if in.Addresses != nil {
 		in, out := &in.Addresses, &out.Addresses
-		*out = make([]corev1.NodeAddress, len(*in))
+		*out = make([]v1.NodeAddress, len(*in))
 		copy(*out, *in)
 	}
-	if in.FailureReason != nil {
-		in, out := &in.FailureReason, &out.FailureReason
-		*out = new(string)
-		**out = **in
-	}
-	if in.FailureMessage != nil {
-		in, out := &in.FailureMessage, &out.FailureMessage
-		*out = new(string)
-		**out = **in
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make(v1beta1.Conditions, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
-		*out = new(string)
-		**out = **in
-	}
-	if in.Zone != nil {
-		in, out := &in.Zone, &out.Zone
-		*out = new(string)
-		**out = **in
-	}
-	if in.V1Beta2 != nil {
-		in, out := &in.V1Beta2, &out.V1Beta2
-		*out = new(IBMPowerVSMachineV1Beta2Status)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineStatus.
-func (in *IBMPowerVSMachineStatus) DeepCopy() *IBMPowerVSMachineStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(IBMPowerVSMachineStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMPowerVSMachineTemplate) DeepCopyInto(out *IBMPowerVSMachineTemplate) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineTemplate.
-func (in *IBMPowerVSMachineTemplate) DeepCopy() *IBMPowerVSMachineTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachinePoolInstanceStatus.
+func (in *IBMPowerVSMachinePoolInstanceStatus) DeepCopy() *IBMPowerVSMachinePoolInstanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMPowerVSMachineTemplate)
+	out := new(IBMPowerVSMachinePoolInstanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBMPowerVSMachineTemplate) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMPowerVSMachineTemplateList) DeepCopyInto(out *IBMPowerVSMachineTemplateList) {
+func (in *IBMPowerVSMachinePoolList) DeepCopyInto(out *IBMPowerVSMachinePoolList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IBMPowerVSMachineTemplate, len(*in))
+		*out = make([]IBMPowerVSMachinePool, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineTemplateList.
-func (in *IBMPowerVSMachineTemplateList) DeepCopy() *IBMPowerVSMachineTemplateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachinePoolList.
+func (in *IBMPowerVSMachinePoolList) DeepCopy() *IBMPowerVSMachinePoolList {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMPowerVSMachineTemplateList)
+	out := new(IBMPowerVSMachinePoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBMPowerVSMachineTemplateList) DeepCopyObject() runtime.Object {
+func (in *IBMPowerVSMachinePoolList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -842,113 +1132,104 @@ func (in *IBMPowerVSMachineTemplateList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMPowerVSMachineTemplateResource) DeepCopyInto(out *IBMPowerVSMachineTemplateResource) {
+func (in *IBMPowerVSMachinePoolSpec) DeepCopyInto(out *IBMPowerVSMachinePoolSpec) {
 	*out = *in
-	in.Spec.DeepCopyInto(&out.Spec)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineTemplateResource.
-func (in *IBMPowerVSMachineTemplateResource) DeepCopy() *IBMPowerVSMachineTemplateResource {
-	if in == nil {
-		return nil
+	if in.ProviderIDList != nil {
+		in, out := &in.ProviderIDList, &out.ProviderIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(IBMPowerVSMachineTemplateResource)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMPowerVSMachineTemplateSpec) DeepCopyInto(out *IBMPowerVSMachineTemplateSpec) {
-	*out = *in
 	in.Template.DeepCopyInto(&out.Template)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineTemplateSpec.
-func (in *IBMPowerVSMachineTemplateSpec) DeepCopy() *IBMPowerVSMachineTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachinePoolSpec.
+func (in *IBMPowerVSMachinePoolSpec) DeepCopy() *IBMPowerVSMachinePoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMPowerVSMachineTemplateSpec)
+	out := new(IBMPowerVSMachinePoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMPowerVSMachineTemplateStatus) DeepCopyInto(out *IBMPowerVSMachineTemplateStatus) {
+func (in *IBMPowerVSMachinePoolStatus) DeepCopyInto(out *IBMPowerVSMachinePoolStatus) {
 	*out = *in
-	if in.Capacity != nil {
-		in, out := &in.Capacity, &out.Capacity
-		*out = make(corev1.ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]IBMPowerVSMachinePoolInstanceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineTemplateStatus.
-func (in *IBMPowerVSMachineTemplateStatus) DeepCopy() *IBMPowerVSMachineTemplateStatus {
-	if in == nil {
-		return nil
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
 	}
-	out := new(IBMPowerVSMachineTemplateStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMPowerVSMachineV1Beta2Status) DeepCopyInto(out *IBMPowerVSMachineV1Beta2Status) {
-	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make(v1beta1.Conditions, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.V1Beta2 != nil {
+		in, out := &in.V1Beta2, &out.V1Beta2
+		*out = new(IBMPowerVSMachinePoolV1Beta2Status)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineV1Beta2Status.
-func (in *IBMPowerVSMachineV1Beta2Status) DeepCopy() *IBMPowerVSMachineV1Beta2Status {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachinePoolStatus.
+func (in *IBMPowerVSMachinePoolStatus) DeepCopy() *IBMPowerVSMachinePoolStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMPowerVSMachineV1Beta2Status)
+	out := new(IBMPowerVSMachinePoolStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMPowerVSResourceReference) DeepCopyInto(out *IBMPowerVSResourceReference) {
+func (in *IBMPowerVSMachinePoolV1Beta2Status) DeepCopyInto(out *IBMPowerVSMachinePoolV1Beta2Status) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.RegEx != nil {
-		in, out := &in.RegEx, &out.RegEx
-		*out = new(string)
-		**out = **in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSResourceReference.
-func (in *IBMPowerVSResourceReference) DeepCopy() *IBMPowerVSResourceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachinePoolV1Beta2Status.
+func (in *IBMPowerVSMachinePoolV1Beta2Status) DeepCopy() *IBMPowerVSMachinePoolV1Beta2Status {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMPowerVSResourceReference)
+	out := new(IBMPowerVSMachinePoolV1Beta2Status)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMVPCCluster) DeepCopyInto(out *IBMVPCCluster) {
+func (in *IBMPowerVSMachineSnapshot) DeepCopyInto(out *IBMPowerVSMachineSnapshot) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -956,18 +1237,18 @@ func (in *IBMVPCCluster) DeepCopyInto(out *IBMVPCCluster) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCCluster.
-func (in *IBMVPCCluster) DeepCopy() *IBMVPCCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineSnapshot.
+func (in *IBMPowerVSMachineSnapshot) DeepCopy() *IBMPowerVSMachineSnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCCluster)
+	out := new(IBMPowerVSMachineSnapshot)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBMVPCCluster) DeepCopyObject() runtime.Object {
+func (in *IBMPowerVSMachineSnapshot) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -975,31 +1256,31 @@ func (in *IBMVPCCluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMVPCClusterList) DeepCopyInto(out *IBMVPCClusterList) {
+func (in *IBMPowerVSMachineSnapshotList) DeepCopyInto(out *IBMPowerVSMachineSnapshotList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IBMVPCCluster, len(*in))
+		*out = make([]IBMPowerVSMachineSnapshot, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterList.
-func (in *IBMVPCClusterList) DeepCopy() *IBMVPCClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineSnapshotList.
+func (in *IBMPowerVSMachineSnapshotList) DeepCopy() *IBMPowerVSMachineSnapshotList {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCClusterList)
+	out := new(IBMPowerVSMachineSnapshotList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBMVPCClusterList) DeepCopyObject() runtime.Object {
+func (in *IBMPowerVSMachineSnapshotList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1007,57 +1288,48 @@ func (in *IBMVPCClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMVPCClusterSpec) DeepCopyInto(out *IBMVPCClusterSpec) {
+func (in *IBMPowerVSMachineSnapshotSpec) DeepCopyInto(out *IBMPowerVSMachineSnapshotSpec) {
 	*out = *in
-	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
-	if in.ControlPlaneLoadBalancer != nil {
-		in, out := &in.ControlPlaneLoadBalancer, &out.ControlPlaneLoadBalancer
-		*out = new(VPCLoadBalancerSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(ImageSpec)
-		(*in).DeepCopyInto(*out)
+	if in.VolumeIDs != nil {
+		in, out := &in.VolumeIDs, &out.VolumeIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(VPCNetworkSpec)
-		(*in).DeepCopyInto(*out)
+	if in.RetentionPeriod != nil {
+		in, out := &in.RetentionPeriod, &out.RetentionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterSpec.
-func (in *IBMVPCClusterSpec) DeepCopy() *IBMVPCClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineSnapshotSpec.
+func (in *IBMPowerVSMachineSnapshotSpec) DeepCopy() *IBMPowerVSMachineSnapshotSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCClusterSpec)
+	out := new(IBMPowerVSMachineSnapshotSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMVPCClusterStatus) DeepCopyInto(out *IBMVPCClusterStatus) {
+func (in *IBMPowerVSMachineSnapshotStatus) DeepCopyInto(out *IBMPowerVSMachineSnapshotStatus) {
 	*out = *in
-	out.VPC = in.VPC
-	if in.Image != nil {
-		in, out := &in.Image, &out.Image
-		*out = new(ResourceStatus)
-		(*in).DeepCopyInto(*out)
+	if in.VolumeSnapshots != nil {
+		in, out := &in.VolumeSnapshots, &out.VolumeSnapshots
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.Network != nil {
-		in, out := &in.Network, &out.Network
-		*out = new(VPCNetworkStatus)
-		(*in).DeepCopyInto(*out)
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
 	}
-	if in.ResourceGroup != nil {
-		in, out := &in.ResourceGroup, &out.ResourceGroup
-		*out = new(ResourceStatus)
-		(*in).DeepCopyInto(*out)
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
 	}
-	in.Subnet.DeepCopyInto(&out.Subnet)
-	in.VPCEndpoint.DeepCopyInto(&out.VPCEndpoint)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(v1beta1.Conditions, len(*in))
@@ -1067,43 +1339,538 @@ func (in *IBMVPCClusterStatus) DeepCopyInto(out *IBMVPCClusterStatus) {
 	}
 	if in.V1Beta2 != nil {
 		in, out := &in.V1Beta2, &out.V1Beta2
-		*out = new(IBMVPCClusterV1Beta2Status)
+		*out = new(IBMPowerVSMachineSnapshotV1Beta2Status)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterStatus.
-func (in *IBMVPCClusterStatus) DeepCopy() *IBMVPCClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineSnapshotStatus.
+func (in *IBMPowerVSMachineSnapshotStatus) DeepCopy() *IBMPowerVSMachineSnapshotStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCClusterStatus)
+	out := new(IBMPowerVSMachineSnapshotStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMVPCClusterTemplate) DeepCopyInto(out *IBMVPCClusterTemplate) {
+func (in *IBMPowerVSMachineSnapshotV1Beta2Status) DeepCopyInto(out *IBMPowerVSMachineSnapshotV1Beta2Status) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterTemplate.
-func (in *IBMVPCClusterTemplate) DeepCopy() *IBMVPCClusterTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineSnapshotV1Beta2Status.
+func (in *IBMPowerVSMachineSnapshotV1Beta2Status) DeepCopy() *IBMPowerVSMachineSnapshotV1Beta2Status {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCClusterTemplate)
+	out := new(IBMPowerVSMachineSnapshotV1Beta2Status)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBMVPCClusterTemplate) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSMachineSpec) DeepCopyInto(out *IBMPowerVSMachineSpec) {
+	*out = *in
+	if in.ServiceInstance != nil {
+		in, out := &in.ServiceInstance, &out.ServiceInstance
+		*out = new(IBMPowerVSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(IBMPowerVSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageRef != nil {
+		in, out := &in.ImageRef, &out.ImageRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	out.Processors = in.Processors
+	in.Network.DeepCopyInto(&out.Network)
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]PowerVSNetworkAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalVolumes != nil {
+		in, out := &in.AdditionalVolumes, &out.AdditionalVolumes
+		*out = make([]PowerVSAdditionalVolume, len(*in))
+		copy(*out, *in)
+	}
+	if in.PlacementGroup != nil {
+		in, out := &in.PlacementGroup, &out.PlacementGroup
+		*out = new(PowerVSPlacementGroupReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SharedProcessorPool != nil {
+		in, out := &in.SharedProcessorPool, &out.SharedProcessorPool
+		*out = new(IBMPowerVSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeploymentTarget != nil {
+		in, out := &in.DeploymentTarget, &out.DeploymentTarget
+		*out = new(PowerVSDeploymentTarget)
+		**out = **in
+	}
+	if in.StorageAffinity != nil {
+		in, out := &in.StorageAffinity, &out.StorageAffinity
+		*out = new(PowerVSStorageAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Diagnostics != nil {
+		in, out := &in.Diagnostics, &out.Diagnostics
+		*out = new(PowerVSDiagnostics)
+		**out = **in
+	}
+	if in.ProviderID != nil {
+		in, out := &in.ProviderID, &out.ProviderID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineSpec.
+func (in *IBMPowerVSMachineSpec) DeepCopy() *IBMPowerVSMachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSMachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSMachineStatus) DeepCopyInto(out *IBMPowerVSMachineStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]v1.NodeAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdditionalVolumes != nil {
+		in, out := &in.AdditionalVolumes, &out.AdditionalVolumes
+		*out = make([]PowerVSVolumeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Diagnostics != nil {
+		in, out := &in.Diagnostics, &out.Diagnostics
+		*out = new(PowerVSInstanceDiagnostics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resize != nil {
+		in, out := &in.Resize, &out.Resize
+		*out = new(PowerVSInstanceResize)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.V1Beta2 != nil {
+		in, out := &in.V1Beta2, &out.V1Beta2
+		*out = new(IBMPowerVSMachineV1Beta2Status)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineStatus.
+func (in *IBMPowerVSMachineStatus) DeepCopy() *IBMPowerVSMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSMachineTemplate) DeepCopyInto(out *IBMPowerVSMachineTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineTemplate.
+func (in *IBMPowerVSMachineTemplate) DeepCopy() *IBMPowerVSMachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSMachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMPowerVSMachineTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSMachineTemplateList) DeepCopyInto(out *IBMPowerVSMachineTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBMPowerVSMachineTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineTemplateList.
+func (in *IBMPowerVSMachineTemplateList) DeepCopy() *IBMPowerVSMachineTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSMachineTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMPowerVSMachineTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSMachineTemplateResource) DeepCopyInto(out *IBMPowerVSMachineTemplateResource) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineTemplateResource.
+func (in *IBMPowerVSMachineTemplateResource) DeepCopy() *IBMPowerVSMachineTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSMachineTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSMachineTemplateSpec) DeepCopyInto(out *IBMPowerVSMachineTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineTemplateSpec.
+func (in *IBMPowerVSMachineTemplateSpec) DeepCopy() *IBMPowerVSMachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSMachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSMachineTemplateStatus) DeepCopyInto(out *IBMPowerVSMachineTemplateStatus) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineTemplateStatus.
+func (in *IBMPowerVSMachineTemplateStatus) DeepCopy() *IBMPowerVSMachineTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSMachineTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSMachineV1Beta2Status) DeepCopyInto(out *IBMPowerVSMachineV1Beta2Status) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineV1Beta2Status.
+func (in *IBMPowerVSMachineV1Beta2Status) DeepCopy() *IBMPowerVSMachineV1Beta2Status {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSMachineV1Beta2Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSResourceReference) DeepCopyInto(out *IBMPowerVSResourceReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.RegEx != nil {
+		in, out := &in.RegEx, &out.RegEx
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSResourceReference.
+func (in *IBMPowerVSResourceReference) DeepCopy() *IBMPowerVSResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCCluster) DeepCopyInto(out *IBMVPCCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCCluster.
+func (in *IBMVPCCluster) DeepCopy() *IBMVPCCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMVPCCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCClusterList) DeepCopyInto(out *IBMVPCClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBMVPCCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterList.
+func (in *IBMVPCClusterList) DeepCopy() *IBMVPCClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMVPCClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCClusterSpec) DeepCopyInto(out *IBMVPCClusterSpec) {
+	*out = *in
+	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
+	if in.ControlPlaneLoadBalancer != nil {
+		in, out := &in.ControlPlaneLoadBalancer, &out.ControlPlaneLoadBalancer
+		*out = new(VPCLoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(ImageSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(VPCNetworkSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneDNS != nil {
+		in, out := &in.ControlPlaneDNS, &out.ControlPlaneDNS
+		*out = new(ControlPlaneDNS)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdentityRef != nil {
+		in, out := &in.IdentityRef, &out.IdentityRef
+		*out = new(IBMCloudIdentityReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterSpec.
+func (in *IBMVPCClusterSpec) DeepCopy() *IBMVPCClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCClusterStatus) DeepCopyInto(out *IBMVPCClusterStatus) {
+	*out = *in
+	out.VPC = in.VPC
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(ResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(VPCNetworkStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceGroup != nil {
+		in, out := &in.ResourceGroup, &out.ResourceGroup
+		*out = new(ResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControlPlaneDNS != nil {
+		in, out := &in.ControlPlaneDNS, &out.ControlPlaneDNS
+		*out = new(ControlPlaneDNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Subnet.DeepCopyInto(&out.Subnet)
+	in.VPCEndpoint.DeepCopyInto(&out.VPCEndpoint)
+	if in.FailureDomains != nil {
+		in, out := &in.FailureDomains, &out.FailureDomains
+		*out = make(v1beta1.FailureDomains, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.V1Beta2 != nil {
+		in, out := &in.V1Beta2, &out.V1Beta2
+		*out = new(IBMVPCClusterV1Beta2Status)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterStatus.
+func (in *IBMVPCClusterStatus) DeepCopy() *IBMVPCClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCClusterTemplate) DeepCopyInto(out *IBMVPCClusterTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterTemplate.
+func (in *IBMVPCClusterTemplate) DeepCopy() *IBMVPCClusterTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCClusterTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMVPCClusterTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
 	return nil
 }
@@ -1178,7 +1945,7 @@ func (in *IBMVPCClusterV1Beta2Status) DeepCopyInto(out *IBMVPCClusterV1Beta2Stat
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1214,46 +1981,243 @@ func (in *IBMVPCMachine) DeepCopy() *IBMVPCMachine {
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBMVPCMachine) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMVPCMachine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachineList) DeepCopyInto(out *IBMVPCMachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBMVPCMachine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineList.
+func (in *IBMVPCMachineList) DeepCopy() *IBMVPCMachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMVPCMachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachinePool) DeepCopyInto(out *IBMVPCMachinePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachinePool.
+func (in *IBMVPCMachinePool) DeepCopy() *IBMVPCMachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMVPCMachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachinePoolInstanceStatus) DeepCopyInto(out *IBMVPCMachinePoolInstanceStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]v1.NodeAddress, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachinePoolInstanceStatus.
+func (in *IBMVPCMachinePoolInstanceStatus) DeepCopy() *IBMVPCMachinePoolInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachinePoolInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachinePoolList) DeepCopyInto(out *IBMVPCMachinePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBMVPCMachinePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachinePoolList.
+func (in *IBMVPCMachinePoolList) DeepCopy() *IBMVPCMachinePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachinePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMVPCMachinePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachinePoolSpec) DeepCopyInto(out *IBMVPCMachinePoolSpec) {
+	*out = *in
+	if in.ProviderIDList != nil {
+		in, out := &in.ProviderIDList, &out.ProviderIDList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(IBMVPCResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootVolume != nil {
+		in, out := &in.BootVolume, &out.BootVolume
+		*out = new(VPCVolume)
+		**out = **in
+	}
+	in.PrimaryNetworkInterface.DeepCopyInto(&out.PrimaryNetworkInterface)
+	if in.SSHKeys != nil {
+		in, out := &in.SSHKeys, &out.SSHKeys
+		*out = make([]*IBMVPCResourceReference, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IBMVPCResourceReference)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachinePoolSpec.
+func (in *IBMVPCMachinePoolSpec) DeepCopy() *IBMVPCMachinePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachinePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachinePoolStatus) DeepCopyInto(out *IBMVPCMachinePoolStatus) {
+	*out = *in
+	if in.InstanceTemplate != nil {
+		in, out := &in.InstanceTemplate, &out.InstanceTemplate
+		*out = new(ResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceGroup != nil {
+		in, out := &in.InstanceGroup, &out.InstanceGroup
+		*out = new(ResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]IBMVPCMachinePoolInstanceStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1beta1.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureMessage != nil {
+		in, out := &in.FailureMessage, &out.FailureMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.V1Beta2 != nil {
+		in, out := &in.V1Beta2, &out.V1Beta2
+		*out = new(IBMVPCMachinePoolV1Beta2Status)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachinePoolStatus.
+func (in *IBMVPCMachinePoolStatus) DeepCopy() *IBMVPCMachinePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachinePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMVPCMachineList) DeepCopyInto(out *IBMVPCMachineList) {
+func (in *IBMVPCMachinePoolV1Beta2Status) DeepCopyInto(out *IBMVPCMachinePoolV1Beta2Status) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]IBMVPCMachine, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineList.
-func (in *IBMVPCMachineList) DeepCopy() *IBMVPCMachineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachinePoolV1Beta2Status.
+func (in *IBMVPCMachinePoolV1Beta2Status) DeepCopy() *IBMVPCMachinePoolV1Beta2Status {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCMachineList)
+	out := new(IBMVPCMachinePoolV1Beta2Status)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBMVPCMachineList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMVPCMachineSpec) DeepCopyInto(out *IBMVPCMachineSpec) {
 	*out = *in
@@ -1329,7 +2293,7 @@ func (in *IBMVPCMachineStatus) DeepCopyInto(out *IBMVPCMachineStatus) {
 	*out = *in
 	if in.Addresses != nil {
 		in, out := &in.Addresses, &out.Addresses
-		*out = make([]corev1.NodeAddress, len(*in))
+		*out = make([]v1.NodeAddress, len(*in))
 		copy(*out, *in)
 	}
 	if in.Conditions != nil {
@@ -1414,218 +2378,487 @@ func (in *IBMVPCMachineTemplateList) DeepCopyInto(out *IBMVPCMachineTemplateList
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineTemplateList.
-func (in *IBMVPCMachineTemplateList) DeepCopy() *IBMVPCMachineTemplateList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineTemplateList.
+func (in *IBMVPCMachineTemplateList) DeepCopy() *IBMVPCMachineTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachineTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMVPCMachineTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachineTemplateResource) DeepCopyInto(out *IBMVPCMachineTemplateResource) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineTemplateResource.
+func (in *IBMVPCMachineTemplateResource) DeepCopy() *IBMVPCMachineTemplateResource {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachineTemplateResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachineTemplateSpec) DeepCopyInto(out *IBMVPCMachineTemplateSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineTemplateSpec.
+func (in *IBMVPCMachineTemplateSpec) DeepCopy() *IBMVPCMachineTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachineTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachineTemplateStatus) DeepCopyInto(out *IBMVPCMachineTemplateStatus) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineTemplateStatus.
+func (in *IBMVPCMachineTemplateStatus) DeepCopy() *IBMVPCMachineTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachineTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCMachineV1Beta2Status) DeepCopyInto(out *IBMVPCMachineV1Beta2Status) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalVolumeIDs != nil {
+		in, out := &in.AdditionalVolumeIDs, &out.AdditionalVolumeIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineV1Beta2Status.
+func (in *IBMVPCMachineV1Beta2Status) DeepCopy() *IBMVPCMachineV1Beta2Status {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCMachineV1Beta2Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMVPCResourceReference) DeepCopyInto(out *IBMVPCResourceReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCResourceReference.
+func (in *IBMVPCResourceReference) DeepCopy() *IBMVPCResourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMVPCResourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ignition) DeepCopyInto(out *Ignition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ignition.
+func (in *Ignition) DeepCopy() *Ignition {
+	if in == nil {
+		return nil
+	}
+	out := new(Ignition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.CRN != nil {
+		in, out := &in.CRN, &out.CRN
+		*out = new(string)
+		**out = **in
+	}
+	if in.COSInstance != nil {
+		in, out := &in.COSInstance, &out.COSInstance
+		*out = new(string)
+		**out = **in
+	}
+	if in.COSBucket != nil {
+		in, out := &in.COSBucket, &out.COSBucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.COSBucketRegion != nil {
+		in, out := &in.COSBucketRegion, &out.COSBucketRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.COSObject != nil {
+		in, out := &in.COSObject, &out.COSObject
+		*out = new(string)
+		**out = **in
+	}
+	if in.OperatingSystem != nil {
+		in, out := &in.OperatingSystem, &out.OperatingSystem
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceGroup != nil {
+		in, out := &in.ResourceGroup, &out.ResourceGroup
+		*out = new(IBMCloudResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
+func (in *ImageSpec) DeepCopy() *ImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
+	*out = *in
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]VPCResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
+func (in *NetworkInterface) DeepCopy() *NetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSAdditionalVolume) DeepCopyInto(out *PowerVSAdditionalVolume) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSAdditionalVolume.
+func (in *PowerVSAdditionalVolume) DeepCopy() *PowerVSAdditionalVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSAdditionalVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSDeploymentTarget) DeepCopyInto(out *PowerVSDeploymentTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSDeploymentTarget.
+func (in *PowerVSDeploymentTarget) DeepCopy() *PowerVSDeploymentTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCMachineTemplateList)
+	out := new(PowerVSDeploymentTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IBMVPCMachineTemplateList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMVPCMachineTemplateResource) DeepCopyInto(out *IBMVPCMachineTemplateResource) {
+func (in *PowerVSDiagnostics) DeepCopyInto(out *PowerVSDiagnostics) {
 	*out = *in
-	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineTemplateResource.
-func (in *IBMVPCMachineTemplateResource) DeepCopy() *IBMVPCMachineTemplateResource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSDiagnostics.
+func (in *PowerVSDiagnostics) DeepCopy() *PowerVSDiagnostics {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCMachineTemplateResource)
+	out := new(PowerVSDiagnostics)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMVPCMachineTemplateSpec) DeepCopyInto(out *IBMVPCMachineTemplateSpec) {
+func (in *PowerVSInstanceDiagnostics) DeepCopyInto(out *PowerVSInstanceDiagnostics) {
 	*out = *in
-	in.Template.DeepCopyInto(&out.Template)
+	in.CollectedAt.DeepCopyInto(&out.CollectedAt)
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineTemplateSpec.
-func (in *IBMVPCMachineTemplateSpec) DeepCopy() *IBMVPCMachineTemplateSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSInstanceDiagnostics.
+func (in *PowerVSInstanceDiagnostics) DeepCopy() *PowerVSInstanceDiagnostics {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCMachineTemplateSpec)
+	out := new(PowerVSInstanceDiagnostics)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMVPCMachineTemplateStatus) DeepCopyInto(out *IBMVPCMachineTemplateStatus) {
+func (in *PowerVSInstanceResize) DeepCopyInto(out *PowerVSInstanceResize) {
 	*out = *in
-	if in.Capacity != nil {
-		in, out := &in.Capacity, &out.Capacity
-		*out = make(corev1.ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
-	}
+	out.Processors = in.Processors
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineTemplateStatus.
-func (in *IBMVPCMachineTemplateStatus) DeepCopy() *IBMVPCMachineTemplateStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSInstanceResize.
+func (in *PowerVSInstanceResize) DeepCopy() *PowerVSInstanceResize {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCMachineTemplateStatus)
+	out := new(PowerVSInstanceResize)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMVPCMachineV1Beta2Status) DeepCopyInto(out *IBMVPCMachineV1Beta2Status) {
+func (in *PowerVSNetwork) DeepCopyInto(out *PowerVSNetwork) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.CIDR != nil {
+		in, out := &in.CIDR, &out.CIDR
+		*out = new(string)
+		**out = **in
 	}
-	if in.AdditionalVolumeIDs != nil {
-		in, out := &in.AdditionalVolumeIDs, &out.AdditionalVolumeIDs
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SNAT != nil {
+		in, out := &in.SNAT, &out.SNAT
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MTU != nil {
+		in, out := &in.MTU, &out.MTU
+		*out = new(int64)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineV1Beta2Status.
-func (in *IBMVPCMachineV1Beta2Status) DeepCopy() *IBMVPCMachineV1Beta2Status {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSNetwork.
+func (in *PowerVSNetwork) DeepCopy() *PowerVSNetwork {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCMachineV1Beta2Status)
+	out := new(PowerVSNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMVPCResourceReference) DeepCopyInto(out *IBMVPCResourceReference) {
+func (in *PowerVSNetworkAttachment) DeepCopyInto(out *PowerVSNetworkAttachment) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	in.IBMPowerVSResourceReference.DeepCopyInto(&out.IBMPowerVSResourceReference)
+	if in.IPAddress != nil {
+		in, out := &in.IPAddress, &out.IPAddress
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCResourceReference.
-func (in *IBMVPCResourceReference) DeepCopy() *IBMVPCResourceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSNetworkAttachment.
+func (in *PowerVSNetworkAttachment) DeepCopy() *PowerVSNetworkAttachment {
 	if in == nil {
 		return nil
 	}
-	out := new(IBMVPCResourceReference)
+	out := new(PowerVSNetworkAttachment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Ignition) DeepCopyInto(out *Ignition) {
+func (in *PowerVSNetworkStatus) DeepCopyInto(out *PowerVSNetworkStatus) {
 	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DHCPServerID != nil {
+		in, out := &in.DHCPServerID, &out.DHCPServerID
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ignition.
-func (in *Ignition) DeepCopy() *Ignition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSNetworkStatus.
+func (in *PowerVSNetworkStatus) DeepCopy() *PowerVSNetworkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Ignition)
+	out := new(PowerVSNetworkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+func (in *PowerVSPlacementGroup) DeepCopyInto(out *PowerVSPlacementGroup) {
 	*out = *in
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.CRN != nil {
-		in, out := &in.CRN, &out.CRN
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSPlacementGroup.
+func (in *PowerVSPlacementGroup) DeepCopy() *PowerVSPlacementGroup {
+	if in == nil {
+		return nil
 	}
-	if in.COSInstance != nil {
-		in, out := &in.COSInstance, &out.COSInstance
+	out := new(PowerVSPlacementGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSPlacementGroupReference) DeepCopyInto(out *PowerVSPlacementGroupReference) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.COSBucket != nil {
-		in, out := &in.COSBucket, &out.COSBucket
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.COSBucketRegion != nil {
-		in, out := &in.COSBucketRegion, &out.COSBucketRegion
-		*out = new(string)
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(PowerVSPlacementGroupPolicy)
 		**out = **in
 	}
-	if in.COSObject != nil {
-		in, out := &in.COSObject, &out.COSObject
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSPlacementGroupReference.
+func (in *PowerVSPlacementGroupReference) DeepCopy() *PowerVSPlacementGroupReference {
+	if in == nil {
+		return nil
 	}
-	if in.OperatingSystem != nil {
-		in, out := &in.OperatingSystem, &out.OperatingSystem
-		*out = new(string)
-		**out = **in
+	out := new(PowerVSPlacementGroupReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSSharedProcessorPool) DeepCopyInto(out *PowerVSSharedProcessorPool) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSSharedProcessorPool.
+func (in *PowerVSSharedProcessorPool) DeepCopy() *PowerVSSharedProcessorPool {
+	if in == nil {
+		return nil
 	}
-	if in.ResourceGroup != nil {
-		in, out := &in.ResourceGroup, &out.ResourceGroup
-		*out = new(IBMCloudResourceReference)
-		(*in).DeepCopyInto(*out)
+	out := new(PowerVSSharedProcessorPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSStorageAffinity) DeepCopyInto(out *PowerVSStorageAffinity) {
+	*out = *in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
-func (in *ImageSpec) DeepCopy() *ImageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSStorageAffinity.
+func (in *PowerVSStorageAffinity) DeepCopy() *PowerVSStorageAffinity {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageSpec)
+	out := new(PowerVSStorageAffinity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
+func (in *PowerVSVolumeStatus) DeepCopyInto(out *PowerVSVolumeStatus) {
 	*out = *in
-	if in.SecurityGroups != nil {
-		in, out := &in.SecurityGroups, &out.SecurityGroups
-		*out = make([]VPCResource, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
-func (in *NetworkInterface) DeepCopy() *NetworkInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSVolumeStatus.
+func (in *PowerVSVolumeStatus) DeepCopy() *PowerVSVolumeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkInterface)
+	out := new(PowerVSVolumeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1663,6 +2896,11 @@ func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceStatus.
@@ -1728,6 +2966,21 @@ func (in *TransitGateway) DeepCopyInto(out *TransitGateway) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Shared != nil {
+		in, out := &in.Shared, &out.Shared
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PowerVSConnection != nil {
+		in, out := &in.PowerVSConnection, &out.PowerVSConnection
+		*out = new(TransitGatewayConnection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCConnection != nil {
+		in, out := &in.VPCConnection, &out.VPCConnection
+		*out = new(TransitGatewayConnection)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGateway.
@@ -1740,6 +2993,53 @@ func (in *TransitGateway) DeepCopy() *TransitGateway {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayConnection) DeepCopyInto(out *TransitGatewayConnection) {
+	*out = *in
+	if in.PrefixFilters != nil {
+		in, out := &in.PrefixFilters, &out.PrefixFilters
+		*out = make([]TransitGatewayPrefixFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayConnection.
+func (in *TransitGatewayConnection) DeepCopy() *TransitGatewayConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPrefixFilter) DeepCopyInto(out *TransitGatewayPrefixFilter) {
+	*out = *in
+	if in.Ge != nil {
+		in, out := &in.Ge, &out.Ge
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Le != nil {
+		in, out := &in.Le, &out.Le
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPrefixFilter.
+func (in *TransitGatewayPrefixFilter) DeepCopy() *TransitGatewayPrefixFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPrefixFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayStatus) DeepCopyInto(out *TransitGatewayStatus) {
 	*out = *in
@@ -2042,6 +3342,13 @@ func (in *VPCNetworkSpec) DeepCopyInto(out *VPCNetworkSpec) {
 		*out = new(VPCResource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPEGateways != nil {
+		in, out := &in.VPEGateways, &out.VPEGateways
+		*out = make([]VPEGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCNetworkSpec.
@@ -2126,6 +3433,22 @@ func (in *VPCNetworkStatus) DeepCopyInto(out *VPCNetworkStatus) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.SecurityGroupRules != nil {
+		in, out := &in.SecurityGroupRules, &out.SecurityGroupRules
+		*out = make(map[string]*VPCSecurityGroupStatus, len(*in))
+		for key, val := range *in {
+			var outVal *VPCSecurityGroupStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(VPCSecurityGroupStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	if in.WorkerSubnets != nil {
 		in, out := &in.WorkerSubnets, &out.WorkerSubnets
 		*out = make(map[string]*ResourceStatus, len(*in))
@@ -2147,6 +3470,22 @@ func (in *VPCNetworkStatus) DeepCopyInto(out *VPCNetworkStatus) {
 		*out = new(ResourceStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VPEGateways != nil {
+		in, out := &in.VPEGateways, &out.VPEGateways
+		*out = make(map[string]*ResourceStatus, len(*in))
+		for key, val := range *in {
+			var outVal *ResourceStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(ResourceStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCNetworkStatus.
@@ -2423,3 +3762,33 @@ func (in *VPCVolume) DeepCopy() *VPCVolume {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPEGateway) DeepCopyInto(out *VPEGateway) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(VPEGatewayService)
+		**out = **in
+	}
+	if in.CRN != nil {
+		in, out := &in.CRN, &out.CRN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPEGateway.
+func (in *VPEGateway) DeepCopy() *VPEGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(VPEGateway)
+	in.DeepCopyInto(out)
+	return out
+}
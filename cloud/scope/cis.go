@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cis"
+)
+
+// publicDNSAPIName returns the fully qualified name of the record of the control-plane endpoint in the CIS domain.
+func publicDNSAPIName(spec *infrav1.DNS, clusterName string) string {
+	return fmt.Sprintf("%s.%s", ptr.Deref(spec.APIRecordName, fmt.Sprintf("api.%s", clusterName)), spec.Domain)
+}
+
+// publicDNSIngressName returns the fully qualified name of the wildcard record of the ingress in the CIS domain.
+func publicDNSIngressName(spec *infrav1.DNS, clusterName string) string {
+	return fmt.Sprintf("%s.%s", ptr.Deref(spec.Ingress.RecordName, fmt.Sprintf("*.apps.%s", clusterName)), spec.Domain)
+}
+
+// publicDNSReconciler reconciles the IBM Cloud Internet Services records of a cluster.
+type publicDNSReconciler struct {
+	client      cis.CIS
+	spec        infrav1.DNS
+	status      *infrav1.DNSStatus
+	clusterName string
+}
+
+// reconcile ensures the api record, and the ingress record when requested, point to the load balancer with given hostname.
+func (r *publicDNSReconciler) reconcile(ctx context.Context, loadBalancerHostName string) error {
+	log := ctrl.LoggerFrom(ctx)
+	if r.status.ZoneID == nil {
+		zone, err := r.client.GetZoneByName(r.spec.Domain)
+		if err != nil {
+			return fmt.Errorf("failed to get CIS domain %s: %w", r.spec.Domain, err)
+		}
+		if zone == nil {
+			return fmt.Errorf("CIS domain %s not found", r.spec.Domain)
+		}
+		log.Info("Found CIS domain", "domain", r.spec.Domain, "zoneID", *zone.ID)
+		r.status.ZoneID = zone.ID
+	}
+
+	apiRecord, err := r.reconcileRecord(ctx, r.status.APIRecord, publicDNSAPIName(&r.spec, r.clusterName), loadBalancerHostName)
+	if err != nil {
+		return err
+	}
+	r.status.APIRecord = apiRecord
+
+	if r.spec.Ingress == nil {
+		// The ingress record is removed when it is no longer requested.
+		return r.deleteRecord(ctx, &r.status.IngressRecord)
+	}
+	ingressRecord, err := r.reconcileRecord(ctx, r.status.IngressRecord, publicDNSIngressName(&r.spec, r.clusterName), ptr.Deref(r.spec.Ingress.Target, loadBalancerHostName))
+	if err != nil {
+		return err
+	}
+	r.status.IngressRecord = ingressRecord
+	return nil
+}
+
+// reconcileRecord ensures a CNAME record with given name pointing to target exists in the domain and returns its reference.
+func (r *publicDNSReconciler) reconcileRecord(ctx context.Context, ref *infrav1.ResourceReference, name, target string) (*infrav1.ResourceReference, error) {
+	log := ctrl.LoggerFrom(ctx)
+	var record *dnsrecordsv1.DnsrecordDetails
+	if ref != nil && ref.ID != nil {
+		result, resp, err := r.client.GetDNSRecord(*r.status.ZoneID, &dnsrecordsv1.GetDnsRecordOptions{
+			DnsrecordIdentifier: ref.ID,
+		})
+		if err != nil {
+			if resp == nil || resp.StatusCode != ResourceNotFoundCode {
+				return nil, fmt.Errorf("failed to get CIS DNS record %s: %w", *ref.ID, err)
+			}
+			log.Info("CIS DNS record not found, recreating it", "record", name, "recordID", *ref.ID)
+			ref = nil
+		} else {
+			record = result.Result
+		}
+	}
+
+	if record == nil {
+		var err error
+		record, err = r.client.GetDNSRecordByName(*r.status.ZoneID, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get CIS DNS record %s: %w", name, err)
+		}
+		if record != nil {
+			log.Info("Found existing CIS DNS record", "record", name, "recordID", *record.ID)
+			ref = &infrav1.ResourceReference{ID: record.ID, ControllerCreated: ptr.To(false)}
+		}
+	}
+
+	if record == nil {
+		log.Info("Creating CIS DNS record", "record", name, "target", target)
+		result, _, err := r.client.CreateDNSRecord(*r.status.ZoneID, &dnsrecordsv1.CreateDnsRecordOptions{
+			Name:    ptr.To(name),
+			Type:    ptr.To(dnsrecordsv1.CreateDnsRecordOptions_Type_Cname),
+			Content: ptr.To(target),
+			TTL:     ptr.To(controlPlaneDNSRecordTTL),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CIS DNS record %s: %w", name, err)
+		}
+		return &infrav1.ResourceReference{ID: result.Result.ID, ControllerCreated: ptr.To(true)}, nil
+	}
+
+	if recordType := ptr.Deref(record.Type, ""); recordType != dnsrecordsv1.CreateDnsRecordOptions_Type_Cname {
+		return nil, fmt.Errorf("CIS DNS record %s is of type %s, expected %s", name, recordType, dnsrecordsv1.CreateDnsRecordOptions_Type_Cname)
+	}
+	if strings.EqualFold(strings.TrimSuffix(ptr.Deref(record.Content, ""), "."), target) {
+		return ref, nil
+	}
+
+	log.Info("Updating CIS DNS record", "record", name, "target", target)
+	if _, _, err := r.client.UpdateDNSRecord(*r.status.ZoneID, &dnsrecordsv1.UpdateDnsRecordOptions{
+		DnsrecordIdentifier: record.ID,
+		Name:                ptr.To(name),
+		Type:                ptr.To(dnsrecordsv1.UpdateDnsRecordOptions_Type_Cname),
+		Content:             ptr.To(target),
+		TTL:                 ptr.To(controlPlaneDNSRecordTTL),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update CIS DNS record %s: %w", name, err)
+	}
+	return ref, nil
+}
+
+// deleteRecord deletes the referenced record when it is created by the controller and clears the reference.
+func (r *publicDNSReconciler) deleteRecord(ctx context.Context, ref **infrav1.ResourceReference) error {
+	log := ctrl.LoggerFrom(ctx)
+	if *ref == nil {
+		return nil
+	}
+	if !ptr.Deref((*ref).ControllerCreated, false) || (*ref).ID == nil {
+		log.Info("Skipping CIS DNS record deletion as resource is not created by controller")
+		*ref = nil
+		return nil
+	}
+	log.Info("Deleting CIS DNS record", "recordID", *(*ref).ID)
+	_, resp, err := r.client.DeleteDNSRecord(*r.status.ZoneID, &dnsrecordsv1.DeleteDnsRecordOptions{
+		DnsrecordIdentifier: (*ref).ID,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return fmt.Errorf("failed to delete CIS DNS record %s: %w", *(*ref).ID, err)
+	}
+	*ref = nil
+	return nil
+}
+
+// delete deletes the records created by the controller, the domain itself is never deleted.
+func (r *publicDNSReconciler) delete(ctx context.Context) error {
+	if r.status == nil || r.status.ZoneID == nil {
+		return nil
+	}
+	for _, ref := range []**infrav1.ResourceReference{&r.status.APIRecord, &r.status.IngressRecord} {
+		if err := r.deleteRecord(ctx, ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -83,10 +83,15 @@ func NewClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		return nil, fmt.Errorf("failed to init patch helper: %w", err)
 	}
 
+	auth, err := GetAuthenticator(context.TODO(), params.Client, params.IBMVPCCluster.Namespace, params.IBMVPCCluster.Spec.IdentityRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator: %w", err)
+	}
+
 	// Fetch the service endpoint.
 	svcEndpoint := endpoints.FetchVPCEndpoint(params.IBMVPCCluster.Spec.Region, params.ServiceEndpoint)
 
-	vpcClient, err := vpc.NewService(svcEndpoint)
+	vpcClient, err := vpc.NewService(svcEndpoint, auth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create IBM VPC session: %w", err)
 	}
@@ -0,0 +1,319 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dnsservices"
+)
+
+// controlPlaneDNSRecordTTL is the time to live in seconds of the control-plane endpoint records.
+const controlPlaneDNSRecordTTL int64 = 300
+
+// controlPlaneDNSName returns the name of the control-plane endpoint in the given zone.
+func controlPlaneDNSName(zone string) string { return fmt.Sprintf("api.%s", zone) }
+
+// controlPlaneInternalDNSName returns the name of the internal control-plane endpoint in the given zone.
+func controlPlaneInternalDNSName(zone string) string { return fmt.Sprintf("api-int.%s", zone) }
+
+// controlPlaneDNSReconciler reconciles the IBM Cloud DNS Services zone, permitted network and records of a cluster control-plane endpoint.
+type controlPlaneDNSReconciler struct {
+	client      dnsservices.DNSServices
+	spec        infrav1.ControlPlaneDNS
+	status      *infrav1.ControlPlaneDNSStatus
+	clusterName string
+}
+
+// reconcileZone fetches the zone of the control-plane endpoint or creates it when it does not exist.
+// Returns true when the zone is not yet usable.
+func (r *controlPlaneDNSReconciler) reconcileZone(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	var zone *dnssvcsv1.Dnszone
+	if r.status.Zone != nil && r.status.Zone.ID != nil {
+		log.V(3).Info("DNS zone ID is set, fetching details", "dnsZoneID", *r.status.Zone.ID)
+		var err error
+		zone, _, err = r.client.GetDnszone(&dnssvcsv1.GetDnszoneOptions{
+			InstanceID: ptr.To(r.spec.InstanceID),
+			DnszoneID:  r.status.Zone.ID,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to get DNS zone %s: %w", *r.status.Zone.ID, err)
+		}
+	} else {
+		var err error
+		zone, err = r.client.GetDnszoneByName(r.spec.InstanceID, r.spec.Zone)
+		if err != nil {
+			return false, fmt.Errorf("failed to get DNS zone %s: %w", r.spec.Zone, err)
+		}
+		if zone != nil {
+			log.Info("Found existing DNS zone", "dnsZoneID", *zone.ID)
+			r.status.Zone = &infrav1.ResourceReference{ID: zone.ID, ControllerCreated: ptr.To(false)}
+		} else {
+			log.Info("Creating DNS zone", "dnsZone", r.spec.Zone)
+			zone, _, err = r.client.CreateDnszone(&dnssvcsv1.CreateDnszoneOptions{
+				InstanceID:  ptr.To(r.spec.InstanceID),
+				Name:        ptr.To(r.spec.Zone),
+				Description: ptr.To(fmt.Sprintf("Control-plane endpoint zone of cluster %s", r.clusterName)),
+			})
+			if err != nil {
+				return false, fmt.Errorf("failed to create DNS zone %s: %w", r.spec.Zone, err)
+			}
+			log.Info("Created DNS zone", "dnsZoneID", *zone.ID)
+			r.status.Zone = &infrav1.ResourceReference{ID: zone.ID, ControllerCreated: ptr.To(true)}
+		}
+	}
+
+	switch state := ptr.Deref(zone.State, ""); state {
+	case dnssvcsv1.Dnszone_State_Active, dnssvcsv1.Dnszone_State_PendingNetworkAdd:
+		return false, nil
+	default:
+		return false, fmt.Errorf("DNS zone %s is in %q state", r.spec.Zone, state)
+	}
+}
+
+// reconcilePermittedNetwork adds the VPC of the cluster to the permitted networks of the zone when it is not already permitted.
+func (r *controlPlaneDNSReconciler) reconcilePermittedNetwork(ctx context.Context, vpcCRN string) error {
+	log := ctrl.LoggerFrom(ctx)
+	if r.status.PermittedNetwork != nil && r.status.PermittedNetwork.ID != nil {
+		return nil
+	}
+
+	network, err := r.client.GetPermittedNetworkByVPCCRN(r.spec.InstanceID, *r.status.Zone.ID, vpcCRN)
+	if err != nil {
+		return fmt.Errorf("failed to get permitted network of DNS zone %s: %w", r.spec.Zone, err)
+	}
+	if network != nil {
+		log.Info("Found existing permitted network in DNS zone", "permittedNetworkID", *network.ID)
+		r.status.PermittedNetwork = &infrav1.ResourceReference{ID: network.ID, ControllerCreated: ptr.To(false)}
+		return nil
+	}
+
+	log.Info("Adding VPC to permitted networks of DNS zone", "vpcCRN", vpcCRN)
+	network, _, err = r.client.CreatePermittedNetwork(&dnssvcsv1.CreatePermittedNetworkOptions{
+		InstanceID: ptr.To(r.spec.InstanceID),
+		DnszoneID:  r.status.Zone.ID,
+		Type:       ptr.To(dnssvcsv1.CreatePermittedNetworkOptions_Type_Vpc),
+		PermittedNetwork: &dnssvcsv1.PermittedNetworkVpc{
+			VpcCrn: ptr.To(vpcCRN),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add permitted network to DNS zone %s: %w", r.spec.Zone, err)
+	}
+	r.status.PermittedNetwork = &infrav1.ResourceReference{ID: network.ID, ControllerCreated: ptr.To(true)}
+	return nil
+}
+
+// reconcileRecords creates or updates the api and api-int CNAME records of the zone to point to the given load balancer hostnames.
+func (r *controlPlaneDNSReconciler) reconcileRecords(ctx context.Context, apiTarget, apiIntTarget string) error {
+	apiRecord, err := r.reconcileRecord(ctx, r.status.APIRecord, controlPlaneDNSName(r.spec.Zone), apiTarget)
+	if err != nil {
+		return err
+	}
+	r.status.APIRecord = apiRecord
+
+	apiIntRecord, err := r.reconcileRecord(ctx, r.status.APIIntRecord, controlPlaneInternalDNSName(r.spec.Zone), apiIntTarget)
+	if err != nil {
+		return err
+	}
+	r.status.APIIntRecord = apiIntRecord
+	return nil
+}
+
+// reconcileRecord ensures a CNAME record with given name pointing to target exists in the zone and returns its reference.
+func (r *controlPlaneDNSReconciler) reconcileRecord(ctx context.Context, ref *infrav1.ResourceReference, name, target string) (*infrav1.ResourceReference, error) {
+	log := ctrl.LoggerFrom(ctx)
+	var record *dnssvcsv1.ResourceRecord
+	if ref != nil && ref.ID != nil {
+		var err error
+		record, err = r.getRecord(*ref.ID)
+		if err != nil {
+			return nil, err
+		}
+		if record == nil {
+			log.Info("DNS record not found, recreating it", "record", name, "recordID", *ref.ID)
+			ref = nil
+		}
+	}
+
+	if record == nil {
+		var err error
+		record, err = r.client.GetResourceRecordByName(r.spec.InstanceID, *r.status.Zone.ID, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get DNS record %s: %w", name, err)
+		}
+		if record != nil {
+			log.Info("Found existing DNS record", "record", name, "recordID", *record.ID)
+			ref = &infrav1.ResourceReference{ID: record.ID, ControllerCreated: ptr.To(false)}
+		}
+	}
+
+	if record == nil {
+		log.Info("Creating DNS record", "record", name, "target", target)
+		record, _, err := r.client.CreateResourceRecord(&dnssvcsv1.CreateResourceRecordOptions{
+			InstanceID: ptr.To(r.spec.InstanceID),
+			DnszoneID:  r.status.Zone.ID,
+			Type:       ptr.To(dnssvcsv1.CreateResourceRecordOptions_Type_Cname),
+			Name:       ptr.To(name),
+			TTL:        ptr.To(controlPlaneDNSRecordTTL),
+			Rdata: &dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{
+				Cname: ptr.To(target),
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create DNS record %s: %w", name, err)
+		}
+		return &infrav1.ResourceReference{ID: record.ID, ControllerCreated: ptr.To(true)}, nil
+	}
+
+	if recordType := ptr.Deref(record.Type, ""); recordType != dnssvcsv1.ResourceRecord_Type_Cname {
+		return nil, fmt.Errorf("DNS record %s is of type %s, expected %s", name, recordType, dnssvcsv1.ResourceRecord_Type_Cname)
+	}
+	if cname, ok := record.Rdata["cname"].(string); ok && strings.EqualFold(strings.TrimSuffix(cname, "."), target) {
+		return ref, nil
+	}
+
+	log.Info("Updating DNS record", "record", name, "target", target)
+	if _, _, err := r.client.UpdateResourceRecord(&dnssvcsv1.UpdateResourceRecordOptions{
+		InstanceID: ptr.To(r.spec.InstanceID),
+		DnszoneID:  r.status.Zone.ID,
+		RecordID:   record.ID,
+		Name:       ptr.To(name),
+		TTL:        ptr.To(controlPlaneDNSRecordTTL),
+		Rdata: &dnssvcsv1.ResourceRecordUpdateInputRdataRdataCnameRecord{
+			Cname: ptr.To(target),
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update DNS record %s: %w", name, err)
+	}
+	return ref, nil
+}
+
+// getRecord returns the record of the zone with given ID. If not found, returns nil.
+func (r *controlPlaneDNSReconciler) getRecord(id string) (*dnssvcsv1.ResourceRecord, error) {
+	record, resp, err := r.client.GetResourceRecord(&dnssvcsv1.GetResourceRecordOptions{
+		InstanceID: ptr.To(r.spec.InstanceID),
+		DnszoneID:  r.status.Zone.ID,
+		RecordID:   ptr.To(id),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get DNS record %s: %w", id, err)
+	}
+	return record, nil
+}
+
+// delete deletes the records, permitted network and zone created by the controller.
+// Returns true when the deletion of a resource is still in progress.
+func (r *controlPlaneDNSReconciler) delete(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if r.status == nil || r.status.Zone == nil || r.status.Zone.ID == nil {
+		return false, nil
+	}
+
+	for _, ref := range []**infrav1.ResourceReference{&r.status.APIRecord, &r.status.APIIntRecord} {
+		if *ref == nil {
+			continue
+		}
+		if ptr.Deref((*ref).ControllerCreated, false) && (*ref).ID != nil {
+			log.Info("Deleting DNS record", "recordID", *(*ref).ID)
+			resp, err := r.client.DeleteResourceRecord(&dnssvcsv1.DeleteResourceRecordOptions{
+				InstanceID: ptr.To(r.spec.InstanceID),
+				DnszoneID:  r.status.Zone.ID,
+				RecordID:   (*ref).ID,
+			})
+			if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+				return false, fmt.Errorf("failed to delete DNS record %s: %w", *(*ref).ID, err)
+			}
+		}
+		*ref = nil
+	}
+
+	if network := r.status.PermittedNetwork; network != nil {
+		if !ptr.Deref(network.ControllerCreated, false) || network.ID == nil {
+			log.Info("Skipping permitted network deletion as resource is not created by controller")
+			r.status.PermittedNetwork = nil
+		} else {
+			permittedNetwork, resp, err := r.client.GetPermittedNetwork(&dnssvcsv1.GetPermittedNetworkOptions{
+				InstanceID:         ptr.To(r.spec.InstanceID),
+				DnszoneID:          r.status.Zone.ID,
+				PermittedNetworkID: network.ID,
+			})
+			if err != nil {
+				if resp == nil || resp.StatusCode != ResourceNotFoundCode {
+					return false, fmt.Errorf("failed to get permitted network %s: %w", *network.ID, err)
+				}
+				log.Info("Permitted network successfully deleted", "permittedNetworkID", *network.ID)
+				r.status.PermittedNetwork = nil
+			} else {
+				if ptr.Deref(permittedNetwork.State, "") == dnssvcsv1.PermittedNetwork_State_RemovalInProgress {
+					log.V(3).Info("Permitted network is being deleted", "permittedNetworkID", *network.ID)
+					return true, nil
+				}
+				log.Info("Deleting permitted network", "permittedNetworkID", *network.ID)
+				if _, _, err := r.client.DeletePermittedNetwork(&dnssvcsv1.DeletePermittedNetworkOptions{
+					InstanceID:         ptr.To(r.spec.InstanceID),
+					DnszoneID:          r.status.Zone.ID,
+					PermittedNetworkID: network.ID,
+				}); err != nil {
+					return false, fmt.Errorf("failed to delete permitted network %s: %w", *network.ID, err)
+				}
+				return true, nil
+			}
+		}
+	}
+
+	if !ptr.Deref(r.status.Zone.ControllerCreated, false) {
+		log.Info("Skipping DNS zone deletion as resource is not created by controller")
+		return false, nil
+	}
+	zone, resp, err := r.client.GetDnszone(&dnssvcsv1.GetDnszoneOptions{
+		InstanceID: ptr.To(r.spec.InstanceID),
+		DnszoneID:  r.status.Zone.ID,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+			log.Info("DNS zone successfully deleted", "dnsZoneID", *r.status.Zone.ID)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get DNS zone %s: %w", *r.status.Zone.ID, err)
+	}
+	if state := ptr.Deref(zone.State, ""); state == dnssvcsv1.Dnszone_State_PendingDelete || state == dnssvcsv1.Dnszone_State_Deleted {
+		log.V(3).Info("DNS zone is being deleted", "dnsZoneID", *r.status.Zone.ID)
+		return true, nil
+	}
+	log.Info("Deleting DNS zone", "dnsZoneID", *r.status.Zone.ID)
+	if _, err := r.client.DeleteDnszone(&dnssvcsv1.DeleteDnszoneOptions{
+		InstanceID: ptr.To(r.spec.InstanceID),
+		DnszoneID:  r.status.Zone.ID,
+	}); err != nil {
+		return false, fmt.Errorf("failed to delete DNS zone %s: %w", *r.status.Zone.ID, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+)
+
+// GetAuthenticator returns the authenticator of the identity referenced by a cluster in the given namespace.
+// The credentials of the manager are used when ref is nil.
+func GetAuthenticator(ctx context.Context, c client.Client, namespace string, ref *infrav1.IBMCloudIdentityReference) (core.Authenticator, error) {
+	if ref == nil {
+		return authenticator.GetAuthenticator()
+	}
+	if c == nil {
+		return nil, fmt.Errorf("failed to get identity %s %s from nil Client", ref.Kind, ref.Name)
+	}
+
+	switch ref.Kind {
+	case infrav1.IBMCloudIdentityKindSecret:
+		return secretAuthenticator(ctx, c, namespace, ref.Name)
+	case infrav1.IBMCloudIdentityKindClusterIdentity:
+		properties, err := clusterIdentityProperties(ctx, c, namespace, ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		auth, err := authenticator.NewAuthenticator(properties)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create authenticator for identity %s %s: %w", ref.Kind, ref.Name, err)
+		}
+		return auth, nil
+	default:
+		return nil, fmt.Errorf("unsupported identity kind %q", ref.Kind)
+	}
+}
+
+// secretAuthenticator returns an IAM API key authenticator with the API key held by a Secret in the namespace of the cluster.
+// The Secret is owned by the tenants of the namespace, so it can't hold any other key: the authentication type, the
+// trusted profile or the IAM endpoint are only configured by an IBMCloudClusterIdentity or the manager.
+func secretAuthenticator(ctx context.Context, c client.Client, namespace, name string) (core.Authenticator, error) {
+	secret, err := getSecret(ctx, c, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	for key := range secret.Data {
+		if key != infrav1.IBMCloudIdentityAPIKeyKey {
+			return nil, fmt.Errorf("identity Secret %s/%s has unsupported key %s, only %s is supported", namespace, name, key, infrav1.IBMCloudIdentityAPIKeyKey)
+		}
+	}
+	apiKey, err := secretAPIKey(secret)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := core.NewIamAuthenticatorBuilder().SetApiKey(apiKey).Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator for identity Secret %s/%s: %w", namespace, name, err)
+	}
+	return auth, nil
+}
+
+// getSecret returns the Secret of an identity.
+func getSecret(ctx context.Context, c client.Client, namespace, name string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get identity Secret %s/%s: %w", namespace, name, err)
+	}
+	return secret, nil
+}
+
+// secretAPIKey returns the API key held by the Secret of an identity.
+func secretAPIKey(secret *corev1.Secret) (string, error) {
+	apiKey := string(secret.Data[infrav1.IBMCloudIdentityAPIKeyKey])
+	if apiKey == "" {
+		return "", fmt.Errorf("identity Secret %s/%s has no %s key", secret.Namespace, secret.Name, infrav1.IBMCloudIdentityAPIKeyKey)
+	}
+	return apiKey, nil
+}
+
+// clusterIdentityProperties returns the credential properties of an IBMCloudClusterIdentity, after checking that
+// the clusters of the namespace are allowed to use it.
+func clusterIdentityProperties(ctx context.Context, c client.Client, namespace, name string) (map[string]string, error) {
+	identity := &infrav1.IBMCloudClusterIdentity{}
+	if err := c.Get(ctx, client.ObjectKey{Name: name}, identity); err != nil {
+		return nil, fmt.Errorf("failed to get IBMCloudClusterIdentity %s: %w", name, err)
+	}
+
+	allowed, err := isNamespaceAllowed(ctx, c, identity.Spec.AllowedNamespaces, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, fmt.Errorf("namespace %s is not allowed to use IBMCloudClusterIdentity %s", namespace, name)
+	}
+
+	spec := identity.Spec
+	properties := map[string]string{}
+	setProperty := func(name, value string) {
+		if value != "" {
+			properties[authenticator.Prefix+name] = value
+		}
+	}
+	setProperty(core.PROPNAME_AUTH_URL, spec.AuthURL)
+	if spec.TrustedProfile != nil {
+		setProperty(core.PROPNAME_IAM_PROFILE_ID, spec.TrustedProfile.ID)
+		setProperty(core.PROPNAME_IAM_PROFILE_NAME, spec.TrustedProfile.Name)
+		setProperty(core.PROPNAME_IAM_PROFILE_CRN, spec.TrustedProfile.CRN)
+		setProperty(core.PROPNAME_CRTOKEN_FILENAME, spec.TrustedProfile.CRTokenFilename)
+	}
+
+	switch spec.Type {
+	case infrav1.IBMCloudIdentityTypeAPIKey:
+		if spec.SecretRef == nil {
+			return nil, fmt.Errorf("IBMCloudClusterIdentity %s has no secretRef", name)
+		}
+		secret, err := getSecret(ctx, c, spec.SecretRef.Namespace, spec.SecretRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		apiKey, err := secretAPIKey(secret)
+		if err != nil {
+			return nil, err
+		}
+		setProperty(core.PROPNAME_AUTH_TYPE, core.AUTHTYPE_IAM)
+		setProperty(core.PROPNAME_APIKEY, apiKey)
+	case infrav1.IBMCloudIdentityTypeTrustedProfile:
+		setProperty(core.PROPNAME_AUTH_TYPE, core.AUTHTYPE_CONTAINER)
+	case infrav1.IBMCloudIdentityTypeComputeResourceToken:
+		setProperty(core.PROPNAME_AUTH_TYPE, core.AUTHTYPE_VPC)
+	default:
+		return nil, fmt.Errorf("IBMCloudClusterIdentity %s has unsupported type %q", name, spec.Type)
+	}
+	return properties, nil
+}
+
+// isNamespaceAllowed returns whether the namespace is selected by allowedNamespaces.
+func isNamespaceAllowed(ctx context.Context, c client.Client, allowedNamespaces *infrav1.AllowedNamespaces, namespace string) (bool, error) {
+	if allowedNamespaces == nil {
+		return false, nil
+	}
+	if allowedNamespaces.NamespaceList == nil && allowedNamespaces.Selector == nil {
+		return true, nil
+	}
+	if slices.Contains(allowedNamespaces.NamespaceList, namespace) {
+		return true, nil
+	}
+	if allowedNamespaces.Selector == nil {
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(allowedNamespaces.Selector)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse allowed namespaces selector: %w", err)
+	}
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		return false, fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// getAccountID returns the ID of the account of the identity authenticator, of the manager when it is nil.
+func getAccountID(identityAuthenticator core.Authenticator) (string, error) {
+	if identityAuthenticator == nil {
+		return accounts.GetAccountIDWrapper()
+	}
+	return accounts.GetAccount(identityAuthenticator)
+}
+
+// cosCredentials returns the credentials to create COS clients with: the API key of the manager when it authenticates
+// with one, the authenticator of the identity or of the manager otherwise.
+func cosCredentials(identityAuthenticator core.Authenticator) (string, core.Authenticator, error) {
+	if identityAuthenticator != nil {
+		return "", identityAuthenticator, nil
+	}
+	auth, err := authenticator.GetAuthenticator()
+	if err != nil {
+		return "", nil, err
+	}
+	if iamAuthenticator, ok := auth.(*core.IamAuthenticator); ok && iamAuthenticator.ApiKey != "" {
+		return iamAuthenticator.ApiKey, nil, nil
+	}
+	return "", auth, nil
+}
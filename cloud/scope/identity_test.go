@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+
+	. "github.com/onsi/gomega"
+)
+
+func newClusterIdentity(name string, spec infrav1.IBMCloudClusterIdentitySpec) *infrav1.IBMCloudClusterIdentity {
+	return &infrav1.IBMCloudClusterIdentity{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: spec,
+	}
+}
+
+func TestGetAuthenticator(t *testing.T) {
+	apiKeySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "credentials",
+			Namespace: "capi-ibmcloud-system",
+		},
+		Data: map[string][]byte{
+			infrav1.IBMCloudIdentityAPIKeyKey: []byte("api-key"),
+		},
+	}
+	clusterSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-credentials",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			infrav1.IBMCloudIdentityAPIKeyKey: []byte("api-key"),
+		},
+	}
+	newClusterSecret := func(data map[string]string) *corev1.Secret {
+		secret := clusterSecret.DeepCopy()
+		secret.Data = map[string][]byte{}
+		for key, value := range data {
+			secret.Data[key] = []byte(value)
+		}
+		return secret
+	}
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "default",
+			Labels: map[string]string{"team": "power"},
+		},
+	}
+	apiKeySpec := func(allowedNamespaces *infrav1.AllowedNamespaces) infrav1.IBMCloudClusterIdentitySpec {
+		return infrav1.IBMCloudClusterIdentitySpec{
+			Type:              infrav1.IBMCloudIdentityTypeAPIKey,
+			SecretRef:         &corev1.SecretReference{Name: apiKeySecret.Name, Namespace: apiKeySecret.Namespace},
+			AllowedNamespaces: allowedNamespaces,
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		objects      []client.Object
+		ref          *infrav1.IBMCloudIdentityReference
+		expectedType string
+		expectError  bool
+	}{
+		{
+			name:         "Secret in the cluster namespace",
+			objects:      []client.Object{clusterSecret},
+			ref:          &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindSecret, Name: clusterSecret.Name},
+			expectedType: core.AUTHTYPE_IAM,
+		},
+		{
+			name: "Secret with an authentication type",
+			objects: []client.Object{newClusterSecret(map[string]string{
+				infrav1.IBMCloudIdentityAPIKeyKey: "api-key",
+				"IBMCLOUD_AUTH_TYPE":              "container",
+				"IBMCLOUD_IAM_PROFILE_NAME":       "capi",
+			})},
+			ref:         &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindSecret, Name: clusterSecret.Name},
+			expectError: true,
+		},
+		{
+			name: "Secret with an IAM endpoint",
+			objects: []client.Object{newClusterSecret(map[string]string{
+				infrav1.IBMCloudIdentityAPIKeyKey: "api-key",
+				"IBMCLOUD_AUTH_URL":               "https://iam.example.com",
+			})},
+			ref:         &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindSecret, Name: clusterSecret.Name},
+			expectError: true,
+		},
+		{
+			name:        "Secret without API key",
+			objects:     []client.Object{newClusterSecret(map[string]string{})},
+			ref:         &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindSecret, Name: clusterSecret.Name},
+			expectError: true,
+		},
+		{
+			name:        "Secret is missing",
+			ref:         &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindSecret, Name: clusterSecret.Name},
+			expectError: true,
+		},
+		{
+			name:         "API key identity allowing all namespaces",
+			objects:      []client.Object{apiKeySecret, newClusterIdentity("identity", apiKeySpec(&infrav1.AllowedNamespaces{}))},
+			ref:          &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindClusterIdentity, Name: "identity"},
+			expectedType: core.AUTHTYPE_IAM,
+		},
+		{
+			name:         "API key identity listing the namespace",
+			objects:      []client.Object{apiKeySecret, newClusterIdentity("identity", apiKeySpec(&infrav1.AllowedNamespaces{NamespaceList: []string{"default"}}))},
+			ref:          &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindClusterIdentity, Name: "identity"},
+			expectedType: core.AUTHTYPE_IAM,
+		},
+		{
+			name: "API key identity selecting the namespace",
+			objects: []client.Object{apiKeySecret, namespace, newClusterIdentity("identity", apiKeySpec(&infrav1.AllowedNamespaces{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "power"}},
+			}))},
+			ref:          &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindClusterIdentity, Name: "identity"},
+			expectedType: core.AUTHTYPE_IAM,
+		},
+		{
+			name: "API key identity not selecting the namespace",
+			objects: []client.Object{apiKeySecret, namespace, newClusterIdentity("identity", apiKeySpec(&infrav1.AllowedNamespaces{
+				NamespaceList: []string{"other"},
+				Selector:      &metav1.LabelSelector{MatchLabels: map[string]string{"team": "vpc"}},
+			}))},
+			ref:         &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindClusterIdentity, Name: "identity"},
+			expectError: true,
+		},
+		{
+			name:        "API key identity without allowed namespaces",
+			objects:     []client.Object{apiKeySecret, newClusterIdentity("identity", apiKeySpec(nil))},
+			ref:         &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindClusterIdentity, Name: "identity"},
+			expectError: true,
+		},
+		{
+			name:        "API key identity with a missing Secret",
+			objects:     []client.Object{newClusterIdentity("identity", apiKeySpec(&infrav1.AllowedNamespaces{}))},
+			ref:         &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindClusterIdentity, Name: "identity"},
+			expectError: true,
+		},
+		{
+			name: "Trusted profile identity",
+			objects: []client.Object{newClusterIdentity("identity", infrav1.IBMCloudClusterIdentitySpec{
+				Type:              infrav1.IBMCloudIdentityTypeTrustedProfile,
+				TrustedProfile:    &infrav1.IBMCloudTrustedProfile{Name: "capi"},
+				AllowedNamespaces: &infrav1.AllowedNamespaces{},
+			})},
+			ref:          &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindClusterIdentity, Name: "identity"},
+			expectedType: core.AUTHTYPE_CONTAINER,
+		},
+		{
+			name: "Compute resource token identity",
+			objects: []client.Object{newClusterIdentity("identity", infrav1.IBMCloudClusterIdentitySpec{
+				Type:              infrav1.IBMCloudIdentityTypeComputeResourceToken,
+				AllowedNamespaces: &infrav1.AllowedNamespaces{},
+			})},
+			ref:          &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindClusterIdentity, Name: "identity"},
+			expectedType: core.AUTHTYPE_VPC,
+		},
+		{
+			name:        "Identity is missing",
+			ref:         &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindClusterIdentity, Name: "identity"},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tc.objects...).Build()
+			auth, err := GetAuthenticator(context.Background(), c, "default", tc.ref)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(auth.AuthenticationType()).To(Equal(tc.expectedType))
+		})
+	}
+}
@@ -20,7 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"slices"
 
 	"github.com/go-logr/logr"
 
@@ -37,11 +39,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
 	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch" //nolint:staticcheck
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
@@ -74,6 +75,9 @@ type MachineScope struct {
 	IBMVPCCluster       *infrav1.IBMVPCCluster
 	IBMVPCMachine       *infrav1.IBMVPCMachine
 	ServiceEndpoint     []endpoints.ServiceEndpoint
+
+	// identityAuthenticator authenticates with the identity of the cluster, nil when the manager credentials are used.
+	identityAuthenticator core.Authenticator
 }
 
 // NewMachineScope creates a new MachineScope from the supplied parameters.
@@ -94,10 +98,15 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 		return nil, fmt.Errorf("failed to init patch helper: %w", err)
 	}
 
+	auth, err := GetAuthenticator(context.TODO(), params.Client, params.IBMVPCCluster.Namespace, params.IBMVPCCluster.Spec.IdentityRef)
+	if err != nil {
+		return nil, fmt.Errorf("error failed to create authenticator: %w", err)
+	}
+
 	// Fetch the service endpoint.
 	svcEndpoint := endpoints.FetchVPCEndpoint(params.IBMVPCCluster.Spec.Region, params.ServiceEndpoint)
 
-	vpcClient, err := vpc.NewService(svcEndpoint)
+	vpcClient, err := vpc.NewService(svcEndpoint, auth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create IBM VPC session: %w", err)
 	}
@@ -106,11 +115,6 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 		core.SetLoggingLevel(core.LevelDebug)
 	}
 
-	auth, err := authenticator.GetAuthenticator()
-	if err != nil {
-		return nil, fmt.Errorf("error failed to create authenticator: %w", err)
-	}
-
 	// Create Global Tagging client.
 	gtOptions := globaltagging.ServiceOptions{
 		GlobalTaggingV1Options: &globaltaggingv1.GlobalTaggingV1Options{
@@ -127,7 +131,7 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 		return nil, fmt.Errorf("failed to create global tagging client: %w", err)
 	}
 
-	return &MachineScope{
+	machineScope := &MachineScope{
 		Client:              params.Client,
 		IBMVPCClient:        vpcClient,
 		GlobalTaggingClient: globalTaggingClient,
@@ -136,7 +140,50 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 		patchHelper:         helper,
 		Machine:             params.Machine,
 		IBMVPCMachine:       params.IBMVPCMachine,
-	}, nil
+	}
+	if params.IBMVPCCluster.Spec.IdentityRef != nil {
+		machineScope.identityAuthenticator = auth
+	}
+	return machineScope, nil
+}
+
+// ReconcileFailureDomain sets the machine's zone from the Machine's failure domain, when the zone is not set.
+// If no subnet is set either, a subnet of the cluster in that zone is selected, based on whether the machine is part of the Control Plane.
+func (m *MachineScope) ReconcileFailureDomain(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	failureDomain := m.Machine.Spec.FailureDomain
+	if failureDomain == "" {
+		return nil
+	}
+
+	if m.IBMVPCMachine.Spec.Zone == "" {
+		log.V(3).Info("Setting machine zone from failure domain", "zone", failureDomain)
+		m.IBMVPCMachine.Spec.Zone = failureDomain
+	}
+
+	if m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet != "" || m.IBMVPCCluster.Status.Network == nil {
+		return nil
+	}
+
+	subnets := m.IBMVPCCluster.Status.Network.WorkerSubnets
+	if util.IsControlPlaneMachine(m.Machine) {
+		subnets = m.IBMVPCCluster.Status.Network.ControlPlaneSubnets
+	}
+	// Sort the subnet names, to select the same subnet on each reconciliation if multiple subnets exist in the zone.
+	for _, name := range slices.Sorted(maps.Keys(subnets)) {
+		subnetDetails, _, err := m.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+			ID: ptr.To(subnets[name].ID),
+		})
+		if err != nil {
+			return fmt.Errorf("error failed retrieving subnet %s: %w", name, err)
+		}
+		if subnetDetails != nil && subnetDetails.Zone != nil && subnetDetails.Zone.Name != nil && *subnetDetails.Zone.Name == m.IBMVPCMachine.Spec.Zone {
+			log.V(3).Info("Setting machine subnet from failure domain", "subnet", name, "zone", m.IBMVPCMachine.Spec.Zone)
+			m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet = name
+			return nil
+		}
+	}
+	return fmt.Errorf("error no subnet found in zone %s for machine %s", m.IBMVPCMachine.Spec.Zone, m.IBMVPCMachine.Name)
 }
 
 // CreateMachine creates a vpc machine.
@@ -281,7 +328,7 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 	sshKeys := make([]vpcv1.KeyIdentityIntf, 0)
 	if m.IBMVPCMachine.Spec.SSHKeys != nil {
 		for _, sshKey := range m.IBMVPCMachine.Spec.SSHKeys {
-			keyID, err := fetchKeyID(ctx, sshKey, m)
+			keyID, err := fetchKeyID(ctx, sshKey, m.IBMVPCClient)
 			if err != nil {
 				return nil, fmt.Errorf("error while fetching SSHKey: %v error: %v", sshKey, err)
 			}
@@ -295,7 +342,7 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 	// Populate boot volume attachment, if provided.
 	var bootVolumeAttachment *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext
 	if m.IBMVPCMachine.Spec.BootVolume != nil {
-		bootVolumeAttachment = m.volumeToVPCVolumeAttachment(ctx, m.IBMVPCMachine.Spec.BootVolume)
+		bootVolumeAttachment = volumeToVPCVolumeAttachment(ctx, m.IBMVPCMachine.Spec.BootVolume)
 	}
 
 	// Configure the Machine's Image or CatalogOffering based on provided fields.
@@ -310,7 +357,7 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 			VPC:                     vpcIdentity,
 			Zone:                    zone,
 		}
-		imageID, err := fetchImageID(ctx, m.IBMVPCMachine.Spec.Image, m)
+		imageID, err := fetchImageID(ctx, m.IBMVPCMachine.Spec.Image, m.IBMVPCClient, m.IBMVPCCluster)
 		if err != nil {
 			record.Warnf(m.IBMVPCMachine, "FailedRetrieveImage", "Failed image retrieval - %w", err)
 			return nil, fmt.Errorf("error while fetching image ID: %w", err)
@@ -422,7 +469,7 @@ func (m *MachineScope) configurePlacementTarget(ctx context.Context) (vpcv1.Inst
 	return nil, nil
 }
 
-func (m *MachineScope) volumeToVPCVolumeAttachment(ctx context.Context, volume *infrav1.VPCVolume) *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext {
+func volumeToVPCVolumeAttachment(ctx context.Context, volume *infrav1.VPCVolume) *vpcv1.VolumeAttachmentPrototypeInstanceByImageContext {
 	log := ctrl.LoggerFrom(ctx)
 	bootVolume := &vpcv1.VolumeAttachmentPrototypeInstanceByImageContext{
 		DeleteVolumeOnInstanceDelete: core.BoolPtr(volume.DeleteVolumeOnInstanceDelete),
@@ -910,7 +957,7 @@ func (m *MachineScope) GetBootstrapData() (string, error) {
 	return string(value), nil
 }
 
-func fetchKeyID(ctx context.Context, key *infrav1.IBMVPCResourceReference, m *MachineScope) (*string, error) {
+func fetchKeyID(ctx context.Context, key *infrav1.IBMVPCResourceReference, vpcClient vpc.Vpc) (*string, error) {
 	log := ctrl.LoggerFrom(ctx)
 	if key.ID == nil && key.Name == nil {
 		return nil, fmt.Errorf("both ID and Name can't be nil")
@@ -928,7 +975,7 @@ func fetchKeyID(ctx context.Context, key *infrav1.IBMVPCResourceReference, m *Ma
 			listKeysOptions.Start = &start
 		}
 
-		keysList, _, err := m.IBMVPCClient.ListKeys(listKeysOptions)
+		keysList, _, err := vpcClient.ListKeys(listKeysOptions)
 		if err != nil {
 			return false, "", fmt.Errorf("failed to get keys: %w", err)
 		}
@@ -962,7 +1009,7 @@ func fetchKeyID(ctx context.Context, key *infrav1.IBMVPCResourceReference, m *Ma
 	return nil, fmt.Errorf("sshkey does not exist - failed to find Key ID")
 }
 
-func fetchImageID(ctx context.Context, image *infrav1.IBMVPCResourceReference, m *MachineScope) (*string, error) {
+func fetchImageID(ctx context.Context, image *infrav1.IBMVPCResourceReference, vpcClient vpc.Vpc, vpcCluster *infrav1.IBMVPCCluster) (*string, error) {
 	log := ctrl.LoggerFrom(ctx)
 	if image.ID == nil && image.Name == nil {
 		return nil, fmt.Errorf("both ID and Name can't be nil")
@@ -975,9 +1022,9 @@ func fetchImageID(ctx context.Context, image *infrav1.IBMVPCResourceReference, m
 	var img *vpcv1.Image
 	f := func(start string) (bool, string, error) {
 		// check for existing images
-		resourceGroupID := ptr.To(m.IBMVPCCluster.Spec.ResourceGroup)
-		if m.IBMVPCCluster.Status.ResourceGroup != nil {
-			resourceGroupID = ptr.To(m.IBMVPCCluster.Status.ResourceGroup.ID)
+		resourceGroupID := ptr.To(vpcCluster.Spec.ResourceGroup)
+		if vpcCluster.Status.ResourceGroup != nil {
+			resourceGroupID = ptr.To(vpcCluster.Status.ResourceGroup.ID)
 		}
 		listImagesOptions := &vpcv1.ListImagesOptions{
 			ResourceGroupID: resourceGroupID,
@@ -986,7 +1033,7 @@ func fetchImageID(ctx context.Context, image *infrav1.IBMVPCResourceReference, m
 			listImagesOptions.Start = &start
 		}
 
-		imagesList, _, err := m.IBMVPCClient.ListImages(listImagesOptions)
+		imagesList, _, err := vpcClient.ListImages(listImagesOptions)
 		if err != nil {
 			return false, "", fmt.Errorf("failed to get images: %w", err)
 		}
@@ -1095,7 +1142,7 @@ func (m *MachineScope) SetNotReady() {
 func (m *MachineScope) SetProviderID(id *string) error {
 	// Based on the ProviderIDFormat version the providerID format will be decided.
 	if options.ProviderIDFormatType(options.ProviderIDFormat) == options.ProviderIDFormatV2 {
-		accountID, err := accounts.GetAccountIDWrapper()
+		accountID, err := getAccountID(m.identityAuthenticator)
 		if err != nil {
 			return fmt.Errorf("failed to get cloud account id: %w", err)
 		}
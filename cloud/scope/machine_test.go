@@ -740,6 +740,77 @@ func TestCreateMachine(t *testing.T) {
 	})
 }
 
+func TestReconcileFailureDomain(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		mockController := gomock.NewController(t)
+		return mockController, mock.NewMockVpc(mockController)
+	}
+
+	workerSubnets := map[string]*infrav1.ResourceStatus{
+		"subnet-us-south-1": {
+			ID: "subnet-id-1",
+		},
+		"subnet-us-south-2": {
+			ID: "subnet-id-2",
+		},
+	}
+
+	t.Run("Reconcile failure domain", func(t *testing.T) {
+		t.Run("Should not change zone when failure domain is not set", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			err := scope.ReconcileFailureDomain(ctx)
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Spec.Zone).To(BeEmpty())
+		})
+
+		t.Run("Should not override zone and subnet when set", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.Machine.Spec.FailureDomain = "us-south-2"
+			scope.IBMVPCMachine.Spec.Zone = "us-south-1"
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet = "subnet-us-south-1"
+			err := scope.ReconcileFailureDomain(ctx)
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Spec.Zone).To(Equal("us-south-1"))
+			g.Expect(scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet).To(Equal("subnet-us-south-1"))
+		})
+
+		t.Run("Should set zone and subnet from failure domain", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.Machine.Spec.FailureDomain = "us-south-2"
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = workerSubnets
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-1")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-2")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-2")}}, &core.DetailedResponse{}, nil)
+			err := scope.ReconcileFailureDomain(ctx)
+			g.Expect(err).To(BeNil())
+			g.Expect(scope.IBMVPCMachine.Spec.Zone).To(Equal("us-south-2"))
+			g.Expect(scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet).To(Equal("subnet-us-south-2"))
+		})
+
+		t.Run("Error when no subnet exists in failure domain", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.Machine.Spec.FailureDomain = "us-south-3"
+			scope.IBMVPCCluster.Status.Network.WorkerSubnets = workerSubnets
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-1")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-2")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-2")}}, &core.DetailedResponse{}, nil)
+			err := scope.ReconcileFailureDomain(ctx)
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+}
+
 func TestDeleteMachine(t *testing.T) {
 	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
 		t.Helper()
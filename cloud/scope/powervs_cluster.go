@@ -33,7 +33,9 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
 	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/networking-go-sdk/zonesv1"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
@@ -45,14 +47,15 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch" //nolint:staticcheck
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/internal/genutil"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cis"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dnsservices"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
@@ -102,6 +105,8 @@ type ClientFactory struct {
 	TransitGatewayFactory     func() (transitgateway.TransitGateway, error)
 	ResourceControllerFactory func() (resourcecontroller.ResourceController, error)
 	ResourceManagerFactory    func() (resourcemanager.ResourceManager, error)
+	DNSServicesFactory        func() (dnsservices.DNSServices, error)
+	CISFactory                func() (cis.CIS, error)
 }
 
 // PowerVSClusterScope defines a scope defined around a Power VS Cluster.
@@ -115,10 +120,17 @@ type PowerVSClusterScope struct {
 	ResourceClient        resourcecontroller.ResourceController
 	COSClient             cos.Cos
 	ResourceManagerClient resourcemanager.ResourceManager
+	DNSServicesClient     dnsservices.DNSServices
+	CISClient             cis.CIS
 
 	Cluster           *clusterv1.Cluster
 	IBMPowerVSCluster *infrav1.IBMPowerVSCluster
 	ServiceEndpoint   []endpoints.ServiceEndpoint
+
+	// workspaceZone is the zone of the Power VS workspace, resolved when the scope is created.
+	workspaceZone string
+	// identityAuthenticator authenticates with the identity of the cluster, nil when the manager credentials are used.
+	identityAuthenticator core.Authenticator
 }
 
 func getTGPowerVSConnectionName(tgName string) string { return fmt.Sprintf("%s-pvs-con", tgName) }
@@ -171,10 +183,20 @@ func NewPowerVSClusterScope(params PowerVSClusterScopeParams) (*PowerVSClusterSc
 		},
 	}
 
+	// Get the authenticator.
+	auth, err := params.getAuthenticator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authenticator %w", err)
+	}
+
 	// if Spec.ServiceInstanceID is set fetch zone associated with it or else use Spec.Zone.
 	if params.IBMPowerVSCluster.Spec.ServiceInstanceID != "" {
 		// Create Resource Controller client.
-		var serviceOption resourcecontroller.ServiceOptions
+		serviceOption := resourcecontroller.ServiceOptions{
+			ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
+				Authenticator: auth,
+			},
+		}
 		// Fetch the resource controller endpoint.
 		rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), params.ServiceEndpoint)
 		if rcEndpoint != "" {
@@ -207,11 +229,6 @@ func NewPowerVSClusterScope(params PowerVSClusterScopeParams) (*PowerVSClusterSc
 		piOptions.Zone = *params.IBMPowerVSCluster.Spec.Zone
 	}
 
-	// Get the authenticator.
-	auth, err := params.getAuthenticator()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create authenticator %w", err)
-	}
 	piOptions.Authenticator = auth
 
 	// Create PowerVS client.
@@ -221,7 +238,7 @@ func NewPowerVSClusterScope(params PowerVSClusterScopeParams) (*PowerVSClusterSc
 	}
 
 	// Create VPC client.
-	vpcClient, err := params.getVPCClient()
+	vpcClient, err := params.getVPCClient(auth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create VPC client: %w", err)
 	}
@@ -269,15 +286,48 @@ func NewPowerVSClusterScope(params PowerVSClusterScopeParams) (*PowerVSClusterSc
 		TransitGatewayClient:  tgClient,
 		ResourceClient:        resourceClient,
 		ResourceManagerClient: rmClient,
+		workspaceZone:         piOptions.Zone,
+	}
+
+	// Create DNS Services client only when the control-plane endpoint is served by a DNS Services zone.
+	if params.IBMPowerVSCluster.Spec.ControlPlaneDNS != nil {
+		dnsClient, err := params.getDNSServicesClient(&dnssvcsv1.DnsSvcsV1Options{
+			Authenticator: auth,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create DNS Services client: %w", err)
+		}
+		clusterScope.DNSServicesClient = dnsClient
+	}
+
+	// Create CIS client only when the public DNS records of the cluster are managed in a CIS domain.
+	if params.IBMPowerVSCluster.Spec.DNS != nil {
+		cisClient, err := params.getCISClient(&zonesv1.ZonesV1Options{
+			Authenticator: auth,
+			Crn:           ptr.To(params.IBMPowerVSCluster.Spec.DNS.CISInstanceCRN),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CIS client: %w", err)
+		}
+		clusterScope.CISClient = cisClient
+	}
+	if params.IBMPowerVSCluster.Spec.IdentityRef != nil {
+		clusterScope.identityAuthenticator = auth
 	}
 	return clusterScope, nil
 }
 
 func (params PowerVSClusterScopeParams) getAuthenticator() (core.Authenticator, error) {
-	if params.AuthenticatorFactory != nil {
-		return params.AuthenticatorFactory()
+	return params.ClientFactory.GetAuthenticator(context.TODO(), params.Client, params.IBMPowerVSCluster.Namespace, params.IBMPowerVSCluster.Spec.IdentityRef)
+}
+
+// GetAuthenticator returns the authenticator of the AuthenticatorFactory when it is set, of the identity referenced
+// by a cluster in the given namespace otherwise.
+func (f ClientFactory) GetAuthenticator(ctx context.Context, c client.Client, namespace string, ref *infrav1.IBMCloudIdentityReference) (core.Authenticator, error) {
+	if f.AuthenticatorFactory != nil {
+		return f.AuthenticatorFactory()
 	}
-	return authenticator.GetAuthenticator()
+	return GetAuthenticator(ctx, c, namespace, ref)
 }
 
 func (params PowerVSClusterScopeParams) getPowerVSClient(options powervs.ServiceOptions) (powervs.PowerVS, error) {
@@ -294,7 +344,7 @@ func (params PowerVSClusterScopeParams) getPowerVSClient(options powervs.Service
 	return powervs.NewService(options)
 }
 
-func (params PowerVSClusterScopeParams) getVPCClient() (vpc.Vpc, error) {
+func (params PowerVSClusterScopeParams) getVPCClient(auth core.Authenticator) (vpc.Vpc, error) {
 	if params.Logger.V(DEBUGLEVEL).Enabled() {
 		core.SetLoggingLevel(core.LevelDebug)
 	}
@@ -306,7 +356,7 @@ func (params PowerVSClusterScopeParams) getVPCClient() (vpc.Vpc, error) {
 	}
 	// Fetch the VPC service endpoint.
 	svcEndpoint := endpoints.FetchVPCEndpoint(*params.IBMPowerVSCluster.Spec.VPC.Region, params.ServiceEndpoint)
-	return vpc.NewService(svcEndpoint)
+	return vpc.NewService(svcEndpoint, auth)
 }
 
 func (params PowerVSClusterScopeParams) getTransitGatewayClient(options *tgapiv1.TransitGatewayApisV1Options) (transitgateway.TransitGateway, error) {
@@ -348,6 +398,32 @@ func (params PowerVSClusterScopeParams) getResourceManagerClient(options *resour
 	return resourcemanager.NewService(options)
 }
 
+func (params PowerVSClusterScopeParams) getDNSServicesClient(options *dnssvcsv1.DnsSvcsV1Options) (dnsservices.DNSServices, error) {
+	if params.DNSServicesFactory != nil {
+		return params.DNSServicesFactory()
+	}
+	// Fetch the DNS Services endpoint.
+	dnsEndpoint := endpoints.FetchEndpoints(string(endpoints.DNSServices), params.ServiceEndpoint)
+	if dnsEndpoint != "" {
+		options.URL = dnsEndpoint
+		params.Logger.V(3).Info("Overriding the default DNS Services endpoint", "DNSServicesEndpoint", dnsEndpoint)
+	}
+	return dnsservices.NewService(options)
+}
+
+func (params PowerVSClusterScopeParams) getCISClient(options *zonesv1.ZonesV1Options) (cis.CIS, error) {
+	if params.CISFactory != nil {
+		return params.CISFactory()
+	}
+	// Fetch the CIS endpoint.
+	cisEndpoint := endpoints.FetchEndpoints(string(endpoints.CIS), params.ServiceEndpoint)
+	if cisEndpoint != "" {
+		options.URL = cisEndpoint
+		params.Logger.V(3).Info("Overriding the default CIS endpoint", "CISEndpoint", cisEndpoint)
+	}
+	return cis.NewService(options)
+}
+
 // PatchObject persists the cluster configuration and status.
 func (s *PowerVSClusterScope) PatchObject() error {
 	return s.patchHelper.Patch(context.TODO(), s.IBMPowerVSCluster)
@@ -368,6 +444,23 @@ func (s *PowerVSClusterScope) Zone() *string {
 	return s.IBMPowerVSCluster.Spec.Zone
 }
 
+// SetFailureDomains sets the failure domains of the cluster.
+// A Power VS workspace is bound to a single zone, so the workspace zone is reported as the only failure domain.
+func (s *PowerVSClusterScope) SetFailureDomains() {
+	zone := s.workspaceZone
+	if zone == "" && s.Zone() != nil {
+		zone = *s.Zone()
+	}
+	if zone == "" {
+		return
+	}
+	s.IBMPowerVSCluster.Status.FailureDomains = clusterv1beta1.FailureDomains{
+		zone: clusterv1beta1.FailureDomainSpec{
+			ControlPlane: true,
+		},
+	}
+}
+
 // ResourceGroup returns the cluster resource group.
 func (s *PowerVSClusterScope) ResourceGroup() *infrav1.IBMPowerVSResourceReference {
 	return s.IBMPowerVSCluster.Spec.ResourceGroup
@@ -488,6 +581,52 @@ func (s *PowerVSClusterScope) GetDHCPServerID() *string {
 	return nil
 }
 
+// GetPlacementGroupID returns the ID of the placement group with policy from status of IBMPowerVSCluster object. If it doesn't exist, returns nil.
+func (s *PowerVSClusterScope) GetPlacementGroupID(policy infrav1.PowerVSPlacementGroupPolicy) *string {
+	if placementGroup, ok := s.IBMPowerVSCluster.Status.PlacementGroups[string(policy)]; ok {
+		return placementGroup.ID
+	}
+	return nil
+}
+
+// SetPlacementGroupStatus sets the status of the placement group with policy.
+func (s *PowerVSClusterScope) SetPlacementGroupStatus(ctx context.Context, policy infrav1.PowerVSPlacementGroupPolicy, resource infrav1.ResourceReference) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(3).Info("Setting status", "policy", policy, "placementGroup", resource)
+	if s.IBMPowerVSCluster.Status.PlacementGroups == nil {
+		s.IBMPowerVSCluster.Status.PlacementGroups = make(map[string]infrav1.ResourceReference)
+	}
+	s.IBMPowerVSCluster.Status.PlacementGroups[string(policy)] = resource
+}
+
+// GetSharedProcessorPoolID returns the ID of the shared processor pool with name from status of IBMPowerVSCluster object. If it doesn't exist, returns nil.
+func (s *PowerVSClusterScope) GetSharedProcessorPoolID(name string) *string {
+	if sharedProcessorPool, ok := s.IBMPowerVSCluster.Status.SharedProcessorPools[name]; ok {
+		return sharedProcessorPool.ID
+	}
+	return nil
+}
+
+// SetSharedProcessorPoolStatus sets the status of the shared processor pool with name.
+func (s *PowerVSClusterScope) SetSharedProcessorPoolStatus(ctx context.Context, name string, resource infrav1.ResourceReference) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(3).Info("Setting status", "name", name, "sharedProcessorPool", resource)
+	if s.IBMPowerVSCluster.Status.SharedProcessorPools == nil {
+		s.IBMPowerVSCluster.Status.SharedProcessorPools = make(map[string]infrav1.ResourceReference)
+	}
+	s.IBMPowerVSCluster.Status.SharedProcessorPools[name] = resource
+}
+
+// SetAdditionalNetworkStatus sets the status of the additional network with name.
+func (s *PowerVSClusterScope) SetAdditionalNetworkStatus(ctx context.Context, name string, network infrav1.PowerVSNetworkStatus) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(3).Info("Setting status", "name", name, "network", network)
+	if s.IBMPowerVSCluster.Status.AdditionalNetworks == nil {
+		s.IBMPowerVSCluster.Status.AdditionalNetworks = make(map[string]infrav1.PowerVSNetworkStatus)
+	}
+	s.IBMPowerVSCluster.Status.AdditionalNetworks[name] = network
+}
+
 // DHCPServer returns the DHCP server details.
 func (s *PowerVSClusterScope) DHCPServer() *infrav1.DHCPServer {
 	return s.IBMPowerVSCluster.Spec.DHCPServer
@@ -588,6 +727,11 @@ func (s *PowerVSClusterScope) TransitGateway() *infrav1.TransitGateway {
 	return s.IBMPowerVSCluster.Spec.TransitGateway
 }
 
+// IsTransitGatewayShared returns true if the transit gateway is shared with other clusters and only the cluster's connections are managed.
+func (s *PowerVSClusterScope) IsTransitGatewayShared() bool {
+	return s.TransitGateway() != nil && ptr.Deref(s.TransitGateway().Shared, false)
+}
+
 // GetTransitGatewayID returns the transit gateway id set in status field of IBMPowerVSCluster object. If it doesn't exist, returns empty string.
 func (s *PowerVSClusterScope) GetTransitGatewayID() *string {
 	if s.IBMPowerVSCluster.Status.TransitGateway != nil {
@@ -671,6 +815,61 @@ func (s *PowerVSClusterScope) GetPublicLoadBalancerHostName() (*string, error) {
 	return nil, nil
 }
 
+// getPrivateLoadBalancerHostName will return the hostname of the private load balancer.
+func (s *PowerVSClusterScope) getPrivateLoadBalancerHostName() (*string, error) {
+	if s.IBMPowerVSCluster.Status.LoadBalancers == nil {
+		return nil, nil
+	}
+
+	for _, lb := range s.IBMPowerVSCluster.Spec.LoadBalancers {
+		if lb.Public == nil || *lb.Public {
+			continue
+		}
+
+		name := lb.Name
+		if name == "" && lb.ID != nil {
+			loadBalancer, _, err := s.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+				ID: lb.ID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			name = *loadBalancer.Name
+		}
+		if val, ok := s.IBMPowerVSCluster.Status.LoadBalancers[name]; ok {
+			return val.Hostname, nil
+		}
+		return nil, nil
+	}
+	return nil, nil
+}
+
+// ControlPlaneDNS returns the DNS Services zone serving the control-plane endpoint.
+func (s *PowerVSClusterScope) ControlPlaneDNS() *infrav1.ControlPlaneDNS {
+	return s.IBMPowerVSCluster.Spec.ControlPlaneDNS
+}
+
+// GetControlPlaneDNSName returns the DNS name of the control-plane endpoint, or nil if no DNS Services zone is set.
+func (s *PowerVSClusterScope) GetControlPlaneDNSName() *string {
+	if s.ControlPlaneDNS() == nil {
+		return nil
+	}
+	return ptr.To(controlPlaneDNSName(s.ControlPlaneDNS().Zone))
+}
+
+// DNS returns the CIS domain serving the public DNS records of the cluster.
+func (s *PowerVSClusterScope) DNS() *infrav1.DNS {
+	return s.IBMPowerVSCluster.Spec.DNS
+}
+
+// GetDNSAPIName returns the public DNS name of the control-plane endpoint, or nil if no CIS domain is set.
+func (s *PowerVSClusterScope) GetDNSAPIName() *string {
+	if s.DNS() == nil {
+		return nil
+	}
+	return ptr.To(publicDNSAPIName(s.DNS(), s.InfraCluster()))
+}
+
 // GetResourceGroupID returns the resource group id if it present under spec or status filed of IBMPowerVSCluster object
 // or returns empty string.
 func (s *PowerVSClusterScope) GetResourceGroupID() string {
@@ -1080,6 +1279,247 @@ func (s *PowerVSClusterScope) createDHCPServer(ctx context.Context) (*string, er
 	return dhcpServer.ID, nil
 }
 
+// ReconcilePlacementGroups reconciles the server placement groups of the cluster.
+func (s *PowerVSClusterScope) ReconcilePlacementGroups(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	for _, placementGroup := range s.IBMPowerVSCluster.Spec.PlacementGroups {
+		if id := s.GetPlacementGroupID(placementGroup.Policy); id != nil {
+			log.V(3).Info("Placement group ID is set, fetching details", "id", *id)
+			if _, err := s.IBMPowerVSClient.GetPlacementGroup(*id); err != nil {
+				return fmt.Errorf("failed to fetch placement group with ID %s: %w", *id, err)
+			}
+			continue
+		}
+
+		name := s.placementGroupName(placementGroup)
+		id, err := s.checkPlacementGroup(name, placementGroup.Policy)
+		if err != nil {
+			return err
+		}
+		if id != nil {
+			log.Info("Found existing placement group", "name", name, "id", *id)
+			s.SetPlacementGroupStatus(ctx, placementGroup.Policy, infrav1.ResourceReference{ID: id, ControllerCreated: ptr.To(false)})
+			continue
+		}
+
+		log.Info("Creating placement group", "name", name, "policy", placementGroup.Policy)
+		created, err := s.IBMPowerVSClient.CreatePlacementGroup(&models.PlacementGroupCreate{
+			Name:   ptr.To(name),
+			Policy: ptr.To(string(placementGroup.Policy)),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create placement group %s: %w", name, err)
+		}
+		log.Info("Created placement group", "name", name, "id", *created.ID)
+		s.SetPlacementGroupStatus(ctx, placementGroup.Policy, infrav1.ResourceReference{ID: created.ID, ControllerCreated: ptr.To(true)})
+	}
+	return nil
+}
+
+// checkPlacementGroup returns the ID of the placement group with name in the Power VS workspace, nil if it doesn't exist.
+func (s *PowerVSClusterScope) checkPlacementGroup(name string, policy infrav1.PowerVSPlacementGroupPolicy) (*string, error) {
+	placementGroups, err := s.IBMPowerVSClient.GetAllPlacementGroups()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch all placement groups: %w", err)
+	}
+	for _, placementGroup := range placementGroups.PlacementGroups {
+		if placementGroup.Name == nil || *placementGroup.Name != name {
+			continue
+		}
+		if placementGroup.Policy == nil || *placementGroup.Policy != string(policy) {
+			return nil, fmt.Errorf("placement group %s has policy %s instead of %s", name, ptr.Deref(placementGroup.Policy, ""), policy)
+		}
+		return placementGroup.ID, nil
+	}
+	return nil, nil
+}
+
+// ReconcileSharedProcessorPools reconciles the shared processor pools of the cluster.
+func (s *PowerVSClusterScope) ReconcileSharedProcessorPools(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	for _, sharedProcessorPool := range s.IBMPowerVSCluster.Spec.SharedProcessorPools {
+		name := sharedProcessorPool.Name
+		if id := s.GetSharedProcessorPoolID(name); id != nil {
+			log.V(3).Info("Shared processor pool ID is set, fetching details", "id", *id)
+			if _, err := s.IBMPowerVSClient.GetSharedProcessorPool(*id); err != nil {
+				return fmt.Errorf("failed to fetch shared processor pool with ID %s: %w", *id, err)
+			}
+			continue
+		}
+
+		sharedProcessorPools, err := s.IBMPowerVSClient.GetAllSharedProcessorPools()
+		if err != nil {
+			return fmt.Errorf("failed to fetch all shared processor pools: %w", err)
+		}
+		var id *string
+		for _, pool := range sharedProcessorPools.SharedProcessorPools {
+			if pool.Name != nil && *pool.Name == name {
+				id = pool.ID
+				break
+			}
+		}
+		if id != nil {
+			log.Info("Found existing shared processor pool", "name", name, "id", *id)
+			s.SetSharedProcessorPoolStatus(ctx, name, infrav1.ResourceReference{ID: id, ControllerCreated: ptr.To(false)})
+			continue
+		}
+
+		hostGroup := sharedProcessorPool.HostGroup
+		if hostGroup == "" {
+			hostGroup = "s922"
+		}
+		log.Info("Creating shared processor pool", "name", name, "reservedCores", sharedProcessorPool.ReservedCores, "hostGroup", hostGroup)
+		created, err := s.IBMPowerVSClient.CreateSharedProcessorPool(&models.SharedProcessorPoolCreate{
+			Name:          ptr.To(name),
+			ReservedCores: ptr.To(sharedProcessorPool.ReservedCores),
+			HostGroup:     ptr.To(hostGroup),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create shared processor pool %s: %w", name, err)
+		}
+		log.Info("Created shared processor pool", "name", name, "id", *created.ID)
+		s.SetSharedProcessorPoolStatus(ctx, name, infrav1.ResourceReference{ID: created.ID, ControllerCreated: ptr.To(true)})
+	}
+	return nil
+}
+
+// ReconcileAdditionalNetworks reconciles the additional networks of the cluster.
+// It returns true once all the networks are ready, a dhcp network being ready once its DHCP server is active.
+func (s *PowerVSClusterScope) ReconcileAdditionalNetworks(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	ready := true
+	for _, network := range s.IBMPowerVSCluster.Spec.AdditionalNetworks {
+		status, ok := s.IBMPowerVSCluster.Status.AdditionalNetworks[network.Name]
+		if !ok || status.ID == nil {
+			existing, err := s.checkAdditionalNetwork(network)
+			if err != nil {
+				return false, err
+			}
+			if existing != nil {
+				log.Info("Found existing network", "name", network.Name, "id", *existing.ID)
+				status = *existing
+			} else {
+				created, err := s.createAdditionalNetwork(ctx, network)
+				if err != nil {
+					return false, err
+				}
+				status = *created
+			}
+			s.SetAdditionalNetworkStatus(ctx, network.Name, status)
+		}
+
+		if status.DHCPServerID == nil {
+			log.V(3).Info("Network ID is set, fetching details", "name", network.Name, "id", *status.ID)
+			if _, err := s.IBMPowerVSClient.GetNetworkByID(*status.ID); err != nil {
+				return false, fmt.Errorf("failed to fetch network %s with ID %s: %w", network.Name, *status.ID, err)
+			}
+			continue
+		}
+
+		log.V(3).Info("DHCP server ID is set, fetching details", "name", network.Name, "dhcpServerID", *status.DHCPServerID)
+		dhcpServer, err := s.IBMPowerVSClient.GetDHCPServer(*status.DHCPServerID)
+		if err != nil {
+			return false, fmt.Errorf("failed to fetch DHCP server of network %s: %w", network.Name, err)
+		}
+		active, err := s.checkDHCPServerStatus(ctx, *dhcpServer)
+		if err != nil {
+			return false, fmt.Errorf("failed to check DHCP server of network %s: %w", network.Name, err)
+		}
+		if !active {
+			log.V(3).Info("DHCP server is not active", "name", network.Name)
+			ready = false
+		}
+	}
+	return ready, nil
+}
+
+// checkAdditionalNetwork returns the status of the additional network existing in the Power VS workspace, nil if it doesn't exist.
+// A dhcp network exists when a DHCP server serves the network DHCPSERVER<name>_Private, other networks when a network has name.
+func (s *PowerVSClusterScope) checkAdditionalNetwork(network infrav1.PowerVSNetwork) (*infrav1.PowerVSNetworkStatus, error) {
+	if network.Type == infrav1.PowerVSNetworkTypeDHCP {
+		dhcpServers, err := s.IBMPowerVSClient.GetAllDHCPServers()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch all DHCP servers: %w", err)
+		}
+		networkName := dhcpNetworkName(network.Name)
+		for _, dhcpServer := range dhcpServers {
+			if dhcpServer.Network != nil && dhcpServer.Network.Name != nil && *dhcpServer.Network.Name == networkName {
+				return &infrav1.PowerVSNetworkStatus{ID: dhcpServer.Network.ID, DHCPServerID: dhcpServer.ID, ControllerCreated: ptr.To(false)}, nil
+			}
+		}
+		return nil, nil
+	}
+
+	existing, err := s.IBMPowerVSClient.GetNetworkByName(network.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch network by name: %w", err)
+	}
+	if existing == nil || existing.NetworkID == nil {
+		return nil, nil
+	}
+	if networkType := powerVSNetworkType(network.Type); existing.Type != nil && *existing.Type != networkType {
+		return nil, fmt.Errorf("network %s has type %s instead of %s", network.Name, *existing.Type, networkType)
+	}
+	return &infrav1.PowerVSNetworkStatus{ID: existing.NetworkID, ControllerCreated: ptr.To(false)}, nil
+}
+
+// createAdditionalNetwork creates an additional network, through a DHCP server for a dhcp network.
+func (s *PowerVSClusterScope) createAdditionalNetwork(ctx context.Context, network infrav1.PowerVSNetwork) (*infrav1.PowerVSNetworkStatus, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if network.Type == infrav1.PowerVSNetworkTypeDHCP {
+		dnsServer := "1.1.1.1"
+		if len(network.DNSServers) > 0 {
+			dnsServer = network.DNSServers[0]
+		}
+		log.Info("Creating DHCP server", "name", network.Name, "cidr", network.CIDR)
+		dhcpServer, err := s.IBMPowerVSClient.CreateDHCPServer(&models.DHCPServerCreate{
+			Name:        ptr.To(network.Name),
+			Cidr:        network.CIDR,
+			DNSServer:   ptr.To(dnsServer),
+			SnatEnabled: ptr.To(ptr.Deref(network.SNAT, true)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create DHCP server of network %s: %w", network.Name, err)
+		}
+		if dhcpServer == nil || dhcpServer.Network == nil {
+			return nil, fmt.Errorf("created DHCP server of network %s has no network", network.Name)
+		}
+		log.Info("Created DHCP server", "name", network.Name, "dhcpServerID", *dhcpServer.ID, "networkID", *dhcpServer.Network.ID)
+		return &infrav1.PowerVSNetworkStatus{ID: dhcpServer.Network.ID, DHCPServerID: dhcpServer.ID, ControllerCreated: ptr.To(true)}, nil
+	}
+
+	networkType := powerVSNetworkType(network.Type)
+	log.Info("Creating network", "name", network.Name, "type", networkType, "cidr", network.CIDR)
+	created, err := s.IBMPowerVSClient.CreateNetwork(&models.NetworkCreate{
+		Name:       network.Name,
+		Type:       ptr.To(networkType),
+		Cidr:       ptr.Deref(network.CIDR, ""),
+		DNSServers: network.DNSServers,
+		Mtu:        network.MTU,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network %s: %w", network.Name, err)
+	}
+	log.Info("Created network", "name", network.Name, "id", *created.NetworkID)
+	return &infrav1.PowerVSNetworkStatus{ID: created.NetworkID, ControllerCreated: ptr.To(true)}, nil
+}
+
+// powerVSNetworkType returns the Power VS type of a public or private network.
+func powerVSNetworkType(networkType infrav1.PowerVSNetworkType) string {
+	if networkType == infrav1.PowerVSNetworkTypePublic {
+		return "pub-vlan"
+	}
+	return "vlan"
+}
+
+// placementGroupName returns the name of a placement group of the cluster, CLUSTER_NAME-POLICY when not set.
+func (s *PowerVSClusterScope) placementGroupName(placementGroup infrav1.PowerVSPlacementGroup) string {
+	if placementGroup.Name != nil {
+		return *placementGroup.Name
+	}
+	return fmt.Sprintf("%s-%s", s.InfraCluster(), placementGroup.Policy)
+}
+
 // ReconcileVPC reconciles VPC.
 func (s *PowerVSClusterScope) ReconcileVPC(ctx context.Context) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -1767,6 +2207,11 @@ func (s *PowerVSClusterScope) ReconcileTransitGateway(ctx context.Context) (bool
 		return requeue, nil
 	}
 
+	// shared transit gateway is managed outside of the cluster and must never be created by the controller.
+	if s.IsTransitGatewayShared() {
+		return false, fmt.Errorf("shared transit gateway %s not found", *s.GetServiceName(infrav1.ResourceTypeTransitGateway))
+	}
+
 	// create transit gateway
 	log.Info("Creating transit gateway")
 	if err := s.createTransitGateway(ctx); err != nil {
@@ -1776,6 +2221,132 @@ func (s *PowerVSClusterScope) ReconcileTransitGateway(ctx context.Context) (bool
 	return true, nil
 }
 
+// ReconcileDNSRecords reconciles the CIS records of the control-plane endpoint, and of the ingress when requested,
+// pointing to the public load balancer.
+func (s *PowerVSClusterScope) ReconcileDNSRecords(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	target, err := s.GetPublicLoadBalancerHostName()
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch public load balancer hostname: %w", err)
+	}
+	if target == nil || *target == "" {
+		log.V(3).Info("Load balancer hostname is not yet available, requeuing CIS DNS records reconciliation")
+		return true, nil
+	}
+
+	if s.IBMPowerVSCluster.Status.DNS == nil {
+		s.IBMPowerVSCluster.Status.DNS = &infrav1.DNSStatus{}
+	}
+	r := &publicDNSReconciler{
+		client:      s.CISClient,
+		spec:        *s.DNS(),
+		status:      s.IBMPowerVSCluster.Status.DNS,
+		clusterName: s.InfraCluster(),
+	}
+	return false, r.reconcile(ctx, *target)
+}
+
+// ReconcileVPEGateways reconciles the VPE gateways of the cluster, bound to the VPC subnets and security groups,
+// and returns whether they are not yet ready.
+func (s *PowerVSClusterScope) ReconcileVPEGateways(ctx context.Context) (bool, error) {
+	vpcID := s.GetVPCID()
+	if vpcID == nil {
+		return false, fmt.Errorf("VPC is not yet reconciled")
+	}
+	r := &vpeGatewayReconciler{
+		client:          s.IBMVPCClient,
+		clusterName:     s.InfraCluster(),
+		vpcID:           *vpcID,
+		resourceGroupID: s.GetResourceGroupID(),
+	}
+	if s.VPC() != nil {
+		r.region = ptr.Deref(s.VPC().Region, "")
+	}
+	for _, subnet := range s.IBMPowerVSCluster.Status.VPCSubnet {
+		if subnet.ID != nil {
+			r.subnetIDs = append(r.subnetIDs, *subnet.ID)
+		}
+	}
+	for _, securityGroup := range s.IBMPowerVSCluster.Status.VPCSecurityGroups {
+		if securityGroup.ID != nil {
+			r.securityGroupIDs = append(r.securityGroupIDs, *securityGroup.ID)
+		}
+	}
+
+	if s.IBMPowerVSCluster.Status.VPEGateways == nil {
+		s.IBMPowerVSCluster.Status.VPEGateways = make(map[string]infrav1.ResourceReference)
+	}
+	requeue := false
+	for _, gateway := range s.IBMPowerVSCluster.Spec.VPEGateways {
+		name := vpeGatewayName(gateway, r.clusterName)
+		var ref *infrav1.ResourceReference
+		if existing, ok := s.IBMPowerVSCluster.Status.VPEGateways[name]; ok {
+			ref = &existing
+		}
+		ref, ready, err := r.reconcile(ctx, gateway, ref)
+		if ref != nil {
+			s.IBMPowerVSCluster.Status.VPEGateways[name] = *ref
+		}
+		if err != nil {
+			return false, err
+		}
+		if !ready {
+			requeue = true
+		}
+	}
+	return requeue, nil
+}
+
+// ReconcileControlPlaneDNS reconciles the DNS Services zone, the permitted network of the VPC and
+// the api and api-int records of the control-plane endpoint.
+func (s *PowerVSClusterScope) ReconcileControlPlaneDNS(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if s.IBMPowerVSCluster.Status.ControlPlaneDNS == nil {
+		s.IBMPowerVSCluster.Status.ControlPlaneDNS = &infrav1.ControlPlaneDNSStatus{}
+	}
+	r := &controlPlaneDNSReconciler{
+		client:      s.DNSServicesClient,
+		spec:        *s.ControlPlaneDNS(),
+		status:      s.IBMPowerVSCluster.Status.ControlPlaneDNS,
+		clusterName: s.InfraCluster(),
+	}
+
+	if requeue, err := r.reconcileZone(ctx); err != nil || requeue {
+		return requeue, err
+	}
+
+	if s.IBMPowerVSCluster.Status.ControlPlaneDNS.PermittedNetwork == nil {
+		vpcCRN, err := s.fetchVPCCRN()
+		if err != nil {
+			return false, fmt.Errorf("failed to fetch VPC CRN: %w", err)
+		}
+		if err := r.reconcilePermittedNetwork(ctx, *vpcCRN); err != nil {
+			return false, err
+		}
+	}
+
+	apiTarget, err := s.GetPublicLoadBalancerHostName()
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch public load balancer hostname: %w", err)
+	}
+	apiIntTarget, err := s.getPrivateLoadBalancerHostName()
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch private load balancer hostname: %w", err)
+	}
+	if apiTarget == nil {
+		apiTarget = apiIntTarget
+	}
+	if apiIntTarget == nil {
+		apiIntTarget = apiTarget
+	}
+	if apiTarget == nil {
+		log.V(3).Info("Load balancer hostname is not yet available, requeuing DNS records reconciliation")
+		return true, nil
+	}
+
+	return false, r.reconcileRecords(ctx, *apiTarget, *apiIntTarget)
+}
+
 // isTransitGatewayExists checks transit gateway exist in cloud.
 func (s *PowerVSClusterScope) isTransitGatewayExists(ctx context.Context) (*tgapiv1.TransitGateway, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -1883,14 +2454,14 @@ func (s *PowerVSClusterScope) checkAndUpdateTransitGatewayConnections(ctx contex
 	// update the connections when connection not exist
 	if !powerVSConnStatus {
 		log.V(3).Info("Only PowerVS connection not exist in transit gateway, creating it")
-		if err := s.createTransitGatewayConnection(ctx, transitGateway.ID, ptr.To(getTGPowerVSConnectionName(*transitGateway.Name)), pvsServiceInstanceCRN, powervsNetworkConnectionType); err != nil {
+		if err := s.createTransitGatewayConnection(ctx, transitGateway, pvsServiceInstanceCRN, powervsNetworkConnectionType); err != nil {
 			return false, fmt.Errorf("failed to create PowerVS transit gateway connection: %w", err)
 		}
 	}
 
 	if !vpcConnStatus {
 		log.V(3).Info("Only VPC connection not exist in transit gateway, creating it")
-		if err := s.createTransitGatewayConnection(ctx, transitGateway.ID, ptr.To(getTGVPCConnectionName(*transitGateway.Name)), vpcCRN, vpcNetworkConnectionType); err != nil {
+		if err := s.createTransitGatewayConnection(ctx, transitGateway, vpcCRN, vpcNetworkConnectionType); err != nil {
 			return false, fmt.Errorf("failed to create VPC transit gateway connection: %w", err)
 		}
 	}
@@ -1950,16 +2521,55 @@ func (s *PowerVSClusterScope) checkTransitGatewayConnectionStatus(ctx context.Co
 	return false, nil
 }
 
+// transitGatewayConnectionName returns the name of the connection of given network type in the transit gateway.
+// connections in a shared transit gateway are named after the cluster to keep them unique across the clusters sharing it.
+func (s *PowerVSClusterScope) transitGatewayConnectionName(tg *tgapiv1.TransitGateway, networkType networkConnectionType) string {
+	name := *tg.Name
+	if s.IsTransitGatewayShared() {
+		name = s.InfraCluster()
+	}
+	if networkType == vpcNetworkConnectionType {
+		return getTGVPCConnectionName(name)
+	}
+	return getTGPowerVSConnectionName(name)
+}
+
+// transitGatewayConnectionSpec returns the spec of the transit gateway connection of given network type.
+func (s *PowerVSClusterScope) transitGatewayConnectionSpec(networkType networkConnectionType) *infrav1.TransitGatewayConnection {
+	if s.TransitGateway() == nil {
+		return nil
+	}
+	if networkType == vpcNetworkConnectionType {
+		return s.TransitGateway().VPCConnection
+	}
+	return s.TransitGateway().PowerVSConnection
+}
+
 // createTransitGatewayConnection creates transit gateway connection and sets the connection status.
-func (s *PowerVSClusterScope) createTransitGatewayConnection(ctx context.Context, transitGatewayID, connName, networkID *string, networkType networkConnectionType) error {
+func (s *PowerVSClusterScope) createTransitGatewayConnection(ctx context.Context, tg *tgapiv1.TransitGateway, networkID *string, networkType networkConnectionType) error {
 	log := ctrl.LoggerFrom(ctx)
-	log.V(3).Info("Creating transit gateway connection", "transitGatewayID", transitGatewayID, "connectionType", networkType, "connectionName", connName)
-	conn, _, err := s.TransitGatewayClient.CreateTransitGatewayConnection(&tgapiv1.CreateTransitGatewayConnectionOptions{
-		TransitGatewayID: transitGatewayID,
+	connName := s.transitGatewayConnectionName(tg, networkType)
+	log.V(3).Info("Creating transit gateway connection", "transitGatewayID", tg.ID, "connectionType", networkType, "connectionName", connName)
+	options := &tgapiv1.CreateTransitGatewayConnectionOptions{
+		TransitGatewayID: tg.ID,
 		NetworkType:      ptr.To(string(networkType)),
 		NetworkID:        networkID,
-		Name:             connName,
-	})
+		Name:             ptr.To(connName),
+	}
+	if connSpec := s.transitGatewayConnectionSpec(networkType); connSpec != nil {
+		for _, filter := range connSpec.PrefixFilters {
+			options.PrefixFilters = append(options.PrefixFilters, tgapiv1.TransitGatewayConnectionPrefixFilter{
+				Action: ptr.To(string(filter.Action)),
+				Prefix: ptr.To(filter.Prefix),
+				Ge:     filter.Ge,
+				Le:     filter.Le,
+			})
+		}
+		if connSpec.PrefixFiltersDefault != "" {
+			options.PrefixFiltersDefault = ptr.To(string(connSpec.PrefixFiltersDefault))
+		}
+	}
+	conn, _, err := s.TransitGatewayClient.CreateTransitGatewayConnection(options)
 	if err != nil {
 		return err
 	}
@@ -1970,11 +2580,11 @@ func (s *PowerVSClusterScope) createTransitGatewayConnection(ctx context.Context
 
 // createTransitGatewayConnections creates PowerVS and VPC connections in the transit gateway.
 func (s *PowerVSClusterScope) createTransitGatewayConnections(ctx context.Context, tg *tgapiv1.TransitGateway, pvsServiceInstanceCRN, vpcCRN *string) error {
-	if err := s.createTransitGatewayConnection(ctx, tg.ID, ptr.To(getTGPowerVSConnectionName(*tg.Name)), pvsServiceInstanceCRN, powervsNetworkConnectionType); err != nil {
+	if err := s.createTransitGatewayConnection(ctx, tg, pvsServiceInstanceCRN, powervsNetworkConnectionType); err != nil {
 		return fmt.Errorf("failed to create PowerVS connection in transit gateway: %w", err)
 	}
 
-	if err := s.createTransitGatewayConnection(ctx, tg.ID, ptr.To(getTGVPCConnectionName(*tg.Name)), vpcCRN, vpcNetworkConnectionType); err != nil {
+	if err := s.createTransitGatewayConnection(ctx, tg, vpcCRN, vpcNetworkConnectionType); err != nil {
 		return fmt.Errorf("failed to create VPC connection in transit gateway: %w", err)
 	}
 
@@ -2275,14 +2885,9 @@ func (s *PowerVSClusterScope) ReconcileCOSInstance(ctx context.Context) error {
 		s.SetStatus(ctx, infrav1.ResourceTypeCOSInstance, infrav1.ResourceReference{ID: cosServiceInstanceStatus.GUID, ControllerCreated: ptr.To(true)})
 	}
 
-	props, err := authenticator.GetProperties()
+	apiKey, auth, err := cosCredentials(s.identityAuthenticator)
 	if err != nil {
-		return fmt.Errorf("failed to get authenticator properties: %w", err)
-	}
-
-	apiKey, ok := props["APIKEY"]
-	if !ok {
-		return fmt.Errorf("IBM Cloud API key is not provided, set %s environmental variable", "IBMCLOUD_API_KEY")
+		return fmt.Errorf("failed to get COS credentials: %w", err)
 	}
 
 	region := s.bucketRegion()
@@ -2305,6 +2910,7 @@ func (s *PowerVSClusterScope) ReconcileCOSInstance(ctx context.Context) error {
 				Region:   &region,
 			},
 		},
+		Authenticator: auth,
 	}
 
 	cosClient, err := cos.NewServiceWrapper(cosOptions, apiKey, *cosServiceInstanceStatus.GUID)
@@ -2414,12 +3020,7 @@ func (s *PowerVSClusterScope) fetchResourceGroupID() (string, error) {
 		return "", fmt.Errorf("resource group name is not set")
 	}
 
-	auth, err := authenticator.GetAuthenticator()
-	if err != nil {
-		return "", err
-	}
-
-	account, err := accounts.GetAccount(auth)
+	account, err := getAccountID(s.identityAuthenticator)
 	if err != nil {
 		return "", err
 	}
@@ -2674,11 +3275,64 @@ func (s *PowerVSClusterScope) DeleteVPC(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// DeleteVPEGateways deletes the VPE gateways created by the controller and returns whether the deletion is pending.
+func (s *PowerVSClusterScope) DeleteVPEGateways(ctx context.Context) (bool, error) {
+	r := &vpeGatewayReconciler{
+		client: s.IBMVPCClient,
+	}
+	var errs []error
+	requeue := false
+	for name, ref := range s.IBMPowerVSCluster.Status.VPEGateways {
+		deleted, err := r.delete(ctx, name, ref)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !deleted {
+			requeue = true
+			continue
+		}
+		delete(s.IBMPowerVSCluster.Status.VPEGateways, name)
+	}
+	if len(errs) > 0 {
+		return false, kerrors.NewAggregate(errs)
+	}
+	return requeue, nil
+}
+
+// DeleteDNSRecords deletes the CIS records of the cluster created by the controller.
+func (s *PowerVSClusterScope) DeleteDNSRecords(ctx context.Context) error {
+	if s.DNS() == nil || s.IBMPowerVSCluster.Status.DNS == nil {
+		return nil
+	}
+	r := &publicDNSReconciler{
+		client:      s.CISClient,
+		spec:        *s.DNS(),
+		status:      s.IBMPowerVSCluster.Status.DNS,
+		clusterName: s.InfraCluster(),
+	}
+	return r.delete(ctx)
+}
+
+// DeleteControlPlaneDNS deletes the DNS Services records, permitted network and zone of the control-plane endpoint created by the controller.
+func (s *PowerVSClusterScope) DeleteControlPlaneDNS(ctx context.Context) (bool, error) {
+	if s.ControlPlaneDNS() == nil || s.IBMPowerVSCluster.Status.ControlPlaneDNS == nil {
+		return false, nil
+	}
+	r := &controlPlaneDNSReconciler{
+		client:      s.DNSServicesClient,
+		spec:        *s.ControlPlaneDNS(),
+		status:      s.IBMPowerVSCluster.Status.ControlPlaneDNS,
+		clusterName: s.InfraCluster(),
+	}
+	return r.delete(ctx)
+}
+
 // DeleteTransitGateway deletes transit gateway.
 func (s *PowerVSClusterScope) DeleteTransitGateway(ctx context.Context) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
 	skipTGDeletion := false
-	if !s.isResourceCreatedByController(infrav1.ResourceTypeTransitGateway) {
+	if !s.isResourceCreatedByController(infrav1.ResourceTypeTransitGateway) || s.IsTransitGatewayShared() {
 		log.Info("Skipping transit gateway deletion as resource is not created by controller, but will check if connections are created by the controller")
 		skipTGDeletion = true
 	}
@@ -2730,7 +3384,7 @@ func (s *PowerVSClusterScope) deleteTransitGatewayConnections(ctx context.Contex
 			TransitGatewayID: tg.ID,
 			ID:               connID,
 		})
-		if resp.StatusCode == ResourceNotFoundCode {
+		if resp != nil && resp.StatusCode == ResourceNotFoundCode {
 			log.V(3).Info("Connection deleted in transit gateway", "connectionID", *connID)
 			return false, nil
 		}
@@ -2751,26 +3405,28 @@ func (s *PowerVSClusterScope) deleteTransitGatewayConnections(ctx context.Contex
 
 		return true, nil
 	}
-	if *s.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated {
+	if powerVSConnection := s.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection; powerVSConnection != nil && ptr.Deref(powerVSConnection.ControllerCreated, false) {
 		log.V(3).Info("Deleting PowerVS connection in Transit gateway")
-		requeue, err := deleteConnection(s.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID)
+		requeue, err := deleteConnection(powerVSConnection.ID)
 		if err != nil {
 			return false, err
 		}
 		if requeue {
 			return requeue, nil
 		}
+		s.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection = nil
 	}
 
-	if *s.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated {
+	if vpcConnection := s.IBMPowerVSCluster.Status.TransitGateway.VPCConnection; vpcConnection != nil && ptr.Deref(vpcConnection.ControllerCreated, false) {
 		log.V(3).Info("Deleting VPC connection in Transit gateway")
-		requeue, err := deleteConnection(s.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID)
+		requeue, err := deleteConnection(vpcConnection.ID)
 		if err != nil {
 			return false, err
 		}
 		if requeue {
 			return requeue, nil
 		}
+		s.IBMPowerVSCluster.Status.TransitGateway.VPCConnection = nil
 	}
 
 	return false, nil
@@ -2807,6 +3463,97 @@ func (s *PowerVSClusterScope) DeleteDHCPServer(ctx context.Context) error {
 	return nil
 }
 
+// DeletePlacementGroups deletes the server placement groups created by the controller.
+func (s *PowerVSClusterScope) DeletePlacementGroups(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	if s.isResourceCreatedByController(infrav1.ResourceTypeServiceInstance) {
+		log.Info("Skipping placement group deletion as PowerVS service instance is created by controller, will directly delete the PowerVS service instance since it will delete the placement groups internally")
+		return nil
+	}
+
+	for policy, placementGroup := range s.IBMPowerVSCluster.Status.PlacementGroups {
+		if placementGroup.ControllerCreated == nil || !*placementGroup.ControllerCreated || placementGroup.ID == nil {
+			log.Info("Skipping placement group deletion as resource is not created by controller", "policy", policy)
+			continue
+		}
+
+		if _, err := s.IBMPowerVSClient.GetPlacementGroup(*placementGroup.ID); err != nil {
+			if !strings.Contains(err.Error(), string(PlacementGroupNotFound)) {
+				return fmt.Errorf("failed to fetch placement group %s: %w", *placementGroup.ID, err)
+			}
+		} else if err := s.IBMPowerVSClient.DeletePlacementGroup(*placementGroup.ID); err != nil {
+			return fmt.Errorf("failed to delete placement group %s: %w", *placementGroup.ID, err)
+		}
+		log.Info("Placement group successfully deleted", "policy", policy, "id", *placementGroup.ID)
+		delete(s.IBMPowerVSCluster.Status.PlacementGroups, policy)
+	}
+	return nil
+}
+
+// DeleteSharedProcessorPools deletes the shared processor pools created by the controller.
+func (s *PowerVSClusterScope) DeleteSharedProcessorPools(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	if s.isResourceCreatedByController(infrav1.ResourceTypeServiceInstance) {
+		log.Info("Skipping shared processor pool deletion as PowerVS service instance is created by controller, will directly delete the PowerVS service instance since it will delete the shared processor pools internally")
+		return nil
+	}
+
+	for name, sharedProcessorPool := range s.IBMPowerVSCluster.Status.SharedProcessorPools {
+		if sharedProcessorPool.ControllerCreated == nil || !*sharedProcessorPool.ControllerCreated || sharedProcessorPool.ID == nil {
+			log.Info("Skipping shared processor pool deletion as resource is not created by controller", "name", name)
+			continue
+		}
+
+		if _, err := s.IBMPowerVSClient.GetSharedProcessorPool(*sharedProcessorPool.ID); err != nil {
+			if !strings.Contains(err.Error(), string(SharedProcessorPoolNotFound)) {
+				return fmt.Errorf("failed to fetch shared processor pool %s: %w", *sharedProcessorPool.ID, err)
+			}
+		} else if err := s.IBMPowerVSClient.DeleteSharedProcessorPool(*sharedProcessorPool.ID); err != nil {
+			return fmt.Errorf("failed to delete shared processor pool %s: %w", *sharedProcessorPool.ID, err)
+		}
+		log.Info("Shared processor pool successfully deleted", "name", name, "id", *sharedProcessorPool.ID)
+		delete(s.IBMPowerVSCluster.Status.SharedProcessorPools, name)
+	}
+	return nil
+}
+
+// DeleteAdditionalNetworks deletes the additional networks created by the controller, through their DHCP server for dhcp networks.
+func (s *PowerVSClusterScope) DeleteAdditionalNetworks(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	if s.isResourceCreatedByController(infrav1.ResourceTypeServiceInstance) {
+		log.Info("Skipping additional network deletion as PowerVS service instance is created by controller, will directly delete the PowerVS service instance since it will delete the networks internally")
+		return nil
+	}
+
+	for name, network := range s.IBMPowerVSCluster.Status.AdditionalNetworks {
+		if network.ControllerCreated == nil || !*network.ControllerCreated || network.ID == nil {
+			log.Info("Skipping additional network deletion as resource is not created by controller", "name", name)
+			continue
+		}
+
+		if network.DHCPServerID != nil {
+			if _, err := s.IBMPowerVSClient.GetDHCPServer(*network.DHCPServerID); err != nil {
+				if !strings.Contains(err.Error(), string(DHCPServerNotFound)) {
+					return fmt.Errorf("failed to fetch DHCP server of network %s: %w", name, err)
+				}
+			} else if err := s.IBMPowerVSClient.DeleteDHCPServer(*network.DHCPServerID); err != nil {
+				return fmt.Errorf("failed to delete DHCP server of network %s: %w", name, err)
+			}
+		} else {
+			if _, err := s.IBMPowerVSClient.GetNetworkByID(*network.ID); err != nil {
+				if !strings.Contains(err.Error(), string(NetworkNotFound)) {
+					return fmt.Errorf("failed to fetch network %s: %w", name, err)
+				}
+			} else if err := s.IBMPowerVSClient.DeleteNetwork(*network.ID); err != nil {
+				return fmt.Errorf("failed to delete network %s: %w", name, err)
+			}
+		}
+		log.Info("Additional network successfully deleted", "name", name, "id", *network.ID)
+		delete(s.IBMPowerVSCluster.Status.AdditionalNetworks, name)
+	}
+	return nil
+}
+
 // DeleteServiceInstance deletes service instance.
 func (s *PowerVSClusterScope) DeleteServiceInstance(ctx context.Context) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
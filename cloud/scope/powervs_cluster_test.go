@@ -28,7 +28,10 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
 	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/networking-go-sdk/zonesv1"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	regionUtil "github.com/ppc64le-cloud/powervs-utils"
@@ -36,12 +39,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/pointer"
+	cismock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cis/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
 	mockcos "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos/mock"
+	dnsmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dnsservices/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	mockP "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
@@ -209,6 +215,52 @@ func TestGetServiceInstanceID(t *testing.T) {
 	}
 }
 
+func TestSetFailureDomains(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		expectedFailureDomains clusterv1beta1.FailureDomains
+		clusterScope           PowerVSClusterScope
+	}{
+		{
+			name: "Zone is not known",
+			clusterScope: PowerVSClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+			},
+		},
+		{
+			name: "Zone is set in spec",
+			clusterScope: PowerVSClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Spec: infrav1.IBMPowerVSClusterSpec{
+						Zone: ptr.To("dal10"),
+					},
+				},
+			},
+			expectedFailureDomains: clusterv1beta1.FailureDomains{
+				"dal10": clusterv1beta1.FailureDomainSpec{ControlPlane: true},
+			},
+		},
+		{
+			name: "Zone is resolved from the workspace",
+			clusterScope: PowerVSClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+				workspaceZone:     "osa21",
+			},
+			expectedFailureDomains: clusterv1beta1.FailureDomains{
+				"osa21": clusterv1beta1.FailureDomainSpec{ControlPlane: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		g := NewWithT(t)
+		t.Run(tc.name, func(_ *testing.T) {
+			tc.clusterScope.SetFailureDomains()
+			g.Expect(tc.clusterScope.IBMPowerVSCluster.Status.FailureDomains).To(Equal(tc.expectedFailureDomains))
+		})
+	}
+}
+
 func TestGetDHCPServerID(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -4925,6 +4977,29 @@ func TestDeleteTransitGateway(t *testing.T) {
 		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeFalse())
 	})
+
+	t.Run("When transit gateway is shared and connections created by controller are deleted", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		tgw := &tgapiv1.TransitGateway{
+			Name:   ptr.To("transitGateway"),
+			ID:     ptr.To("transitGatewayID"),
+			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Spec.TransitGateway = &infrav1.TransitGateway{
+			Name:   ptr.To("transitGateway"),
+			Shared: ptr.To(true),
+		}
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
+		mockTG.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, nil).Times(2)
+		clusterScope.TransitGatewayClient = mockTG
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection).To(BeNil())
+	})
 }
 func TestIsResourceCreatedByController(t *testing.T) {
 	testCases := []struct {
@@ -5485,732 +5560,830 @@ func TestDeleteDHCPServer(t *testing.T) {
 	})
 }
 
-func TestDeleteTransitGatewayConnections(t *testing.T) {
+func TestReconcilePlacementGroups(t *testing.T) {
 	var (
-		mockTransitGateway *tgmock.MockTransitGateway
-		mockCtrl           *gomock.Controller
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
 	)
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When PowerVS connection of transit gateway is in deleting state", func(t *testing.T) {
+	newClusterScope := func(placementGroups ...infrav1.PowerVSPlacementGroup) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			IBMPowerVSClient: mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				Spec:       infrav1.IBMPowerVSClusterSpec{PlacementGroups: placementGroups},
+			},
+		}
+	}
+
+	t.Run("When the placement group does not exist", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := PowerVSClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
-			},
-			TransitGatewayClient: mockTransitGateway,
-		}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateDeleting))}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		clusterScope := newClusterScope(infrav1.PowerVSPlacementGroup{Policy: infrav1.PowerVSPlacementGroupPolicyAntiAffinity})
+		mockPowerVS.EXPECT().GetAllPlacementGroups().Return(&models.PlacementGroups{}, nil)
+		mockPowerVS.EXPECT().CreatePlacementGroup(&models.PlacementGroupCreate{Name: ptr.To("cluster-anti-affinity"), Policy: ptr.To("anti-affinity")}).Return(&models.PlacementGroup{ID: ptr.To("pg-id")}, nil)
+		err := clusterScope.ReconcilePlacementGroups(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.PlacementGroups).To(Equal(map[string]infrav1.ResourceReference{
+			"anti-affinity": {ID: ptr.To("pg-id"), ControllerCreated: ptr.To(true)},
+		}))
 	})
+	t.Run("When a placement group with the name exists", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	t.Run("When DeleteTransitGatewayConnection for PowerVS connection returns error", func(t *testing.T) {
+		clusterScope := newClusterScope(infrav1.PowerVSPlacementGroup{Name: ptr.To("existing"), Policy: infrav1.PowerVSPlacementGroupPolicyAffinity})
+		mockPowerVS.EXPECT().GetAllPlacementGroups().Return(&models.PlacementGroups{PlacementGroups: []*models.PlacementGroup{
+			{ID: ptr.To("pg-id"), Name: ptr.To("existing"), Policy: ptr.To("affinity")},
+		}}, nil)
+		err := clusterScope.ReconcilePlacementGroups(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.PlacementGroups).To(Equal(map[string]infrav1.ResourceReference{
+			"affinity": {ID: ptr.To("pg-id"), ControllerCreated: ptr.To(false)},
+		}))
+	})
+	t.Run("When the existing placement group has a different policy", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := PowerVSClusterScope{
+		clusterScope := newClusterScope(infrav1.PowerVSPlacementGroup{Name: ptr.To("existing"), Policy: infrav1.PowerVSPlacementGroupPolicyAntiAffinity})
+		mockPowerVS.EXPECT().GetAllPlacementGroups().Return(&models.PlacementGroups{PlacementGroups: []*models.PlacementGroup{
+			{ID: ptr.To("pg-id"), Name: ptr.To("existing"), Policy: ptr.To("affinity")},
+		}}, nil)
+		err := clusterScope.ReconcilePlacementGroups(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.PlacementGroups).To(BeEmpty())
+	})
+	t.Run("When the placement group ID is set in status", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(infrav1.PowerVSPlacementGroup{Policy: infrav1.PowerVSPlacementGroupPolicyAntiAffinity})
+		clusterScope.IBMPowerVSCluster.Status.PlacementGroups = map[string]infrav1.ResourceReference{
+			"anti-affinity": {ID: ptr.To("pg-id"), ControllerCreated: ptr.To(true)},
+		}
+		mockPowerVS.EXPECT().GetPlacementGroup("pg-id").Return(&models.PlacementGroup{ID: ptr.To("pg-id")}, nil)
+		err := clusterScope.ReconcilePlacementGroups(ctx)
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When CreatePlacementGroup returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(infrav1.PowerVSPlacementGroup{Policy: infrav1.PowerVSPlacementGroupPolicyAntiAffinity})
+		mockPowerVS.EXPECT().GetAllPlacementGroups().Return(&models.PlacementGroups{}, nil)
+		mockPowerVS.EXPECT().CreatePlacementGroup(gomock.Any()).Return(nil, errors.New("error creating placement group"))
+		err := clusterScope.ReconcilePlacementGroups(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.PlacementGroups).To(BeEmpty())
+	})
+}
+
+func TestReconcileSharedProcessorPools(t *testing.T) {
+	var (
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(sharedProcessorPools ...infrav1.PowerVSSharedProcessorPool) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			IBMPowerVSClient: mockPowerVS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				Spec:       infrav1.IBMPowerVSClusterSpec{SharedProcessorPools: sharedProcessorPools},
 			},
-			TransitGatewayClient: mockTransitGateway,
 		}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, fmt.Errorf("error deleting transit gateway connection"))
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
-		g.Expect(err.Error()).To(Equal("failed to delete transit gateway connection: error deleting transit gateway connection"))
-		g.Expect(requeue).To(BeFalse())
+	}
+
+	t.Run("When the shared processor pool does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(infrav1.PowerVSSharedProcessorPool{Name: "workers", ReservedCores: 2})
+		mockPowerVS.EXPECT().GetAllSharedProcessorPools().Return(&models.SharedProcessorPools{}, nil)
+		mockPowerVS.EXPECT().CreateSharedProcessorPool(&models.SharedProcessorPoolCreate{Name: ptr.To("workers"), ReservedCores: ptr.To(int64(2)), HostGroup: ptr.To("s922")}).Return(&models.SharedProcessorPool{ID: ptr.To("spp-id")}, nil)
+		err := clusterScope.ReconcileSharedProcessorPools(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SharedProcessorPools).To(Equal(map[string]infrav1.ResourceReference{
+			"workers": {ID: ptr.To("spp-id"), ControllerCreated: ptr.To(true)},
+		}))
 	})
+	t.Run("When a shared processor pool with the name exists", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	t.Run("When DeleteTransitGatewayConnection for PowerVS connection succeeds", func(t *testing.T) {
+		clusterScope := newClusterScope(infrav1.PowerVSSharedProcessorPool{Name: "workers", ReservedCores: 2, HostGroup: "e980"})
+		mockPowerVS.EXPECT().GetAllSharedProcessorPools().Return(&models.SharedProcessorPools{SharedProcessorPools: []*models.SharedProcessorPool{
+			{ID: ptr.To("spp-id"), Name: ptr.To("workers")},
+		}}, nil)
+		err := clusterScope.ReconcileSharedProcessorPools(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SharedProcessorPools).To(Equal(map[string]infrav1.ResourceReference{
+			"workers": {ID: ptr.To("spp-id"), ControllerCreated: ptr.To(false)},
+		}))
+	})
+	t.Run("When the shared processor pool ID is set in status", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := PowerVSClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
-			},
-			TransitGatewayClient: mockTransitGateway,
+		clusterScope := newClusterScope(infrav1.PowerVSSharedProcessorPool{Name: "workers", ReservedCores: 2})
+		clusterScope.IBMPowerVSCluster.Status.SharedProcessorPools = map[string]infrav1.ResourceReference{
+			"workers": {ID: ptr.To("spp-id"), ControllerCreated: ptr.To(true)},
 		}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		mockPowerVS.EXPECT().GetSharedProcessorPool("spp-id").Return(&models.SharedProcessorPoolDetail{}, nil)
+		err := clusterScope.ReconcileSharedProcessorPools(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
 	})
+	t.Run("When GetSharedProcessorPool returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	t.Run("When GetTransitGatewayConnection for PowerVS connection returns error", func(t *testing.T) {
+		clusterScope := newClusterScope(infrav1.PowerVSSharedProcessorPool{Name: "workers", ReservedCores: 2})
+		clusterScope.IBMPowerVSCluster.Status.SharedProcessorPools = map[string]infrav1.ResourceReference{
+			"workers": {ID: ptr.To("spp-id"), ControllerCreated: ptr.To(true)},
+		}
+		mockPowerVS.EXPECT().GetSharedProcessorPool("spp-id").Return(nil, errors.New("error fetching shared processor pool"))
+		err := clusterScope.ReconcileSharedProcessorPools(ctx)
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("When CreateSharedProcessorPool returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := PowerVSClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("powerVStgID"),
-							ControllerCreated: ptr.To(true),
-						},
-					},
+		clusterScope := newClusterScope(infrav1.PowerVSSharedProcessorPool{Name: "workers", ReservedCores: 2})
+		mockPowerVS.EXPECT().GetAllSharedProcessorPools().Return(&models.SharedProcessorPools{}, nil)
+		mockPowerVS.EXPECT().CreateSharedProcessorPool(gomock.Any()).Return(nil, errors.New("error creating shared processor pool"))
+		err := clusterScope.ReconcileSharedProcessorPools(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SharedProcessorPools).To(BeEmpty())
+	})
+}
+
+func TestDeletePlacementGroups(t *testing.T) {
+	var (
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When PowerVS service instance is created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+			Status: infrav1.IBMPowerVSClusterStatus{
+				ServiceInstance: &infrav1.ResourceReference{ControllerCreated: ptr.To(true)},
+				PlacementGroups: map[string]infrav1.ResourceReference{
+					"anti-affinity": {ID: ptr.To("pg-id"), ControllerCreated: ptr.To(true)},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
-		}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 400}, fmt.Errorf("error getting transit gateway connection"))
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
-		g.Expect(err.Error()).To(Equal("failed to get transit gateway powervs connection: error getting transit gateway connection"))
-		g.Expect(requeue).To(BeFalse())
+		}}
+		err := clusterScope.DeletePlacementGroups(ctx)
+		g.Expect(err).To(BeNil())
 	})
-	t.Run("When PowerVS connection is not found and VPC connection of transit gateway is deleted successfully", func(t *testing.T) {
+	t.Run("When the placement groups are deleted or not created by controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
+			IBMPowerVSClient: mockPowerVS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("powerVStgID"),
-							ControllerCreated: ptr.To(true),
-						},
-						VPCConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("vpctgID"),
-							ControllerCreated: ptr.To(true),
-						},
+					PlacementGroups: map[string]infrav1.ResourceReference{
+						"anti-affinity": {ID: ptr.To("pg-id"), ControllerCreated: ptr.To(true)},
+						"affinity":      {ID: ptr.To("existing-id"), ControllerCreated: ptr.To(false)},
 					},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
 		}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
-		powerVSTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("powerVStgID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(powerVSTGOptions).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, nil)
-		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		mockPowerVS.EXPECT().GetPlacementGroup("pg-id").Return(&models.PlacementGroup{ID: ptr.To("pg-id")}, nil)
+		mockPowerVS.EXPECT().DeletePlacementGroup("pg-id").Return(nil)
+		err := clusterScope.DeletePlacementGroups(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.PlacementGroups).To(HaveKey("affinity"))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.PlacementGroups).ToNot(HaveKey("anti-affinity"))
 	})
-	t.Run("When GetTransitGatewayConnection for VPC connection returns error", func(t *testing.T) {
+	t.Run("When the placement group is not found", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
+			IBMPowerVSClient: mockPowerVS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(false),
-						},
-						VPCConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("vpctgID"),
-							ControllerCreated: ptr.To(true),
-						},
+					PlacementGroups: map[string]infrav1.ResourceReference{
+						"anti-affinity": {ID: ptr.To("pg-id"), ControllerCreated: ptr.To(true)},
 					},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
 		}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(nil, &core.DetailedResponse{StatusCode: 500}, fmt.Errorf("error getting transit gateway connection"))
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
-		g.Expect(err.Error()).To(Equal("failed to get transit gateway powervs connection: error getting transit gateway connection"))
-		g.Expect(requeue).To(BeFalse())
+		mockPowerVS.EXPECT().GetPlacementGroup("pg-id").Return(nil, fmt.Errorf("placement group does not exist"))
+		err := clusterScope.DeletePlacementGroups(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.PlacementGroups).To(BeEmpty())
 	})
-
-	t.Run("When DeleteTransitGatewayConnection for VPC connection succeeds", func(t *testing.T) {
+	t.Run("When DeletePlacementGroup returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
+			IBMPowerVSClient: mockPowerVS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(false),
-						},
-						VPCConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("vpctgID"),
-							ControllerCreated: ptr.To(true),
-						},
+					PlacementGroups: map[string]infrav1.ResourceReference{
+						"anti-affinity": {ID: ptr.To("pg-id"), ControllerCreated: ptr.To(true)},
 					},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
 		}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
-		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		mockPowerVS.EXPECT().GetPlacementGroup("pg-id").Return(&models.PlacementGroup{ID: ptr.To("pg-id")}, nil)
+		mockPowerVS.EXPECT().DeletePlacementGroup("pg-id").Return(errors.New("placement group has members"))
+		err := clusterScope.DeletePlacementGroups(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.PlacementGroups).To(HaveKey("anti-affinity"))
+	})
+}
+
+func TestDeleteSharedProcessorPools(t *testing.T) {
+	var (
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(sharedProcessorPools map[string]infrav1.ResourceReference) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			IBMPowerVSClient: mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{SharedProcessorPools: sharedProcessorPools},
+			},
+		}
+	}
+
+	t.Run("When PowerVS service instance is created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(map[string]infrav1.ResourceReference{
+			"workers": {ID: ptr.To("spp-id"), ControllerCreated: ptr.To(true)},
+		})
+		clusterScope.IBMPowerVSCluster.Status.ServiceInstance = &infrav1.ResourceReference{ControllerCreated: ptr.To(true)}
+		err := clusterScope.DeleteSharedProcessorPools(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
 	})
+	t.Run("When the shared processor pools are deleted or not created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	t.Run("When VPC connection of transit gateway is not found", func(t *testing.T) {
+		clusterScope := newClusterScope(map[string]infrav1.ResourceReference{
+			"workers":  {ID: ptr.To("spp-id"), ControllerCreated: ptr.To(true)},
+			"existing": {ID: ptr.To("existing-id"), ControllerCreated: ptr.To(false)},
+		})
+		mockPowerVS.EXPECT().GetSharedProcessorPool("spp-id").Return(&models.SharedProcessorPoolDetail{}, nil)
+		mockPowerVS.EXPECT().DeleteSharedProcessorPool("spp-id").Return(nil)
+		err := clusterScope.DeleteSharedProcessorPools(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SharedProcessorPools).To(HaveKey("existing"))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SharedProcessorPools).ToNot(HaveKey("workers"))
+	})
+	t.Run("When the shared processor pool is not found", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := PowerVSClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(false),
-						},
-						VPCConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("vpctgID"),
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
-			},
-			TransitGatewayClient: mockTransitGateway,
-		}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, nil)
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		clusterScope := newClusterScope(map[string]infrav1.ResourceReference{
+			"workers": {ID: ptr.To("spp-id"), ControllerCreated: ptr.To(true)},
+		})
+		mockPowerVS.EXPECT().GetSharedProcessorPool("spp-id").Return(nil, fmt.Errorf("shared processor pool does not exist"))
+		err := clusterScope.DeleteSharedProcessorPools(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SharedProcessorPools).To(BeEmpty())
+	})
+	t.Run("When DeleteSharedProcessorPool returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(map[string]infrav1.ResourceReference{
+			"workers": {ID: ptr.To("spp-id"), ControllerCreated: ptr.To(true)},
+		})
+		mockPowerVS.EXPECT().GetSharedProcessorPool("spp-id").Return(&models.SharedProcessorPoolDetail{}, nil)
+		mockPowerVS.EXPECT().DeleteSharedProcessorPool("spp-id").Return(errors.New("shared processor pool has instances"))
+		err := clusterScope.DeleteSharedProcessorPools(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.SharedProcessorPools).To(HaveKey("workers"))
 	})
 }
-func TestReconcileCOSInstance(t *testing.T) {
+
+func TestReconcileAdditionalNetworks(t *testing.T) {
 	var (
-		mockResourceController *mockRC.MockResourceController
-		mockCOSController      *mockcos.MockCos
-		mockCtrl               *gomock.Controller
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
 	)
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockCOSController = mockcos.NewMockCos(mockCtrl)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
+	newClusterScope := func(networks ...infrav1.PowerVSNetwork) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			IBMPowerVSClient: mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				Spec:       infrav1.IBMPowerVSClusterSpec{AdditionalNetworks: networks},
+			},
+		}
+	}
 
-	t.Run("When fetch for COS service instance fails", func(t *testing.T) {
+	t.Run("When the private network does not exist", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
-
-		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: "test-region",
-					},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
-					},
-				},
-			},
-		}
 
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error fetching instance by name"))
+		clusterScope := newClusterScope(infrav1.PowerVSNetwork{Name: "storage", Type: infrav1.PowerVSNetworkTypePrivate, CIDR: ptr.To("10.0.0.0/24"), DNSServers: []string{"10.0.0.2"}, MTU: ptr.To(int64(9000))})
+		mockPowerVS.EXPECT().GetNetworkByName("storage").Return(nil, nil)
+		mockPowerVS.EXPECT().CreateNetwork(&models.NetworkCreate{Name: "storage", Type: ptr.To("vlan"), Cidr: "10.0.0.0/24", DNSServers: []string{"10.0.0.2"}, Mtu: ptr.To(int64(9000))}).Return(&models.Network{NetworkID: ptr.To("network-id")}, nil)
+		mockPowerVS.EXPECT().GetNetworkByID("network-id").Return(&models.Network{}, nil)
+		ready, err := clusterScope.ReconcileAdditionalNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks).To(Equal(map[string]infrav1.PowerVSNetworkStatus{
+			"storage": {ID: ptr.To("network-id"), ControllerCreated: ptr.To(true)},
+		}))
+	})
+	t.Run("When a public network with the name exists", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance).To(BeNil())
+		clusterScope := newClusterScope(infrav1.PowerVSNetwork{Name: "public", Type: infrav1.PowerVSNetworkTypePublic})
+		mockPowerVS.EXPECT().GetNetworkByName("public").Return(&models.NetworkReference{NetworkID: ptr.To("network-id"), Type: ptr.To("pub-vlan")}, nil)
+		mockPowerVS.EXPECT().GetNetworkByID("network-id").Return(&models.Network{}, nil)
+		ready, err := clusterScope.ReconcileAdditionalNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks).To(Equal(map[string]infrav1.PowerVSNetworkStatus{
+			"public": {ID: ptr.To("network-id"), ControllerCreated: ptr.To(false)},
+		}))
 	})
+	t.Run("When a network with the name exists with another type", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	t.Run("When COS service instance is found in IBM Cloud and cluster status is updated", func(t *testing.T) {
+		clusterScope := newClusterScope(infrav1.PowerVSNetwork{Name: "storage", Type: infrav1.PowerVSNetworkTypePrivate, CIDR: ptr.To("10.0.0.0/24")})
+		mockPowerVS.EXPECT().GetNetworkByName("storage").Return(&models.NetworkReference{NetworkID: ptr.To("network-id"), Type: ptr.To("pub-vlan")}, nil)
+		_, err := clusterScope.ReconcileAdditionalNetworks(ctx)
+		g.Expect(err).To(MatchError(ContainSubstring("has type pub-vlan instead of vlan")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks).To(BeEmpty())
+	})
+	t.Run("When the dhcp network does not exist", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
-		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: "test-region",
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-					Zone: ptr.To("test-zone"),
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
-					},
-				},
-			},
-		}
+		clusterScope := newClusterScope(infrav1.PowerVSNetwork{Name: "workload", Type: infrav1.PowerVSNetworkTypeDHCP, CIDR: ptr.To("192.168.10.0/24"), SNAT: ptr.To(false)})
+		mockPowerVS.EXPECT().GetAllDHCPServers().Return(models.DHCPServers{}, nil)
+		mockPowerVS.EXPECT().CreateDHCPServer(&models.DHCPServerCreate{Name: ptr.To("workload"), Cidr: ptr.To("192.168.10.0/24"), DNSServer: ptr.To("1.1.1.1"), SnatEnabled: ptr.To(false)}).Return(&models.DHCPServer{ID: ptr.To("dhcp-id"), Network: &models.DHCPServerNetwork{ID: ptr.To("network-id")}}, nil)
+		mockPowerVS.EXPECT().GetDHCPServer("dhcp-id").Return(&models.DHCPServerDetail{ID: ptr.To("dhcp-id"), Status: ptr.To(string(infrav1.DHCPServerStateBuild))}, nil)
+		ready, err := clusterScope.ReconcileAdditionalNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks).To(Equal(map[string]infrav1.PowerVSNetworkStatus{
+			"workload": {ID: ptr.To("network-id"), DHCPServerID: ptr.To("dhcp-id"), ControllerCreated: ptr.To(true)},
+		}))
+	})
+	t.Run("When a DHCP server serves the dhcp network", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			Name:  ptr.To("test-cos-resource-name"),
-			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
-			GUID:  ptr.To("test-cos-instance-guid"),
+		clusterScope := newClusterScope(infrav1.PowerVSNetwork{Name: "workload", Type: infrav1.PowerVSNetworkTypeDHCP})
+		mockPowerVS.EXPECT().GetAllDHCPServers().Return(models.DHCPServers{
+			{ID: ptr.To("dhcp-id"), Network: &models.DHCPServerNetwork{ID: ptr.To("network-id"), Name: ptr.To("DHCPSERVERworkload_Private")}},
 		}, nil)
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-cos-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(false)))
+		mockPowerVS.EXPECT().GetDHCPServer("dhcp-id").Return(&models.DHCPServerDetail{ID: ptr.To("dhcp-id"), Status: ptr.To(string(infrav1.DHCPServerStateActive))}, nil)
+		ready, err := clusterScope.ReconcileAdditionalNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks).To(Equal(map[string]infrav1.PowerVSNetworkStatus{
+			"workload": {ID: ptr.To("network-id"), DHCPServerID: ptr.To("dhcp-id"), ControllerCreated: ptr.To(false)},
+		}))
 	})
-
-	t.Run("When COS service instance is not found in IBM Cloud and hence creates COS instance in cloud", func(t *testing.T) {
+	t.Run("When the DHCP server of the dhcp network is in error state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
-		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: "test-region",
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-					Zone: ptr.To("test-zone"),
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
-					},
-				},
-			},
+		clusterScope := newClusterScope(infrav1.PowerVSNetwork{Name: "workload", Type: infrav1.PowerVSNetworkTypeDHCP})
+		clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks = map[string]infrav1.PowerVSNetworkStatus{
+			"workload": {ID: ptr.To("network-id"), DHCPServerID: ptr.To("dhcp-id"), ControllerCreated: ptr.To(true)},
 		}
-
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID:   ptr.To("test-resource-instance-id"),
-			GUID: ptr.To("test-resource-instance-guid"),
-			Name: ptr.To("test-resource-instance-name"),
-		}, nil, nil)
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
+		mockPowerVS.EXPECT().GetDHCPServer("dhcp-id").Return(&models.DHCPServerDetail{ID: ptr.To("dhcp-id"), Status: ptr.To(string(infrav1.DHCPServerStateError))}, nil)
+		_, err := clusterScope.ReconcileAdditionalNetworks(ctx)
 		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
 	})
-
-	t.Run("When COS service instance is not found in IBM Cloud and hence creates COS instance in cloud but fails during the creation", func(t *testing.T) {
+	t.Run("When CreateNetwork returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
-		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
+		clusterScope := newClusterScope(infrav1.PowerVSNetwork{Name: "storage", Type: infrav1.PowerVSNetworkTypePrivate, CIDR: ptr.To("10.0.0.0/24")})
+		mockPowerVS.EXPECT().GetNetworkByName("storage").Return(nil, nil)
+		mockPowerVS.EXPECT().CreateNetwork(gomock.Any()).Return(nil, errors.New("error creating network"))
+		_, err := clusterScope.ReconcileAdditionalNetworks(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks).To(BeEmpty())
+	})
+}
+
+func TestDeleteAdditionalNetworks(t *testing.T) {
+	var (
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(networks map[string]infrav1.PowerVSNetworkStatus) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			IBMPowerVSClient: mockPowerVS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: "test-region",
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-					Zone: ptr.To("test-zone"),
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
-					},
-				},
+				Status: infrav1.IBMPowerVSClusterStatus{AdditionalNetworks: networks},
 			},
 		}
+	}
 
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to create COS service instance"))
+	t.Run("When PowerVS service instance is created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance).To(BeNil())
+		clusterScope := newClusterScope(map[string]infrav1.PowerVSNetworkStatus{
+			"storage": {ID: ptr.To("network-id"), ControllerCreated: ptr.To(true)},
+		})
+		clusterScope.IBMPowerVSCluster.Status.ServiceInstance = &infrav1.ResourceReference{ControllerCreated: ptr.To(true)}
+		err := clusterScope.DeleteAdditionalNetworks(ctx)
+		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("When fetch for API_KEY fails", func(t *testing.T) {
+	t.Run("When the networks are deleted or not created by controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: "test-region",
-					},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
-					},
-				},
-			},
-		}
+		clusterScope := newClusterScope(map[string]infrav1.PowerVSNetworkStatus{
+			"storage":  {ID: ptr.To("network-id"), ControllerCreated: ptr.To(true)},
+			"workload": {ID: ptr.To("dhcp-network-id"), DHCPServerID: ptr.To("dhcp-id"), ControllerCreated: ptr.To(true)},
+			"existing": {ID: ptr.To("existing-id"), ControllerCreated: ptr.To(false)},
+		})
+		mockPowerVS.EXPECT().GetNetworkByID("network-id").Return(&models.Network{}, nil)
+		mockPowerVS.EXPECT().DeleteNetwork("network-id").Return(nil)
+		mockPowerVS.EXPECT().GetDHCPServer("dhcp-id").Return(&models.DHCPServerDetail{}, nil)
+		mockPowerVS.EXPECT().DeleteDHCPServer("dhcp-id").Return(nil)
+		err := clusterScope.DeleteAdditionalNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks).To(HaveLen(1))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks).To(HaveKey("existing"))
+	})
+	t.Run("When the network is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			Name:  ptr.To("test-cos-resource-name"),
-			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
-			GUID:  ptr.To("test-cos-instance-guid"),
-		}, nil)
+		clusterScope := newClusterScope(map[string]infrav1.PowerVSNetworkStatus{
+			"storage": {ID: ptr.To("network-id"), ControllerCreated: ptr.To(true)},
+		})
+		mockPowerVS.EXPECT().GetNetworkByID("network-id").Return(nil, fmt.Errorf("network does not exist"))
+		err := clusterScope.DeleteAdditionalNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks).To(BeEmpty())
+	})
+	t.Run("When DeleteNetwork returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-		err := clusterScope.ReconcileCOSInstance(ctx)
+		clusterScope := newClusterScope(map[string]infrav1.PowerVSNetworkStatus{
+			"storage": {ID: ptr.To("network-id"), ControllerCreated: ptr.To(true)},
+		})
+		mockPowerVS.EXPECT().GetNetworkByID("network-id").Return(&models.Network{}, nil)
+		mockPowerVS.EXPECT().DeleteNetwork("network-id").Return(errors.New("network has attached instances"))
+		err := clusterScope.DeleteAdditionalNetworks(ctx)
 		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-cos-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(false)))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks).To(HaveKey("storage"))
 	})
+}
 
-	t.Run("When COS bucket region is failed to be determined", func(t *testing.T) {
+func TestDeleteTransitGatewayConnections(t *testing.T) {
+	var (
+		mockTransitGateway *tgmock.MockTransitGateway
+		mockCtrl           *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("When connections of transit gateway are not set in status", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						ID: ptr.To("transitGatewayID"),
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID:   ptr.To("test-resource-instance-id"),
-			GUID: ptr.To("test-resource-instance-guid"),
-			Name: ptr.To("test-resource-instance-name"),
-		}, nil, nil)
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(bool(true))))
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
 
-	t.Run("When checkCOSBucket fails to determine whether bucket exist in cloud due to an unexpected error", func(t *testing.T) {
+	t.Run("When PowerVS connection of transit gateway is in deleting state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: "test-bucket-region",
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID:   ptr.To("test-resource-instance-id"),
-			GUID: ptr.To("test-resource-instance-guid"),
-			Name: ptr.To("test-resource-instance-name"),
-		}, nil, nil)
-
-		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, fmt.Errorf("failed to get bucket by name"))
-
-		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
-			return mockCOSController, nil
-		}
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateDeleting))}
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
-	t.Run("When create COS bucket fails", func(t *testing.T) {
+
+	t.Run("When DeleteTransitGatewayConnection for PowerVS connection returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: "test-bucket-region",
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID:   ptr.To("test-resource-instance-id"),
-			GUID: ptr.To("test-resource-instance-guid"),
-			Name: ptr.To("test-resource-instance-name"),
-		}, nil, nil)
-
-		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "bucket does not exist", nil))
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, fmt.Errorf("failed to create bucket"))
-
-		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
-			return mockCOSController, nil
-		}
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, fmt.Errorf("error deleting transit gateway connection"))
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		g.Expect(err.Error()).To(Equal("failed to delete transit gateway connection: error deleting transit gateway connection"))
+		g.Expect(requeue).To(BeFalse())
 	})
 
-	t.Run("When create COS bucket succeeds", func(t *testing.T) {
+	t.Run("When DeleteTransitGatewayConnection for PowerVS connection succeeds", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: "test-bucket-region",
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID:   ptr.To("test-resource-instance-id"),
-			GUID: ptr.To("test-resource-instance-guid"),
-			Name: ptr.To("test-resource-instance-name"),
-		}, nil, nil)
-
-		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "bucket does not exist", nil))
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, nil)
-
-		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
-			return mockCOSController, nil
-		}
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
 		g.Expect(err).To(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
+		g.Expect(requeue).To(BeTrue())
 	})
-}
-
-func TestCheckCOSServiceInstance(t *testing.T) {
-	var (
-		mockResourceController *mockRC.MockResourceController
-		mockCtrl               *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
 
-	t.Run("When fetching of cos service instance returns error", func(t *testing.T) {
+	t.Run("When GetTransitGatewayConnection for PowerVS connection returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("powerVStgID"),
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error listing COS instances"))
-
-		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
-		g.Expect(cosResourceInstance).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 400}, fmt.Errorf("error getting transit gateway connection"))
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		g.Expect(err.Error()).To(Equal("failed to get transit gateway powervs connection: error getting transit gateway connection"))
+		g.Expect(requeue).To(BeFalse())
 	})
-
-	t.Run("When COS service instance is not found in IBM Cloud", func(t *testing.T) {
+	t.Run("When PowerVS connection is not found and VPC connection of transit gateway is deleted successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("powerVStgID"),
+							ControllerCreated: ptr.To(true),
+						},
+						VPCConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("vpctgID"),
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
-
-		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
-		g.Expect(cosResourceInstance).To(BeNil())
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
+		powerVSTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("powerVStgID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(powerVSTGOptions).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, nil)
+		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
-
-	t.Run("When COS service instance exists but state is not active", func(t *testing.T) {
+	t.Run("When GetTransitGatewayConnection for VPC connection returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(false),
+						},
+						VPCConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("vpctgID"),
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			Name:  ptr.To("test-cos-resource-name"),
-			State: ptr.To("failed"),
-		}, nil)
-
-		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
-		g.Expect(cosResourceInstance).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(err).To(MatchError(fmt.Errorf("COS service instance is not in active state, current state: %s", "failed")))
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(nil, &core.DetailedResponse{StatusCode: 500}, fmt.Errorf("error getting transit gateway connection"))
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		g.Expect(err.Error()).To(Equal("failed to get transit gateway powervs connection: error getting transit gateway connection"))
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When COS service instance exists and state is active", func(t *testing.T) {
+
+	t.Run("When DeleteTransitGatewayConnection for VPC connection succeeds", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(false),
+						},
+						VPCConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("vpctgID"),
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
+		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
 
-		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			Name:  ptr.To("test-cos-resource-name"),
-			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
-		}, nil)
+	t.Run("When VPC connection of transit gateway is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
-		g.Expect(cosResourceInstance.Name).To(Equal(ptr.To("test-cos-resource-name")))
-		g.Expect(cosResourceInstance.State).To(Equal(ptr.To(string(infrav1.ServiceInstanceStateActive))))
+		clusterScope := PowerVSClusterScope{
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(false),
+						},
+						VPCConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("vpctgID"),
+							ControllerCreated: ptr.To(true),
+						},
+					},
+				},
+			},
+			TransitGatewayClient: mockTransitGateway,
+		}
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, nil)
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
 }
-
-func TestCreateCOSBucket(t *testing.T) {
+func TestReconcileCOSInstance(t *testing.T) {
 	var (
 		mockResourceController *mockRC.MockResourceController
 		mockCOSController      *mockcos.MockCos
@@ -6219,22 +6392,29 @@ func TestCreateCOSBucket(t *testing.T) {
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
 		mockCOSController = mockcos.NewMockCos(mockCtrl)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
 
-	t.Run("When COS bucket creation fails in cloud", func(t *testing.T) {
+	t.Run("When fetch for COS service instance fails", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
-			COSClient:      mockCOSController,
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: "test-region",
+					},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
 						ID: ptr.To("test-serviceinstance-id"),
@@ -6242,20 +6422,34 @@ func TestCreateCOSBucket(t *testing.T) {
 				},
 			},
 		}
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, fmt.Errorf("failed to create COS bucket"))
-		err := clusterScope.createCOSBucket()
+
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error fetching instance by name"))
+
+		err = clusterScope.ReconcileCOSInstance(ctx)
 		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance).To(BeNil())
 	})
 
-	t.Run("When COS bucket already exists and is owned by the user", func(t *testing.T) {
+	t.Run("When COS service instance is found in IBM Cloud and cluster status is updated", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
-			COSClient:      mockCOSController,
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: "test-region",
+					},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-group-id"),
+					},
+					Zone: ptr.To("test-zone"),
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
 						ID: ptr.To("test-serviceinstance-id"),
@@ -6264,21 +6458,38 @@ func TestCreateCOSBucket(t *testing.T) {
 			},
 		}
 
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeBucketAlreadyOwnedByYou, "Bucket already owned by user", nil))
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			Name:  ptr.To("test-cos-resource-name"),
+			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
+			GUID:  ptr.To("test-cos-instance-guid"),
+		}, nil)
 
-		err := clusterScope.createCOSBucket()
-		g.Expect(err).To(BeNil())
+		err = clusterScope.ReconcileCOSInstance(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-cos-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(false)))
 	})
 
-	t.Run("When COS bucket already exists but is owned by someone else", func(t *testing.T) {
+	t.Run("When COS service instance is not found in IBM Cloud and hence creates COS instance in cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
-			COSClient:      mockCOSController,
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: "test-region",
+					},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-group-id"),
+					},
+					Zone: ptr.To("test-zone"),
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
 						ID: ptr.To("test-serviceinstance-id"),
@@ -6286,20 +6497,40 @@ func TestCreateCOSBucket(t *testing.T) {
 				},
 			},
 		}
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeBucketAlreadyExists, "Bucket already exists", nil))
-		err := clusterScope.createCOSBucket()
-		g.Expect(err).To(BeNil())
+
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			ID:   ptr.To("test-resource-instance-id"),
+			GUID: ptr.To("test-resource-instance-guid"),
+			Name: ptr.To("test-resource-instance-name"),
+		}, nil, nil)
+
+		err = clusterScope.ReconcileCOSInstance(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
 	})
 
-	t.Run("When an unexpected error occurs during COS bucket creation", func(t *testing.T) {
+	t.Run("When COS service instance is not found in IBM Cloud and hence creates COS instance in cloud but fails during the creation", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
-			COSClient:      mockCOSController,
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: "test-region",
+					},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-group-id"),
+					},
+					Zone: ptr.To("test-zone"),
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
 						ID: ptr.To("test-serviceinstance-id"),
@@ -6308,22 +6539,27 @@ func TestCreateCOSBucket(t *testing.T) {
 			},
 		}
 
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New("UnexpectedError", "An unexpected error occurred", nil))
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to create COS service instance"))
 
-		err := clusterScope.createCOSBucket()
+		err = clusterScope.ReconcileCOSInstance(ctx)
 		g.Expect(err).ToNot(BeNil())
-		g.Expect(err.Error()).To(ContainSubstring("failed to create COS bucket"))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance).To(BeNil())
 	})
 
-	t.Run("When COS bucket is created successfully", func(t *testing.T) {
+	t.Run("When fetch for API_KEY fails", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			COSClient:      mockCOSController,
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: "test-region",
+					},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
 						ID: ptr.To("test-serviceinstance-id"),
@@ -6332,37 +6568,35 @@ func TestCreateCOSBucket(t *testing.T) {
 			},
 		}
 
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(&s3.CreateBucketOutput{}, nil)
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			Name:  ptr.To("test-cos-resource-name"),
+			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
+			GUID:  ptr.To("test-cos-instance-guid"),
+		}, nil)
 
-		err := clusterScope.createCOSBucket()
-		g.Expect(err).To(BeNil())
+		err := clusterScope.ReconcileCOSInstance(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-cos-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(false)))
 	})
-}
 
-func TestCheckCOSBucket(t *testing.T) {
-	var (
-		mockResourceController *mockRC.MockResourceController
-		mockCOSController      *mockcos.MockCos
-		mockCtrl               *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
-		mockCOSController = mockcos.NewMockCos(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
-	t.Run("When checking if COS bucket exists in cloud", func(t *testing.T) {
+	t.Run("When COS bucket region is failed to be determined", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
-			COSClient:      mockCOSController,
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-group-id"),
+					},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
 						ID: ptr.To("test-serviceinstance-id"),
@@ -6370,82 +6604,39 @@ func TestCheckCOSBucket(t *testing.T) {
 				},
 			},
 		}
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 
-		testScenarios := []struct {
-			name         string
-			mockError    error
-			bucketExists bool
-			expectErr    bool
-		}{
-			{
-				name:         "NoSuchBucket error",
-				mockError:    awserr.New(s3.ErrCodeNoSuchBucket, "NoSuchBucket", nil),
-				bucketExists: false,
-				expectErr:    false,
-			},
-			{
-				name:         "Forbidden error",
-				mockError:    awserr.New("Forbidden", "Forbidden", nil),
-				bucketExists: false,
-				expectErr:    false,
-			},
-			{
-				name:         "NotFound error",
-				mockError:    awserr.New("NotFound", "NotFound", nil),
-				bucketExists: false,
-				expectErr:    false,
-			},
-			{
-				name:         "Other aws error",
-				mockError:    awserr.New("OtherAWSError", "OtherAWSError", nil),
-				bucketExists: false,
-				expectErr:    true,
-			},
-			{
-				name:         "Bucket exists",
-				mockError:    nil,
-				bucketExists: true,
-				expectErr:    false,
-			},
-		}
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			ID:   ptr.To("test-resource-instance-id"),
+			GUID: ptr.To("test-resource-instance-guid"),
+			Name: ptr.To("test-resource-instance-name"),
+		}, nil, nil)
 
-		for _, scenario := range testScenarios {
-			t.Run(scenario.name, func(_ *testing.T) {
-				mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, scenario.mockError)
-				exists, err := clusterScope.checkCOSBucket()
-				g.Expect(exists).To(Equal(scenario.bucketExists))
-				if scenario.expectErr {
-					g.Expect(err).ToNot(BeNil())
-				} else {
-					g.Expect(err).To(BeNil())
-				}
-			})
-		}
+		err = clusterScope.ReconcileCOSInstance(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(bool(true))))
 	})
-}
-
-func TestCreateCOSServiceInstance(t *testing.T) {
-	var (
-		mockResourceController *mockRC.MockResourceController
-		mockCtrl               *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
 
-	t.Run("When creating COS resource instance fails due to missing resource group id", func(t *testing.T) {
+	t.Run("When checkCOSBucket fails to determine whether bucket exist in cloud due to an unexpected error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: "test-bucket-region",
+					},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-group-id"),
+					},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
 						ID: ptr.To("test-serviceinstance-id"),
@@ -6453,23 +6644,42 @@ func TestCreateCOSServiceInstance(t *testing.T) {
 				},
 			},
 		}
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 
-		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
-		g.Expect(cosResourceInstance).To(BeNil())
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			ID:   ptr.To("test-resource-instance-id"),
+			GUID: ptr.To("test-resource-instance-guid"),
+			Name: ptr.To("test-resource-instance-name"),
+		}, nil, nil)
+
+		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, fmt.Errorf("failed to get bucket by name"))
+
+		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
+			return mockCOSController, nil
+		}
+
+		err = clusterScope.ReconcileCOSInstance(ctx)
 		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
 	})
-
-	t.Run("When creating COS resource instance fails", func(t *testing.T) {
+	t.Run("When create COS bucket fails", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: "test-bucket-region",
+					},
 					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resourcegroup-id"),
+						ID: ptr.To("test-resource-group-id"),
 					},
 				},
 				Status: infrav1.IBMPowerVSClusterStatus{
@@ -6479,25 +6689,44 @@ func TestCreateCOSServiceInstance(t *testing.T) {
 				},
 			},
 		}
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(nil, nil, fmt.Errorf("error creating resource instance"))
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			ID:   ptr.To("test-resource-instance-id"),
+			GUID: ptr.To("test-resource-instance-guid"),
+			Name: ptr.To("test-resource-instance-name"),
+		}, nil, nil)
 
-		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
-		g.Expect(cosResourceInstance).To(BeNil())
+		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "bucket does not exist", nil))
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, fmt.Errorf("failed to create bucket"))
+
+		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
+			return mockCOSController, nil
+		}
+
+		err = clusterScope.ReconcileCOSInstance(ctx)
 		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
 	})
 
-	t.Run("When COS resource instance creation is successful", func(t *testing.T) {
+	t.Run("When create COS bucket succeeds", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := PowerVSClusterScope{
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: "test-bucket-region",
+					},
 					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resourcegroup-id"),
+						ID: ptr.To("test-resource-group-id"),
 					},
 				},
 				Status: infrav1.IBMPowerVSClusterStatus{
@@ -6507,492 +6736,451 @@ func TestCreateCOSServiceInstance(t *testing.T) {
 				},
 			},
 		}
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 
 		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID: ptr.To("new-resource-instance-id"),
+			ID:   ptr.To("test-resource-instance-id"),
+			GUID: ptr.To("test-resource-instance-guid"),
+			Name: ptr.To("test-resource-instance-name"),
 		}, nil, nil)
 
-		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
+		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "bucket does not exist", nil))
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, nil)
+
+		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
+			return mockCOSController, nil
+		}
+
+		err = clusterScope.ReconcileCOSInstance(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(cosResourceInstance.ID).To(Equal(ptr.To("new-resource-instance-id")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
 	})
 }
 
-func TestReconcileTransitGateway(t *testing.T) {
+func TestCheckCOSServiceInstance(t *testing.T) {
 	var (
 		mockResourceController *mockRC.MockResourceController
-		mockVPC                *mock.MockVpc
-		mockTransitGateway     *tgmock.MockTransitGateway
 		mockCtrl               *gomock.Controller
 	)
-
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
-		mockVPC = mock.NewMockVpc(mockCtrl)
 		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("when TransitGatewayID is set in status and returns error getting TransitGateway", func(t *testing.T) {
+
+	t.Run("When fetching of cos service instance returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						ID: ptr.To("transitGatewayID"),
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get transit gateway"))
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeFalse())
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error listing COS instances"))
+
+		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
+		g.Expect(cosResourceInstance).To(BeNil())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When TransitGateway ID is set in status and already exists but returns error when getting TransitGateway connections", func(t *testing.T) {
+	t.Run("When COS service instance is not found in IBM Cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						ID: ptr.To("transitGatewayID"),
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(nil, nil, errors.New("failed to get transitGateway connections"))
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
+		g.Expect(cosResourceInstance).To(BeNil())
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When TransitGatewayID is set in status and TransitGateway not in available state", func(t *testing.T) {
+	t.Run("When COS service instance exists but state is not active", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						ID: ptr.To("transitGatewayID"),
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStatePending))}, nil, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			Name:  ptr.To("test-cos-resource-name"),
+			State: ptr.To("failed"),
+		}, nil)
+
+		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
+		g.Expect(cosResourceInstance).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(err).To(MatchError(fmt.Errorf("COS service instance is not in active state, current state: %s", "failed")))
 	})
-	t.Run("When TransitGatewayID is set in spec already exists in cloud and is in available state", func(t *testing.T) {
+	t.Run("When COS service instance exists and state is active", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+
 		clusterScope := PowerVSClusterScope{
-			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					TransitGateway: &infrav1.TransitGateway{
-						ID: ptr.To("transitGatewayID"),
-					},
-					VPC: &infrav1.VPCResourceReference{
-						ID: ptr.To("vpcID"),
-					},
-					ServiceInstance: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("serviceInstanceID"),
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeFalse())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
-		g.Expect(requeue).To(BeTrue())
+		mockResourceController.EXPECT().GetInstanceByName(gomock.Any(), gomock.Any(), gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			Name:  ptr.To("test-cos-resource-name"),
+			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
+		}, nil)
+
+		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
+		g.Expect(cosResourceInstance.Name).To(Equal(ptr.To("test-cos-resource-name")))
+		g.Expect(cosResourceInstance.State).To(Equal(ptr.To(string(infrav1.ServiceInstanceStateActive))))
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When TransitGatewayID is set in spec and returns error while getting TransitGateway details", func(t *testing.T) {
+}
+
+func TestCreateCOSBucket(t *testing.T) {
+	var (
+		mockResourceController *mockRC.MockResourceController
+		mockCOSController      *mockcos.MockCos
+		mockCtrl               *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+		mockCOSController = mockcos.NewMockCos(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When COS bucket creation fails in cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					TransitGateway: &infrav1.TransitGateway{
-						ID: ptr.To("transitGatewayID"),
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
-
-		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get transit gateway"))
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeFalse())
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, fmt.Errorf("failed to create COS bucket"))
+		err := clusterScope.createCOSBucket()
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When TransitGatewayID is not set in spec and fetching using name returns with transit gateway in failed state", func(t *testing.T) {
+	t.Run("When COS bucket already exists and is owned by the user", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), ID: ptr.To("transitGatewayID"), Status: ptr.To(string(infrav1.TransitGatewayStateFailed))}, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeBucketAlreadyOwnedByYou, "Bucket already owned by user", nil))
+
+		err := clusterScope.createCOSBucket()
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When TransitGatewayID is not set in spec and fetching using name returns with transit gateway in pending state", func(t *testing.T) {
+	t.Run("When COS bucket already exists but is owned by someone else", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
 			},
 		}
-
-		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStatePending))}, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeTrue())
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeBucketAlreadyExists, "Bucket already exists", nil))
+		err := clusterScope.createCOSBucket()
 		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("Creates TransitGateway and transitGatewayConnections successfully", func(t *testing.T) {
+	t.Run("When an unexpected error occurs during COS bucket creation", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("vpcID"),
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New("UnexpectedError", "An unexpected error occurred", nil))
+
+		err := clusterScope.createCOSBucket()
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(err.Error()).To(ContainSubstring("failed to create COS bucket"))
 	})
 
-	t.Run("When PowerVS service Instance and VPC details are not set in status and fails to create transit gateway", func(t *testing.T) {
+	t.Run("When COS bucket is created successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
-			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
-			IBMPowerVSCluster:    &infrav1.IBMPowerVSCluster{},
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(nil, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(&s3.CreateBucketOutput{}, nil)
+
+		err := clusterScope.createCOSBucket()
+		g.Expect(err).To(BeNil())
 	})
 }
 
-func TestCheckAndUpdateTransitGatewayConnections(t *testing.T) {
+func TestCheckCOSBucket(t *testing.T) {
 	var (
 		mockResourceController *mockRC.MockResourceController
-		mockVPC                *mock.MockVpc
-		mockTransitGateway     *tgmock.MockTransitGateway
+		mockCOSController      *mockcos.MockCos
 		mockCtrl               *gomock.Controller
 	)
-
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
-		mockVPC = mock.NewMockVpc(mockCtrl)
 		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+		mockCOSController = mockcos.NewMockCos(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("Returns error when getting VPC details", func(t *testing.T) {
+	t.Run("When checking if COS bucket exists in cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get vpc"))
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		clusterScope := PowerVSClusterScope{
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
+
+		testScenarios := []struct {
+			name         string
+			mockError    error
+			bucketExists bool
+			expectErr    bool
+		}{
+			{
+				name:         "NoSuchBucket error",
+				mockError:    awserr.New(s3.ErrCodeNoSuchBucket, "NoSuchBucket", nil),
+				bucketExists: false,
+				expectErr:    false,
+			},
+			{
+				name:         "Forbidden error",
+				mockError:    awserr.New("Forbidden", "Forbidden", nil),
+				bucketExists: false,
+				expectErr:    false,
+			},
+			{
+				name:         "NotFound error",
+				mockError:    awserr.New("NotFound", "NotFound", nil),
+				bucketExists: false,
+				expectErr:    false,
+			},
+			{
+				name:         "Other aws error",
+				mockError:    awserr.New("OtherAWSError", "OtherAWSError", nil),
+				bucketExists: false,
+				expectErr:    true,
+			},
+			{
+				name:         "Bucket exists",
+				mockError:    nil,
+				bucketExists: true,
+				expectErr:    false,
+			},
+		}
+
+		for _, scenario := range testScenarios {
+			t.Run(scenario.name, func(_ *testing.T) {
+				mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, scenario.mockError)
+				exists, err := clusterScope.checkCOSBucket()
+				g.Expect(exists).To(Equal(scenario.bucketExists))
+				if scenario.expectErr {
+					g.Expect(err).ToNot(BeNil())
+				} else {
+					g.Expect(err).To(BeNil())
+				}
+			})
+		}
 	})
+}
 
-	t.Run("Returns error when getting PowerVS service Instance details", func(t *testing.T) {
+func TestCreateCOSServiceInstance(t *testing.T) {
+	var (
+		mockResourceController *mockRC.MockResourceController
+		mockCtrl               *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When creating COS resource instance fails due to missing resource group id", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
-
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to get serviceInstance"))
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
-	})
 
-	t.Run("When TransitGateway connections doesn't exist and creates connections", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
-
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
-	})
-
-	t.Run("When TransitGateway connections doesn't exist and return error while creating PowerVSConnection", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		clusterScope := PowerVSClusterScope{
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
 
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("error while creating connections"))
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
+		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
+		g.Expect(cosResourceInstance).To(BeNil())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When TransitGateway connections exist and both are in attached state already", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
-
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeFalse())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeFalse())
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).To(BeNil())
-	})
-
-	t.Run("WHen PowerVSConnection exist and is in pending state", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
-
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).To(BeNil())
-	})
-
-	t.Run("When VPCConnection exist and is in pending state", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
-
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStatePending))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
-	})
-
-	t.Run("When VPCConnection status exist and is in failed state", func(t *testing.T) {
+	t.Run("When creating COS resource instance fails", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateFailed))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
-	})
+		clusterScope := PowerVSClusterScope{
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resourcegroup-id"),
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
 
-	t.Run("When PowerVSConnection status exist and is in failed state", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(nil, nil, fmt.Errorf("error creating resource instance"))
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateFailed))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
+		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
+		g.Expect(cosResourceInstance).To(BeNil())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When PowerVSConnection doesn't exist and creates it", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
-
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
-	})
-
-	t.Run("When PowerVSConnection doesn't exist and returns error while creating it", func(t *testing.T) {
+	t.Run("When COS resource instance creation is successful", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit gateway connection"))
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
-	})
+		clusterScope := PowerVSClusterScope{
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resourcegroup-id"),
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
 
-	t.Run("When VPCConnection doesn't exist and creates it", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			ID: ptr.To("new-resource-instance-id"),
+		}, nil, nil)
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
-		g.Expect(requeue).To(BeTrue())
+		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
 		g.Expect(err).To(BeNil())
-	})
-
-	t.Run("When VPCConnection doesn't exist and returns error while creating it", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
-
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit gateway connection"))
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		g.Expect(cosResourceInstance.ID).To(Equal(ptr.To("new-resource-instance-id")))
 	})
 }
 
-func TestCreateTransitGateway(t *testing.T) {
+func TestReconcileTransitGateway(t *testing.T) {
 	var (
 		mockResourceController *mockRC.MockResourceController
 		mockVPC                *mock.MockVpc
@@ -7010,162 +7198,170 @@ func TestCreateTransitGateway(t *testing.T) {
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("when PowerVS serviceInstance ID and VPC ID is not set in Status", func(t *testing.T) {
+	t.Run("when TransitGatewayID is set in status and returns error getting TransitGateway", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
 			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						ID: ptr.To("transitGatewayID"),
+					},
 				},
 			},
 		}
 
-		err := clusterScope.createTransitGateway(ctx)
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get transit gateway"))
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("Fails to get TransitGateway location and routing", func(t *testing.T) {
+	t.Run("When TransitGateway ID is set in status and already exists but returns error when getting TransitGateway connections", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
 			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("zone-ID"),
-					VPC:           &infrav1.VPCResourceReference{},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("vpcID"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						ID: ptr.To("transitGatewayID"),
 					},
 				},
 			},
 		}
 
-		err := clusterScope.createTransitGateway(ctx)
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(nil, nil, errors.New("failed to get transitGateway connections"))
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("Return error while creating TransitGateway", func(t *testing.T) {
+	t.Run("When TransitGatewayID is set in status and TransitGateway not in available state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
 			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("vpcID"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						ID: ptr.To("transitGatewayID"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to create transit Gateway"))
-		err := clusterScope.createTransitGateway(ctx)
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStatePending))}, nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("Creates TransitGateway but return error when getting VPC details", func(t *testing.T) {
+	t.Run("When TransitGatewayID is set in spec already exists in cloud and is in available state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
 		clusterScope := PowerVSClusterScope{
 			TransitGatewayClient: mockTransitGateway,
 			IBMVPCClient:         mockVPC,
 			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
+					TransitGateway: &infrav1.TransitGateway{
+						ID: ptr.To("transitGatewayID"),
 					},
-					VPC: &infrav1.ResourceReference{
+					VPC: &infrav1.VPCResourceReference{
 						ID: ptr.To("vpcID"),
 					},
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To("pending")}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get vpc"))
-		err := clusterScope.createTransitGateway(ctx)
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
-		g.Expect(err).ToNot(BeNil())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeFalse())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("Creates TransitGateway but return error while getting PowerVS details", func(t *testing.T) {
+	t.Run("When TransitGatewayID is set in spec and returns error while getting TransitGateway details", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
 			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("vpcID"),
+					TransitGateway: &infrav1.TransitGateway{
+						ID: ptr.To("transitGatewayID"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to get power vs instance"))
-		err := clusterScope.createTransitGateway(ctx)
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get transit gateway"))
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When PowerVSConnection creation is completed but fails to create VPCConnection", func(t *testing.T) {
+	t.Run("When TransitGatewayID is not set in spec and fetching using name returns with transit gateway in failed state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{},
+			},
+		}
+
+		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), ID: ptr.To("transitGatewayID"), Status: ptr.To(string(infrav1.TransitGatewayStateFailed))}, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When TransitGatewayID is not set in spec and fetching using name returns with transit gateway in pending state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{},
+			},
+		}
+
+		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStatePending))}, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("Creates TransitGateway and transitGatewayConnections successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -7191,54 +7387,48 @@ func TestCreateTransitGateway(t *testing.T) {
 			},
 		}
 
+		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(nil, nil)
 		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
 		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
 		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
 		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit Gateway connection"))
-		err := clusterScope.createTransitGateway(ctx)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
-		g.Expect(err).ToNot(BeNil())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When local routing is configured but global routing is required", func(t *testing.T) {
+	t.Run("When shared TransitGateway does not exist in cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := PowerVSClusterScope{
 			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
 					TransitGateway: &infrav1.TransitGateway{
-						GlobalRouting: ptr.To(false),
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("vpcID"),
+						Name:   ptr.To("hub"),
+						Shared: ptr.To(true),
 					},
 				},
 			},
 		}
 
-		err := clusterScope.createTransitGateway(ctx)
+		mockTransitGateway.EXPECT().GetTransitGatewayByName("hub").Return(nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).To(MatchError("shared transit gateway hub not found"))
 		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When global routing is set to true", func(t *testing.T) {
+	t.Run("When shared TransitGateway exists with other connections, creates only the cluster connections", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -7248,107 +7438,790 @@ func TestCreateTransitGateway(t *testing.T) {
 			IBMVPCClient:         mockVPC,
 			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster"},
 				Spec: infrav1.IBMPowerVSClusterSpec{
 					TransitGateway: &infrav1.TransitGateway{
-						GlobalRouting: ptr.To(true),
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("zone-ID"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
+						Name:   ptr.To("hub"),
+						Shared: ptr.To(true),
+						PowerVSConnection: &infrav1.TransitGatewayConnection{
+							PrefixFilters: []infrav1.TransitGatewayPrefixFilter{
+								{Action: infrav1.TransitGatewayPrefixFilterActionPermit, Prefix: "10.0.0.0/8", Le: ptr.To(int64(24))},
+							},
+							PrefixFiltersDefault: infrav1.TransitGatewayPrefixFilterActionDeny,
+						},
 					},
-					VPC: &infrav1.ResourceReference{
+					VPC: &infrav1.VPCResourceReference{
 						ID: ptr.To("vpcID"),
 					},
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
-		err := clusterScope.createTransitGateway(ctx)
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		mockTransitGateway.EXPECT().GetTransitGatewayByName("hub").Return(&tgapiv1.TransitGateway{ID: ptr.To("hubID"), Name: ptr.To("hub"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{
+			Connections: []tgapiv1.TransitGatewayConnectionCust{
+				{ID: ptr.To("greID"), Name: ptr.To("gre-con"), NetworkType: ptr.To("gre_tunnel"), NetworkID: ptr.To(""), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateFailed))},
+				{ID: ptr.To("otherID"), Name: ptr.To("other-pvs-con"), NetworkType: ptr.To(string(powervsNetworkConnectionType)), NetworkID: ptr.To("other-pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))},
+			},
+		}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(&tgapiv1.CreateTransitGatewayConnectionOptions{
+			TransitGatewayID: ptr.To("hubID"),
+			NetworkType:      ptr.To(string(powervsNetworkConnectionType)),
+			NetworkID:        ptr.To("pvs-crn"),
+			Name:             ptr.To("capi-cluster-pvs-con"),
+			PrefixFilters: []tgapiv1.TransitGatewayConnectionPrefixFilter{
+				{Action: ptr.To("permit"), Prefix: ptr.To("10.0.0.0/8"), Le: ptr.To(int64(24))},
+			},
+			PrefixFiltersDefault: ptr.To("deny"),
+		}).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(&tgapiv1.CreateTransitGatewayConnectionOptions{
+			TransitGatewayID: ptr.To("hubID"),
+			NetworkType:      ptr.To(string(vpcNetworkConnectionType)),
+			NetworkID:        ptr.To("vpc-crn"),
+			Name:             ptr.To("capi-cluster-vpc-con"),
+		}).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(Equal("hubID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeFalse())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(Equal("pvs-connID"))
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(Equal("vpc-connID"))
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
-		g.Expect(err).To(BeNil())
 	})
-}
 
-func makePowerVSClusterScope(mockTransitGateway *tgmock.MockTransitGateway, mockVPC *mock.MockVpc, mockResourceController *mockRC.MockResourceController) PowerVSClusterScope {
-	clusterScope := PowerVSClusterScope{
-		TransitGatewayClient: mockTransitGateway,
-		IBMVPCClient:         mockVPC,
-		ResourceClient:       mockResourceController,
-		IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-			Status: infrav1.IBMPowerVSClusterStatus{
-				TransitGateway: &infrav1.TransitGatewayStatus{
-					ID: ptr.To("transitGatewayID"),
-				},
-				ServiceInstance: &infrav1.ResourceReference{
-					ID: ptr.To("serviceInstanceID"),
-				},
-				VPC: &infrav1.ResourceReference{
-					ID: ptr.To("vpcID"),
-				},
-			},
-		},
-	}
+	t.Run("When PowerVS service Instance and VPC details are not set in status and fails to create transit gateway", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	return clusterScope
+		clusterScope := PowerVSClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+			IBMPowerVSCluster:    &infrav1.IBMPowerVSCluster{},
+		}
+
+		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
 }
 
-func TestReconcileVPCSecurityGroups(t *testing.T) {
+func TestCheckAndUpdateTransitGatewayConnections(t *testing.T) {
 	var (
-		mockVPC  *mock.MockVpc
-		mockCtrl *gomock.Controller
+		mockResourceController *mockRC.MockResourceController
+		mockVPC                *mock.MockVpc
+		mockTransitGateway     *tgmock.MockTransitGateway
+		mockCtrl               *gomock.Controller
 	)
-	securityGroupID := "securityGroupID"
-	securityGroupName := "securityGroupName"
 
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
+		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
 		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-
-	t.Run("When SecurityGroup ID is set and returns error while getting SecurityGroup", func(t *testing.T) {
+	t.Run("Returns error when getting VPC details", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						ID: ptr.To("securityGroupID"),
-					}),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get security group"))
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
-		g.Expect(err).ToNot(BeNil())
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get vpc"))
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("Returns error when getting PowerVS service Instance details", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to get serviceInstance"))
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When TransitGateway connections doesn't exist and creates connections", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("When TransitGateway connections doesn't exist and return error while creating PowerVSConnection", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("error while creating connections"))
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When TransitGateway connections exist and both are in attached state already", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeFalse())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeFalse())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("WHen PowerVSConnection exist and is in pending state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("When VPCConnection exist and is in pending state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStatePending))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("When VPCConnection status exist and is in failed state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateFailed))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When PowerVSConnection status exist and is in failed state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateFailed))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When PowerVSConnection doesn't exist and creates it", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("When PowerVSConnection doesn't exist and returns error while creating it", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit gateway connection"))
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When VPCConnection doesn't exist and creates it", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("When VPCConnection doesn't exist and returns error while creating it", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit gateway connection"))
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestCreateTransitGateway(t *testing.T) {
+	var (
+		mockResourceController *mockRC.MockResourceController
+		mockVPC                *mock.MockVpc
+		mockTransitGateway     *tgmock.MockTransitGateway
+		mockCtrl               *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("when PowerVS serviceInstance ID and VPC ID is not set in Status", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
+			},
+		}
+
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("Fails to get TransitGateway location and routing", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("zone-ID"),
+					VPC:           &infrav1.VPCResourceReference{},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("vpcID"),
+					},
+				},
+			},
+		}
+
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("Return error while creating TransitGateway", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("vpcID"),
+					},
+				},
+			},
+		}
+
+		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to create transit Gateway"))
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("Creates TransitGateway but return error when getting VPC details", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("vpcID"),
+					},
+				},
+			},
+		}
+
+		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To("pending")}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get vpc"))
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("Creates TransitGateway but return error while getting PowerVS details", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("vpcID"),
+					},
+				},
+			},
+		}
+
+		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to get power vs instance"))
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When PowerVSConnection creation is completed but fails to create VPCConnection", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("vpcID"),
+					},
+				},
+			},
+		}
+
+		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit Gateway connection"))
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When local routing is configured but global routing is required", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					TransitGateway: &infrav1.TransitGateway{
+						GlobalRouting: ptr.To(false),
+					},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("vpcID"),
+					},
+				},
+			},
+		}
+
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When global routing is set to true", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := PowerVSClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					TransitGateway: &infrav1.TransitGateway{
+						GlobalRouting: ptr.To(true),
+					},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("zone-ID"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("vpcID"),
+					},
+				},
+			},
+		}
+
+		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+}
+
+func makePowerVSClusterScope(mockTransitGateway *tgmock.MockTransitGateway, mockVPC *mock.MockVpc, mockResourceController *mockRC.MockResourceController) PowerVSClusterScope {
+	clusterScope := PowerVSClusterScope{
+		TransitGatewayClient: mockTransitGateway,
+		IBMVPCClient:         mockVPC,
+		ResourceClient:       mockResourceController,
+		IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+			Status: infrav1.IBMPowerVSClusterStatus{
+				TransitGateway: &infrav1.TransitGatewayStatus{
+					ID: ptr.To("transitGatewayID"),
+				},
+				ServiceInstance: &infrav1.ResourceReference{
+					ID: ptr.To("serviceInstanceID"),
+				},
+				VPC: &infrav1.ResourceReference{
+					ID: ptr.To("vpcID"),
+				},
+			},
+		},
+	}
+
+	return clusterScope
+}
+
+func TestReconcileVPCSecurityGroups(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	securityGroupID := "securityGroupID"
+	securityGroupName := "securityGroupName"
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When SecurityGroup ID is set and returns error while getting SecurityGroup", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						ID: ptr.To("securityGroupID"),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get security group"))
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("When SecurityGroup Name is set and returns error while creating SecurityGroup", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
+		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to create security group"))
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When SecurityGroup Name is set and creates SecurityGroup successfully", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
+		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When SecurityGroup Name is set and SecurityGroup already exists", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: &securityGroupName, ID: &securityGroupID, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}, nil)
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("When SecurityGroup ID is set and SecurityGroup already exists", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						ID: ptr.To("securityGroupID"),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: &securityGroupName, ID: &securityGroupID, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}, nil, nil)
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).To(BeNil())
 	})
-	t.Run("When SecurityGroup Name is set and returns error while creating SecurityGroup", func(t *testing.T) {
+	t.Run("When SecurityGroup Name is set and GetSecurityGroup returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
+		vpcSecurityGroupStatus[securityGroupName] = infrav1.VPCSecurityGroupStatus{ID: ptr.To("securityGroupID"), RuleIDs: []*string{ptr.To("ruleID")}, ControllerCreated: ptr.To(true)}
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPCSecurityGroups: vpcSecurityGroupStatus,
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get security group"))
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("When SecurityGroup Name is set  and returns error while getting SecurityGroupRules", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
+		vpcSecurityGroupStatus[securityGroupName] = infrav1.VPCSecurityGroupStatus{ID: &securityGroupID, RuleIDs: []*string{ptr.To("ruleID")}, ControllerCreated: ptr.To(true)}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPCSecurityGroups: vpcSecurityGroupStatus,
+				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
 					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
 						Name: ptr.To("securityGroupName"),
@@ -7357,36 +8230,254 @@ func TestReconcileVPCSecurityGroups(t *testing.T) {
 			},
 		}
 
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID"), Name: ptr.To("securityGroupName")}, nil, nil)
+		mockVPC.EXPECT().GetSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to get security group rule"))
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When SecurityGroup is created successfully but returns error while creating SecurityGroupRules", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.0.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
 		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
-		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to create security group"))
+		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to create security group rule"))
 		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
 		g.Expect(err).ToNot(BeNil())
 	})
+}
+
+func TestValidateVPCSecurityGroup(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When SecurityGroup by name exists and SecurityGroupRule matches", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("0.0.0.0/0"),
+			},
+			ID: ptr.To("ruleID"),
+		}
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
+		g.Expect(sg).To(BeEquivalentTo(securityGroupDetails))
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("When SecurityGroup by id exists and SecurityGroupRule matches", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("0.0.0.0/0"),
+			},
+			ID: ptr.To("ruleID"),
+		}
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
+		g.Expect(sg).To(BeEquivalentTo(securityGroupDetails))
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("When SecurityGroup by name doesn't exist and return error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name: ptr.To("securityGroupNamw"),
+		}
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get SecurityGroup"))
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When SecurityGroup by id doesn't exist and return error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID: ptr.To("securityGroupID"),
+		}
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get SecurityGroup"))
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
 
-	t.Run("When SecurityGroup Name is set and creates SecurityGroup successfully", func(t *testing.T) {
+	t.Run("When SecurityGroup by name exists but is not attached to VPC", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name: ptr.To("securityGroupName"),
+		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
-		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
-		g.Expect(err).To(BeNil())
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID")}
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeEmpty())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When SecurityGroup Name is set and SecurityGroup already exists", func(t *testing.T) {
+
+	t.Run("When SecurityGroup by name exists but VPC id not matching", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name: ptr.To("securityGroupName"),
+		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -7396,22 +8487,26 @@ func TestReconcileVPCSecurityGroups(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: &securityGroupName, ID: &securityGroupID, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}, nil)
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
-		g.Expect(err).To(BeNil())
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID"), VPC: &vpcv1.VPCReference{ID: ptr.To("vpcID")}}
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When SecurityGroup ID is set and SecurityGroup already exists", func(t *testing.T) {
+	t.Run("When SecurityGroup by id exists but VPC id not matching", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID: ptr.To("securityGroupID"),
+		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -7421,108 +8516,170 @@ func TestReconcileVPCSecurityGroups(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						ID: ptr.To("securityGroupID"),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: &securityGroupName, ID: &securityGroupID, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}, nil, nil)
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
-		g.Expect(err).To(BeNil())
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID"), VPC: &vpcv1.VPCReference{ID: ptr.To("vpcID")}}
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When SecurityGroup Name is set and GetSecurityGroup returns error", func(t *testing.T) {
+
+	t.Run("When it returns error while validating SecurityGroupRules", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
-		vpcSecurityGroupStatus[securityGroupName] = infrav1.VPCSecurityGroupStatus{ID: ptr.To("securityGroupID"), RuleIDs: []*string{ptr.To("ruleID")}, ControllerCreated: ptr.To(true)}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					CIDRSubnetName: ptr.To("CIDRSubnetName"),
+					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("192.168.1.1/24"),
+			},
+			ID:      ptr.To("ruleID"),
+			PortMax: ptr.To(int64(65535)),
+			PortMin: ptr.To(int64(1)),
+		}
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					VPCSecurityGroups: vpcSecurityGroupStatus,
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
-
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get security group"))
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
 		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When SecurityGroup Name is set  and returns error while getting SecurityGroupRules", func(t *testing.T) {
+
+	t.Run("When SecurityGroupRule doesn't exist and creates it", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
-		vpcSecurityGroupStatus[securityGroupName] = infrav1.VPCSecurityGroupStatus{ID: &securityGroupID, RuleIDs: []*string{ptr.To("ruleID")}, ControllerCreated: ptr.To(true)}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.1.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					VPCSecurityGroups: vpcSecurityGroupStatus,
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID"), Name: ptr.To("securityGroupName")}, nil, nil)
-		mockVPC.EXPECT().GetSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to get security group rule"))
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
-		g.Expect(err).ToNot(BeNil())
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Protocol: ptr.To("tcp"), ID: ptr.To("ruleID")}, nil, nil)
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When SecurityGroup is created successfully but returns error while creating SecurityGroupRules", func(t *testing.T) {
+	t.Run("When SecurityGroupRule doesn't match and return error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.0.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
 				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("192.168.1.1/24"),
+			},
+			ID: ptr.To("ruleID"),
+		}
 
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
+		vpcSecurityGroupStatus["securityGroupName"] = infrav1.VPCSecurityGroupStatus{ID: ptr.To("securityGroupID"), RuleIDs: []*string{}, ControllerCreated: ptr.To(false)}
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
+					VPCSecurityGroups: vpcSecurityGroupStatus,
 					VPC: &infrav1.ResourceReference{
 						ID: ptr.To("VPCID"),
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
-		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to create security group rule"))
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
 		g.Expect(err).ToNot(BeNil())
 	})
 }
 
-func TestValidateVPCSecurityGroup(t *testing.T) {
+func TestValidateVPCSecurityGroupRule(t *testing.T) {
 	var (
 		mockVPC  *mock.MockVpc
 		mockCtrl *gomock.Controller
@@ -7536,30 +8693,89 @@ func TestValidateVPCSecurityGroup(t *testing.T) {
 		mockCtrl.Finish()
 	}
 
-	t.Run("When SecurityGroup by name exists and SecurityGroupRule matches", func(t *testing.T) {
+	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("0.0.0.0/0"),
+			},
+			ID:      ptr.To("ruleID"),
+			PortMax: ptr.To(int64(65535)),
+			PortMin: ptr.To(int64(1)),
+		}
+
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
 			Direction: ptr.To("outbound"),
 			Protocol:  ptr.To("tcp"),
 			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("0.0.0.0/0"),
+				CIDRBlock: ptr.To("192.168.1.1/24"),
 			},
-			ID: ptr.To("ruleID"),
+			ID:      ptr.To("ruleID"),
+			PortMax: ptr.To(int64(65535)),
+			PortMin: ptr.To(int64(1)),
 		}
+
 		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
+			ID:    ptr.To("securityGroupID"),
 			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
 		}
 		clusterScope := PowerVSClusterScope{
@@ -7576,35 +8792,40 @@ func TestValidateVPCSecurityGroup(t *testing.T) {
 			},
 		}
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
-		g.Expect(sg).To(BeEquivalentTo(securityGroupDetails))
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(match).To(BeFalse())
 		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("When SecurityGroup by id exists and SecurityGroupRule matches", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
 			Direction: ptr.To("outbound"),
 			Protocol:  ptr.To("tcp"),
 			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("0.0.0.0/0"),
+				CIDRBlock: ptr.To("192.168.1.1/24"),
 			},
-			ID: ptr.To("ruleID"),
+			ID:      ptr.To("ruleID"),
+			PortMax: ptr.To(int64(65535)),
+			PortMin: ptr.To(int64(1)),
 		}
+
 		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
 			ID:    ptr.To("securityGroupID"),
@@ -7624,48 +8845,40 @@ func TestValidateVPCSecurityGroup(t *testing.T) {
 			},
 		}
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
-		g.Expect(sg).To(BeEquivalentTo(securityGroupDetails))
-		g.Expect(err).To(BeNil())
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
 	})
-
-	t.Run("When SecurityGroup by name doesn't exist and return error", func(t *testing.T) {
+	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name: ptr.To("securityGroupNamw"),
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
 		}
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("0.0.0.0/0"),
+			},
+			ID: ptr.To("ruleID"),
+		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get SecurityGroup"))
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
-	})
-
-	t.Run("When SecurityGroup by id doesn't exist and return error", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
+		var vpcSecurityGroupRules []vpcv1.SecurityGroupRuleIntf
+		vpcSecurityGroupRules = append(vpcSecurityGroupRules, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID: ptr.To("securityGroupID"),
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
 		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
@@ -7681,48 +8894,40 @@ func TestValidateVPCSecurityGroup(t *testing.T) {
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get SecurityGroup"))
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("When SecurityGroup by name exists but is not attached to VPC", func(t *testing.T) {
+	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name: ptr.To("securityGroupName"),
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			Address:    ptr.To("192.168.0.1/24"),
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
 		}
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				Address: ptr.To("192.168.1.1/24"),
+			},
+			ID: ptr.To("ruleID"),
+		}
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID")}
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeEmpty())
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
-	})
-
-	t.Run("When SecurityGroup by name exists but VPC id not matching", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
+		var vpcSecurityGroupRules []vpcv1.SecurityGroupRuleIntf
+		vpcSecurityGroupRules = append(vpcSecurityGroupRules, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name: ptr.To("securityGroupName"),
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
 		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
@@ -7738,20 +8943,39 @@ func TestValidateVPCSecurityGroup(t *testing.T) {
 			},
 		}
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID"), VPC: &vpcv1.VPCReference{ID: ptr.To("vpcID")}}
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("When SecurityGroup by id exists but VPC id not matching", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("192.168.1.1/24"),
+			},
+			ID: ptr.To("ruleID"),
+		}
+
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID: ptr.To("securityGroupID"),
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
 		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
@@ -7767,43 +8991,41 @@ func TestValidateVPCSecurityGroup(t *testing.T) {
 			},
 		}
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID"), VPC: &vpcv1.VPCReference{ID: ptr.To("vpcID")}}
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(match).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
-
-	t.Run("When it returns error while validating SecurityGroupRules", func(t *testing.T) {
+	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					CIDRSubnetName: ptr.To("CIDRSubnetName"),
-					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-				}),
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
-				},
+				ICMPCode: ptr.To(int64(12)),
+				ICMPType: ptr.To(int64(3)),
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
 			Direction: ptr.To("outbound"),
 			Protocol:  ptr.To("tcp"),
 			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
+				CRN: ptr.To("crn"),
 			},
-			ID:      ptr.To("ruleID"),
-			PortMax: ptr.To(int64(65535)),
-			PortMin: ptr.To(int64(1)),
+			ID:   ptr.To("ruleID"),
+			Code: ptr.To(int64(12)),
+			Type: ptr.To(int64(3)),
 		}
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
 			ID:    ptr.To("securityGroupID"),
 			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
@@ -7821,32 +9043,44 @@ func TestValidateVPCSecurityGroup(t *testing.T) {
 				},
 			},
 		}
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
-	})
 
-	t.Run("When SecurityGroupRule doesn't exist and creates it", func(t *testing.T) {
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("crn"), CRN: ptr.To("crn")}, nil)
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.1.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-				}),
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				ICMPCode: ptr.To(int64(12)),
+				ICMPType: ptr.To(int64(3)),
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CRN: ptr.To("crn"),
 			},
+			ID:   ptr.To("ruleID"),
+			Code: ptr.To(int64(12)),
+			Type: ptr.To(int64(3)),
 		}
 
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
+			ID:    ptr.To("securityGroupID"),
 			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
 		}
 		clusterScope := PowerVSClusterScope{
@@ -7863,49 +9097,49 @@ func TestValidateVPCSecurityGroup(t *testing.T) {
 			},
 		}
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Protocol: ptr.To("tcp"), ID: ptr.To("ruleID")}, nil, nil)
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("crn"), CRN: ptr.To("CRN")}, nil)
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(match).To(BeFalse())
 		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("When SecurityGroupRule doesn't match and return error", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				ICMPCode: ptr.To(int64(12)),
+				ICMPType: ptr.To(int64(3)),
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
 			Direction: ptr.To("outbound"),
 			Protocol:  ptr.To("tcp"),
 			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
+				CRN: ptr.To("crn"),
 			},
-			ID: ptr.To("ruleID"),
+			ID:   ptr.To("ruleID"),
+			Code: ptr.To(int64(12)),
+			Type: ptr.To(int64(3)),
 		}
 
 		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
-		vpcSecurityGroupStatus["securityGroupName"] = infrav1.VPCSecurityGroupStatus{ID: ptr.To("securityGroupID"), RuleIDs: []*string{}, ControllerCreated: ptr.To(false)}
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
+			ID:    ptr.To("securityGroupID"),
 			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
 		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					VPCSecurityGroups: vpcSecurityGroupStatus,
 					VPC: &infrav1.ResourceReference{
 						ID: ptr.To("VPCID"),
 					},
@@ -7916,16 +9150,15 @@ func TestValidateVPCSecurityGroup(t *testing.T) {
 			},
 		}
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get securityGroup"))
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(match).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
 }
 
-func TestValidateVPCSecurityGroupRule(t *testing.T) {
+func TestValidateVPCSecurityGroupRules(t *testing.T) {
 	var (
 		mockVPC  *mock.MockVpc
 		mockCtrl *gomock.Controller
@@ -7939,32 +9172,26 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 		mockCtrl.Finish()
 	}
 
-	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of Direction Inbound matches", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
-				},
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
-			Direction: ptr.To("outbound"),
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("inbound"),
 			Protocol:  ptr.To("tcp"),
 			Remote: &vpcv1.SecurityGroupRuleRemote{
 				CIDRBlock: ptr.To("0.0.0.0/0"),
 			},
-			ID:      ptr.To("ruleID"),
-			PortMax: ptr.To(int64(65535)),
-			PortMin: ptr.To(int64(1)),
+			ID: ptr.To("ruleID"),
 		}
 
 		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
@@ -7986,37 +9213,31 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 			},
 		}
 
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
+		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
+		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
 		g.Expect(match).To(BeTrue())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of Direction Inbound doesn't match", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
-				},
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
-			Direction: ptr.To("outbound"),
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("inbound"),
 			Protocol:  ptr.To("tcp"),
 			Remote: &vpcv1.SecurityGroupRuleRemote{
 				CIDRBlock: ptr.To("192.168.1.1/24"),
 			},
-			ID:      ptr.To("ruleID"),
-			PortMax: ptr.To(int64(65535)),
-			PortMin: ptr.To(int64(1)),
+			ID: ptr.To("ruleID"),
 		}
 
 		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
@@ -8029,7 +9250,7 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
 					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+						ID: ptr.To("securityGroupID"),
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
@@ -8038,12 +9259,12 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 			},
 		}
 
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
+		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
+		g.Expect(ruleIDs).To(BeNil())
 		g.Expect(match).To(BeFalse())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp returns error", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of Direction Inbound returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -8052,31 +9273,26 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
-				},
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
-			Direction: ptr.To("outbound"),
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("inbound"),
 			Protocol:  ptr.To("tcp"),
 			Remote: &vpcv1.SecurityGroupRuleRemote{
 				CIDRBlock: ptr.To("192.168.1.1/24"),
 			},
-			ID:      ptr.To("ruleID"),
-			PortMax: ptr.To(int64(65535)),
-			PortMin: ptr.To(int64(1)),
+			ID: ptr.To("ruleID"),
 		}
-
 		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
+			ID:    ptr.To("securityGroupName"),
 			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
 		}
+
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8092,22 +9308,21 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 		}
 
 		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
+		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
+		g.Expect(ruleIDs).To(BeNil())
 		g.Expect(match).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of Direction Outbound matches", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
@@ -8120,8 +9335,7 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 			ID: ptr.To("ruleID"),
 		}
 
-		var vpcSecurityGroupRules []vpcv1.SecurityGroupRuleIntf
-		vpcSecurityGroupRules = append(vpcSecurityGroupRules, &vpcSecurityGroupRule)
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
 			ID:    ptr.To("securityGroupID"),
 			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
@@ -8140,23 +9354,21 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 			},
 		}
 
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
+		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
+		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
 		g.Expect(match).To(BeTrue())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of Direction Outbound doesn't match", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			Address:    ptr.To("192.168.0.1/24"),
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
@@ -8164,13 +9376,12 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 			Direction: ptr.To("outbound"),
 			Protocol:  ptr.To("tcp"),
 			Remote: &vpcv1.SecurityGroupRuleRemote{
-				Address: ptr.To("192.168.1.1/24"),
+				CIDRBlock: ptr.To("192.168.1.1/24"),
 			},
 			ID: ptr.To("ruleID"),
 		}
 
-		var vpcSecurityGroupRules []vpcv1.SecurityGroupRuleIntf
-		vpcSecurityGroupRules = append(vpcSecurityGroupRules, &vpcSecurityGroupRule)
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
 			ID:    ptr.To("securityGroupID"),
 			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
@@ -8189,18 +9400,33 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 			},
 		}
 
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
+		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
+		g.Expect(ruleIDs).To(BeNil())
 		g.Expect(match).To(BeFalse())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll returns error", func(t *testing.T) {
+}
+
+func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("When it matches the remoteType Address", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+			Address:    ptr.To("192.168.0.1/24"),
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
@@ -8209,20 +9435,44 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
+
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
 			},
-			ID: ptr.To("ruleID"),
 		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{Address: ptr.To("192.168.0.1/24")}, remote)
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When it doesn't match the remoteType Address", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			Address:    ptr.To("192.168.0.1/24"),
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
 		}
+
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8232,50 +9482,33 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{Address: ptr.To("192.168.1.1/24")}, remote)
 		g.Expect(match).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		g.Expect(err).To(BeNil())
 	})
-	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp", func(t *testing.T) {
+	t.Run("When it matches the remoteType Any", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				ICMPCode: ptr.To(int64(12)),
-				ICMPType: ptr.To(int64(3)),
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CRN: ptr.To("crn"),
 			},
-			ID:   ptr.To("ruleID"),
-			Code: ptr.To(int64(12)),
-			Type: ptr.To(int64(3)),
 		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8285,50 +9518,33 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("crn"), CRN: ptr.To("crn")}, nil)
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("0.0.0.0/0")}, remote)
 		g.Expect(match).To(BeTrue())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp", func(t *testing.T) {
+	t.Run("When it doesn't match the remoteType Any", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				ICMPCode: ptr.To(int64(12)),
-				ICMPType: ptr.To(int64(3)),
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CRN: ptr.To("crn"),
 			},
-			ID:   ptr.To("ruleID"),
-			Code: ptr.To(int64(12)),
-			Type: ptr.To(int64(3)),
 		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8338,50 +9554,34 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("crn"), CRN: ptr.To("CRN")}, nil)
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
 		g.Expect(match).To(BeFalse())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp returns error", func(t *testing.T) {
+	t.Run("When it matches the remoteType CIDR", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				ICMPCode: ptr.To(int64(12)),
-				ICMPType: ptr.To(int64(3)),
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CRN: ptr.To("crn"),
 			},
-			ID:   ptr.To("ruleID"),
-			Code: ptr.To(int64(12)),
-			Type: ptr.To(int64(3)),
 		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8391,60 +9591,35 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get securityGroup"))
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.1.1/24")}, nil)
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
-}
-
-func TestValidateVPCSecurityGroupRules(t *testing.T) {
-	var (
-		mockVPC  *mock.MockVpc
-		mockCtrl *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockVPC = mock.NewMockVpc(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
-
-	t.Run("When SecurityGroupRule of Direction Inbound matches", func(t *testing.T) {
+	t.Run("When it doesn't match the remoteType CIDR", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("inbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("0.0.0.0/0"),
-			},
-			ID: ptr.To("ruleID"),
-		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8454,90 +9629,72 @@ func TestValidateVPCSecurityGroupRules(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
 				},
 			},
 		}
 
-		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
-		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
-		g.Expect(match).To(BeTrue())
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.0.1/24")}, nil)
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
+		g.Expect(match).To(BeFalse())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When SecurityGroupRule of Direction Inbound doesn't match", func(t *testing.T) {
+	t.Run("When the remoteType CIDR and it returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("inbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
-			},
-			ID: ptr.To("ruleID"),
-		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
 					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("securityGroupID"),
+						ID: ptr.To("VPCID"),
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
 				},
 			},
 		}
 
-		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
-		g.Expect(ruleIDs).To(BeNil())
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
 		g.Expect(match).To(BeFalse())
-		g.Expect(err).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When SecurityGroupRule of Direction Inbound returns error", func(t *testing.T) {
+	t.Run("When it matches the remoteType SG", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("inbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
-			},
-			ID: ptr.To("ruleID"),
-		}
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
@@ -8548,44 +9705,35 @@ func TestValidateVPCSecurityGroupRules(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("192.168.1.1/24"), CRN: ptr.To("crn")}, nil)
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
-	t.Run("When SecurityGroupRule of Direction Outbound matches", func(t *testing.T) {
+	t.Run("When it doesn't match the remoteType SG", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("0.0.0.0/0"),
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
-			ID: ptr.To("ruleID"),
 		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8595,43 +9743,35 @@ func TestValidateVPCSecurityGroupRules(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
 				},
 			},
 		}
 
-		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
-		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
-		g.Expect(match).To(BeTrue())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("192.168.1.1/24"), CRN: ptr.To("CRN")}, nil)
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
+		g.Expect(match).To(BeFalse())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When SecurityGroupRule of Direction Outbound doesn't match", func(t *testing.T) {
+	t.Run("When the remoteType SG and it returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
-			},
-			ID: ptr.To("ruleID"),
-		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8641,23 +9781,30 @@ func TestValidateVPCSecurityGroupRules(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
 				},
 			},
 		}
 
-		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
-		g.Expect(ruleIDs).To(BeNil())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get security group"))
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
 		g.Expect(match).To(BeFalse())
-		g.Expect(err).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
 }
 
-func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
+func TestCreateVPCSecurityGroupRule(t *testing.T) {
 	var (
 		mockVPC  *mock.MockVpc
 		mockCtrl *gomock.Controller
 	)
+	securityGroupID := "securityGroupID"
+	var portMax int64 = 65535
+	var portMin int64 = 1
+	var protocol = "tcp"
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
@@ -8666,7 +9813,8 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When it matches the remoteType Address", func(t *testing.T) {
+
+	t.Run("Creates SecurityGroupRule of remoteType Address successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -8699,17 +9847,18 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 			},
 		}
 
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{Address: ptr.To("192.168.0.1/24")}, remote)
-		g.Expect(match).To(BeTrue())
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When it doesn't match the remoteType Address", func(t *testing.T) {
+	t.Run("Creates SecurityGroupRule of remoteType CIDR successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			Address:    ptr.To("192.168.0.1/24"),
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
@@ -8736,16 +9885,19 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 			},
 		}
 
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{Address: ptr.To("192.168.1.1/24")}, remote)
-		g.Expect(match).To(BeFalse())
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.1.1/24")}, nil)
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When it matches the remoteType Any", func(t *testing.T) {
+	t.Run("SecurityGroupRule of remoteType CIDR returns error when getting VPC subnet", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
@@ -8772,11 +9924,12 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 			},
 		}
 
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("0.0.0.0/0")}, remote)
-		g.Expect(match).To(BeTrue())
-		g.Expect(err).To(BeNil())
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it doesn't match the remoteType Any", func(t *testing.T) {
+	t.Run("Creates SecurityGroupRule of remoteType Any successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -8808,21 +9961,22 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 			},
 		}
 
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
-		g.Expect(match).To(BeFalse())
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When it matches the remoteType CIDR", func(t *testing.T) {
+	t.Run("Creates SecurityGroupRule of remoteType SG successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
@@ -8845,22 +9999,23 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.1.1/24")}, nil)
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
-		g.Expect(match).To(BeTrue())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{CRN: ptr.To("crn"), Name: ptr.To("securityGroupName")}, nil)
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{Direction: ptr.To("inbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When it doesn't match the remoteType CIDR", func(t *testing.T) {
+	t.Run("SecurityGroupRule of remoteType SG returns error while getting securityGroup", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
@@ -8883,22 +10038,22 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.0.1/24")}, nil)
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).To(BeNil())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get security group"))
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When the remoteType CIDR and it returns error", func(t *testing.T) {
+	t.Run("SecurityGroupRule of remoteType SG returns error when SecurityGroup doesn't exist", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
@@ -8921,27 +10076,49 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
-		g.Expect(match).To(BeFalse())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeNil())
 		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it matches the remoteType SG", func(t *testing.T) {
+}
+
+func TestCreateVPCSecurityGroupRules(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When SecurityGroupRule of Direction Outbound created successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.0.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
 			},
 		}
 
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8951,35 +10128,35 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("192.168.1.1/24"), CRN: ptr.To("crn")}, nil)
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
-		g.Expect(match).To(BeTrue())
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
+		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When it doesn't match the remoteType SG", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of Direction Outbound returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					CIDRSubnetName: ptr.To("CIDRSubnetName"),
+					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
 
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8989,35 +10166,76 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
+		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("When SecurityGroupRule of Direction Inbound created successfully", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.0.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
+			},
+		}
+
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := PowerVSClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("192.168.1.1/24"), CRN: ptr.To("CRN")}, nil)
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
-		g.Expect(match).To(BeFalse())
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("inbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
+		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When the remoteType SG and it returns error", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of Direction Inbound returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					CIDRSubnetName: ptr.To("CIDRSubnetName"),
+					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
 
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -9027,30 +10245,23 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get security group"))
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
-		g.Expect(match).To(BeFalse())
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
+		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
+		g.Expect(ruleIDs).To(BeNil())
 		g.Expect(err).ToNot(BeNil())
 	})
 }
 
-func TestCreateVPCSecurityGroupRule(t *testing.T) {
+func TestCreateVPCSecurityGroupRulesAndSetStatus(t *testing.T) {
 	var (
 		mockVPC  *mock.MockVpc
 		mockCtrl *gomock.Controller
 	)
-	securityGroupID := "securityGroupID"
-	var portMax int64 = 65535
-	var portMin int64 = 1
-	var protocol = "tcp"
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
@@ -9059,451 +10270,663 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-
-	t.Run("Creates SecurityGroupRule of remoteType Address successfully", func(t *testing.T) {
+	t.Run("When SecurityGroupRule is created successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			Address:    ptr.To("192.168.0.1/24"),
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.0.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
-
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
 					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+						Name: ptr.To("securityGroupName"),
 					}),
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("resourceGroupID"),
+					},
 				},
 			},
 		}
 
 		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
+		err := clusterScope.createVPCSecurityGroupRulesAndSetStatus(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"), ptr.To("securityGroupName"))
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("Creates SecurityGroupRule of remoteType CIDR successfully", func(t *testing.T) {
+	t.Run("When CreateSecurityGroupRule returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.0.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
-
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
 					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+						Name: ptr.To("securityGroupName"),
 					}),
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("resourceGroupID"),
+					},
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.1.1/24")}, nil)
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
-		g.Expect(err).To(BeNil())
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to create securityGroupRules"))
+		err := clusterScope.createVPCSecurityGroupRulesAndSetStatus(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"), ptr.To("securityGroupName"))
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("SecurityGroupRule of remoteType CIDR returns error when getting VPC subnet", func(t *testing.T) {
+}
+
+func TestCreateVPCSecurityGroup(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("When SecurityGroup is created successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
 					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+						Name: ptr.To("securityGroupName"),
 					}),
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("resourceGroupID"),
+					},
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
+		sg, err := clusterScope.createVPCSecurityGroup(ctx, clusterScope.IBMPowerVSCluster.Spec.VPCSecurityGroups[0])
+		g.Expect(*sg).To(BeEquivalentTo("securityGroupID"))
+		g.Expect(err).To(BeNil())
 	})
-	t.Run("Creates SecurityGroupRule of remoteType Any successfully", func(t *testing.T) {
+	t.Run("When SecurityGroup returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-
 		clusterScope := PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
 					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+						Name: ptr.To("securityGroupName"),
 					}),
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("resourceGroupID"),
+					},
+				},
+			},
+		}
+
+		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to create SecurityGroup"))
+		sg, err := clusterScope.createVPCSecurityGroup(ctx, clusterScope.IBMPowerVSCluster.Spec.VPCSecurityGroups[0])
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestReconcileControlPlaneDNS(t *testing.T) {
+	var (
+		mockCtrl *gomock.Controller
+		mockDNS  *dnsmock.MockDNSServices
+		mockVPC  *mock.MockVpc
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockDNS = dnsmock.NewMockDNSServices(mockCtrl)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	powervsClusterScope := func() *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			IBMPowerVSClient:  nil,
+			IBMVPCClient:      mockVPC,
+			DNSServicesClient: mockDNS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster"},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ControlPlaneDNS: &infrav1.ControlPlaneDNS{
+						InstanceID: "dns-instance-id",
+						Zone:       "capi.example.com",
+					},
+					LoadBalancers: []infrav1.VPCLoadBalancerSpec{
+						{Name: "public-lb", Public: ptr.To(true)},
+						{Name: "private-lb", Public: ptr.To(false)},
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{ID: ptr.To("vpc-id")},
+					LoadBalancers: map[string]infrav1.VPCLoadBalancerStatus{
+						"public-lb":  {ID: ptr.To("public-lb-id"), Hostname: ptr.To("public.lb.example.com")},
+						"private-lb": {ID: ptr.To("private-lb-id"), Hostname: ptr.To("private.lb.example.com")},
+					},
 				},
 			},
 		}
+	}
+
+	t.Run("When the zone, permitted network and records are created", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockDNS.EXPECT().GetDnszoneByName("dns-instance-id", "capi.example.com").Return(nil, nil)
+		mockDNS.EXPECT().CreateDnszone(gomock.Any()).Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id"), State: ptr.To(dnssvcsv1.Dnszone_State_PendingNetworkAdd)}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockDNS.EXPECT().GetPermittedNetworkByVPCCRN("dns-instance-id", "zone-id", "vpc-crn").Return(nil, nil)
+		mockDNS.EXPECT().CreatePermittedNetwork(gomock.Any()).Return(&dnssvcsv1.PermittedNetwork{ID: ptr.To("network-id")}, nil, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(nil, nil)
+		mockDNS.EXPECT().CreateResourceRecord(gomock.Any()).DoAndReturn(func(options *dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+			g.Expect(*options.Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord).Cname).To(Equal("public.lb.example.com"))
+			return &dnssvcsv1.ResourceRecord{ID: ptr.To("api-record-id")}, nil, nil
+		})
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api-int.capi.example.com").Return(nil, nil)
+		mockDNS.EXPECT().CreateResourceRecord(gomock.Any()).DoAndReturn(func(options *dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+			g.Expect(*options.Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord).Cname).To(Equal("private.lb.example.com"))
+			return &dnssvcsv1.ResourceRecord{ID: ptr.To("api-int-record-id")}, nil, nil
+		})
+
+		requeue, err := clusterScope.ReconcileControlPlaneDNS(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS).To(Equal(&infrav1.ControlPlaneDNSStatus{
+			Zone:             &infrav1.ResourceReference{ID: ptr.To("zone-id"), ControllerCreated: ptr.To(true)},
+			PermittedNetwork: &infrav1.ResourceReference{ID: ptr.To("network-id"), ControllerCreated: ptr.To(true)},
+			APIRecord:        &infrav1.ResourceReference{ID: ptr.To("api-record-id"), ControllerCreated: ptr.To(true)},
+			APIIntRecord:     &infrav1.ResourceReference{ID: ptr.To("api-int-record-id"), ControllerCreated: ptr.To(true)},
+		}))
+		g.Expect(*clusterScope.GetControlPlaneDNSName()).To(Equal("api.capi.example.com"))
+	})
+
+	t.Run("When the existing zone, permitted network and records are adopted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockDNS.EXPECT().GetDnszoneByName("dns-instance-id", "capi.example.com").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id"), State: ptr.To(dnssvcsv1.Dnszone_State_Active)}, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockDNS.EXPECT().GetPermittedNetworkByVPCCRN("dns-instance-id", "zone-id", "vpc-crn").Return(&dnssvcsv1.PermittedNetwork{ID: ptr.To("network-id")}, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(&dnssvcsv1.ResourceRecord{
+			ID:    ptr.To("api-record-id"),
+			Type:  ptr.To(dnssvcsv1.ResourceRecord_Type_Cname),
+			Rdata: map[string]interface{}{"cname": "public.lb.example.com"},
+		}, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api-int.capi.example.com").Return(&dnssvcsv1.ResourceRecord{
+			ID:    ptr.To("api-int-record-id"),
+			Type:  ptr.To(dnssvcsv1.ResourceRecord_Type_Cname),
+			Rdata: map[string]interface{}{"cname": "stale.lb.example.com"},
+		}, nil)
+		mockDNS.EXPECT().UpdateResourceRecord(gomock.Any()).DoAndReturn(func(options *dnssvcsv1.UpdateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+			g.Expect(*options.RecordID).To(Equal("api-int-record-id"))
+			g.Expect(*options.Rdata.(*dnssvcsv1.ResourceRecordUpdateInputRdataRdataCnameRecord).Cname).To(Equal("private.lb.example.com"))
+			return &dnssvcsv1.ResourceRecord{}, nil, nil
+		})
+
+		requeue, err := clusterScope.ReconcileControlPlaneDNS(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS).To(Equal(&infrav1.ControlPlaneDNSStatus{
+			Zone:             &infrav1.ResourceReference{ID: ptr.To("zone-id"), ControllerCreated: ptr.To(false)},
+			PermittedNetwork: &infrav1.ResourceReference{ID: ptr.To("network-id"), ControllerCreated: ptr.To(false)},
+			APIRecord:        &infrav1.ResourceReference{ID: ptr.To("api-record-id"), ControllerCreated: ptr.To(false)},
+			APIIntRecord:     &infrav1.ResourceReference{ID: ptr.To("api-int-record-id"), ControllerCreated: ptr.To(false)},
+		}))
+	})
+
+	t.Run("When the load balancer hostname is not yet available", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.LoadBalancers = map[string]infrav1.VPCLoadBalancerStatus{"public-lb": {ID: ptr.To("public-lb-id")}}
+		clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS = &infrav1.ControlPlaneDNSStatus{
+			Zone:             &infrav1.ResourceReference{ID: ptr.To("zone-id"), ControllerCreated: ptr.To(true)},
+			PermittedNetwork: &infrav1.ResourceReference{ID: ptr.To("network-id"), ControllerCreated: ptr.To(true)},
+		}
+		mockDNS.EXPECT().GetDnszone(gomock.Any()).Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id"), State: ptr.To(dnssvcsv1.Dnszone_State_Active)}, nil, nil)
+
+		requeue, err := clusterScope.ReconcileControlPlaneDNS(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("When the zone is disabled", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockDNS.EXPECT().GetDnszoneByName("dns-instance-id", "capi.example.com").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id"), State: ptr.To(dnssvcsv1.Dnszone_State_Disabled)}, nil)
 
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
-		g.Expect(err).To(BeNil())
+		requeue, err := clusterScope.ReconcileControlPlaneDNS(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("Creates SecurityGroupRule of remoteType SG successfully", func(t *testing.T) {
+
+	t.Run("When an existing record is not a CNAME record", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS = &infrav1.ControlPlaneDNSStatus{
+			Zone:             &infrav1.ResourceReference{ID: ptr.To("zone-id"), ControllerCreated: ptr.To(true)},
+			PermittedNetwork: &infrav1.ResourceReference{ID: ptr.To("network-id"), ControllerCreated: ptr.To(true)},
+		}
+		mockDNS.EXPECT().GetDnszone(gomock.Any()).Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id"), State: ptr.To(dnssvcsv1.Dnszone_State_Active)}, nil, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(&dnssvcsv1.ResourceRecord{
+			ID:    ptr.To("api-record-id"),
+			Type:  ptr.To(dnssvcsv1.ResourceRecord_Type_A),
+			Rdata: map[string]interface{}{"ip": "10.0.0.1"},
+		}, nil)
 
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
+		requeue, err := clusterScope.ReconcileControlPlaneDNS(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
+
+func TestDeleteControlPlaneDNS(t *testing.T) {
+	var (
+		mockCtrl *gomock.Controller
+		mockDNS  *dnsmock.MockDNSServices
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockDNS = dnsmock.NewMockDNSServices(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	powervsClusterScope := func(controllerCreated bool) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			DNSServicesClient: mockDNS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ControlPlaneDNS: &infrav1.ControlPlaneDNS{
+						InstanceID: "dns-instance-id",
+						Zone:       "capi.example.com",
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ControlPlaneDNS: &infrav1.ControlPlaneDNSStatus{
+						Zone:             &infrav1.ResourceReference{ID: ptr.To("zone-id"), ControllerCreated: ptr.To(controllerCreated)},
+						PermittedNetwork: &infrav1.ResourceReference{ID: ptr.To("network-id"), ControllerCreated: ptr.To(controllerCreated)},
+						APIRecord:        &infrav1.ResourceReference{ID: ptr.To("api-record-id"), ControllerCreated: ptr.To(controllerCreated)},
+						APIIntRecord:     &infrav1.ResourceReference{ID: ptr.To("api-int-record-id"), ControllerCreated: ptr.To(controllerCreated)},
+					},
 				},
 			},
 		}
+	}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{CRN: ptr.To("crn"), Name: ptr.To("securityGroupName")}, nil)
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{Direction: ptr.To("inbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
+	t.Run("When control-plane DNS status is nil", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(true)
+		clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS = nil
+		requeue, err := clusterScope.DeleteControlPlaneDNS(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("SecurityGroupRule of remoteType SG returns error while getting securityGroup", func(t *testing.T) {
+
+	t.Run("When resources are not created by controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
+		clusterScope := powervsClusterScope(false)
+		requeue, err := clusterScope.DeleteControlPlaneDNS(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.APIRecord).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.PermittedNetwork).To(BeNil())
+	})
 
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
-				},
-			},
-		}
+	t.Run("When records are deleted and permitted network deletion is started", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(true)
+		mockDNS.EXPECT().DeleteResourceRecord(gomock.Any()).Return(nil, nil).Times(2)
+		mockDNS.EXPECT().GetPermittedNetwork(gomock.Any()).Return(&dnssvcsv1.PermittedNetwork{ID: ptr.To("network-id"), State: ptr.To(dnssvcsv1.PermittedNetwork_State_Active)}, nil, nil)
+		mockDNS.EXPECT().DeletePermittedNetwork(gomock.Any()).Return(nil, nil, nil)
+		requeue, err := clusterScope.DeleteControlPlaneDNS(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.APIRecord).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.APIIntRecord).To(BeNil())
+	})
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get security group"))
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+	t.Run("When permitted network is being removed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(true)
+		clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.APIRecord = nil
+		clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.APIIntRecord = nil
+		mockDNS.EXPECT().GetPermittedNetwork(gomock.Any()).Return(&dnssvcsv1.PermittedNetwork{ID: ptr.To("network-id"), State: ptr.To(dnssvcsv1.PermittedNetwork_State_RemovalInProgress)}, nil, nil)
+		requeue, err := clusterScope.DeleteControlPlaneDNS(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
-	t.Run("SecurityGroupRule of remoteType SG returns error when SecurityGroup doesn't exist", func(t *testing.T) {
+
+	t.Run("When DeleteResourceRecord returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
+		clusterScope := powervsClusterScope(true)
+		mockDNS.EXPECT().DeleteResourceRecord(gomock.Any()).Return(&core.DetailedResponse{StatusCode: 500}, errors.New("failed to delete record"))
+		requeue, err := clusterScope.DeleteControlPlaneDNS(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
 
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
-				},
-			},
-		}
+	t.Run("When permitted network is removed and zone is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(true)
+		clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.APIRecord = nil
+		clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.APIIntRecord = nil
+		mockDNS.EXPECT().GetPermittedNetwork(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, errors.New("not found"))
+		mockDNS.EXPECT().GetDnszone(gomock.Any()).Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id"), State: ptr.To(dnssvcsv1.Dnszone_State_PendingNetworkAdd)}, nil, nil)
+		mockDNS.EXPECT().DeleteDnszone(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.DeleteControlPlaneDNS(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.PermittedNetwork).To(BeNil())
+	})
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+	t.Run("When zone is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(true)
+		clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.APIRecord = nil
+		clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.APIIntRecord = nil
+		clusterScope.IBMPowerVSCluster.Status.ControlPlaneDNS.PermittedNetwork = nil
+		mockDNS.EXPECT().GetDnszone(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, errors.New("not found"))
+		requeue, err := clusterScope.DeleteControlPlaneDNS(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
 }
 
-func TestCreateVPCSecurityGroupRules(t *testing.T) {
+func TestReconcileDNSRecords(t *testing.T) {
 	var (
-		mockVPC  *mock.MockVpc
 		mockCtrl *gomock.Controller
+		mockCIS  *cismock.MockCIS
 	)
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockCIS = cismock.NewMockCIS(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-
-	t.Run("When SecurityGroupRule of Direction Outbound created successfully", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.0.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
-				},
-			},
-		}
-
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
+	powervsClusterScope := func() *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			CISClient: mockCIS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster"},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					DNS: &infrav1.DNS{
+						CISInstanceCRN: "cis-crn",
+						Domain:         "example.com",
+					},
+					LoadBalancers: []infrav1.VPCLoadBalancerSpec{
+						{Name: "public-lb", Public: ptr.To(true)},
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				Status: infrav1.IBMPowerVSClusterStatus{
+					LoadBalancers: map[string]infrav1.VPCLoadBalancerStatus{
+						"public-lb": {ID: ptr.To("public-lb-id"), Hostname: ptr.To("public.lb.example.com")},
+					},
 				},
 			},
 		}
+	}
 
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
-		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
+	t.Run("When the api and ingress records are created", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Spec.DNS.Ingress = &infrav1.DNSIngress{Target: ptr.To("ingress.lb.example.com")}
+		mockCIS.EXPECT().GetZoneByName("example.com").Return(&zonesv1.ZoneDetails{ID: ptr.To("zone-id")}, nil)
+		mockCIS.EXPECT().GetDNSRecordByName("zone-id", "api.capi-cluster.example.com").Return(nil, nil)
+		mockCIS.EXPECT().CreateDNSRecord("zone-id", gomock.Any()).DoAndReturn(func(_ string, options *dnsrecordsv1.CreateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+			g.Expect(*options.Content).To(Equal("public.lb.example.com"))
+			return &dnsrecordsv1.DnsrecordResp{Result: &dnsrecordsv1.DnsrecordDetails{ID: ptr.To("api-record-id")}}, nil, nil
+		})
+		mockCIS.EXPECT().GetDNSRecordByName("zone-id", "*.apps.capi-cluster.example.com").Return(nil, nil)
+		mockCIS.EXPECT().CreateDNSRecord("zone-id", gomock.Any()).DoAndReturn(func(_ string, options *dnsrecordsv1.CreateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+			g.Expect(*options.Content).To(Equal("ingress.lb.example.com"))
+			return &dnsrecordsv1.DnsrecordResp{Result: &dnsrecordsv1.DnsrecordDetails{ID: ptr.To("ingress-record-id")}}, nil, nil
+		})
+
+		requeue, err := clusterScope.ReconcileDNSRecords(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS).To(Equal(&infrav1.DNSStatus{
+			ZoneID:        ptr.To("zone-id"),
+			APIRecord:     &infrav1.ResourceReference{ID: ptr.To("api-record-id"), ControllerCreated: ptr.To(true)},
+			IngressRecord: &infrav1.ResourceReference{ID: ptr.To("ingress-record-id"), ControllerCreated: ptr.To(true)},
+		}))
+		g.Expect(*clusterScope.GetDNSAPIName()).To(Equal("api.capi-cluster.example.com"))
 	})
-	t.Run("When SecurityGroupRule of Direction Outbound returns error", func(t *testing.T) {
+
+	t.Run("When an existing api record with a different target is adopted", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					CIDRSubnetName: ptr.To("CIDRSubnetName"),
-					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Spec.DNS.APIRecordName = ptr.To("k8s")
+		clusterScope.IBMPowerVSCluster.Status.DNS = &infrav1.DNSStatus{ZoneID: ptr.To("zone-id")}
+		mockCIS.EXPECT().GetDNSRecordByName("zone-id", "k8s.example.com").Return(&dnsrecordsv1.DnsrecordDetails{
+			ID:      ptr.To("api-record-id"),
+			Type:    ptr.To(dnsrecordsv1.CreateDnsRecordOptions_Type_Cname),
+			Content: ptr.To("stale.lb.example.com"),
+		}, nil)
+		mockCIS.EXPECT().UpdateDNSRecord("zone-id", gomock.Any()).DoAndReturn(func(_ string, options *dnsrecordsv1.UpdateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+			g.Expect(*options.DnsrecordIdentifier).To(Equal("api-record-id"))
+			g.Expect(*options.Content).To(Equal("public.lb.example.com"))
+			return &dnsrecordsv1.DnsrecordResp{}, nil, nil
+		})
 
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-			},
+		requeue, err := clusterScope.ReconcileDNSRecords(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS.APIRecord).To(Equal(&infrav1.ResourceReference{ID: ptr.To("api-record-id"), ControllerCreated: ptr.To(false)}))
+		g.Expect(*clusterScope.GetDNSAPIName()).To(Equal("k8s.example.com"))
+	})
+
+	t.Run("When the api record is up to date and ingress record is no longer requested", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.DNS = &infrav1.DNSStatus{
+			ZoneID:        ptr.To("zone-id"),
+			APIRecord:     &infrav1.ResourceReference{ID: ptr.To("api-record-id"), ControllerCreated: ptr.To(true)},
+			IngressRecord: &infrav1.ResourceReference{ID: ptr.To("ingress-record-id"), ControllerCreated: ptr.To(true)},
 		}
+		mockCIS.EXPECT().GetDNSRecord("zone-id", gomock.Any()).Return(&dnsrecordsv1.DnsrecordResp{Result: &dnsrecordsv1.DnsrecordDetails{
+			ID:      ptr.To("api-record-id"),
+			Type:    ptr.To(dnsrecordsv1.CreateDnsRecordOptions_Type_Cname),
+			Content: ptr.To("public.lb.example.com"),
+		}}, nil, nil)
+		mockCIS.EXPECT().DeleteDNSRecord("zone-id", gomock.Any()).Return(nil, nil, nil)
+
+		requeue, err := clusterScope.ReconcileDNSRecords(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS.IngressRecord).To(BeNil())
+	})
+
+	t.Run("When the load balancer hostname is not yet available", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.LoadBalancers = map[string]infrav1.VPCLoadBalancerStatus{"public-lb": {ID: ptr.To("public-lb-id")}}
+
+		requeue, err := clusterScope.ReconcileDNSRecords(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
-		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
-		g.Expect(ruleIDs).To(BeNil())
+	t.Run("When the domain is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockCIS.EXPECT().GetZoneByName("example.com").Return(nil, nil)
+
+		requeue, err := clusterScope.ReconcileDNSRecords(ctx)
 		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When SecurityGroupRule of Direction Inbound created successfully", func(t *testing.T) {
+
+	t.Run("When an existing record is not a CNAME record", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.0.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
-				},
-			},
-		}
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.DNS = &infrav1.DNSStatus{ZoneID: ptr.To("zone-id")}
+		mockCIS.EXPECT().GetDNSRecordByName("zone-id", "api.capi-cluster.example.com").Return(&dnsrecordsv1.DnsrecordDetails{
+			ID:      ptr.To("api-record-id"),
+			Type:    ptr.To(dnsrecordsv1.CreateDnsRecordOptions_Type_A),
+			Content: ptr.To("192.168.0.1"),
+		}, nil)
 
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
+		requeue, err := clusterScope.ReconcileDNSRecords(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
+
+func TestDeleteDNSRecords(t *testing.T) {
+	var (
+		mockCtrl *gomock.Controller
+		mockCIS  *cismock.MockCIS
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockCIS = cismock.NewMockCIS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	powervsClusterScope := func(controllerCreated bool) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
+			CISClient: mockCIS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					DNS: &infrav1.DNS{
+						CISInstanceCRN: "cis-crn",
+						Domain:         "example.com",
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				Status: infrav1.IBMPowerVSClusterStatus{
+					DNS: &infrav1.DNSStatus{
+						ZoneID:        ptr.To("zone-id"),
+						APIRecord:     &infrav1.ResourceReference{ID: ptr.To("api-record-id"), ControllerCreated: ptr.To(controllerCreated)},
+						IngressRecord: &infrav1.ResourceReference{ID: ptr.To("ingress-record-id"), ControllerCreated: ptr.To(controllerCreated)},
+					},
 				},
 			},
 		}
+	}
 
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("inbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
-		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
-		g.Expect(err).To(BeNil())
+	t.Run("When DNS status is nil", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(true)
+		clusterScope.IBMPowerVSCluster.Status.DNS = nil
+		g.Expect(clusterScope.DeleteDNSRecords(ctx)).To(Succeed())
 	})
-	t.Run("When SecurityGroupRule of Direction Inbound returns error", func(t *testing.T) {
+
+	t.Run("When records are not created by controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					CIDRSubnetName: ptr.To("CIDRSubnetName"),
-					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
+		clusterScope := powervsClusterScope(false)
+		g.Expect(clusterScope.DeleteDNSRecords(ctx)).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS.APIRecord).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS.IngressRecord).To(BeNil())
+	})
 
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-			},
-		}
+	t.Run("When records are deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(true)
+		mockCIS.EXPECT().DeleteDNSRecord("zone-id", gomock.Any()).Return(nil, nil, nil)
+		mockCIS.EXPECT().DeleteDNSRecord("zone-id", gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, errors.New("not found"))
+		g.Expect(clusterScope.DeleteDNSRecords(ctx)).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS.APIRecord).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS.IngressRecord).To(BeNil())
+	})
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
-		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+	t.Run("When DeleteDNSRecord returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(true)
+		mockCIS.EXPECT().DeleteDNSRecord("zone-id", gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 500}, errors.New("failed to delete record"))
+		g.Expect(clusterScope.DeleteDNSRecords(ctx)).ToNot(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS.APIRecord).ToNot(BeNil())
 	})
 }
 
-func TestCreateVPCSecurityGroupRulesAndSetStatus(t *testing.T) {
+func TestReconcileVPEGateways(t *testing.T) {
 	var (
 		mockVPC  *mock.MockVpc
 		mockCtrl *gomock.Controller
@@ -9516,81 +10939,106 @@ func TestCreateVPCSecurityGroupRulesAndSetStatus(t *testing.T) {
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When SecurityGroupRule is created successfully", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.0.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := PowerVSClusterScope{
+	powervsClusterScope := func() *PowerVSClusterScope {
+		return &PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi"},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("resourceGroupID"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("us-south")},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("rg-id")},
+					VPEGateways: []infrav1.VPEGateway{
+						{Service: ptr.To(infrav1.VPEGatewayServiceCOS)},
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{ID: ptr.To("vpc-id")},
+					VPCSubnet: map[string]infrav1.ResourceReference{
+						"subnet-1": {ID: ptr.To("subnet-id-1")},
+						"subnet-2": {ID: ptr.To("subnet-id-2")},
+					},
+					VPCSecurityGroups: map[string]infrav1.VPCSecurityGroupStatus{
+						"sg": {ID: ptr.To("sg-id")},
 					},
 				},
 			},
 		}
+	}
 
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
-		err := clusterScope.createVPCSecurityGroupRulesAndSetStatus(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"), ptr.To("securityGroupName"))
-		g.Expect(err).To(BeNil())
+	t.Run("When VPC is not reconciled", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.VPC = nil
+		requeue, err := clusterScope.ReconcileVPEGateways(ctx)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When CreateSecurityGroupRule returns error", func(t *testing.T) {
+
+	t.Run("When VPE gateway is created", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.0.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("resourceGroupID"),
-					},
-				},
-			},
+		clusterScope := powervsClusterScope()
+		mockVPC.EXPECT().GetEndpointGatewayByName("capi-vpe-cos").Return(nil, nil)
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-1")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, nil, nil)
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-2")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, nil, nil)
+		mockVPC.EXPECT().CreateEndpointGateway(gomock.Any()).DoAndReturn(func(options *vpcv1.CreateEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error) {
+			g.Expect(*options.Name).To(Equal("capi-vpe-cos"))
+			g.Expect(options.Ips).To(HaveLen(1))
+			g.Expect(options.SecurityGroups).To(HaveLen(1))
+			target := options.Target.(*vpcv1.EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypeProviderCloudServicePrototype)
+			g.Expect(*target.CRN).To(ContainSubstring("s3.direct.us-south"))
+			return &vpcv1.EndpointGateway{ID: ptr.To("vpe-id"), LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStatePendingConst)}, nil, nil
+		})
+		requeue, err := clusterScope.ReconcileVPEGateways(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPEGateways).To(HaveKeyWithValue("capi-vpe-cos", infrav1.ResourceReference{ID: ptr.To("vpe-id"), ControllerCreated: ptr.To(true)}))
+	})
+
+	t.Run("When VPE gateway exists in status and is stable", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.VPEGateways = map[string]infrav1.ResourceReference{
+			"capi-vpe-cos": {ID: ptr.To("vpe-id"), ControllerCreated: ptr.To(true)},
 		}
+		mockVPC.EXPECT().GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{ID: ptr.To("vpe-id")}).Return(&vpcv1.EndpointGateway{ID: ptr.To("vpe-id"), LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateStableConst)}, nil, nil)
+		requeue, err := clusterScope.ReconcileVPEGateways(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(requeue).To(BeFalse())
+	})
 
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to create securityGroupRules"))
-		err := clusterScope.createVPCSecurityGroupRulesAndSetStatus(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"), ptr.To("securityGroupName"))
-		g.Expect(err).ToNot(BeNil())
+	t.Run("When VPE gateway exists in cloud", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockVPC.EXPECT().GetEndpointGatewayByName("capi-vpe-cos").Return(&vpcv1.EndpointGateway{ID: ptr.To("vpe-id"), LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateStableConst)}, nil)
+		requeue, err := clusterScope.ReconcileVPEGateways(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPEGateways).To(HaveKeyWithValue("capi-vpe-cos", infrav1.ResourceReference{ID: ptr.To("vpe-id"), ControllerCreated: ptr.To(false)}))
+	})
+
+	t.Run("When VPE gateway is in failed state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.VPEGateways = map[string]infrav1.ResourceReference{
+			"capi-vpe-cos": {ID: ptr.To("vpe-id"), ControllerCreated: ptr.To(true)},
+		}
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{ID: ptr.To("vpe-id"), LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateFailedConst)}, nil, nil)
+		_, err := clusterScope.ReconcileVPEGateways(ctx)
+		g.Expect(err).To(HaveOccurred())
 	})
 }
 
-func TestCreateVPCSecurityGroup(t *testing.T) {
+func TestDeleteVPEGateways(t *testing.T) {
 	var (
 		mockVPC  *mock.MockVpc
 		mockCtrl *gomock.Controller
@@ -9603,50 +11051,64 @@ func TestCreateVPCSecurityGroup(t *testing.T) {
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When SecurityGroup is created successfully", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := PowerVSClusterScope{
+	powervsClusterScope := func(controllerCreated bool) *PowerVSClusterScope {
+		return &PowerVSClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("resourceGroupID"),
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPEGateways: map[string]infrav1.ResourceReference{
+						"capi-vpe-cos": {ID: ptr.To("vpe-id"), ControllerCreated: ptr.To(controllerCreated)},
 					},
 				},
 			},
 		}
+	}
 
-		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
-		sg, err := clusterScope.createVPCSecurityGroup(ctx, clusterScope.IBMPowerVSCluster.Spec.VPCSecurityGroups[0])
-		g.Expect(*sg).To(BeEquivalentTo("securityGroupID"))
-		g.Expect(err).To(BeNil())
+	t.Run("When VPE gateway is not created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(false)
+		requeue, err := clusterScope.DeleteVPEGateways(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPEGateways).To(BeEmpty())
 	})
-	t.Run("When SecurityGroup returns error", func(t *testing.T) {
+
+	t.Run("When VPE gateway deletion is started", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := PowerVSClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("resourceGroupID"),
-					},
-				},
-			},
-		}
+		clusterScope := powervsClusterScope(true)
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{ID: ptr.To("vpe-id"), LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateStableConst)}, nil, nil)
+		mockVPC.EXPECT().DeleteEndpointGateway(&vpcv1.DeleteEndpointGatewayOptions{ID: ptr.To("vpe-id")}).Return(nil, nil)
+		requeue, err := clusterScope.DeleteVPEGateways(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPEGateways).To(HaveKey("capi-vpe-cos"))
+	})
 
-		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to create SecurityGroup"))
-		sg, err := clusterScope.createVPCSecurityGroup(ctx, clusterScope.IBMPowerVSCluster.Spec.VPCSecurityGroups[0])
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+	t.Run("When VPE gateway is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(true)
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, errors.New("not found"))
+		requeue, err := clusterScope.DeleteVPEGateways(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPEGateways).To(BeEmpty())
+	})
+
+	t.Run("When DeleteEndpointGateway returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(true)
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{ID: ptr.To("vpe-id"), LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateStableConst)}, nil, nil)
+		mockVPC.EXPECT().DeleteEndpointGateway(gomock.Any()).Return(nil, errors.New("failed to delete VPE gateway"))
+		_, err := clusterScope.DeleteVPEGateways(ctx)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPEGateways).To(HaveKey("capi-vpe-cos"))
 	})
 }
@@ -50,6 +50,8 @@ type PowerVSImageScopeParams struct {
 	IBMPowerVSImage *infrav1.IBMPowerVSImage
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Zone            *string
+	// IdentityRef is the identityRef of the cluster of the image, the credentials of the manager are used when nil.
+	IdentityRef *infrav1.IBMCloudIdentityReference
 }
 
 // PowerVSImageScope defines a scope defined around a Power VS Cluster.
@@ -77,8 +79,20 @@ func NewPowerVSImageScope(ctx context.Context, params PowerVSImageScopeParams) (
 	}
 	scope.IBMPowerVSImage = params.IBMPowerVSImage
 
+	var identityAuthenticator core.Authenticator
+	if params.IdentityRef != nil {
+		identityAuthenticator, err = GetAuthenticator(ctx, params.Client, params.IBMPowerVSImage.Namespace, params.IdentityRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create authenticator: %w", err)
+		}
+	}
+
 	// Create Resource Controller client.
-	var serviceOption resourcecontroller.ServiceOptions
+	serviceOption := resourcecontroller.ServiceOptions{
+		ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
+			Authenticator: identityAuthenticator,
+		},
+	}
 	// Fetch the resource controller endpoint.
 	rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), params.ServiceEndpoint)
 	if rcEndpoint != "" {
@@ -127,8 +141,9 @@ func NewPowerVSImageScope(ctx context.Context, params PowerVSImageScopeParams) (
 
 	options := powervs.ServiceOptions{
 		IBMPIOptions: &ibmpisession.IBMPIOptions{
-			Debug: log.V(DEBUGLEVEL).Enabled(),
-			Zone:  *res.RegionID,
+			Authenticator: identityAuthenticator,
+			Debug:         log.V(DEBUGLEVEL).Enabled(),
+			Zone:          *res.RegionID,
 		},
 	}
 
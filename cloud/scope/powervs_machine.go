@@ -18,16 +18,24 @@ package scope
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"math"
+	"net/http"
 	"net/url"
 	"path"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/blang/semver/v4"
 	ignV3Types "github.com/coreos/ignition/v2/config/v3_4/types"
@@ -41,6 +49,7 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	corev1 "k8s.io/api/core/v1"
@@ -72,6 +81,20 @@ import (
 
 const cosURLDomain = "cloud-object-storage.appdomain.cloud"
 
+const (
+	// diagnosticsKeyPrefix is the prefix of the keys of the diagnostics objects in the COS bucket.
+	diagnosticsKeyPrefix = "diagnostics"
+	// diagnosticsEventsWindow is how far back the Power VS events of an instance are looked up when its creation date is unknown.
+	diagnosticsEventsWindow = 24 * time.Hour
+	// maxDiagnosticsEvents, maxDiagnosticsEventLength and maxDiagnosticsFaultLength bound the diagnostics kept in the status of a machine.
+	maxDiagnosticsEvents      = 10
+	maxDiagnosticsEventLength = 256
+	maxDiagnosticsFaultLength = 1024
+)
+
+// ErrInstancePreflightFailed is returned when the zone or the service instance cannot accommodate the instance of the machine.
+var ErrInstancePreflightFailed = errors.New("instance preflight failed")
+
 // PowerVSMachineScopeParams defines the input parameters used to create a new PowerVSMachineScope.
 type PowerVSMachineScopeParams struct {
 	Logger            logr.Logger
@@ -99,6 +122,9 @@ type PowerVSMachineScope struct {
 	IBMPowerVSImage   *infrav1.IBMPowerVSImage
 	ServiceEndpoint   []endpoints.ServiceEndpoint
 	DHCPIPCacheStore  cache.Store
+
+	// identityAuthenticator authenticates with the identity of the cluster, nil when the manager credentials are used.
+	identityAuthenticator core.Authenticator
 }
 
 // NewPowerVSMachineScope creates a new PowerVSMachineScope from the supplied parameters.
@@ -139,8 +165,19 @@ func NewPowerVSMachineScope(params PowerVSMachineScopeParams) (scope *PowerVSMac
 		core.SetLoggingLevel(core.LevelDebug)
 	}
 
+	if params.IBMPowerVSCluster.Spec.IdentityRef != nil {
+		scope.identityAuthenticator, err = GetAuthenticator(context.TODO(), params.Client, params.IBMPowerVSCluster.Namespace, params.IBMPowerVSCluster.Spec.IdentityRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create authenticator: %w", err)
+		}
+	}
+
 	// Create Resource Controller client.
-	var serviceOption resourcecontroller.ServiceOptions
+	serviceOption := resourcecontroller.ServiceOptions{
+		ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
+			Authenticator: scope.identityAuthenticator,
+		},
+	}
 	// Fetch the resource controller endpoint.
 	rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), params.ServiceEndpoint)
 	if rcEndpoint != "" {
@@ -184,8 +221,9 @@ func NewPowerVSMachineScope(params PowerVSMachineScopeParams) (scope *PowerVSMac
 
 	serviceOptions := powervs.ServiceOptions{
 		IBMPIOptions: &ibmpisession.IBMPIOptions{
-			Debug: params.Logger.V(DEBUGLEVEL).Enabled(),
-			Zone:  *serviceInstance.RegionID,
+			Authenticator: scope.identityAuthenticator,
+			Debug:         params.Logger.V(DEBUGLEVEL).Enabled(),
+			Zone:          *serviceInstance.RegionID,
 		},
 		CloudInstanceID: serviceInstanceID,
 	}
@@ -216,7 +254,7 @@ func NewPowerVSMachineScope(params PowerVSMachineScopeParams) (scope *PowerVSMac
 		vpcRegion = *params.IBMPowerVSCluster.Spec.VPC.Region
 	}
 	svcEndpoint := endpoints.FetchVPCEndpoint(vpcRegion, params.ServiceEndpoint)
-	vpcClient, err := vpc.NewService(svcEndpoint)
+	vpcClient, err := vpc.NewService(svcEndpoint, scope.identityAuthenticator)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create IBM VPC client: %w", err)
 	}
@@ -270,15 +308,9 @@ func (m *PowerVSMachineScope) CreateMachine(ctx context.Context) (*models.PVMIns
 
 	memory := float64(machineSpec.MemoryGiB)
 
-	var processors float64
-	switch machineSpec.Processors.Type {
-	case intstr.Int:
-		processors = float64(machineSpec.Processors.IntVal)
-	case intstr.String:
-		processors, err = strconv.ParseFloat(machineSpec.Processors.StrVal, 64)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert Processors(%s) to float64", machineSpec.Processors.StrVal)
-		}
+	processors, err := getProcessors(machineSpec.Processors)
+	if err != nil {
+		return nil, err
 	}
 
 	var imageID *string
@@ -292,31 +324,19 @@ func (m *PowerVSMachineScope) CreateMachine(ctx context.Context) (*models.PVMIns
 		}
 		log.V(3).Info("Retrieved image id", "imageID", *imageID)
 	}
-	network := machineSpec.Network
-	if network.ID == nil && network.Name == nil && network.RegEx == nil {
-		// if the network is nil, Fetch from cluster.
-		if m.IBMPowerVSCluster.Status.Network != nil && m.IBMPowerVSCluster.Status.Network.ID != nil {
-			network.ID = m.IBMPowerVSCluster.Status.Network.ID
-		}
-	}
-
-	networkID, err := getNetworkID(network, m)
+	networks, err := getInstanceNetworks(networkAttachments(machineSpec), m.IBMPowerVSCluster, m)
 	if err != nil {
 		record.Warnf(m.IBMPowerVSMachine, "FailedRetrieveNetwork", "Failed network retrieval - %v", err)
 		return nil, fmt.Errorf("error getting network ID: %v", err)
 	}
-	log.V(3).Info("Retrieved network id", "networkID", *networkID)
+	log.V(3).Info("Retrieved network id", "networkID", *networks[0].NetworkID, "networks", len(networks))
 
 	procType := strings.ToLower(string(machineSpec.ProcessorType))
 
 	params := &p_cloud_p_vm_instances.PcloudPvminstancesPostParams{
 		Body: &models.PVMInstanceCreate{
-			ImageID: imageID,
-			Networks: []*models.PVMInstanceAddNetwork{
-				{
-					NetworkID: networkID,
-				},
-			},
+			ImageID:    imageID,
+			Networks:   networks,
 			ServerName: &m.IBMPowerVSMachine.Name,
 			Memory:     &memory,
 			Processors: &processors,
@@ -328,8 +348,31 @@ func (m *PowerVSMachineScope) CreateMachine(ctx context.Context) (*models.PVMIns
 	if machineSpec.SSHKey != "" {
 		params.Body.KeyPairName = machineSpec.SSHKey
 	}
+	if machineSpec.PlacementGroup != nil {
+		placementGroupID, err := getPlacementGroupID(machineSpec.PlacementGroup, m.IBMPowerVSCluster, m)
+		if err != nil {
+			record.Warnf(m.IBMPowerVSMachine, "FailedRetrievePlacementGroup", "Failed placement group retrieval - %v", err)
+			return nil, fmt.Errorf("error getting placement group ID: %w", err)
+		}
+		log.V(3).Info("Retrieved placement group id", "placementGroupID", *placementGroupID)
+		params.Body.PlacementGroup = *placementGroupID
+	}
+	if machineSpec.SharedProcessorPool != nil {
+		sharedProcessorPoolID, err := getSharedProcessorPoolID(*machineSpec.SharedProcessorPool, m)
+		if err != nil {
+			record.Warnf(m.IBMPowerVSMachine, "FailedRetrieveSharedProcessorPool", "Failed shared processor pool retrieval - %v", err)
+			return nil, fmt.Errorf("error getting shared processor pool ID: %w", err)
+		}
+		log.V(3).Info("Retrieved shared processor pool id", "sharedProcessorPoolID", *sharedProcessorPoolID)
+		params.Body.SharedProcessorPool = *sharedProcessorPoolID
+	}
+	setInstancePlacement(params.Body, machineSpec)
+	if err := m.preflightInstance(params.Body); err != nil {
+		record.Warnf(m.IBMPowerVSMachine, "FailedInstancePreflight", "Failed instance preflight - %v", err)
+		return nil, err
+	}
 	log.V(3).Info("Creating PowerVS instance", "params", params)
-	_, err = m.IBMPowerVSClient.CreateInstance(params.Body)
+	_, err = createInstance(m.IBMPowerVSClient, params.Body, machineSpec.Profile)
 	if err != nil {
 		record.Warnf(m.IBMPowerVSMachine, "FailedCreateInstance", "Failed instance creation - %v", err)
 		return nil, err
@@ -338,6 +381,79 @@ func (m *PowerVSMachineScope) CreateMachine(ctx context.Context) (*models.PVMIns
 	return nil, nil
 }
 
+// getProcessors returns the number of processors of a machine.
+func getProcessors(processors intstr.IntOrString) (float64, error) {
+	switch processors.Type {
+	case intstr.Int:
+		return float64(processors.IntVal), nil
+	case intstr.String:
+		value, err := strconv.ParseFloat(processors.StrVal, 64)
+		if err != nil {
+			return 0, fmt.Errorf("failed to convert Processors(%s) to float64", processors.StrVal)
+		}
+		return value, nil
+	}
+	return 0, nil
+}
+
+// resizeRequestTimeout is how long a requested resize is waited for before it is requested again,
+// when the instance neither starts resizing nor reports the requested processors and memory.
+const resizeRequestTimeout = 10 * time.Minute
+
+// ReconcileResize resizes the processors and memory of the running instance in place when they differ from the machine.
+// The requested resize is recorded in the status of the machine, so it is not requested again while Power VS applies it.
+// It returns true while the instance is being resized.
+func (m *PowerVSMachineScope) ReconcileResize(ctx context.Context, instance *models.PVMInstance) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if infrav1.PowerVSInstanceState(ptr.Deref(instance.Status, "")) == infrav1.PowerVSInstanceStateRESIZE {
+		log.V(3).Info("PowerVS instance is being resized", "instanceID", *instance.PvmInstanceID)
+		return true, nil
+	}
+
+	processors, err := getProcessors(m.IBMPowerVSMachine.Spec.Processors)
+	if err != nil {
+		return false, err
+	}
+	memory := float64(m.IBMPowerVSMachine.Spec.MemoryGiB)
+
+	update := &models.PVMInstanceUpdate{}
+	if processors != 0 && processors != ptr.Deref(instance.Processors, 0) {
+		if instance.Maxproc != 0 && processors > instance.Maxproc {
+			return false, fmt.Errorf("processors %v exceed the maximum processors %v the instance can be resized to in place", processors, instance.Maxproc)
+		}
+		update.Processors = processors
+	}
+	if memory != 0 && memory != ptr.Deref(instance.Memory, 0) {
+		if instance.Maxmem != 0 && memory > instance.Maxmem {
+			return false, fmt.Errorf("memory %vGiB exceeds the maximum memory %vGiB the instance can be resized to in place", memory, instance.Maxmem)
+		}
+		update.Memory = memory
+	}
+	if update.Processors == 0 && update.Memory == 0 {
+		m.IBMPowerVSMachine.Status.Resize = nil
+		return false, nil
+	}
+
+	if resize := m.IBMPowerVSMachine.Status.Resize; resize != nil && resize.Processors == m.IBMPowerVSMachine.Spec.Processors &&
+		resize.MemoryGiB == m.IBMPowerVSMachine.Spec.MemoryGiB && time.Since(resize.RequestedAt.Time) < resizeRequestTimeout {
+		log.V(3).Info("Waiting for the requested resize of the PowerVS instance", "instanceID", *instance.PvmInstanceID, "requestedAt", resize.RequestedAt)
+		return true, nil
+	}
+
+	log.Info("Resizing PowerVS instance", "instanceID", *instance.PvmInstanceID, "processors", processors, "memoryGiB", memory)
+	if _, err := m.IBMPowerVSClient.UpdateInstance(*instance.PvmInstanceID, update); err != nil {
+		record.Warnf(m.IBMPowerVSMachine, "FailedResizeInstance", "Failed instance resize - %v", err)
+		return false, err
+	}
+	m.IBMPowerVSMachine.Status.Resize = &infrav1.PowerVSInstanceResize{
+		Processors:  m.IBMPowerVSMachine.Spec.Processors,
+		MemoryGiB:   m.IBMPowerVSMachine.Spec.MemoryGiB,
+		RequestedAt: metav1.Now(),
+	}
+	record.Eventf(m.IBMPowerVSMachine, "SuccessfulResizeInstance", "Resizing Instance %q to %v processors and %vGiB memory", m.IBMPowerVSMachine.Name, processors, memory)
+	return true, nil
+}
+
 func (m *PowerVSMachineScope) resolveUserData(ctx context.Context) (string, error) {
 	userData, err := m.GetRawBootstrapData()
 	if err != nil {
@@ -430,25 +546,41 @@ func (m *PowerVSMachineScope) createIgnitionData(ctx context.Context, data []byt
 	return objectURL.String(), nil
 }
 
-func (m *PowerVSMachineScope) ignitionUserData(ctx context.Context, userData []byte) ([]byte, error) {
-	objectURL, err := m.createIgnitionData(ctx, userData)
+// bearerToken returns the IAM bearer token the instance uses to fetch its ignition from COS.
+func (m *PowerVSMachineScope) bearerToken() (string, error) {
+	auth := m.identityAuthenticator
+	if auth == nil {
+		var err error
+		auth, err = authenticator.GetAuthenticator()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "/", http.NoBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create user data object %w", err)
+		return "", err
 	}
+	if err := auth.Authenticate(req); err != nil {
+		return "", err
+	}
+	token := req.Header.Get("Authorization")
+	if token == "" {
+		return "", fmt.Errorf("IAM token is empty")
+	}
+	return token, nil
+}
 
-	auth, err := authenticator.GetIAMAuthenticator()
+func (m *PowerVSMachineScope) ignitionUserData(ctx context.Context, userData []byte) ([]byte, error) {
+	objectURL, err := m.createIgnitionData(ctx, userData)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to create user data object %w", err)
 	}
 
-	iamtoken, err := auth.GetToken()
+	token, err := m.bearerToken()
 	if err != nil {
 		return nil, err
 	}
-	if iamtoken == "" {
-		return nil, fmt.Errorf("IAM token is empty")
-	}
-	token := "Bearer " + iamtoken
 
 	ignVersion := getIgnitionVersion(m)
 	semver, err := semver.ParseTolerant(ignVersion)
@@ -513,6 +645,338 @@ func (m *PowerVSMachineScope) DeleteMachine() error {
 	return nil
 }
 
+// ReconcileAdditionalVolumes creates the additional volumes of the machine and attaches them to its instance.
+// It returns true if the volumes are not yet all attached.
+func (m *PowerVSMachineScope) ReconcileAdditionalVolumes(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	instanceID := m.GetInstanceID()
+	requeue := false
+	for _, additionalVolume := range m.IBMPowerVSMachine.Spec.AdditionalVolumes {
+		volumeStatus := m.GetAdditionalVolumeStatus(additionalVolume.Name)
+		if volumeStatus == nil {
+			volumeID, err := m.findOrCreateAdditionalVolume(ctx, additionalVolume)
+			if err != nil {
+				return false, err
+			}
+			volumeStatus = &infrav1.PowerVSVolumeStatus{
+				Name:         additionalVolume.Name,
+				ID:           *volumeID,
+				DeletePolicy: additionalVolume.DeletePolicy,
+			}
+		}
+
+		volume, err := m.IBMPowerVSClient.GetVolume(volumeStatus.ID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get volume %s: %w", volumeStatus.ID, err)
+		}
+		volumeStatus.State = infrav1.PowerVSVolumeState(volume.State)
+		volumeStatus.Attached = slices.Contains(volume.PvmInstanceIDs, instanceID)
+		m.SetAdditionalVolumeStatus(*volumeStatus)
+
+		switch {
+		case volumeStatus.Attached:
+			continue
+		case volumeStatus.State == infrav1.PowerVSVolumeStateError:
+			return false, fmt.Errorf("volume %s is in %s state", volumeStatus.ID, volumeStatus.State)
+		case volumeStatus.State == infrav1.PowerVSVolumeStateAvailable:
+			log.Info("Attaching volume to PowerVS instance", "volumeID", volumeStatus.ID, "instanceID", instanceID)
+			if err := m.IBMPowerVSClient.AttachVolume(instanceID, volumeStatus.ID); err != nil {
+				record.Warnf(m.IBMPowerVSMachine, "FailedAttachVolume", "Failed volume %q attachment - %v", additionalVolume.Name, err)
+				return false, fmt.Errorf("failed to attach volume %s: %w", volumeStatus.ID, err)
+			}
+			record.Eventf(m.IBMPowerVSMachine, "SuccessfulAttachVolume", "Attached volume %q", additionalVolume.Name)
+		}
+		log.V(3).Info("Volume is not yet attached to PowerVS instance", "volumeID", volumeStatus.ID, "state", volumeStatus.State)
+		requeue = true
+	}
+	return requeue, nil
+}
+
+// findOrCreateAdditionalVolume returns the ID of the volume of an additional volume of the machine, creating it if it doesn't exist.
+func (m *PowerVSMachineScope) findOrCreateAdditionalVolume(ctx context.Context, additionalVolume infrav1.PowerVSAdditionalVolume) (*string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	volumeName := m.additionalVolumeName(additionalVolume.Name)
+	volumes, err := m.IBMPowerVSClient.GetAllVolume()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volumes: %w", err)
+	}
+	for _, volume := range volumes.Volumes {
+		if volume.Name != nil && *volume.Name == volumeName {
+			log.V(3).Info("Volume already exists", "volumeName", volumeName, "volumeID", volume.VolumeID)
+			return volume.VolumeID, nil
+		}
+	}
+
+	body := &models.CreateDataVolume{
+		Name:       &volumeName,
+		Size:       ptr.To(float64(additionalVolume.SizeGiB)),
+		DiskType:   additionalVolume.Tier,
+		VolumePool: additionalVolume.StoragePool,
+		Shareable:  ptr.To(additionalVolume.Shareable),
+	}
+	switch additionalVolume.AffinityPolicy {
+	case infrav1.PowerVSVolumeAffinityPolicyAffinity:
+		body.AffinityPolicy = ptr.To(string(additionalVolume.AffinityPolicy))
+		body.AffinityPVMInstance = ptr.To(m.GetInstanceID())
+	case infrav1.PowerVSVolumeAffinityPolicyAntiAffinity:
+		body.AffinityPolicy = ptr.To(string(additionalVolume.AffinityPolicy))
+		body.AntiAffinityPVMInstances = []string{m.GetInstanceID()}
+	}
+	log.Info("Creating volume", "volumeName", volumeName)
+	volume, err := m.IBMPowerVSClient.CreateVolume(body)
+	if err != nil {
+		record.Warnf(m.IBMPowerVSMachine, "FailedCreateVolume", "Failed volume %q creation - %v", additionalVolume.Name, err)
+		return nil, fmt.Errorf("failed to create volume %s: %w", volumeName, err)
+	}
+	record.Eventf(m.IBMPowerVSMachine, "SuccessfulCreateVolume", "Created volume %q", additionalVolume.Name)
+	return volume.VolumeID, nil
+}
+
+// DeleteAdditionalVolumes detaches and deletes the additional volumes of the machine, except the retained ones.
+// It returns true if the volumes are not yet all deleted.
+func (m *PowerVSMachineScope) DeleteAdditionalVolumes(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	instanceID := m.GetInstanceID()
+	requeue := false
+	var volumeStatuses []infrav1.PowerVSVolumeStatus
+	for _, volumeStatus := range m.IBMPowerVSMachine.Status.AdditionalVolumes {
+		if volumeStatus.DeletePolicy == string(infrav1.DeletePolicyRetain) {
+			log.V(3).Info("Skipping deletion of retained volume", "volumeID", volumeStatus.ID)
+			volumeStatuses = append(volumeStatuses, volumeStatus)
+			continue
+		}
+
+		volume, err := m.IBMPowerVSClient.GetVolume(volumeStatus.ID)
+		if err != nil {
+			if strings.Contains(err.Error(), string(VolumeNotFound)) {
+				log.Info("Volume successfully deleted", "volumeID", volumeStatus.ID)
+				continue
+			}
+			return false, fmt.Errorf("failed to get volume %s: %w", volumeStatus.ID, err)
+		}
+		volumeStatuses = append(volumeStatuses, volumeStatus)
+		requeue = true
+
+		if instanceID != "" && slices.Contains(volume.PvmInstanceIDs, instanceID) {
+			log.Info("Detaching volume from PowerVS instance", "volumeID", volumeStatus.ID, "instanceID", instanceID)
+			if err := m.IBMPowerVSClient.DetachVolume(instanceID, volumeStatus.ID); err != nil {
+				return false, fmt.Errorf("failed to detach volume %s: %w", volumeStatus.ID, err)
+			}
+			continue
+		}
+		if infrav1.PowerVSVolumeState(volume.State) == infrav1.PowerVSVolumeStateInUse {
+			log.V(3).Info("Volume is not yet detached", "volumeID", volumeStatus.ID)
+			continue
+		}
+		log.Info("Deleting volume", "volumeID", volumeStatus.ID)
+		if err := m.IBMPowerVSClient.DeleteVolume(volumeStatus.ID); err != nil {
+			record.Warnf(m.IBMPowerVSMachine, "FailedDeleteVolume", "Failed volume %q deletion - %v", volumeStatus.Name, err)
+			return false, fmt.Errorf("failed to delete volume %s: %w", volumeStatus.ID, err)
+		}
+		record.Eventf(m.IBMPowerVSMachine, "SuccessfulDeleteVolume", "Deleted volume %q", volumeStatus.Name)
+	}
+	m.IBMPowerVSMachine.Status.AdditionalVolumes = volumeStatuses
+	return requeue, nil
+}
+
+// additionalVolumeName returns the name of the volume of an additional volume of the machine.
+func (m *PowerVSMachineScope) additionalVolumeName(name string) string {
+	return fmt.Sprintf("%s-%s", m.IBMPowerVSMachine.Name, name)
+}
+
+// GetAdditionalVolumeStatus returns the status of an additional volume of the machine, nil if it was not created.
+func (m *PowerVSMachineScope) GetAdditionalVolumeStatus(name string) *infrav1.PowerVSVolumeStatus {
+	for _, volumeStatus := range m.IBMPowerVSMachine.Status.AdditionalVolumes {
+		if volumeStatus.Name == name {
+			return &volumeStatus
+		}
+	}
+	return nil
+}
+
+// SetAdditionalVolumeStatus sets the status of an additional volume of the machine.
+func (m *PowerVSMachineScope) SetAdditionalVolumeStatus(volumeStatus infrav1.PowerVSVolumeStatus) {
+	for i := range m.IBMPowerVSMachine.Status.AdditionalVolumes {
+		if m.IBMPowerVSMachine.Status.AdditionalVolumes[i].Name == volumeStatus.Name {
+			m.IBMPowerVSMachine.Status.AdditionalVolumes[i] = volumeStatus
+			return
+		}
+	}
+	m.IBMPowerVSMachine.Status.AdditionalVolumes = append(m.IBMPowerVSMachine.Status.AdditionalVolumes, volumeStatus)
+}
+
+// instanceDiagnostics are the full diagnostics of an instance uploaded to the COS bucket.
+type instanceDiagnostics struct {
+	Machine     string                    `json:"machine"`
+	InstanceID  string                    `json:"instanceID"`
+	State       string                    `json:"state"`
+	Trigger     string                    `json:"trigger"`
+	CollectedAt time.Time                 `json:"collectedAt"`
+	Fault       *models.PVMInstanceFault  `json:"fault,omitempty"`
+	Events      []*models.Event           `json:"events"`
+	Health      *models.PVMInstanceHealth `json:"health,omitempty"`
+}
+
+// DiagnosticsRequested returns true when the collection of the diagnostics of the instance is requested with the collect-diagnostics annotation.
+func (m *PowerVSMachineScope) DiagnosticsRequested() bool {
+	_, ok := m.IBMPowerVSMachine.Annotations[infrav1.CollectDiagnosticsAnnotation]
+	return ok
+}
+
+// ClearDiagnosticsRequest removes the collect-diagnostics annotation from the machine.
+func (m *PowerVSMachineScope) ClearDiagnosticsRequest() {
+	delete(m.IBMPowerVSMachine.Annotations, infrav1.CollectDiagnosticsAnnotation)
+}
+
+// HasDiagnostics returns true when the last diagnostics of the instance were collected for trigger,
+// or were requested while the instance was in the error state when trigger is InstanceError, so the error is not collected twice.
+func (m *PowerVSMachineScope) HasDiagnostics(trigger infrav1.PowerVSDiagnosticsTrigger) bool {
+	diagnostics := m.IBMPowerVSMachine.Status.Diagnostics
+	if diagnostics == nil {
+		return false
+	}
+	if diagnostics.Trigger == trigger {
+		return true
+	}
+	return trigger == infrav1.PowerVSDiagnosticsTriggerInstanceError && diagnostics.Trigger == infrav1.PowerVSDiagnosticsTriggerRequested &&
+		diagnostics.InstanceState == infrav1.PowerVSInstanceStateERROR
+}
+
+// CollectDiagnostics collects the fault and recent Power VS events of the instance.
+// A bounded summary is kept in the status of the machine and, when spec.diagnostics.uploadToCOS is set,
+// the full diagnostics are written to the COS bucket of the cluster.
+func (m *PowerVSMachineScope) CollectDiagnostics(ctx context.Context, instance *models.PVMInstance, trigger infrav1.PowerVSDiagnosticsTrigger) error {
+	instanceID := ptr.Deref(instance.PvmInstanceID, "")
+	collectedAt := metav1.Now()
+	diagnostics := &infrav1.PowerVSInstanceDiagnostics{
+		Trigger:       trigger,
+		CollectedAt:   collectedAt,
+		InstanceState: infrav1.PowerVSInstanceState(ptr.Deref(instance.Status, "")),
+	}
+	if instance.Fault != nil {
+		fault := slices.DeleteFunc([]string{instance.Fault.Message, instance.Fault.Details}, func(s string) bool { return s == "" })
+		diagnostics.Fault = truncate(strings.Join(fault, ": "), maxDiagnosticsFaultLength)
+	}
+
+	serviceInstanceID, err := m.GetServiceInstanceID()
+	if err != nil {
+		record.Warnf(m.IBMPowerVSMachine, "FailedCollectInstanceDiagnostics", "Failed instance diagnostics collection - %v", err)
+		return fmt.Errorf("failed to get service instance ID: %w", err)
+	}
+	fromTime := collectedAt.Add(-diagnosticsEventsWindow)
+	if creationDate := time.Time(instance.CreationDate); !creationDate.IsZero() {
+		fromTime = creationDate
+	}
+	events, err := m.IBMPowerVSClient.GetEvents(serviceInstanceID, fromTime)
+	if err != nil {
+		record.Warnf(m.IBMPowerVSMachine, "FailedCollectInstanceDiagnostics", "Failed instance diagnostics collection - %v", err)
+		return fmt.Errorf("failed to get Power VS events: %w", err)
+	}
+	instanceEvents := []*models.Event{}
+	for _, event := range events.Events {
+		if isInstanceEvent(event, instanceID, m.IBMPowerVSMachine.Name) {
+			instanceEvents = append(instanceEvents, event)
+		}
+	}
+	slices.SortStableFunc(instanceEvents, func(a, b *models.Event) int {
+		return cmp.Compare(ptr.Deref(a.Timestamp, 0), ptr.Deref(b.Timestamp, 0))
+	})
+	for _, event := range instanceEvents[max(0, len(instanceEvents)-maxDiagnosticsEvents):] {
+		summary := fmt.Sprintf("%s %s: %s", time.Unix(ptr.Deref(event.Timestamp, 0), 0).UTC().Format(time.RFC3339), ptr.Deref(event.Level, ""), ptr.Deref(event.Message, ""))
+		diagnostics.Events = append(diagnostics.Events, truncate(summary, maxDiagnosticsEventLength))
+	}
+
+	if m.IBMPowerVSMachine.Spec.Diagnostics != nil && m.IBMPowerVSMachine.Spec.Diagnostics.UploadToCOS {
+		full := instanceDiagnostics{
+			Machine:     m.IBMPowerVSMachine.Name,
+			InstanceID:  instanceID,
+			State:       ptr.Deref(instance.Status, ""),
+			Trigger:     string(trigger),
+			CollectedAt: collectedAt.UTC(),
+			Fault:       instance.Fault,
+			Events:      instanceEvents,
+			Health:      instance.Health,
+		}
+		key, err := m.uploadDiagnostics(ctx, full)
+		if err != nil {
+			record.Warnf(m.IBMPowerVSMachine, "FailedCollectInstanceDiagnostics", "Failed instance diagnostics upload - %v", err)
+			return err
+		}
+		diagnostics.ObjectKey = key
+	}
+
+	m.IBMPowerVSMachine.Status.Diagnostics = diagnostics
+	record.Eventf(m.IBMPowerVSMachine, "SuccessfulCollectInstanceDiagnostics", "Collected diagnostics of instance %q for %s", m.IBMPowerVSMachine.Name, trigger)
+	return nil
+}
+
+// uploadDiagnostics writes the diagnostics of the instance to the COS bucket and returns the key of the object.
+func (m *PowerVSMachineScope) uploadDiagnostics(ctx context.Context, diagnostics instanceDiagnostics) (string, error) {
+	data, err := json.MarshalIndent(diagnostics, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal diagnostics: %w", err)
+	}
+	cosClient, err := m.createCOSClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to create COS client %w", err)
+	}
+	key := path.Join(diagnosticsKeyPrefix, m.Name(), fmt.Sprintf("%s-%s.json", diagnostics.CollectedAt.Format("20060102T150405Z"), strings.ToLower(diagnostics.Trigger)))
+	if _, err := cosClient.PutObject(&s3.PutObjectInput{
+		Body:   aws.ReadSeekCloser(bytes.NewReader(data)),
+		Bucket: aws.String(m.bucketName()),
+		Key:    aws.String(key),
+	}); err != nil {
+		return "", fmt.Errorf("failed to push object to COS bucket %w", err)
+	}
+	return key, nil
+}
+
+// isInstanceEvent returns true when the Power VS event is about the instance with the ID or name.
+// The ID and name are matched as whole words of the message, so the events of machine-10 are not taken for those of machine-1.
+func isInstanceEvent(event *models.Event, instanceID, name string) bool {
+	message := ptr.Deref(event.Message, "")
+	if instanceID != "" && containsWord(message, instanceID) || containsWord(message, name) {
+		return true
+	}
+	if event.Metadata == nil || instanceID == "" {
+		return false
+	}
+	metadata, err := json.Marshal(event.Metadata)
+	return err == nil && strings.Contains(string(metadata), instanceID)
+}
+
+// containsWord returns true when word is in s and is neither preceded nor followed by a letter, digit, hyphen or underscore.
+func containsWord(s, word string) bool {
+	if word == "" {
+		return false
+	}
+	isWordRune := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_'
+	}
+	for offset := 0; offset < len(s); {
+		i := strings.Index(s[offset:], word)
+		if i < 0 {
+			return false
+		}
+		start, end := offset+i, offset+i+len(word)
+		before, _ := utf8.DecodeLastRuneInString(s[:start])
+		after, _ := utf8.DecodeRuneInString(s[end:])
+		if (start == 0 || !isWordRune(before)) && (end == len(s) || !isWordRune(after)) {
+			return true
+		}
+		offset = start + 1
+	}
+	return false
+}
+
+// truncate shortens s to at most n characters.
+func truncate(s string, n int) string {
+	if runes := []rune(s); len(runes) > n {
+		return string(runes[:n-3]) + "..."
+	}
+	return s
+}
+
 // DeleteMachineIgnition deletes the ignition associated with machine.
 func (m *PowerVSMachineScope) DeleteMachineIgnition(ctx context.Context) error {
 	log := ctrl.LoggerFrom(ctx)
@@ -535,6 +999,10 @@ func (m *PowerVSMachineScope) DeleteMachineIgnition(ctx context.Context) error {
 	})
 
 	for _, j := range objs.Contents {
+		// The diagnostics of the machine are kept for post-mortems.
+		if strings.HasPrefix(*j.Key, diagnosticsKeyPrefix+"/") {
+			continue
+		}
 		if strings.Contains(*j.Key, m.Name()) {
 			if _, err := cosClient.DeleteObject(&s3.DeleteObjectInput{
 				Bucket: aws.String(bucket),
@@ -572,13 +1040,9 @@ func (m *PowerVSMachineScope) createCOSClient(ctx context.Context) (cos.Cos, err
 		return nil, fmt.Errorf("COS service instance is not in active state, current state: %s", *serviceInstance.State)
 	}
 
-	props, err := authenticator.GetProperties()
+	apiKey, auth, err := cosCredentials(m.identityAuthenticator)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch service properties: %w", err)
-	}
-	apiKey := props["APIKEY"]
-	if apiKey == "" {
-		fmt.Printf("IBM Cloud API key is not provided, set %s environmental variable", "IBMCLOUD_API_KEY")
+		return nil, fmt.Errorf("failed to get COS credentials: %w", err)
 	}
 
 	region := m.bucketRegion()
@@ -601,6 +1065,7 @@ func (m *PowerVSMachineScope) createCOSClient(ctx context.Context) (cos.Cos, err
 				Region:   &region,
 			},
 		},
+		Authenticator: auth,
 	}
 
 	cosClient, err := cos.NewService(cosOptions, apiKey, *serviceInstance.GUID)
@@ -688,6 +1153,271 @@ func getNetworkID(network infrav1.IBMPowerVSResourceReference, m *PowerVSMachine
 	return nil, fmt.Errorf("ID, Name and RegEx can't be nil")
 }
 
+// networkAttachments returns a copy of the networks of a machine, or its single network when networks is not set.
+func networkAttachments(spec infrav1.IBMPowerVSMachineSpec) []infrav1.PowerVSNetworkAttachment {
+	if len(spec.Networks) == 0 {
+		return []infrav1.PowerVSNetworkAttachment{{IBMPowerVSResourceReference: spec.Network}}
+	}
+	return slices.Clone(spec.Networks)
+}
+
+// getInstanceNetworks returns the networks to attach to an instance from its network attachments, the primary network first.
+func getInstanceNetworks(attachments []infrav1.PowerVSNetworkAttachment, cluster *infrav1.IBMPowerVSCluster, m *PowerVSMachineScope) ([]*models.PVMInstanceAddNetwork, error) {
+	networks := make([]*models.PVMInstanceAddNetwork, 0, len(attachments))
+	for _, attachment := range attachments {
+		networkID, err := getNetworkID(clusterNetworkReference(attachment.IBMPowerVSResourceReference, cluster), m)
+		if err != nil {
+			return nil, err
+		}
+		networks = append(networks, &models.PVMInstanceAddNetwork{
+			NetworkID: networkID,
+			IPAddress: ptr.Deref(attachment.IPAddress, ""),
+		})
+	}
+	return networks, nil
+}
+
+// clusterNetworkReference returns the network reference, the network of the cluster if none of ID, Name and RegEx is set,
+// or the additional network of the cluster with Name.
+func clusterNetworkReference(network infrav1.IBMPowerVSResourceReference, cluster *infrav1.IBMPowerVSCluster) infrav1.IBMPowerVSResourceReference {
+	switch {
+	case network.ID == nil && network.Name == nil && network.RegEx == nil:
+		// if the network is nil, Fetch from cluster.
+		if cluster.Status.Network != nil && cluster.Status.Network.ID != nil {
+			network.ID = cluster.Status.Network.ID
+		}
+	case network.ID == nil && network.Name != nil:
+		// the Power VS network of a dhcp additional network is not named after it, so resolve it from the cluster.
+		if additionalNetwork, ok := cluster.Status.AdditionalNetworks[*network.Name]; ok && additionalNetwork.ID != nil {
+			network.ID = additionalNetwork.ID
+		}
+	}
+	return network
+}
+
+// getPlacementGroupID returns the ID of the placement group referenced by ID, by name, or by the policy of a placement group owned by the cluster.
+func getPlacementGroupID(placementGroup *infrav1.PowerVSPlacementGroupReference, cluster *infrav1.IBMPowerVSCluster, m *PowerVSMachineScope) (*string, error) {
+	switch {
+	case placementGroup.ID != nil:
+		return placementGroup.ID, nil
+	case placementGroup.Name != nil:
+		placementGroups, err := m.IBMPowerVSClient.GetAllPlacementGroups()
+		if err != nil {
+			return nil, err
+		}
+		for _, pg := range placementGroups.PlacementGroups {
+			if pg.Name != nil && *placementGroup.Name == *pg.Name {
+				return pg.ID, nil
+			}
+		}
+		return nil, fmt.Errorf("failed to find a placement group ID with name %s", *placementGroup.Name)
+	case placementGroup.Policy != nil:
+		if cluster == nil || !slices.ContainsFunc(cluster.Spec.PlacementGroups, func(pg infrav1.PowerVSPlacementGroup) bool { return pg.Policy == *placementGroup.Policy }) {
+			return nil, fmt.Errorf("no placement group with policy %s in the placementGroups of the IBMPowerVSCluster", *placementGroup.Policy)
+		}
+		if pg, ok := cluster.Status.PlacementGroups[string(*placementGroup.Policy)]; ok && pg.ID != nil {
+			return pg.ID, nil
+		}
+		return nil, fmt.Errorf("placement group with policy %s of the IBMPowerVSCluster is not yet created", *placementGroup.Policy)
+	}
+	return nil, fmt.Errorf("ID, Name and Policy can't be nil")
+}
+
+// getSharedProcessorPoolID returns the ID of the shared processor pool referenced by ID or name.
+func getSharedProcessorPoolID(sharedProcessorPool infrav1.IBMPowerVSResourceReference, m *PowerVSMachineScope) (*string, error) {
+	if sharedProcessorPool.ID != nil {
+		return sharedProcessorPool.ID, nil
+	} else if sharedProcessorPool.Name != nil {
+		sharedProcessorPools, err := m.IBMPowerVSClient.GetAllSharedProcessorPools()
+		if err != nil {
+			return nil, err
+		}
+		for _, pool := range sharedProcessorPools.SharedProcessorPools {
+			if pool.Name != nil && *sharedProcessorPool.Name == *pool.Name {
+				return pool.ID, nil
+			}
+		}
+		return nil, fmt.Errorf("failed to find a shared processor pool ID with name %s", *sharedProcessorPool.Name)
+	}
+	return nil, fmt.Errorf("both shared processor pool ID and Name can't be nil")
+}
+
+// preflightInstance checks that the system type, processors, memory and storage tiers of the instance are available
+// in the zone and that the service instance has the quota for it, so that an instance which cannot be created fails
+// with ErrInstancePreflightFailed instead of erroring on every create request.
+// The cores and memory of a SAP profile are checked by the SAP API when the instance is created.
+func (m *PowerVSMachineScope) preflightInstance(body *models.PVMInstanceCreate) error {
+	machineSpec := m.IBMPowerVSMachine.Spec
+
+	var processors, memory float64
+	if machineSpec.Profile == "" {
+		processors, memory = ptr.Deref(body.Processors, 0), ptr.Deref(body.Memory, 0)
+		if ptr.Deref(body.ProcType, "") == strings.ToLower(string(infrav1.PowerVSProcessorTypeDedicated)) {
+			processors = math.Ceil(processors)
+		}
+		systemPools, err := m.IBMPowerVSClient.GetSystemPools()
+		if err != nil {
+			return fmt.Errorf("failed to get system pools: %w", err)
+		}
+		if err := checkSystemPools(systemPools, body.SysType, processors, memory); err != nil {
+			return err
+		}
+	}
+
+	tiers := make([]string, 0, len(machineSpec.AdditionalVolumes)+1)
+	if body.StorageType != "" {
+		tiers = append(tiers, body.StorageType)
+	}
+	var storage float64
+	for _, volume := range machineSpec.AdditionalVolumes {
+		if volume.Tier != "" {
+			tiers = append(tiers, volume.Tier)
+		}
+		storage += float64(volume.SizeGiB)
+	}
+	if len(tiers) != 0 {
+		storageTiers, err := m.IBMPowerVSClient.GetStorageTiers()
+		if err != nil {
+			return fmt.Errorf("failed to get storage tiers: %w", err)
+		}
+		for _, tier := range tiers {
+			if !slices.ContainsFunc(storageTiers, func(t *models.StorageTier) bool {
+				return t != nil && t.Name == tier && ptr.Deref(t.State, "") == models.StorageTierStateActive
+			}) {
+				return fmt.Errorf("%w: storage tier %s is not available in the zone", ErrInstancePreflightFailed, tier)
+			}
+		}
+	}
+
+	serviceInstanceID, err := m.GetServiceInstanceID()
+	if err != nil {
+		return fmt.Errorf("failed to get service instance ID: %w", err)
+	}
+	cloudInstance, err := m.IBMPowerVSClient.GetCloudInstance(serviceInstanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get service instance %s: %w", serviceInstanceID, err)
+	}
+	if cloudInstance.Limits != nil && cloudInstance.Limits.Storage != nil {
+		// The boot volume of the instance is a copy of the image, so it takes the size of the image from the storage quota.
+		imageID := ptr.Deref(body.ImageID, "")
+		image, err := m.IBMPowerVSClient.GetImage(imageID)
+		if err != nil {
+			return fmt.Errorf("failed to get image %s: %w", imageID, err)
+		}
+		storage += ptr.Deref(image.Size, 0)
+	}
+	return checkCloudInstanceQuota(cloudInstance, processors, memory, storage)
+}
+
+// checkSystemPools checks that a host of the system type, or of any system type when it is not set, has the processors and memory available.
+func checkSystemPools(systemPools models.SystemPools, systemType string, processors, memory float64) error {
+	if systemType != "" {
+		pool, ok := systemPools[systemType]
+		if !ok {
+			return fmt.Errorf("%w: system type %s is not available in the zone, available system types: %s",
+				ErrInstancePreflightFailed, systemType, strings.Join(slices.Sorted(maps.Keys(systemPools)), ", "))
+		}
+		if !poolHasCapacity(pool, processors, memory) {
+			return fmt.Errorf("%w: no %s host has %v processors and %vGiB memory available", ErrInstancePreflightFailed, systemType, processors, memory)
+		}
+		return nil
+	}
+	for _, pool := range systemPools {
+		if poolHasCapacity(pool, processors, memory) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: no host has %v processors and %vGiB memory available", ErrInstancePreflightFailed, processors, memory)
+}
+
+// poolHasCapacity returns true when the host with the most cores or the host with the most memory available in the pool
+// has both the processors and memory available.
+func poolHasCapacity(pool models.SystemPool, processors, memory float64) bool {
+	for _, host := range []*models.System{pool.MaxCoresAvailable, pool.MaxMemoryAvailable} {
+		if host != nil && ptr.Deref(host.Cores, 0) >= processors && float64(ptr.Deref(host.Memory, 0)) >= memory {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCloudInstanceQuota checks that the service instance has the quota for one more instance with the processors, memory and storage,
+// where storage is the size of the boot volume and of the additional volumes of the instance.
+func checkCloudInstanceQuota(cloudInstance *models.CloudInstance, processors, memory, storage float64) error {
+	if cloudInstance.Limits == nil || cloudInstance.Usage == nil {
+		return nil
+	}
+	limits, usage := cloudInstance.Limits, cloudInstance.Usage
+	quotas := []struct {
+		name         string
+		limit, usage *float64
+		requested    float64
+	}{
+		{"instances", limits.Instances, usage.Instances, 1},
+		{"processor units", limits.ProcUnits, usage.ProcUnits, processors},
+		{"memory GiB", limits.Memory, usage.Memory, memory},
+		{"storage GiB", limits.Storage, usage.Storage, storage},
+	}
+	for _, quota := range quotas {
+		if quota.limit == nil || quota.requested == 0 {
+			continue
+		}
+		if available := *quota.limit - ptr.Deref(quota.usage, 0); quota.requested > available {
+			return fmt.Errorf("%w: the service instance has %v of %v %s available, %v requested",
+				ErrInstancePreflightFailed, available, *quota.limit, quota.name, quota.requested)
+		}
+	}
+	return nil
+}
+
+// createInstance creates the instance described by body. When profile is set, the instance is created with the SAP API,
+// once the profile is found to be available in the zone of the service instance.
+func createInstance(client powervs.PowerVS, body *models.PVMInstanceCreate, profile string) (*models.PVMInstanceList, error) {
+	if profile == "" {
+		return client.CreateInstance(body)
+	}
+	if _, err := client.GetSAPProfile(profile); err != nil {
+		return nil, fmt.Errorf("SAP profile %s is not available in the zone of the service instance: %w", profile, err)
+	}
+	return client.CreateSAPInstance(&models.SAPCreate{
+		ImageID:          body.ImageID,
+		Name:             body.ServerName,
+		Networks:         body.Networks,
+		ProfileID:        ptr.To(profile),
+		SSHKeyName:       body.KeyPairName,
+		UserData:         body.UserData,
+		PlacementGroup:   body.PlacementGroup,
+		DeploymentTarget: body.DeploymentTarget,
+		StorageType:      body.StorageType,
+		StoragePool:      body.StoragePool,
+		StorageAffinity:  body.StorageAffinity,
+	})
+}
+
+// setInstancePlacement sets the deployment target and the boot volume storage options of the spec on the instance create body.
+func setInstancePlacement(body *models.PVMInstanceCreate, spec infrav1.IBMPowerVSMachineSpec) {
+	if spec.DeploymentTarget != nil {
+		body.DeploymentTarget = &models.DeploymentTarget{
+			ID:   ptr.To(spec.DeploymentTarget.ID),
+			Type: ptr.To(string(spec.DeploymentTarget.Type)),
+		}
+	}
+	body.StorageType = spec.StorageType
+	body.StoragePool = spec.StoragePool
+	if spec.StorageAffinity == nil {
+		return
+	}
+	storageAffinity := &models.StorageAffinity{
+		AffinityPolicy: ptr.To(string(spec.StorageAffinity.Policy)),
+	}
+	if spec.StorageAffinity.Policy == infrav1.PowerVSVolumeAffinityPolicyAffinity {
+		storageAffinity.AffinityPVMInstance = ptr.To(spec.StorageAffinity.Instances[0])
+	} else {
+		storageAffinity.AntiAffinityPVMInstances = slices.Clone(spec.StorageAffinity.Instances)
+	}
+	body.StorageAffinity = storageAffinity
+}
+
 // GetNetworks will get list of networks for the powervs service instance.
 func (m *PowerVSMachineScope) GetNetworks() (*models.Networks, error) {
 	return m.IBMPowerVSClient.GetAllNetwork()
@@ -740,6 +1470,9 @@ func (m *PowerVSMachineScope) SetHealth(health *models.PVMInstanceHealth) {
 }
 
 // SetAddresses will set the addresses for the machine.
+// The address of the primary network comes first, whatever the order of the networks reported by the instance, and when it is not
+// reported by the instance it is fetched from the DHCP server of the network.
+// The primary network is found among the networks reported by the instance, so no network lookup is made when only another network lacks an address.
 func (m *PowerVSMachineScope) SetAddresses(ctx context.Context, instance *models.PVMInstance) { //nolint:gocyclo
 	log := ctrl.LoggerFrom(ctx)
 	var addresses []corev1.NodeAddress
@@ -752,7 +1485,17 @@ func (m *PowerVSMachineScope) SetAddresses(ctx context.Context, instance *models
 		Type:    corev1.NodeHostName,
 		Address: *instance.ServerName,
 	})
-	for _, network := range instance.Networks {
+	hostAddresses := len(addresses)
+	// Find the primary network among the networks attached to the VM
+	pvmNetwork, primaryNetworkErr := m.primaryInstanceNetwork(instance)
+	networks := instance.Networks
+	if pvmNetwork != nil {
+		networks = append([]*models.PVMInstanceNetwork{pvmNetwork}, slices.DeleteFunc(slices.Clone(instance.Networks), func(network *models.PVMInstanceNetwork) bool {
+			return network == pvmNetwork
+		})...)
+	}
+	missingIP := len(instance.Networks) == 0
+	for _, network := range networks {
 		if strings.TrimSpace(network.IPAddress) != "" {
 			addresses = append(addresses, corev1.NodeAddress{
 				Type:    corev1.NodeInternalIP,
@@ -765,13 +1508,30 @@ func (m *PowerVSMachineScope) SetAddresses(ctx context.Context, instance *models
 				Address: strings.TrimSpace(network.ExternalIP),
 			})
 		}
+		if strings.TrimSpace(network.IPAddress) == "" && strings.TrimSpace(network.ExternalIP) == "" {
+			missingIP = true
+		}
 	}
 	m.IBMPowerVSMachine.Status.Addresses = addresses
-	if len(addresses) > 2 {
-		// If the address length is more than 2 means either NodeInternalIP or NodeExternalIP is updated so return
+	if !missingIP {
+		// If every network of the instance has either NodeInternalIP or NodeExternalIP, the addresses are complete so return
+		return
+	}
+	// In this case an IP is missing under instance.Networks, So try to fetch the IP of the primary network from cache or DHCP server
+	if primaryNetworkErr != nil {
+		log.Error(primaryNetworkErr, "failed to find the primary network of the machine")
+		return
+	}
+	if pvmNetwork == nil {
+		log.V(3).Info("Failed to get primary network attached to machine")
+		return
+	}
+	if strings.TrimSpace(pvmNetwork.IPAddress) != "" {
+		// The primary network already has an IP, the missing IP belongs to another network
 		return
 	}
-	// In this case there is no IP found under instance.Networks, So try to fetch the IP from cache or DHCP server
+	networkID := pvmNetwork.NetworkID
+	log.V(3).Info("Found primary network attached to machine", "networkID", networkID)
 
 	// Look for DHCP IP from the cache
 	obj, exists, err := m.DHCPIPCacheStore.GetByKey(*instance.ServerName)
@@ -779,37 +1539,10 @@ func (m *PowerVSMachineScope) SetAddresses(ctx context.Context, instance *models
 		log.Error(err, "failed to fetch the DHCP IP address from cache store")
 	} else if exists {
 		log.V(3).Info("Found IP for machine from DHCP cache", "IP", obj.(powervs.VMip).IP)
-		addresses = append(addresses, corev1.NodeAddress{
+		m.IBMPowerVSMachine.Status.Addresses = slices.Insert(addresses, hostAddresses, corev1.NodeAddress{
 			Type:    corev1.NodeInternalIP,
 			Address: obj.(powervs.VMip).IP,
 		})
-		m.IBMPowerVSMachine.Status.Addresses = addresses
-		return
-	}
-	// Fetch the VM network ID
-	network := m.IBMPowerVSMachine.Spec.Network
-	if network.ID == nil && network.Name == nil && network.RegEx == nil {
-		// if the network is nil, Fetch from cluster.
-		if m.IBMPowerVSCluster.Status.Network != nil && m.IBMPowerVSCluster.Status.Network.ID != nil {
-			network.ID = m.IBMPowerVSCluster.Status.Network.ID
-		}
-	}
-	networkID, err := getNetworkID(network, m)
-	if err != nil {
-		log.Error(err, "failed to fetch network id from network resource")
-		return
-	}
-	log.V(3).Info("Retrieved network id", "networkID", *networkID)
-	// Fetch the details of the network attached to the VM
-	var pvmNetwork *models.PVMInstanceNetwork
-	for _, network := range instance.Networks {
-		if network.NetworkID == *networkID {
-			pvmNetwork = network
-			log.V(3).Info("Found network attached to machine", "networkID", network.NetworkID)
-		}
-	}
-	if pvmNetwork == nil {
-		log.V(3).Info("Failed to get network attached to machine", "networkID", *networkID)
 		return
 	}
 	// Get all the DHCP servers
@@ -825,8 +1558,8 @@ func (m *PowerVSMachineScope) SetAddresses(ctx context.Context, instance *models
 			log.V(3).Info("Skipping the DHCP server as its network details is nil", "dhcpServerID", *server.ID)
 			continue
 		}
-		if *server.Network.ID == *networkID {
-			log.V(3).Info("Found DHCP server for network", "dhcpServerID", *server.ID, "networkID", *networkID)
+		if *server.Network.ID == networkID {
+			log.V(3).Info("Found DHCP server for network", "dhcpServerID", *server.ID, "networkID", networkID)
 			dhcpServerDetails, err = m.IBMPowerVSClient.GetDHCPServer(*server.ID)
 			if err != nil {
 				log.Error(err, "failed to get DHCP server details", "dhcpServerID", *server.ID)
@@ -837,7 +1570,7 @@ func (m *PowerVSMachineScope) SetAddresses(ctx context.Context, instance *models
 	}
 	if dhcpServerDetails == nil {
 		errStr := fmt.Errorf("DHCP server details is nil")
-		log.Error(errStr, "DHCP server associated with network is nil", "networkID", *networkID)
+		log.Error(errStr, "DHCP server associated with network is nil", "networkID", networkID)
 		return
 	}
 
@@ -857,7 +1590,7 @@ func (m *PowerVSMachineScope) SetAddresses(ctx context.Context, instance *models
 		return
 	}
 	log.V(3).Info("Found internal IP for VM from DHCP lease", "IP", *internalIP)
-	addresses = append(addresses, corev1.NodeAddress{
+	addresses = slices.Insert(addresses, hostAddresses, corev1.NodeAddress{
 		Type:    corev1.NodeInternalIP,
 		Address: *internalIP,
 	})
@@ -872,6 +1605,33 @@ func (m *PowerVSMachineScope) SetAddresses(ctx context.Context, instance *models
 	m.IBMPowerVSMachine.Status.Addresses = addresses
 }
 
+// primaryInstanceNetwork returns the network of the instance matching the primary network of the machine, or nil if it is not attached.
+// The network reference is matched against the networks reported by the instance, so no lookup of the networks of the workspace is needed.
+func (m *PowerVSMachineScope) primaryInstanceNetwork(instance *models.PVMInstance) (*models.PVMInstanceNetwork, error) {
+	network := clusterNetworkReference(networkAttachments(m.IBMPowerVSMachine.Spec)[0].IBMPowerVSResourceReference, m.IBMPowerVSCluster)
+	var matches func(*models.PVMInstanceNetwork) bool
+	switch {
+	case network.ID != nil:
+		matches = func(nw *models.PVMInstanceNetwork) bool { return nw.NetworkID == *network.ID }
+	case network.Name != nil:
+		matches = func(nw *models.PVMInstanceNetwork) bool { return nw.NetworkName == *network.Name }
+	case network.RegEx != nil:
+		re, err := regexp.Compile(*network.RegEx)
+		if err != nil {
+			return nil, err
+		}
+		matches = func(nw *models.PVMInstanceNetwork) bool { return re.MatchString(nw.NetworkName) }
+	default:
+		return nil, fmt.Errorf("ID, Name and RegEx can't be nil")
+	}
+	for _, nw := range instance.Networks {
+		if matches(nw) {
+			return nw, nil
+		}
+	}
+	return nil, nil
+}
+
 // SetInstanceState will set the state for the machine.
 func (m *PowerVSMachineScope) SetInstanceState(status *string) {
 	m.IBMPowerVSMachine.Status.InstanceState = infrav1.PowerVSInstanceState(*status)
@@ -945,7 +1705,7 @@ func (m *PowerVSMachineScope) SetProviderID(instanceID string) error {
 	return nil
 }
 
-// GetMachineInternalIP returns the machine's internal IP.
+// GetMachineInternalIP returns the machine's internal IP, the IP of its primary network as SetAddresses lists it first.
 func (m *PowerVSMachineScope) GetMachineInternalIP() string {
 	for _, address := range m.IBMPowerVSMachine.Status.Addresses {
 		if address.Type == corev1.NodeInternalIP {
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strings"
 
 	"testing"
 	"time"
@@ -863,6 +864,601 @@ func TestGetNetworkID(t *testing.T) {
 	})
 }
 
+func TestGetInstanceNetworks(t *testing.T) {
+	var (
+		mockCtrl    *gomock.Controller
+		mockpowervs *mock.MockPowerVS
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	cluster := &infrav1.IBMPowerVSCluster{
+		Status: infrav1.IBMPowerVSClusterStatus{
+			Network: &infrav1.ResourceReference{ID: ptr.To("cluster-net-id")},
+		},
+	}
+
+	t.Run("Returns the network of the cluster when network is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		networks, err := getInstanceNetworks(networkAttachments(infrav1.IBMPowerVSMachineSpec{}), cluster, &PowerVSMachineScope{})
+		g.Expect(err).To(BeNil())
+		g.Expect(networks).To(Equal([]*models.PVMInstanceAddNetwork{{NetworkID: ptr.To("cluster-net-id")}}))
+	})
+	t.Run("Returns the networks with their fixed IP addresses, primary network first", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllNetwork().Return(&models.Networks{Networks: []*models.NetworkReference{
+			{Name: ptr.To("storage"), NetworkID: ptr.To("storage-net-id")},
+		}}, nil)
+		spec := infrav1.IBMPowerVSMachineSpec{
+			Networks: []infrav1.PowerVSNetworkAttachment{
+				{IPAddress: ptr.To("192.168.0.10")},
+				{IBMPowerVSResourceReference: infrav1.IBMPowerVSResourceReference{Name: ptr.To("storage")}, IPAddress: ptr.To("10.0.0.10")},
+			},
+		}
+		networks, err := getInstanceNetworks(networkAttachments(spec), cluster, &PowerVSMachineScope{IBMPowerVSClient: mockpowervs})
+		g.Expect(err).To(BeNil())
+		g.Expect(networks).To(Equal([]*models.PVMInstanceAddNetwork{
+			{NetworkID: ptr.To("cluster-net-id"), IPAddress: "192.168.0.10"},
+			{NetworkID: ptr.To("storage-net-id"), IPAddress: "10.0.0.10"},
+		}))
+	})
+	t.Run("Returns the additional networks of the cluster by name", func(t *testing.T) {
+		g := NewWithT(t)
+		cluster := cluster.DeepCopy()
+		cluster.Status.AdditionalNetworks = map[string]infrav1.PowerVSNetworkStatus{
+			"workload": {ID: ptr.To("workload-net-id"), DHCPServerID: ptr.To("dhcp-id")},
+		}
+		spec := infrav1.IBMPowerVSMachineSpec{
+			Networks: []infrav1.PowerVSNetworkAttachment{
+				{IBMPowerVSResourceReference: infrav1.IBMPowerVSResourceReference{Name: ptr.To("workload")}},
+				{},
+			},
+		}
+		networks, err := getInstanceNetworks(networkAttachments(spec), cluster, &PowerVSMachineScope{})
+		g.Expect(err).To(BeNil())
+		g.Expect(networks).To(Equal([]*models.PVMInstanceAddNetwork{
+			{NetworkID: ptr.To("workload-net-id")},
+			{NetworkID: ptr.To("cluster-net-id")},
+		}))
+	})
+	t.Run("Failed to find a network", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllNetwork().Return(&models.Networks{}, nil)
+		spec := infrav1.IBMPowerVSMachineSpec{
+			Networks: []infrav1.PowerVSNetworkAttachment{
+				{},
+				{IBMPowerVSResourceReference: infrav1.IBMPowerVSResourceReference{Name: ptr.To("storage")}},
+			},
+		}
+		networks, err := getInstanceNetworks(networkAttachments(spec), cluster, &PowerVSMachineScope{IBMPowerVSClient: mockpowervs})
+		g.Expect(networks).To(BeNil())
+		g.Expect(err.Error()).To(Equal("failed to find a network ID with name storage"))
+	})
+}
+
+func TestGetPlacementGroupID(t *testing.T) {
+	var (
+		mockCtrl    *gomock.Controller
+		mockpowervs *mock.MockPowerVS
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	antiAffinity := infrav1.PowerVSPlacementGroupPolicyAntiAffinity
+	cluster := &infrav1.IBMPowerVSCluster{
+		Spec: infrav1.IBMPowerVSClusterSpec{
+			PlacementGroups: []infrav1.PowerVSPlacementGroup{{Policy: antiAffinity}},
+		},
+	}
+
+	t.Run("Returns placement group ID from spec's ID", func(t *testing.T) {
+		g := NewWithT(t)
+		placementGroupID, err := getPlacementGroupID(&infrav1.PowerVSPlacementGroupReference{ID: ptr.To("pg-id")}, cluster, &PowerVSMachineScope{})
+		g.Expect(err).To(BeNil())
+		g.Expect(*placementGroupID).To(Equal("pg-id"))
+	})
+	t.Run("Returns placement group ID by name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllPlacementGroups().Return(&models.PlacementGroups{PlacementGroups: []*models.PlacementGroup{
+			{ID: ptr.To("other-id"), Name: ptr.To("other")},
+			{ID: ptr.To("pg-id"), Name: ptr.To("control-plane")},
+		}}, nil)
+		placementGroupID, err := getPlacementGroupID(&infrav1.PowerVSPlacementGroupReference{Name: ptr.To("control-plane")}, cluster, &PowerVSMachineScope{IBMPowerVSClient: mockpowervs})
+		g.Expect(err).To(BeNil())
+		g.Expect(*placementGroupID).To(Equal("pg-id"))
+	})
+	t.Run("Failed to find placement group by name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllPlacementGroups().Return(&models.PlacementGroups{}, nil)
+		placementGroupID, err := getPlacementGroupID(&infrav1.PowerVSPlacementGroupReference{Name: ptr.To("control-plane")}, cluster, &PowerVSMachineScope{IBMPowerVSClient: mockpowervs})
+		g.Expect(placementGroupID).To(BeNil())
+		g.Expect(err.Error()).To(Equal("failed to find a placement group ID with name control-plane"))
+	})
+	t.Run("Returns placement group ID of the cluster by policy", func(t *testing.T) {
+		g := NewWithT(t)
+		cluster := cluster.DeepCopy()
+		cluster.Status.PlacementGroups = map[string]infrav1.ResourceReference{
+			"anti-affinity": {ID: ptr.To("pg-id"), ControllerCreated: ptr.To(true)},
+		}
+		placementGroupID, err := getPlacementGroupID(&infrav1.PowerVSPlacementGroupReference{Policy: &antiAffinity}, cluster, &PowerVSMachineScope{})
+		g.Expect(err).To(BeNil())
+		g.Expect(*placementGroupID).To(Equal("pg-id"))
+	})
+	t.Run("When the placement group of the cluster is not yet created", func(t *testing.T) {
+		g := NewWithT(t)
+		placementGroupID, err := getPlacementGroupID(&infrav1.PowerVSPlacementGroupReference{Policy: &antiAffinity}, cluster, &PowerVSMachineScope{})
+		g.Expect(placementGroupID).To(BeNil())
+		g.Expect(err.Error()).To(Equal("placement group with policy anti-affinity of the IBMPowerVSCluster is not yet created"))
+	})
+	t.Run("When the cluster has no placement group with the policy", func(t *testing.T) {
+		g := NewWithT(t)
+		affinity := infrav1.PowerVSPlacementGroupPolicyAffinity
+		placementGroupID, err := getPlacementGroupID(&infrav1.PowerVSPlacementGroupReference{Policy: &affinity}, cluster, &PowerVSMachineScope{})
+		g.Expect(placementGroupID).To(BeNil())
+		g.Expect(err.Error()).To(Equal("no placement group with policy affinity in the placementGroups of the IBMPowerVSCluster"))
+	})
+}
+
+func TestGetSharedProcessorPoolID(t *testing.T) {
+	var (
+		mockCtrl    *gomock.Controller
+		mockpowervs *mock.MockPowerVS
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Returns shared processor pool ID from spec's ID", func(t *testing.T) {
+		g := NewWithT(t)
+		sharedProcessorPoolID, err := getSharedProcessorPoolID(infrav1.IBMPowerVSResourceReference{ID: ptr.To("spp-id")}, &PowerVSMachineScope{})
+		g.Expect(err).To(BeNil())
+		g.Expect(*sharedProcessorPoolID).To(Equal("spp-id"))
+	})
+	t.Run("Returns shared processor pool ID by name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllSharedProcessorPools().Return(&models.SharedProcessorPools{SharedProcessorPools: []*models.SharedProcessorPool{
+			{ID: ptr.To("other-id"), Name: ptr.To("other")},
+			{ID: ptr.To("spp-id"), Name: ptr.To("workers")},
+		}}, nil)
+		sharedProcessorPoolID, err := getSharedProcessorPoolID(infrav1.IBMPowerVSResourceReference{Name: ptr.To("workers")}, &PowerVSMachineScope{IBMPowerVSClient: mockpowervs})
+		g.Expect(err).To(BeNil())
+		g.Expect(*sharedProcessorPoolID).To(Equal("spp-id"))
+	})
+	t.Run("Failed to find shared processor pool by name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllSharedProcessorPools().Return(&models.SharedProcessorPools{}, nil)
+		sharedProcessorPoolID, err := getSharedProcessorPoolID(infrav1.IBMPowerVSResourceReference{Name: ptr.To("workers")}, &PowerVSMachineScope{IBMPowerVSClient: mockpowervs})
+		g.Expect(sharedProcessorPoolID).To(BeNil())
+		g.Expect(err.Error()).To(Equal("failed to find a shared processor pool ID with name workers"))
+	})
+	t.Run("Failed to fetch shared processor pools", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllSharedProcessorPools().Return(nil, errors.New("error fetching shared processor pools"))
+		sharedProcessorPoolID, err := getSharedProcessorPoolID(infrav1.IBMPowerVSResourceReference{Name: ptr.To("workers")}, &PowerVSMachineScope{IBMPowerVSClient: mockpowervs})
+		g.Expect(sharedProcessorPoolID).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestSetInstancePlacement(t *testing.T) {
+	t.Run("Leaves the body unchanged when placement is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		body := &models.PVMInstanceCreate{}
+		setInstancePlacement(body, infrav1.IBMPowerVSMachineSpec{})
+		g.Expect(body).To(Equal(&models.PVMInstanceCreate{}))
+	})
+	t.Run("Sets deployment target and boot volume storage", func(t *testing.T) {
+		g := NewWithT(t)
+		body := &models.PVMInstanceCreate{}
+		setInstancePlacement(body, infrav1.IBMPowerVSMachineSpec{
+			DeploymentTarget: &infrav1.PowerVSDeploymentTarget{Type: infrav1.PowerVSDeploymentTargetTypeHostGroup, ID: "host-group-id"},
+			StorageType:      "tier0",
+			StoragePool:      "Tier0-Flash-1",
+		})
+		g.Expect(body.DeploymentTarget).To(Equal(&models.DeploymentTarget{ID: ptr.To("host-group-id"), Type: ptr.To(models.DeploymentTargetTypeHostGroup)}))
+		g.Expect(body.StorageType).To(Equal("tier0"))
+		g.Expect(body.StoragePool).To(Equal("Tier0-Flash-1"))
+		g.Expect(body.StorageAffinity).To(BeNil())
+	})
+	t.Run("Sets storage affinity", func(t *testing.T) {
+		g := NewWithT(t)
+		body := &models.PVMInstanceCreate{}
+		setInstancePlacement(body, infrav1.IBMPowerVSMachineSpec{
+			StorageAffinity: &infrav1.PowerVSStorageAffinity{Policy: infrav1.PowerVSVolumeAffinityPolicyAffinity, Instances: []string{"instance-1"}},
+		})
+		g.Expect(body.StorageAffinity).To(Equal(&models.StorageAffinity{
+			AffinityPolicy:      ptr.To(models.StorageAffinityAffinityPolicyAffinity),
+			AffinityPVMInstance: ptr.To("instance-1"),
+		}))
+	})
+	t.Run("Sets storage anti-affinity", func(t *testing.T) {
+		g := NewWithT(t)
+		body := &models.PVMInstanceCreate{}
+		setInstancePlacement(body, infrav1.IBMPowerVSMachineSpec{
+			StorageAffinity: &infrav1.PowerVSStorageAffinity{Policy: infrav1.PowerVSVolumeAffinityPolicyAntiAffinity, Instances: []string{"instance-1", "instance-2"}},
+		})
+		g.Expect(body.StorageAffinity).To(Equal(&models.StorageAffinity{
+			AffinityPolicy:           ptr.To(models.StorageAffinityAffinityPolicyAntiDashAffinity),
+			AntiAffinityPVMInstances: []string{"instance-1", "instance-2"},
+		}))
+	})
+}
+
+func TestCreateInstance(t *testing.T) {
+	var (
+		mockCtrl    *gomock.Controller
+		mockpowervs *mock.MockPowerVS
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	body := &models.PVMInstanceCreate{
+		ImageID:     ptr.To("image-id"),
+		ServerName:  ptr.To("machine"),
+		KeyPairName: "key",
+		Networks:    []*models.PVMInstanceAddNetwork{{NetworkID: ptr.To("network-id")}},
+		Memory:      ptr.To(float64(8)),
+		Processors:  ptr.To(0.5),
+		StorageType: "tier1",
+	}
+
+	t.Run("Creates the instance with the PVM instance API when profile is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().CreateInstance(body).Return(&models.PVMInstanceList{}, nil)
+		_, err := createInstance(mockpowervs, body, "")
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+	t.Run("Creates the instance with the SAP API when profile is set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetSAPProfile("ush1-4x128").Return(&models.SAPProfile{ProfileID: ptr.To("ush1-4x128")}, nil)
+		mockpowervs.EXPECT().CreateSAPInstance(&models.SAPCreate{
+			ImageID:     ptr.To("image-id"),
+			Name:        ptr.To("machine"),
+			Networks:    body.Networks,
+			ProfileID:   ptr.To("ush1-4x128"),
+			SSHKeyName:  "key",
+			StorageType: "tier1",
+		}).Return(&models.PVMInstanceList{}, nil)
+		_, err := createInstance(mockpowervs, body, "ush1-4x128")
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+	t.Run("Fails when the profile is not available", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetSAPProfile("ush1-4x128").Return(nil, errors.New("sap profile not found"))
+		_, err := createInstance(mockpowervs, body, "ush1-4x128")
+		g.Expect(err).To(MatchError(ContainSubstring("SAP profile ush1-4x128 is not available")))
+	})
+}
+
+func TestReconcileResize(t *testing.T) {
+	var (
+		mockCtrl    *gomock.Controller
+		mockpowervs *mock.MockPowerVS
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newMachineScope := func(processors string, memory int32) *PowerVSMachineScope {
+		return &PowerVSMachineScope{
+			IBMPowerVSClient: mockpowervs,
+			IBMPowerVSMachine: &infrav1.IBMPowerVSMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine"},
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ResizePolicy: infrav1.PowerVSResizePolicyInPlace,
+					Processors:   intstr.FromString(processors),
+					MemoryGiB:    memory,
+				},
+			},
+		}
+	}
+	newInstance := func(status string) *models.PVMInstance {
+		return &models.PVMInstance{
+			PvmInstanceID: ptr.To("instance-id"),
+			Status:        ptr.To(status),
+			Processors:    ptr.To(0.5),
+			Memory:        ptr.To(float64(4)),
+			Maxproc:       2,
+			Maxmem:        16,
+		}
+	}
+
+	t.Run("When the instance matches the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		requeue, err := newMachineScope("0.5", 4).ReconcileResize(ctx, newInstance("ACTIVE"))
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When the instance is being resized", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		requeue, err := newMachineScope("1", 8).ReconcileResize(ctx, newInstance("RESIZE"))
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When the processors and memory of the machine changed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		mockpowervs.EXPECT().UpdateInstance("instance-id", &models.PVMInstanceUpdate{Processors: 1, Memory: 8}).Return(&models.PVMInstanceUpdateResponse{}, nil)
+		machineScope := newMachineScope("1", 8)
+		requeue, err := machineScope.ReconcileResize(ctx, newInstance("ACTIVE"))
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(machineScope.IBMPowerVSMachine.Status.Resize).ToNot(BeNil())
+		g.Expect(machineScope.IBMPowerVSMachine.Status.Resize.Processors).To(Equal(intstr.FromString("1")))
+		g.Expect(machineScope.IBMPowerVSMachine.Status.Resize.MemoryGiB).To(Equal(int32(8)))
+
+		// The instance does not report the resize yet, so it is not requested again.
+		requeue, err = machineScope.ReconcileResize(ctx, newInstance("ACTIVE"))
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When the instance reports the requested resize", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		machineScope := newMachineScope("0.5", 4)
+		machineScope.IBMPowerVSMachine.Status.Resize = &infrav1.PowerVSInstanceResize{
+			Processors:  intstr.FromString("0.5"),
+			MemoryGiB:   4,
+			RequestedAt: metav1.Now(),
+		}
+		requeue, err := machineScope.ReconcileResize(ctx, newInstance("ACTIVE"))
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(machineScope.IBMPowerVSMachine.Status.Resize).To(BeNil())
+	})
+	t.Run("When the requested resize is not applied in time", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		mockpowervs.EXPECT().UpdateInstance("instance-id", &models.PVMInstanceUpdate{Processors: 1, Memory: 8}).Return(&models.PVMInstanceUpdateResponse{}, nil)
+		machineScope := newMachineScope("1", 8)
+		machineScope.IBMPowerVSMachine.Status.Resize = &infrav1.PowerVSInstanceResize{
+			Processors:  intstr.FromString("1"),
+			MemoryGiB:   8,
+			RequestedAt: metav1.NewTime(time.Now().Add(-resizeRequestTimeout)),
+		}
+		requeue, err := machineScope.ReconcileResize(ctx, newInstance("ACTIVE"))
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(machineScope.IBMPowerVSMachine.Status.Resize.RequestedAt.Time).To(BeTemporally("~", time.Now(), time.Minute))
+	})
+	t.Run("When the machine changed after the resize was requested", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		mockpowervs.EXPECT().UpdateInstance("instance-id", &models.PVMInstanceUpdate{Processors: 2, Memory: 8}).Return(&models.PVMInstanceUpdateResponse{}, nil)
+		machineScope := newMachineScope("2", 8)
+		machineScope.IBMPowerVSMachine.Status.Resize = &infrav1.PowerVSInstanceResize{
+			Processors:  intstr.FromString("1"),
+			MemoryGiB:   8,
+			RequestedAt: metav1.Now(),
+		}
+		requeue, err := machineScope.ReconcileResize(ctx, newInstance("ACTIVE"))
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(machineScope.IBMPowerVSMachine.Status.Resize.Processors).To(Equal(intstr.FromString("2")))
+	})
+	t.Run("When only the memory of the machine changed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		mockpowervs.EXPECT().UpdateInstance("instance-id", &models.PVMInstanceUpdate{Memory: 8}).Return(&models.PVMInstanceUpdateResponse{}, nil)
+		requeue, err := newMachineScope("0.5", 8).ReconcileResize(ctx, newInstance("ACTIVE"))
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When the memory exceeds the maximum memory of the instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		requeue, err := newMachineScope("0.5", 32).ReconcileResize(ctx, newInstance("ACTIVE"))
+		g.Expect(err).To(MatchError("memory 32GiB exceeds the maximum memory 16GiB the instance can be resized to in place"))
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When UpdateInstance returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		mockpowervs.EXPECT().UpdateInstance("instance-id", gomock.Any()).Return(nil, errors.New("error updating instance"))
+		requeue, err := newMachineScope("1", 4).ReconcileResize(ctx, newInstance("ACTIVE"))
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
+
+func TestCollectDiagnostics(t *testing.T) {
+	var (
+		mockCtrl    *gomock.Controller
+		mockpowervs *mock.MockPowerVS
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newMachineScope := func() *PowerVSMachineScope {
+		return &PowerVSMachineScope{
+			IBMPowerVSClient: mockpowervs,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{ServiceInstanceID: "service-instance-id"},
+			},
+			IBMPowerVSMachine: &infrav1.IBMPowerVSMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "machine",
+					Annotations: map[string]string{infrav1.CollectDiagnosticsAnnotation: ""},
+				},
+			},
+		}
+	}
+	newEvent := func(timestamp int64, message string) *models.Event {
+		return &models.Event{Level: ptr.To("error"), Message: ptr.To(message), Timestamp: ptr.To(timestamp)}
+	}
+	instance := &models.PVMInstance{
+		PvmInstanceID: ptr.To("instance-id"),
+		Status:        ptr.To("ERROR"),
+		Fault:         &models.PVMInstanceFault{Message: "No valid host was found", Details: strings.Repeat("x", 2000)},
+	}
+
+	t.Run("Keeps a bounded summary of the fault and the events of the instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		events := []*models.Event{newEvent(100, "Virtual server instance other (other-id) was created")}
+		for i := int64(12); i > 0; i-- {
+			events = append(events, newEvent(i, fmt.Sprintf("Virtual server instance machine (instance-id) event %d", i)))
+		}
+		events = append(events, &models.Event{Level: ptr.To("info"), Message: ptr.To(strings.Repeat("y", 300)), Timestamp: ptr.To(int64(13)), Metadata: map[string]interface{}{"pvmInstanceID": "instance-id"}})
+		mockpowervs.EXPECT().GetEvents("service-instance-id", gomock.Any()).Return(&models.Events{Events: events}, nil)
+
+		machineScope := newMachineScope()
+		err := machineScope.CollectDiagnostics(ctx, instance, infrav1.PowerVSDiagnosticsTriggerInstanceError)
+		g.Expect(err).To(BeNil())
+		diagnostics := machineScope.IBMPowerVSMachine.Status.Diagnostics
+		g.Expect(diagnostics.Trigger).To(Equal(infrav1.PowerVSDiagnosticsTriggerInstanceError))
+		g.Expect(diagnostics.InstanceState).To(Equal(infrav1.PowerVSInstanceStateERROR))
+		g.Expect(diagnostics.Fault).To(HavePrefix("No valid host was found: xxx"))
+		g.Expect(diagnostics.Fault).To(HaveLen(1024))
+		g.Expect(diagnostics.Events).To(HaveLen(10))
+		g.Expect(diagnostics.Events[0]).To(HaveSuffix("event 4"))
+		g.Expect(diagnostics.Events[9]).To(HaveLen(256))
+		g.Expect(diagnostics.ObjectKey).To(BeEmpty())
+		g.Expect(machineScope.HasDiagnostics(infrav1.PowerVSDiagnosticsTriggerInstanceError)).To(BeTrue())
+		g.Expect(machineScope.HasDiagnostics(infrav1.PowerVSDiagnosticsTriggerRequested)).To(BeFalse())
+	})
+	t.Run("Matches the ID and name of the instance as whole words", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		events := []*models.Event{
+			newEvent(1, "Virtual server instance machine-10 (instance-id-10) was created"),
+			newEvent(2, "Virtual server instance machine_1 (other-id) was created"),
+			newEvent(3, "Virtual server instance machine (other-id) was created"),
+			newEvent(4, "Virtual server instance other (instance-id) failed"),
+			newEvent(5, "Volume attached to machine."),
+		}
+		mockpowervs.EXPECT().GetEvents("service-instance-id", gomock.Any()).Return(&models.Events{Events: events}, nil)
+
+		machineScope := newMachineScope()
+		err := machineScope.CollectDiagnostics(ctx, instance, infrav1.PowerVSDiagnosticsTriggerRequested)
+		g.Expect(err).To(BeNil())
+		diagnostics := machineScope.IBMPowerVSMachine.Status.Diagnostics
+		g.Expect(diagnostics.Events).To(HaveLen(3))
+		g.Expect(diagnostics.Events[0]).To(HaveSuffix("Virtual server instance machine (other-id) was created"))
+		g.Expect(diagnostics.Events[1]).To(HaveSuffix("Virtual server instance other (instance-id) failed"))
+		g.Expect(diagnostics.Events[2]).To(HaveSuffix("Volume attached to machine."))
+	})
+	t.Run("Does not collect the error of the instance again after a requested collection", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		mockpowervs.EXPECT().GetEvents("service-instance-id", gomock.Any()).Return(&models.Events{}, nil).Times(2)
+
+		machineScope := newMachineScope()
+		err := machineScope.CollectDiagnostics(ctx, instance, infrav1.PowerVSDiagnosticsTriggerRequested)
+		g.Expect(err).To(BeNil())
+		g.Expect(machineScope.HasDiagnostics(infrav1.PowerVSDiagnosticsTriggerInstanceError)).To(BeTrue())
+
+		activeInstance := &models.PVMInstance{PvmInstanceID: ptr.To("instance-id"), Status: ptr.To("ACTIVE")}
+		err = machineScope.CollectDiagnostics(ctx, activeInstance, infrav1.PowerVSDiagnosticsTriggerRequested)
+		g.Expect(err).To(BeNil())
+		g.Expect(machineScope.HasDiagnostics(infrav1.PowerVSDiagnosticsTriggerInstanceError)).To(BeFalse())
+		g.Expect(machineScope.HasDiagnostics(infrav1.PowerVSDiagnosticsTriggerWaitingForNetworkAddress)).To(BeFalse())
+	})
+	t.Run("Fails to get the events", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		mockpowervs.EXPECT().GetEvents("service-instance-id", gomock.Any()).Return(nil, errors.New("error getting events"))
+
+		machineScope := newMachineScope()
+		err := machineScope.CollectDiagnostics(ctx, instance, infrav1.PowerVSDiagnosticsTriggerRequested)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(machineScope.IBMPowerVSMachine.Status.Diagnostics).To(BeNil())
+	})
+	t.Run("Clears the diagnostics request", func(t *testing.T) {
+		g := NewWithT(t)
+
+		machineScope := newMachineScope()
+		g.Expect(machineScope.DiagnosticsRequested()).To(BeTrue())
+		machineScope.ClearDiagnosticsRequest()
+		g.Expect(machineScope.DiagnosticsRequested()).To(BeFalse())
+	})
+}
+
 func TestGetMachineInternalIP(t *testing.T) {
 	t.Run("Get Machine Internal IP", func(t *testing.T) {
 		t.Run("Returns machine IP for address type - Node Internal IP", func(t *testing.T) {
@@ -907,6 +1503,27 @@ func TestGetMachineInternalIP(t *testing.T) {
 			}
 			g.Expect("").To(Equal(scope.GetMachineInternalIP()))
 		})
+
+		t.Run("Returns IP of primary network for machine with multiple networks", func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			networkID := "primary-net-ID"
+			scope := setupPowerVSMachineScope("test-cluster", "test-machine-0", ptr.To("test-image-ID"), &networkID, true, mock.NewMockPowerVS(mockCtrl))
+			scope.SetAddresses(ctx, &models.PVMInstance{
+				Networks: []*models.PVMInstanceNetwork{
+					{
+						NetworkID: "storage-net-ID",
+						IPAddress: "10.0.0.10",
+					},
+					{
+						NetworkID: networkID,
+						IPAddress: "192.168.10.3",
+					},
+				},
+				ServerName: ptr.To("test-machine-0"),
+			})
+			g.Expect(scope.GetMachineInternalIP()).To(Equal("192.168.10.3"))
+		})
 	})
 }
 
@@ -1019,10 +1636,29 @@ func TestCreateCOSClient(t *testing.T) {
 			g.Expect(err.Error()).To(ContainSubstring(expectedError))
 		})
 
+		t.Run("IBM Cloud credentials are not provided", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			serviceInstance := &resourcecontrollerv2.ResourceInstance{
+				State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
+				GUID:  ptr.To("foo-guid"),
+			}
+			scope.SetRegion(region)
+			cosInstanceName := fmt.Sprintf("%s-%s", scope.IBMPowerVSCluster.GetName(), "cosinstance")
+			mockResourceController.EXPECT().GetInstanceByName(cosInstanceName, resourcecontroller.CosResourceID, resourcecontroller.CosResourcePlanID).Return(serviceInstance, nil)
+			scope.ResourceClient = mockResourceController
+			result, err := scope.createCOSClient(ctx)
+			g.Expect(result).To(BeNil())
+			g.Expect(err.Error()).To(ContainSubstring("failed to get COS credentials"))
+		})
+
 		t.Run("Failed to determine COS bucket region", func(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
 			t.Cleanup(teardown)
+			t.Setenv("IBMCLOUD_APIKEY", "test-api-key")
 			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
 			serviceInstance := &resourcecontrollerv2.ResourceInstance{
 				State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
@@ -1040,6 +1676,7 @@ func TestCreateCOSClient(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
 			t.Cleanup(teardown)
+			t.Setenv("IBMCLOUD_APIKEY", "test-api-key")
 			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
 			serviceInstance := &resourcecontrollerv2.ResourceInstance{
 				State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
@@ -1225,6 +1862,7 @@ func TestDeleteMachineIgnition(t *testing.T) {
 
 		t.Run("Successful DeleteMachineIgnition", func(t *testing.T) {
 			g := NewWithT(t)
+			t.Setenv("IBMCLOUD_APIKEY", "test-api-key")
 			bootstrapSecret := newBootstrapSecret(clusterName, machineName)
 			initObjects := []client.Object{
 				bootstrapSecret,
@@ -1317,6 +1955,14 @@ func TestCreateMachinePVS(t *testing.T) {
 		}
 		pvmInstanceList := &models.PVMInstanceList{}
 		pvmInstanceCreate := &models.PVMInstanceCreate{}
+		systemPools := models.SystemPools{
+			"s922": {MaxCoresAvailable: &models.System{Cores: ptr.To(float64(4)), Memory: ptr.To(int64(64))}},
+		}
+		expectInstancePreflight := func(scope *PowerVSMachineScope) {
+			scope.IBMPowerVSCluster.Spec.ServiceInstanceID = "service-instance-id"
+			mockpowervs.EXPECT().GetSystemPools().Return(systemPools, nil)
+			mockpowervs.EXPECT().GetCloudInstance("service-instance-id").Return(&models.CloudInstance{}, nil)
+		}
 
 		t.Run("Should create Machine", func(t *testing.T) {
 			g := NewWithT(t)
@@ -1324,6 +1970,7 @@ func TestCreateMachinePVS(t *testing.T) {
 			t.Cleanup(teardown)
 			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
 			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			expectInstancePreflight(scope)
 			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).Return(pvmInstanceList, nil)
 			_, err := scope.CreateMachine(ctx)
 			g.Expect(err).To(BeNil())
@@ -1435,6 +2082,7 @@ func TestCreateMachinePVS(t *testing.T) {
 				},
 			}
 			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			expectInstancePreflight(scope)
 			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).Return(pvmInstanceList, nil)
 			_, err := scope.CreateMachine(ctx)
 			g.Expect(err).To(BeNil())
@@ -1448,6 +2096,7 @@ func TestCreateMachinePVS(t *testing.T) {
 			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
 			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
 			mockpowervs.EXPECT().GetAllNetwork().Return(networks, nil)
+			expectInstancePreflight(scope)
 			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).Return(pvmInstanceList, nil)
 			_, err := scope.CreateMachine(ctx)
 			g.Expect(err).To(BeNil())
@@ -1492,10 +2141,77 @@ func TestCreateMachinePVS(t *testing.T) {
 			t.Cleanup(teardown)
 			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
 			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			expectInstancePreflight(scope)
 			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).Return(pvmInstanceList, errors.New("failed to create machine"))
 			_, err := scope.CreateMachine(ctx)
 			g.Expect(err).To(Not(BeNil()))
 		})
+
+		t.Run("Error when the system type is not available in the zone", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSMachine.Spec.SystemType = "e980"
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().GetSystemPools().Return(systemPools, nil)
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(MatchError(ErrInstancePreflightFailed))
+			g.Expect(err).To(MatchError(ContainSubstring("system type e980 is not available in the zone, available system types: s922")))
+		})
+
+		t.Run("Error when the storage tier is not available in the zone", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSMachine.Spec.StorageType = "tier0"
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().GetSystemPools().Return(systemPools, nil)
+			mockpowervs.EXPECT().GetStorageTiers().Return(models.RegionStorageTiers{
+				{Name: "tier0", State: ptr.To(models.StorageTierStateInactive)},
+				{Name: "tier1", State: ptr.To(models.StorageTierStateActive)},
+			}, nil)
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(MatchError(ErrInstancePreflightFailed))
+			g.Expect(err).To(MatchError(ContainSubstring("storage tier tier0 is not available in the zone")))
+		})
+
+		t.Run("Error when the service instance is out of quota", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSCluster.Spec.ServiceInstanceID = "service-instance-id"
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().GetSystemPools().Return(systemPools, nil)
+			mockpowervs.EXPECT().GetCloudInstance("service-instance-id").Return(&models.CloudInstance{
+				Limits: &models.CloudInstanceUsageLimits{Instances: ptr.To(float64(10)), Memory: ptr.To(float64(64)), ProcUnits: ptr.To(float64(8))},
+				Usage:  &models.CloudInstanceUsageLimits{Instances: ptr.To(float64(5)), Memory: ptr.To(float64(60)), ProcUnits: ptr.To(float64(2))},
+			}, nil)
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(MatchError(ErrInstancePreflightFailed))
+			g.Expect(err).To(MatchError(ContainSubstring("the service instance has 4 of 64 memory GiB available, 8 requested")))
+		})
+
+		t.Run("Error when the service instance is out of storage quota for the boot volume", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSCluster.Spec.ServiceInstanceID = "service-instance-id"
+			scope.IBMPowerVSMachine.Spec.AdditionalVolumes = []infrav1.PowerVSAdditionalVolume{{Name: "data", SizeGiB: 10}}
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().GetSystemPools().Return(systemPools, nil)
+			mockpowervs.EXPECT().GetCloudInstance("service-instance-id").Return(&models.CloudInstance{
+				Limits: &models.CloudInstanceUsageLimits{Storage: ptr.To(float64(1000))},
+				Usage:  &models.CloudInstanceUsageLimits{Storage: ptr.To(float64(950))},
+			}, nil)
+			mockpowervs.EXPECT().GetImage(pvsImage).Return(&models.Image{Size: ptr.To(float64(120))}, nil)
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(MatchError(ErrInstancePreflightFailed))
+			g.Expect(err).To(MatchError(ContainSubstring("the service instance has 50 of 1000 storage GiB available, 130 requested")))
+		})
 	})
 }
 
@@ -2121,29 +2837,163 @@ func TestCreateVPCLoadBalancerPoolMemberPowerVSMachine(t *testing.T) {
 				},
 			}
 
-			mockClient.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancers, nil, nil).AnyTimes()
-			loadBalancerPoolMemberCollection := &vpcv1.LoadBalancerPoolMemberCollection{
-				Members: []vpcv1.LoadBalancerPoolMember{
-					{
-						Port: core.Int64Ptr(3040),
-						Target: &vpcv1.LoadBalancerPoolMemberTarget{
-							Address: ptr.To(nodeAddress),
-						},
-					},
-				},
-			}
-			mockClient.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, nil, nil).AnyTimes()
-			expectedLoadBalancerPoolMemberID := "pool-member-2"
-			expectedLoadBalancerPoolMember := &vpcv1.LoadBalancerPoolMember{ID: ptr.To(expectedLoadBalancerPoolMemberID)}
-			mockClient.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(expectedLoadBalancerPoolMember, nil, nil).AnyTimes()
-			result, err := scope.CreateVPCLoadBalancerPoolMember(ctx)
-			g.Expect(result).To(BeNil())
-			g.Expect(err).To(BeNil())
-		})
+			mockClient.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancers, nil, nil).AnyTimes()
+			loadBalancerPoolMemberCollection := &vpcv1.LoadBalancerPoolMemberCollection{
+				Members: []vpcv1.LoadBalancerPoolMember{
+					{
+						Port: core.Int64Ptr(3040),
+						Target: &vpcv1.LoadBalancerPoolMemberTarget{
+							Address: ptr.To(nodeAddress),
+						},
+					},
+				},
+			}
+			mockClient.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, nil, nil).AnyTimes()
+			expectedLoadBalancerPoolMemberID := "pool-member-2"
+			expectedLoadBalancerPoolMember := &vpcv1.LoadBalancerPoolMember{ID: ptr.To(expectedLoadBalancerPoolMemberID)}
+			mockClient.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).Return(expectedLoadBalancerPoolMember, nil, nil).AnyTimes()
+			result, err := scope.CreateVPCLoadBalancerPoolMember(ctx)
+			g.Expect(result).To(BeNil())
+			g.Expect(err).To(BeNil())
+		})
+	})
+}
+
+func TestDeleteMachinePVS(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Delete Machine", func(t *testing.T) {
+		var id string
+		t.Run("Should delete Machine", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSMachine.Status.InstanceID = machineName + idSuffix
+			mockpowervs.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(id)).Return(nil)
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Error while deleting Machine", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSMachine.Status.InstanceID = machineName + idSuffix
+			mockpowervs.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(id)).Return(errors.New("failed to delete machine"))
+			err := scope.DeleteMachine()
+			g.Expect(err).To(Not(BeNil()))
+		})
+	})
+}
+
+func TestReconcileAdditionalVolumes(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	instanceID := machineName + idSuffix
+	newScope := func() *PowerVSMachineScope {
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.InstanceID = instanceID
+		scope.IBMPowerVSMachine.Spec.AdditionalVolumes = []infrav1.PowerVSAdditionalVolume{
+			{
+				Name:           "data",
+				SizeGiB:        100,
+				Tier:           "tier1",
+				AffinityPolicy: infrav1.PowerVSVolumeAffinityPolicyAntiAffinity,
+			},
+		}
+		return scope
+	}
+
+	t.Run("Should create volume when it does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		mockpowervs.EXPECT().GetAllVolume().Return(&models.Volumes{}, nil)
+		mockpowervs.EXPECT().CreateVolume(&models.CreateDataVolume{
+			Name:                     ptr.To(machineName + "-data"),
+			Size:                     ptr.To(float64(100)),
+			DiskType:                 "tier1",
+			Shareable:                ptr.To(false),
+			AffinityPolicy:           ptr.To("anti-affinity"),
+			AntiAffinityPVMInstances: []string{instanceID},
+		}).Return(&models.Volume{VolumeID: ptr.To("volume-id")}, nil)
+		mockpowervs.EXPECT().GetVolume("volume-id").Return(&models.Volume{VolumeID: ptr.To("volume-id"), State: "creating"}, nil)
+		requeue, err := scope.ReconcileAdditionalVolumes(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSMachine.Status.AdditionalVolumes).To(ConsistOf(infrav1.PowerVSVolumeStatus{
+			Name:  "data",
+			ID:    "volume-id",
+			State: infrav1.PowerVSVolumeStateCreating,
+		}))
+	})
+
+	t.Run("Should reuse existing volume with the same name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		mockpowervs.EXPECT().GetAllVolume().Return(&models.Volumes{Volumes: []*models.VolumeReference{{Name: ptr.To(machineName + "-data"), VolumeID: ptr.To("volume-id")}}}, nil)
+		mockpowervs.EXPECT().GetVolume("volume-id").Return(&models.Volume{VolumeID: ptr.To("volume-id"), State: "available"}, nil)
+		mockpowervs.EXPECT().AttachVolume(instanceID, "volume-id").Return(nil)
+		requeue, err := scope.ReconcileAdditionalVolumes(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Should not requeue when volume is attached", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		scope.IBMPowerVSMachine.Status.AdditionalVolumes = []infrav1.PowerVSVolumeStatus{{Name: "data", ID: "volume-id"}}
+		mockpowervs.EXPECT().GetVolume("volume-id").Return(&models.Volume{VolumeID: ptr.To("volume-id"), State: "in-use", PvmInstanceIDs: []string{instanceID}}, nil)
+		requeue, err := scope.ReconcileAdditionalVolumes(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMPowerVSMachine.Status.AdditionalVolumes[0].Attached).To(BeTrue())
+	})
+
+	t.Run("Error when volume is in error state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope()
+		scope.IBMPowerVSMachine.Status.AdditionalVolumes = []infrav1.PowerVSVolumeStatus{{Name: "data", ID: "volume-id"}}
+		mockpowervs.EXPECT().GetVolume("volume-id").Return(&models.Volume{VolumeID: ptr.To("volume-id"), State: "error"}, nil)
+		_, err := scope.ReconcileAdditionalVolumes(ctx)
+		g.Expect(err).ToNot(BeNil())
 	})
 }
 
-func TestDeleteMachinePVS(t *testing.T) {
+func TestDeleteAdditionalVolumes(t *testing.T) {
 	var (
 		mockpowervs *mock.MockPowerVS
 		mockCtrl    *gomock.Controller
@@ -2158,29 +3008,69 @@ func TestDeleteMachinePVS(t *testing.T) {
 		mockCtrl.Finish()
 	}
 
-	t.Run("Delete Machine", func(t *testing.T) {
-		var id string
-		t.Run("Should delete Machine", func(t *testing.T) {
-			g := NewWithT(t)
-			setup(t)
-			t.Cleanup(teardown)
-			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
-			scope.IBMPowerVSMachine.Status.InstanceID = machineName + idSuffix
-			mockpowervs.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(id)).Return(nil)
-			err := scope.DeleteMachine()
-			g.Expect(err).To(BeNil())
-		})
+	instanceID := machineName + idSuffix
+	newScope := func(volumeStatuses ...infrav1.PowerVSVolumeStatus) *PowerVSMachineScope {
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.IBMPowerVSMachine.Status.InstanceID = instanceID
+		scope.IBMPowerVSMachine.Status.AdditionalVolumes = volumeStatuses
+		return scope
+	}
 
-		t.Run("Error while deleting Machine", func(t *testing.T) {
-			g := NewWithT(t)
-			setup(t)
-			t.Cleanup(teardown)
-			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
-			scope.IBMPowerVSMachine.Status.InstanceID = machineName + idSuffix
-			mockpowervs.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(id)).Return(errors.New("failed to delete machine"))
-			err := scope.DeleteMachine()
-			g.Expect(err).To(Not(BeNil()))
-		})
+	t.Run("Should detach attached volume", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope(infrav1.PowerVSVolumeStatus{Name: "data", ID: "volume-id"})
+		mockpowervs.EXPECT().GetVolume("volume-id").Return(&models.Volume{State: "in-use", PvmInstanceIDs: []string{instanceID}}, nil)
+		mockpowervs.EXPECT().DetachVolume(instanceID, "volume-id").Return(nil)
+		requeue, err := scope.DeleteAdditionalVolumes(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Should delete detached volume", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope(infrav1.PowerVSVolumeStatus{Name: "data", ID: "volume-id"})
+		mockpowervs.EXPECT().GetVolume("volume-id").Return(&models.Volume{State: "available"}, nil)
+		mockpowervs.EXPECT().DeleteVolume("volume-id").Return(nil)
+		requeue, err := scope.DeleteAdditionalVolumes(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Should not delete retained volume", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope(infrav1.PowerVSVolumeStatus{Name: "data", ID: "volume-id", DeletePolicy: string(infrav1.DeletePolicyRetain)})
+		requeue, err := scope.DeleteAdditionalVolumes(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Should remove deleted volume from status", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope(infrav1.PowerVSVolumeStatus{Name: "data", ID: "volume-id"})
+		mockpowervs.EXPECT().GetVolume("volume-id").Return(nil, errors.New("volume does not exist. ID: volume-id"))
+		requeue, err := scope.DeleteAdditionalVolumes(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMPowerVSMachine.Status.AdditionalVolumes).To(BeEmpty())
+	})
+
+	t.Run("Error while deleting volume", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := newScope(infrav1.PowerVSVolumeStatus{Name: "data", ID: "volume-id"})
+		mockpowervs.EXPECT().GetVolume("volume-id").Return(&models.Volume{State: "available"}, nil)
+		mockpowervs.EXPECT().DeleteVolume("volume-id").Return(errors.New("failed to delete volume"))
+		_, err := scope.DeleteAdditionalVolumes(ctx)
+		g.Expect(err).ToNot(BeNil())
 	})
 }
 
@@ -2282,10 +3172,38 @@ func TestSetAddresses(t *testing.T) {
 			dhcpCacheStoreFunc: defaultDhcpCacheStoreFunc,
 		},
 		{
-			testcase: "error while getting network id",
+			testcase: "should set IP addresses of every instance network",
+			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
+				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
+				return mockPowerVSClient
+			},
+			pvmInstance: &models.PVMInstance{
+				Networks: []*models.PVMInstanceNetwork{
+					{
+						IPAddress: "192.168.10.3",
+					},
+					{
+						IPAddress: "10.0.0.10",
+					},
+				},
+				ServerName: ptr.To(instanceName),
+			},
+			expectedNodeAddress: append(defaultExpectedMachineAddress, []corev1.NodeAddress{
+				{
+					Type:    corev1.NodeInternalIP,
+					Address: "192.168.10.3",
+				},
+				{
+					Type:    corev1.NodeInternalIP,
+					Address: "10.0.0.10",
+				},
+			}...),
+			dhcpCacheStoreFunc: defaultDhcpCacheStoreFunc,
+		},
+		{
+			testcase: "no network attached to vm",
 			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
 				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
-				mockPowerVSClient.EXPECT().GetAllNetwork().Return(nil, fmt.Errorf("intentional error"))
 				return mockPowerVSClient
 			},
 			pvmInstance: &models.PVMInstance{
@@ -2295,18 +3213,9 @@ func TestSetAddresses(t *testing.T) {
 			dhcpCacheStoreFunc:  defaultDhcpCacheStoreFunc,
 		},
 		{
-			testcase: "no network id associated with network name",
+			testcase: "no network attached to vm with network name",
 			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
 				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
-				networks := &models.Networks{
-					Networks: []*models.NetworkReference{
-						{
-							NetworkID: ptr.To("test-ID"),
-							Name:      ptr.To("test-name"),
-						},
-					},
-				}
-				mockPowerVSClient.EXPECT().GetAllNetwork().Return(networks, nil)
 				return mockPowerVSClient
 			},
 			pvmInstance:         newPowerVSInstance(instanceName, networkID, instanceMac),
@@ -2390,6 +3299,102 @@ func TestSetAddresses(t *testing.T) {
 			dhcpCacheStoreFunc: defaultDhcpCacheStoreFunc,
 			setNetworkID:       true,
 		},
+		{
+			testcase: "ip address of primary network from dhcp server comes before fixed ip of another network",
+			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
+				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
+				mockPowerVSClient.EXPECT().GetAllDHCPServers().Return(newDHCPServer(dhcpServerID, networkID), nil)
+				mockPowerVSClient.EXPECT().GetDHCPServer(dhcpServerID).Return(newDHCPServerDetails(dhcpServerID, leaseIP, instanceMac), nil)
+				return mockPowerVSClient
+			},
+			pvmInstance: &models.PVMInstance{
+				Networks: []*models.PVMInstanceNetwork{
+					{
+						NetworkID:  networkID,
+						MacAddress: instanceMac,
+					},
+					{
+						NetworkID: "storage-net-ID",
+						IPAddress: "10.0.0.10",
+					},
+				},
+				ServerName: ptr.To(instanceName),
+			},
+			expectedNodeAddress: append(defaultExpectedMachineAddress, []corev1.NodeAddress{
+				{
+					Type:    corev1.NodeInternalIP,
+					Address: leaseIP,
+				},
+				{
+					Type:    corev1.NodeInternalIP,
+					Address: "10.0.0.10",
+				},
+			}...),
+			dhcpCacheStoreFunc: defaultDhcpCacheStoreFunc,
+			setNetworkID:       true,
+		},
+		{
+			testcase: "ip addresses of primary network come first when another network is reported first",
+			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
+				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
+				return mockPowerVSClient
+			},
+			pvmInstance: &models.PVMInstance{
+				Networks: []*models.PVMInstanceNetwork{
+					{
+						NetworkID: "storage-net-ID",
+						IPAddress: "10.0.0.10",
+					},
+					{
+						NetworkID:  networkID,
+						IPAddress:  "192.168.10.3",
+						ExternalIP: "10.11.2.3",
+					},
+				},
+				ServerName: ptr.To(instanceName),
+			},
+			expectedNodeAddress: append(defaultExpectedMachineAddress, []corev1.NodeAddress{
+				{
+					Type:    corev1.NodeInternalIP,
+					Address: "192.168.10.3",
+				},
+				{
+					Type:    corev1.NodeExternalIP,
+					Address: "10.11.2.3",
+				},
+				{
+					Type:    corev1.NodeInternalIP,
+					Address: "10.0.0.10",
+				},
+			}...),
+			dhcpCacheStoreFunc: defaultDhcpCacheStoreFunc,
+			setNetworkID:       true,
+		},
+		{
+			testcase: "primary network has an ip address while another network has none",
+			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
+				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
+				return mockPowerVSClient
+			},
+			pvmInstance: &models.PVMInstance{
+				Networks: []*models.PVMInstanceNetwork{
+					{
+						NetworkID: networkID,
+						IPAddress: "192.168.10.3",
+					},
+					{
+						NetworkID: "storage-net-ID",
+					},
+				},
+				ServerName: ptr.To(instanceName),
+			},
+			expectedNodeAddress: append(defaultExpectedMachineAddress, corev1.NodeAddress{
+				Type:    corev1.NodeInternalIP,
+				Address: "192.168.10.3",
+			}),
+			dhcpCacheStoreFunc: defaultDhcpCacheStoreFunc,
+			setNetworkID:       true,
+		},
 		{
 			testcase: "ip stored in cache expired, fetch from dhcp server",
 			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
@@ -2414,6 +3419,88 @@ func TestSetAddresses(t *testing.T) {
 			},
 			setNetworkID: true,
 		},
+		{
+			testcase: "success in getting ip address from dhcp server for primary network referenced by name",
+			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
+				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
+				mockPowerVSClient.EXPECT().GetAllDHCPServers().Return(newDHCPServer(dhcpServerID, "primary-net-ID"), nil)
+				mockPowerVSClient.EXPECT().GetDHCPServer(dhcpServerID).Return(newDHCPServerDetails(dhcpServerID, leaseIP, instanceMac), nil)
+				return mockPowerVSClient
+			},
+			pvmInstance: &models.PVMInstance{
+				Networks: []*models.PVMInstanceNetwork{
+					{
+						NetworkID:   "primary-net-ID",
+						NetworkName: networkID,
+						MacAddress:  instanceMac,
+					},
+				},
+				ServerName: ptr.To(instanceName),
+			},
+			expectedNodeAddress: append(defaultExpectedMachineAddress, corev1.NodeAddress{
+				Type:    corev1.NodeInternalIP,
+				Address: leaseIP,
+			}),
+			dhcpCacheStoreFunc: defaultDhcpCacheStoreFunc,
+		},
+		{
+			testcase: "primary network referenced by name has an ip address while another network has none",
+			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
+				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
+				return mockPowerVSClient
+			},
+			pvmInstance: &models.PVMInstance{
+				Networks: []*models.PVMInstanceNetwork{
+					{
+						NetworkID:   "primary-net-ID",
+						NetworkName: networkID,
+						IPAddress:   "192.168.10.3",
+					},
+					{
+						NetworkID:   "storage-net-ID",
+						NetworkName: "storage-net",
+					},
+				},
+				ServerName: ptr.To(instanceName),
+			},
+			expectedNodeAddress: append(defaultExpectedMachineAddress, corev1.NodeAddress{
+				Type:    corev1.NodeInternalIP,
+				Address: "192.168.10.3",
+			}),
+			dhcpCacheStoreFunc: defaultDhcpCacheStoreFunc,
+		},
+		{
+			testcase: "cached DHCP IP is not used when the primary network has an ip address",
+			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
+				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
+				return mockPowerVSClient
+			},
+			pvmInstance: &models.PVMInstance{
+				Networks: []*models.PVMInstanceNetwork{
+					{
+						NetworkID: networkID,
+						IPAddress: "192.168.10.3",
+					},
+					{
+						NetworkID: "storage-net-ID",
+					},
+				},
+				ServerName: ptr.To(instanceName),
+			},
+			expectedNodeAddress: append(defaultExpectedMachineAddress, corev1.NodeAddress{
+				Type:    corev1.NodeInternalIP,
+				Address: "192.168.10.3",
+			}),
+			dhcpCacheStoreFunc: func() cache.Store {
+				cacheStore := cache.NewTTLStore(powervs.CacheKeyFunc, powervs.CacheTTL)
+				_ = cacheStore.Add(powervs.VMip{
+					Name: instanceName,
+					IP:   leaseIP,
+				})
+				return cacheStore
+			},
+			setNetworkID: true,
+		},
 		{
 			testcase: "success in fetching DHCP IP from cache",
 			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
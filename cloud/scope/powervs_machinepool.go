@@ -0,0 +1,537 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
+)
+
+const (
+	// powerVSMachinePoolNameLength is the maximum length of the machine pool name used in its instance names.
+	powerVSMachinePoolNameLength = 21
+	// powerVSMachinePoolSuffixLength is the length of the random suffix of the machine pool instance names.
+	powerVSMachinePoolSuffixLength = 5
+)
+
+// PowerVSMachinePoolScopeParams defines the input parameters used to create a new PowerVSMachinePoolScope.
+type PowerVSMachinePoolScopeParams struct {
+	Logger                logr.Logger
+	Client                client.Client
+	Cluster               *clusterv1.Cluster
+	MachinePool           *clusterv1.MachinePool
+	IBMPowerVSCluster     *infrav1.IBMPowerVSCluster
+	IBMPowerVSMachinePool *infrav1.IBMPowerVSMachinePool
+	ServiceEndpoint       []endpoints.ServiceEndpoint
+}
+
+// PowerVSMachinePoolScope defines a scope defined around a Power VS machine pool.
+type PowerVSMachinePoolScope struct {
+	Client client.Client
+
+	IBMPowerVSClient      powervs.PowerVS
+	ResourceClient        resourcecontroller.ResourceController
+	Cluster               *clusterv1.Cluster
+	MachinePool           *clusterv1.MachinePool
+	IBMPowerVSCluster     *infrav1.IBMPowerVSCluster
+	IBMPowerVSMachinePool *infrav1.IBMPowerVSMachinePool
+	ServiceEndpoint       []endpoints.ServiceEndpoint
+
+	serviceInstanceID string
+}
+
+// NewPowerVSMachinePoolScope creates a new PowerVSMachinePoolScope from the supplied parameters.
+func NewPowerVSMachinePoolScope(params PowerVSMachinePoolScopeParams) (*PowerVSMachinePoolScope, error) {
+	if params.Client == nil {
+		return nil, errors.New("client is required when creating a MachinePoolScope")
+	}
+	if params.MachinePool == nil {
+		return nil, errors.New("machine pool is required when creating a MachinePoolScope")
+	}
+	if params.IBMPowerVSMachinePool == nil {
+		return nil, errors.New("ibmPowerVSMachinePool is required when creating a MachinePoolScope")
+	}
+	if params.IBMPowerVSCluster == nil {
+		return nil, errors.New("ibmPowerVSCluster is required when creating a MachinePoolScope")
+	}
+
+	if params.Logger == (logr.Logger{}) {
+		params.Logger = klog.Background()
+	}
+	if params.Logger.V(DEBUGLEVEL).Enabled() {
+		core.SetLoggingLevel(core.LevelDebug)
+	}
+
+	scope := &PowerVSMachinePoolScope{
+		Client:                params.Client,
+		Cluster:               params.Cluster,
+		MachinePool:           params.MachinePool,
+		IBMPowerVSCluster:     params.IBMPowerVSCluster,
+		IBMPowerVSMachinePool: params.IBMPowerVSMachinePool,
+		ServiceEndpoint:       params.ServiceEndpoint,
+	}
+
+	var identityAuthenticator core.Authenticator
+	var err error
+	if params.IBMPowerVSCluster.Spec.IdentityRef != nil {
+		identityAuthenticator, err = GetAuthenticator(context.TODO(), params.Client, params.IBMPowerVSCluster.Namespace, params.IBMPowerVSCluster.Spec.IdentityRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create authenticator: %w", err)
+		}
+	}
+
+	// Create Resource Controller client.
+	serviceOption := resourcecontroller.ServiceOptions{
+		ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
+			Authenticator: identityAuthenticator,
+		},
+	}
+	// Fetch the resource controller endpoint.
+	if rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), params.ServiceEndpoint); rcEndpoint != "" {
+		serviceOption.URL = rcEndpoint
+		params.Logger.V(3).Info("Overriding the default resource controller endpoint", "resourceControllerEndpoint", rcEndpoint)
+	}
+	rc, err := resourcecontroller.NewService(serviceOption)
+	if err != nil {
+		return nil, err
+	}
+	scope.ResourceClient = rc
+
+	templateSpec := params.IBMPowerVSMachinePool.Spec.Template.Spec
+	var serviceInstanceID, serviceInstanceName string
+	switch {
+	case templateSpec.ServiceInstanceID != "":
+		serviceInstanceID = templateSpec.ServiceInstanceID
+	case templateSpec.ServiceInstance != nil && templateSpec.ServiceInstance.ID != nil:
+		serviceInstanceID = *templateSpec.ServiceInstance.ID
+	case params.IBMPowerVSCluster.Status.ServiceInstance != nil && params.IBMPowerVSCluster.Status.ServiceInstance.ID != nil:
+		serviceInstanceID = *params.IBMPowerVSCluster.Status.ServiceInstance.ID
+	case params.IBMPowerVSCluster.Spec.ServiceInstanceID != "":
+		serviceInstanceID = params.IBMPowerVSCluster.Spec.ServiceInstanceID
+	default:
+		serviceInstanceName = fmt.Sprintf("%s-%s", params.IBMPowerVSCluster.GetName(), "serviceInstance")
+		if params.IBMPowerVSCluster.Spec.ServiceInstance != nil && params.IBMPowerVSCluster.Spec.ServiceInstance.Name != nil {
+			serviceInstanceName = *params.IBMPowerVSCluster.Spec.ServiceInstance.Name
+		}
+	}
+	serviceInstance, err := rc.GetServiceInstance(serviceInstanceID, serviceInstanceName, params.IBMPowerVSCluster.Spec.Zone)
+	if err != nil {
+		params.Logger.Error(err, "failed to get PowerVS service instance details", "serviceInstanceName", serviceInstanceName, "serviceInstanceID", serviceInstanceID)
+		return nil, err
+	}
+	if serviceInstance == nil {
+		return nil, fmt.Errorf("PowerVS service instance %s is not yet created", serviceInstanceName)
+	}
+	if *serviceInstance.State != string(infrav1.ServiceInstanceStateActive) {
+		return nil, fmt.Errorf("PowerVS service instance name: %s id: %s is not in active state", serviceInstanceName, serviceInstanceID)
+	}
+	scope.serviceInstanceID = *serviceInstance.GUID
+
+	region := endpoints.ConstructRegionFromZone(*serviceInstance.RegionID)
+	scope.IBMPowerVSMachinePool.Status.Region = ptr.To(region)
+	scope.IBMPowerVSMachinePool.Status.Zone = serviceInstance.RegionID
+
+	serviceOptions := powervs.ServiceOptions{
+		IBMPIOptions: &ibmpisession.IBMPIOptions{
+			Authenticator: identityAuthenticator,
+			Debug:         params.Logger.V(DEBUGLEVEL).Enabled(),
+			Zone:          *serviceInstance.RegionID,
+		},
+		CloudInstanceID: scope.serviceInstanceID,
+	}
+	// Fetch the service endpoint.
+	if svcEndpoint := endpoints.FetchPVSEndpoint(region, params.ServiceEndpoint); svcEndpoint != "" {
+		serviceOptions.IBMPIOptions.URL = svcEndpoint
+		params.Logger.V(3).Info("Overriding the default PowerVS service endpoint", "serviceEndpoint", svcEndpoint)
+	}
+	c, err := powervs.NewService(serviceOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PowerVS service")
+	}
+	c.WithClients(serviceOptions)
+	scope.IBMPowerVSClient = c
+
+	return scope, nil
+}
+
+// Name returns the name of the IBMPowerVSMachinePool.
+func (m *PowerVSMachinePoolScope) Name() string {
+	return m.IBMPowerVSMachinePool.Name
+}
+
+// DesiredReplicas returns the number of replicas requested by the MachinePool.
+func (m *PowerVSMachinePoolScope) DesiredReplicas() int {
+	return int(ptr.Deref(m.MachinePool.Spec.Replicas, 0))
+}
+
+// instanceNamePrefix returns the prefix shared by the names of all the instances of the machine pool,
+// which is unique to the cluster and namespace of the pool as the instances are listed in the whole workspace.
+func (m *PowerVSMachinePoolScope) instanceNamePrefix() string {
+	return machinePoolResourceName(m.IBMPowerVSMachinePool.Namespace, m.IBMPowerVSCluster.Name, m.Name(), powerVSMachinePoolNameLength) + "-"
+}
+
+// templateHash returns the hash of the current template, which is part of the names of up to date instances.
+// The bootstrap data is not part of the hash, new instances are created with the current bootstrap data
+// but rotating it doesn't replace the existing instances.
+func (m *PowerVSMachinePoolScope) templateHash() (string, error) {
+	// The providerID is set per instance and is not part of the template.
+	spec := m.IBMPowerVSMachinePool.Spec.Template.Spec.DeepCopy()
+	spec.ProviderID = nil
+	return templateHash(spec)
+}
+
+// poolInstance is an instance which belongs to the machine pool.
+type poolInstance struct {
+	*models.PVMInstanceReference
+	upToDate bool
+	deleting bool
+}
+
+// listInstances returns the instances of the machine pool, which are the ones named after the pool with a template hash and random suffix.
+func (m *PowerVSMachinePoolScope) listInstances(hash string) ([]poolInstance, error) {
+	instances, err := m.IBMPowerVSClient.GetAllInstance()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PowerVS instances: %w", err)
+	}
+	prefix := m.instanceNamePrefix()
+	deleting := map[string]bool{}
+	for _, instance := range m.IBMPowerVSMachinePool.Status.Instances {
+		if instance.Deleting {
+			deleting[instance.InstanceID] = true
+		}
+	}
+	var result []poolInstance
+	for _, instance := range instances.PvmInstances {
+		if instance == nil || instance.ServerName == nil || !strings.HasPrefix(*instance.ServerName, prefix) {
+			continue
+		}
+		// The name is <prefix><hash>-<suffix>, ignore other instances sharing the prefix.
+		rest := strings.TrimPrefix(*instance.ServerName, prefix)
+		if len(rest) != templateHashLength+1+powerVSMachinePoolSuffixLength || rest[templateHashLength] != '-' {
+			continue
+		}
+		result = append(result, poolInstance{
+			PVMInstanceReference: instance,
+			upToDate:             hash != "" && strings.HasPrefix(rest, hash),
+			deleting:             instance.PvmInstanceID != nil && deleting[*instance.PvmInstanceID],
+		})
+	}
+	// Sort by name, to process the instances in the same order on each reconciliation.
+	slices.SortFunc(result, func(a, b poolInstance) int {
+		return strings.Compare(*a.ServerName, *b.ServerName)
+	})
+	return result, nil
+}
+
+// ReconcileInstances creates and deletes instances to match the desired replicas and template, and updates the status of the instances.
+// When the template changed, a new instance is created before deleting an outdated one, once all up to date instances are active.
+// It returns true when the instances are not yet up to date and ready.
+func (m *PowerVSMachinePoolScope) ReconcileInstances(ctx context.Context) (bool, error) { //nolint:gocyclo
+	rawUserData, err := getMachinePoolBootstrapData(ctx, m.Client, m.MachinePool)
+	if err != nil {
+		return false, err
+	}
+	hash, err := m.templateHash()
+	if err != nil {
+		return false, fmt.Errorf("failed to compute template hash: %w", err)
+	}
+	instances, err := m.listInstances(hash)
+	if err != nil {
+		return false, err
+	}
+
+	if err := m.setInstancesStatus(instances); err != nil {
+		return false, err
+	}
+
+	desired := m.DesiredReplicas()
+	var upToDate, outdated []poolInstance
+	allUpToDateActive := true
+	anyDeleting := false
+	for _, instance := range instances {
+		if instance.deleting {
+			anyDeleting = true
+		}
+		if !instance.upToDate {
+			outdated = append(outdated, instance)
+			continue
+		}
+		upToDate = append(upToDate, instance)
+		if ptr.Deref(instance.Status, "") != string(infrav1.PowerVSInstanceStateACTIVE) {
+			allUpToDateActive = false
+		}
+	}
+
+	// Create the missing up to date instances, with at most one extra instance while outdated instances remain.
+	toCreate := desired - len(upToDate)
+	if len(outdated) > 0 {
+		toCreate = min(toCreate, desired+1-len(instances))
+	}
+	if toCreate > 0 {
+		for range toCreate {
+			name := fmt.Sprintf("%s%s-%s", m.instanceNamePrefix(), hash, utilrand.String(powerVSMachinePoolSuffixLength))
+			if err := m.createInstance(ctx, name, rawUserData); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+
+	if len(instances) > desired {
+		// Wait for the new instances to be active and the previously deleted instance to be gone before removing any other instance.
+		if !allUpToDateActive || anyDeleting {
+			return true, nil
+		}
+		remove := append(outdated, upToDate...)[0]
+		if err := m.deleteInstance(ctx, remove); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	return len(outdated) > 0 || !allUpToDateActive, nil
+}
+
+func (m *PowerVSMachinePoolScope) setInstancesStatus(instances []poolInstance) error {
+	statuses := make([]infrav1.IBMPowerVSMachinePoolInstanceStatus, 0, len(instances))
+	providerIDs := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		providerID, err := m.providerID(*instance.PvmInstanceID)
+		if err != nil {
+			return err
+		}
+		status := infrav1.IBMPowerVSMachinePoolInstanceStatus{
+			InstanceID:    *instance.PvmInstanceID,
+			Name:          *instance.ServerName,
+			ProviderID:    providerID,
+			InstanceState: infrav1.PowerVSInstanceState(ptr.Deref(instance.Status, "")),
+			Addresses:     powerVSInstanceAddresses(instance.PVMInstanceReference),
+			UpToDate:      instance.upToDate,
+			Deleting:      instance.deleting,
+		}
+		if instance.Health != nil {
+			status.Health = instance.Health.Status
+		}
+		statuses = append(statuses, status)
+		providerIDs = append(providerIDs, providerID)
+	}
+	m.IBMPowerVSMachinePool.Status.Instances = statuses
+	m.IBMPowerVSMachinePool.Status.Replicas = int32(len(statuses)) //nolint:gosec
+	m.IBMPowerVSMachinePool.Spec.ProviderIDList = providerIDs
+	return nil
+}
+
+func (m *PowerVSMachinePoolScope) createInstance(ctx context.Context, name, rawUserData string) error {
+	log := ctrl.LoggerFrom(ctx)
+	spec := m.IBMPowerVSMachinePool.Spec.Template.Spec
+	lookup := &PowerVSMachineScope{IBMPowerVSClient: m.IBMPowerVSClient}
+
+	var processors float64
+	switch spec.Processors.Type {
+	case intstr.Int:
+		processors = float64(spec.Processors.IntVal)
+	case intstr.String:
+		var err error
+		processors, err = strconv.ParseFloat(spec.Processors.StrVal, 64)
+		if err != nil {
+			return fmt.Errorf("failed to convert Processors(%s) to float64", spec.Processors.StrVal)
+		}
+	}
+	memory := float64(spec.MemoryGiB)
+
+	if spec.Image == nil {
+		return fmt.Errorf("error image is empty for machine pool %s", m.Name())
+	}
+	imageID, err := getImageID(spec.Image, lookup)
+	if err != nil {
+		record.Warnf(m.IBMPowerVSMachinePool, "FailedRetriveImage", "Failed image retrival - %v", err)
+		return fmt.Errorf("error getting image ID: %v", err)
+	}
+
+	// Fixed IP addresses can't be shared by the instances of the machine pool.
+	attachments := networkAttachments(spec)
+	for i := range attachments {
+		attachments[i].IPAddress = nil
+	}
+	networks, err := getInstanceNetworks(attachments, m.IBMPowerVSCluster, lookup)
+	if err != nil {
+		record.Warnf(m.IBMPowerVSMachinePool, "FailedRetrieveNetwork", "Failed network retrieval - %v", err)
+		return fmt.Errorf("error getting network ID: %v", err)
+	}
+
+	body := &models.PVMInstanceCreate{
+		ImageID:    imageID,
+		Networks:   networks,
+		ServerName: ptr.To(name),
+		Memory:     &memory,
+		Processors: &processors,
+		ProcType:   ptr.To(strings.ToLower(string(spec.ProcessorType))),
+		SysType:    spec.SystemType,
+		UserData:   base64.StdEncoding.EncodeToString([]byte(rawUserData)),
+	}
+	if spec.SSHKey != "" {
+		body.KeyPairName = spec.SSHKey
+	}
+	if spec.PlacementGroup != nil {
+		placementGroupID, err := getPlacementGroupID(spec.PlacementGroup, m.IBMPowerVSCluster, lookup)
+		if err != nil {
+			record.Warnf(m.IBMPowerVSMachinePool, "FailedRetrievePlacementGroup", "Failed placement group retrieval - %v", err)
+			return fmt.Errorf("error getting placement group ID: %w", err)
+		}
+		body.PlacementGroup = *placementGroupID
+	}
+	if spec.SharedProcessorPool != nil {
+		sharedProcessorPoolID, err := getSharedProcessorPoolID(*spec.SharedProcessorPool, lookup)
+		if err != nil {
+			record.Warnf(m.IBMPowerVSMachinePool, "FailedRetrieveSharedProcessorPool", "Failed shared processor pool retrieval - %v", err)
+			return fmt.Errorf("error getting shared processor pool ID: %w", err)
+		}
+		body.SharedProcessorPool = *sharedProcessorPoolID
+	}
+	setInstancePlacement(body, spec)
+
+	log.Info("Creating PowerVS machine pool instance", "name", name)
+	if _, err := createInstance(m.IBMPowerVSClient, body, spec.Profile); err != nil {
+		record.Warnf(m.IBMPowerVSMachinePool, "FailedCreateInstance", "Failed instance creation - %v", err)
+		return fmt.Errorf("failed to create instance %s: %w", name, err)
+	}
+	record.Eventf(m.IBMPowerVSMachinePool, "SuccessfulCreateInstance", "Created Instance %q", name)
+	return nil
+}
+
+// DeleteInstances deletes all the instances of the machine pool.
+// It returns true while instances of the machine pool still exist.
+func (m *PowerVSMachinePoolScope) DeleteInstances(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	instances, err := m.listInstances("")
+	if err != nil {
+		return false, err
+	}
+	if err := m.setInstancesStatus(instances); err != nil {
+		return false, err
+	}
+	if len(instances) == 0 {
+		return false, nil
+	}
+
+	var errs []error
+	for _, instance := range instances {
+		if instance.deleting {
+			log.V(3).Info("Waiting for PowerVS machine pool instance to be deleted", "name", *instance.ServerName)
+			continue
+		}
+		if err := m.deleteInstance(ctx, instance); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return true, errors.Join(errs...)
+}
+
+// deleteInstance deletes an instance of the machine pool and records in its status that its deletion was requested.
+func (m *PowerVSMachinePoolScope) deleteInstance(ctx context.Context, instance poolInstance) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Deleting PowerVS machine pool instance", "name", *instance.ServerName, "upToDate", instance.upToDate)
+	if err := m.IBMPowerVSClient.DeleteInstance(*instance.PvmInstanceID); err != nil {
+		record.Warnf(m.IBMPowerVSMachinePool, "FailedDeleteInstance", "Failed instance deletion - %v", err)
+		return fmt.Errorf("failed to delete instance %s: %w", *instance.ServerName, err)
+	}
+	record.Eventf(m.IBMPowerVSMachinePool, "SuccessfulDeleteInstance", "Deleted Instance %q", *instance.ServerName)
+	for i := range m.IBMPowerVSMachinePool.Status.Instances {
+		if m.IBMPowerVSMachinePool.Status.Instances[i].InstanceID == *instance.PvmInstanceID {
+			m.IBMPowerVSMachinePool.Status.Instances[i].Deleting = true
+		}
+	}
+	return nil
+}
+
+// SetReady sets the machine pool status as ready.
+func (m *PowerVSMachinePoolScope) SetReady() {
+	m.IBMPowerVSMachinePool.Status.Ready = true
+}
+
+// SetNotReady sets the machine pool status as not ready.
+func (m *PowerVSMachinePoolScope) SetNotReady() {
+	m.IBMPowerVSMachinePool.Status.Ready = false
+}
+
+func (m *PowerVSMachinePoolScope) providerID(instanceID string) (string, error) {
+	if options.ProviderIDFormatType(options.ProviderIDFormat) != options.ProviderIDFormatV2 {
+		return "", fmt.Errorf("invalid value for ProviderIDFormat")
+	}
+	return fmt.Sprintf("ibmpowervs://%s/%s/%s/%s", ptr.Deref(m.IBMPowerVSMachinePool.Status.Region, ""), ptr.Deref(m.IBMPowerVSMachinePool.Status.Zone, ""), m.serviceInstanceID, instanceID), nil
+}
+
+func powerVSInstanceAddresses(instance *models.PVMInstanceReference) []corev1.NodeAddress {
+	addresses := []corev1.NodeAddress{
+		{
+			Type:    corev1.NodeInternalDNS,
+			Address: *instance.ServerName,
+		},
+		{
+			Type:    corev1.NodeHostName,
+			Address: *instance.ServerName,
+		},
+	}
+	for _, network := range instance.Networks {
+		if network == nil {
+			continue
+		}
+		if strings.TrimSpace(network.IPAddress) != "" {
+			addresses = append(addresses, corev1.NodeAddress{
+				Type:    corev1.NodeInternalIP,
+				Address: strings.TrimSpace(network.IPAddress),
+			})
+		}
+		if strings.TrimSpace(network.ExternalIP) != "" {
+			addresses = append(addresses, corev1.NodeAddress{
+				Type:    corev1.NodeExternalIP,
+				Address: strings.TrimSpace(network.ExternalIP),
+			})
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,343 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
+
+	. "github.com/onsi/gomega"
+)
+
+func setupPowerVSMachinePoolScope(clusterName, machinePoolName string, replicas int32, mockpowervs *mock.MockPowerVS) *PowerVSMachinePoolScope {
+	cluster := newCluster(clusterName)
+	machinePool := newMachinePool(clusterName, machinePoolName, replicas)
+	secret := newBootstrapSecret(clusterName, machinePoolName)
+	powerVSCluster := newPowerVSCluster(clusterName)
+	powerVSMachinePool := &infrav1.IBMPowerVSMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      machinePoolName,
+			Namespace: "default",
+		},
+		Spec: infrav1.IBMPowerVSMachinePoolSpec{
+			Template: infrav1.IBMPowerVSMachineTemplateResource{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					Image:      &infrav1.IBMPowerVSResourceReference{ID: ptr.To("image-id")},
+					Network:    infrav1.IBMPowerVSResourceReference{ID: ptr.To("network-id")},
+					Processors: intstr.FromString("0.5"),
+					MemoryGiB:  4,
+				},
+			},
+		},
+		Status: infrav1.IBMPowerVSMachinePoolStatus{
+			Region: ptr.To("osa"),
+			Zone:   ptr.To("osa21"),
+		},
+	}
+
+	initObjects := []client.Object{cluster, machinePool, secret, powerVSCluster, powerVSMachinePool}
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(initObjects...).Build()
+	return &PowerVSMachinePoolScope{
+		Client:                client,
+		IBMPowerVSClient:      mockpowervs,
+		Cluster:               cluster,
+		MachinePool:           machinePool,
+		IBMPowerVSCluster:     powerVSCluster,
+		IBMPowerVSMachinePool: powerVSMachinePool,
+		serviceInstanceID:     "service-instance-id",
+	}
+}
+
+func newPowerVSMachinePoolInstance(scope *PowerVSMachinePoolScope, hash, suffix, status string) *models.PVMInstanceReference {
+	return &models.PVMInstanceReference{
+		PvmInstanceID: ptr.To(fmt.Sprintf("%s-id", suffix)),
+		ServerName:    ptr.To(fmt.Sprintf("%s%s-%s", scope.instanceNamePrefix(), hash, suffix)),
+		Status:        ptr.To(status),
+	}
+}
+
+func TestReconcilePowerVSMachinePoolInstances(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+	const outdatedHash = "00000000"
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		options.ProviderIDFormat = string(options.ProviderIDFormatV2)
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Creates the missing instances", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 2, mockpowervs)
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{}, nil)
+		mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&models.PVMInstanceCreate{})).Return(&models.PVMInstanceList{}, nil).Times(2)
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Instances are up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 2, mockpowervs)
+		hash, err := scope.templateHash()
+		g.Expect(err).To(BeNil())
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{
+			PvmInstances: []*models.PVMInstanceReference{
+				newPowerVSMachinePoolInstance(scope, hash, "aaaaa", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(scope, hash, "bbbbb", string(infrav1.PowerVSInstanceStateACTIVE)),
+				{PvmInstanceID: ptr.To("other-id"), ServerName: ptr.To("other-instance")},
+			},
+		}, nil)
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMPowerVSMachinePool.Status.Replicas).To(Equal(int32(2)))
+		g.Expect(scope.IBMPowerVSMachinePool.Spec.ProviderIDList).To(Equal([]string{
+			"ibmpowervs://osa/osa21/service-instance-id/aaaaa-id",
+			"ibmpowervs://osa/osa21/service-instance-id/bbbbb-id",
+		}))
+	})
+
+	t.Run("Instances of a machine pool with the same name in another cluster are ignored", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 1, mockpowervs)
+		otherCluster := setupPowerVSMachinePoolScope("other-cluster", machinePoolName, 1, mockpowervs)
+		hash, err := scope.templateHash()
+		g.Expect(err).To(BeNil())
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{
+			PvmInstances: []*models.PVMInstanceReference{
+				newPowerVSMachinePoolInstance(scope, hash, "aaaaa", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(otherCluster, outdatedHash, "bbbbb", string(infrav1.PowerVSInstanceStateACTIVE)),
+			},
+		}, nil)
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMPowerVSMachinePool.Spec.ProviderIDList).To(Equal([]string{
+			"ibmpowervs://osa/osa21/service-instance-id/aaaaa-id",
+		}))
+	})
+
+	t.Run("Instances are kept when the bootstrap data changes", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 2, mockpowervs)
+		hash, err := scope.templateHash()
+		g.Expect(err).To(BeNil())
+		secret := newBootstrapSecret(clusterName, machinePoolName)
+		secret.Data["value"] = []byte("rotated user data")
+		g.Expect(scope.Client.Update(context.Background(), secret)).To(Succeed())
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{
+			PvmInstances: []*models.PVMInstanceReference{
+				newPowerVSMachinePoolInstance(scope, hash, "aaaaa", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(scope, hash, "bbbbb", string(infrav1.PowerVSInstanceStateACTIVE)),
+			},
+		}, nil)
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMPowerVSMachinePool.Status.Instances[0].UpToDate).To(BeTrue())
+	})
+
+	t.Run("Creates one new instance when the template changed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 2, mockpowervs)
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{
+			PvmInstances: []*models.PVMInstanceReference{
+				newPowerVSMachinePoolInstance(scope, outdatedHash, "aaaaa", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(scope, outdatedHash, "bbbbb", string(infrav1.PowerVSInstanceStateACTIVE)),
+			},
+		}, nil)
+		mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&models.PVMInstanceCreate{})).Return(&models.PVMInstanceList{}, nil).Times(1)
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Waits for the new instance to be active before deleting an outdated instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 2, mockpowervs)
+		hash, err := scope.templateHash()
+		g.Expect(err).To(BeNil())
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{
+			PvmInstances: []*models.PVMInstanceReference{
+				newPowerVSMachinePoolInstance(scope, outdatedHash, "aaaaa", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(scope, outdatedHash, "bbbbb", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(scope, hash, "ccccc", string(infrav1.PowerVSInstanceStateBUILD)),
+			},
+		}, nil)
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Deletes an outdated instance once the new instance is active", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 2, mockpowervs)
+		hash, err := scope.templateHash()
+		g.Expect(err).To(BeNil())
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{
+			PvmInstances: []*models.PVMInstanceReference{
+				newPowerVSMachinePoolInstance(scope, hash, "ccccc", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(scope, outdatedHash, "aaaaa", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(scope, outdatedHash, "bbbbb", string(infrav1.PowerVSInstanceStateACTIVE)),
+			},
+		}, nil)
+		mockpowervs.EXPECT().DeleteInstance("aaaaa-id").Return(nil)
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSMachinePool.Status.Instances[0].Name).To(HaveSuffix("aaaaa"))
+		g.Expect(scope.IBMPowerVSMachinePool.Status.Instances[0].Deleting).To(BeTrue())
+	})
+
+	t.Run("Waits for the deleted instance to be gone before deleting another instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 1, mockpowervs)
+		hash, err := scope.templateHash()
+		g.Expect(err).To(BeNil())
+		scope.IBMPowerVSMachinePool.Status.Instances = []infrav1.IBMPowerVSMachinePoolInstanceStatus{
+			{InstanceID: "aaaaa-id", Deleting: true},
+		}
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{
+			PvmInstances: []*models.PVMInstanceReference{
+				newPowerVSMachinePoolInstance(scope, outdatedHash, "aaaaa", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(scope, outdatedHash, "bbbbb", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(scope, hash, "ccccc", string(infrav1.PowerVSInstanceStateACTIVE)),
+			},
+		}, nil)
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSMachinePool.Status.Instances[0].Deleting).To(BeTrue())
+	})
+
+	t.Run("Failed to create instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 1, mockpowervs)
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{}, nil)
+		mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&models.PVMInstanceCreate{})).Return(nil, errors.New("failed to create instance"))
+		_, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestDeletePowerVSMachinePoolInstances(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		options.ProviderIDFormat = string(options.ProviderIDFormatV2)
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Deletes all the instances", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 2, mockpowervs)
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{
+			PvmInstances: []*models.PVMInstanceReference{
+				newPowerVSMachinePoolInstance(scope, "00000000", "aaaaa", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(scope, "11111111", "bbbbb", string(infrav1.PowerVSInstanceStateACTIVE)),
+			},
+		}, nil)
+		mockpowervs.EXPECT().DeleteInstance(gomock.Any()).Return(nil).Times(2)
+		requeue, err := scope.DeleteInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Instances being deleted are not deleted again", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 2, mockpowervs)
+		scope.IBMPowerVSMachinePool.Status.Instances = []infrav1.IBMPowerVSMachinePoolInstanceStatus{
+			{InstanceID: "aaaaa-id", Deleting: true},
+		}
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{
+			PvmInstances: []*models.PVMInstanceReference{
+				newPowerVSMachinePoolInstance(scope, "00000000", "aaaaa", string(infrav1.PowerVSInstanceStateACTIVE)),
+				newPowerVSMachinePoolInstance(scope, "11111111", "bbbbb", string(infrav1.PowerVSInstanceStateACTIVE)),
+			},
+		}, nil)
+		mockpowervs.EXPECT().DeleteInstance("bbbbb-id").Return(nil)
+		requeue, err := scope.DeleteInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSMachinePool.Status.Instances).To(HaveEach(HaveField("Deleting", BeTrue())))
+	})
+
+	t.Run("No instances left", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachinePoolScope(clusterName, machinePoolName, 2, mockpowervs)
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{}, nil)
+		requeue, err := scope.DeleteInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
@@ -0,0 +1,382 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
+)
+
+const (
+	// powerVSCaptureDestination is the destination of the instances captured for a snapshot, the image catalog of the workspace.
+	powerVSCaptureDestination = "image-catalog"
+	// powerVSSnapshotDeleting is the status of a snapshot being deleted.
+	powerVSSnapshotDeleting = "deleting"
+)
+
+var (
+	// ErrSnapshotFailed indicates the PowerVS snapshot of the volumes of an instance failed.
+	ErrSnapshotFailed = errors.New("snapshot failed")
+	// ErrImageCaptureFailed indicates the capture of an instance to the image catalog failed.
+	ErrImageCaptureFailed = errors.New("image capture failed")
+)
+
+// PowerVSMachineSnapshotScopeParams defines the input parameters used to create a new PowerVSMachineSnapshotScope.
+type PowerVSMachineSnapshotScopeParams struct {
+	Logger                    logr.Logger
+	Client                    client.Client
+	IBMPowerVSCluster         *infrav1.IBMPowerVSCluster
+	IBMPowerVSMachine         *infrav1.IBMPowerVSMachine
+	IBMPowerVSMachineSnapshot *infrav1.IBMPowerVSMachineSnapshot
+	ServiceEndpoint           []endpoints.ServiceEndpoint
+}
+
+// PowerVSMachineSnapshotScope defines a scope defined around a snapshot of a Power VS machine.
+type PowerVSMachineSnapshotScope struct {
+	Client client.Client
+
+	IBMPowerVSClient          powervs.PowerVS
+	ResourceClient            resourcecontroller.ResourceController
+	IBMPowerVSCluster         *infrav1.IBMPowerVSCluster
+	IBMPowerVSMachine         *infrav1.IBMPowerVSMachine
+	IBMPowerVSMachineSnapshot *infrav1.IBMPowerVSMachineSnapshot
+	ServiceEndpoint           []endpoints.ServiceEndpoint
+}
+
+// NewPowerVSMachineSnapshotScope creates a new PowerVSMachineSnapshotScope from the supplied parameters.
+// The IBMPowerVSMachine is only required until the snapshot is created, the snapshot is then tracked with the ID in its status.
+// The IBMPowerVSCluster is only optional when the snapshot is being deleted and it no longer exists,
+// the snapshot is then deleted in the workspace recorded in its status with the credentials of the manager.
+func NewPowerVSMachineSnapshotScope(ctx context.Context, params PowerVSMachineSnapshotScopeParams) (*PowerVSMachineSnapshotScope, error) {
+	if params.Client == nil {
+		return nil, errors.New("client is required when creating a MachineSnapshotScope")
+	}
+	if params.IBMPowerVSMachineSnapshot == nil {
+		return nil, errors.New("ibmPowerVSMachineSnapshot is required when creating a MachineSnapshotScope")
+	}
+	if params.IBMPowerVSMachineSnapshot.DeletionTimestamp.IsZero() {
+		if params.IBMPowerVSMachine == nil && params.IBMPowerVSMachineSnapshot.Status.SnapshotID == "" {
+			return nil, errors.New("ibmPowerVSMachine is required when creating a MachineSnapshotScope")
+		}
+		if params.IBMPowerVSCluster == nil {
+			return nil, errors.New("ibmPowerVSCluster is required when creating a MachineSnapshotScope")
+		}
+	}
+
+	if params.Logger == (logr.Logger{}) {
+		params.Logger = klog.Background()
+	}
+	if params.Logger.V(DEBUGLEVEL).Enabled() {
+		core.SetLoggingLevel(core.LevelDebug)
+	}
+
+	scope := &PowerVSMachineSnapshotScope{
+		Client:                    params.Client,
+		IBMPowerVSCluster:         params.IBMPowerVSCluster,
+		IBMPowerVSMachine:         params.IBMPowerVSMachine,
+		IBMPowerVSMachineSnapshot: params.IBMPowerVSMachineSnapshot,
+		ServiceEndpoint:           params.ServiceEndpoint,
+	}
+
+	var identityAuthenticator core.Authenticator
+	if params.IBMPowerVSCluster != nil && params.IBMPowerVSCluster.Spec.IdentityRef != nil {
+		var err error
+		identityAuthenticator, err = GetAuthenticator(ctx, params.Client, params.IBMPowerVSCluster.Namespace, params.IBMPowerVSCluster.Spec.IdentityRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create authenticator: %w", err)
+		}
+	}
+
+	// Create Resource Controller client.
+	serviceOption := resourcecontroller.ServiceOptions{
+		ResourceControllerV2Options: &resourcecontrollerv2.ResourceControllerV2Options{
+			Authenticator: identityAuthenticator,
+		},
+	}
+	// Fetch the resource controller endpoint.
+	if rcEndpoint := endpoints.FetchEndpoints(string(endpoints.RC), params.ServiceEndpoint); rcEndpoint != "" {
+		serviceOption.URL = rcEndpoint
+		params.Logger.V(3).Info("Overriding the default resource controller endpoint", "resourceControllerEndpoint", rcEndpoint)
+	}
+	rc, err := resourcecontroller.NewService(serviceOption)
+	if err != nil {
+		return nil, err
+	}
+	scope.ResourceClient = rc
+
+	// The snapshot is taken in the workspace of the instance of the machine.
+	var serviceInstanceID, serviceInstanceName string
+	var zone *string
+	if params.IBMPowerVSCluster != nil {
+		zone = params.IBMPowerVSCluster.Spec.Zone
+	}
+	switch {
+	case params.IBMPowerVSMachineSnapshot.Status.ServiceInstanceID != "":
+		serviceInstanceID = params.IBMPowerVSMachineSnapshot.Status.ServiceInstanceID
+	case params.IBMPowerVSMachine != nil && params.IBMPowerVSMachine.Spec.ServiceInstanceID != "":
+		serviceInstanceID = params.IBMPowerVSMachine.Spec.ServiceInstanceID
+	case params.IBMPowerVSMachine != nil && params.IBMPowerVSMachine.Spec.ServiceInstance != nil && params.IBMPowerVSMachine.Spec.ServiceInstance.ID != nil:
+		serviceInstanceID = *params.IBMPowerVSMachine.Spec.ServiceInstance.ID
+	case params.IBMPowerVSCluster == nil:
+		return nil, errors.New("failed to find the PowerVS service instance of the snapshot as the IBMPowerVSCluster does not exist")
+	case params.IBMPowerVSCluster.Status.ServiceInstance != nil && params.IBMPowerVSCluster.Status.ServiceInstance.ID != nil:
+		serviceInstanceID = *params.IBMPowerVSCluster.Status.ServiceInstance.ID
+	case params.IBMPowerVSCluster.Spec.ServiceInstanceID != "":
+		serviceInstanceID = params.IBMPowerVSCluster.Spec.ServiceInstanceID
+	default:
+		serviceInstanceName = fmt.Sprintf("%s-%s", params.IBMPowerVSCluster.GetName(), "serviceInstance")
+		if params.IBMPowerVSCluster.Spec.ServiceInstance != nil && params.IBMPowerVSCluster.Spec.ServiceInstance.Name != nil {
+			serviceInstanceName = *params.IBMPowerVSCluster.Spec.ServiceInstance.Name
+		}
+	}
+	serviceInstance, err := rc.GetServiceInstance(serviceInstanceID, serviceInstanceName, zone)
+	if err != nil {
+		params.Logger.Error(err, "failed to get PowerVS service instance details", "serviceInstanceName", serviceInstanceName, "serviceInstanceID", serviceInstanceID)
+		return nil, err
+	}
+	if serviceInstance == nil {
+		return nil, fmt.Errorf("PowerVS service instance %s is not yet created", serviceInstanceName)
+	}
+	if *serviceInstance.State != string(infrav1.ServiceInstanceStateActive) {
+		return nil, fmt.Errorf("PowerVS service instance name: %s id: %s is not in active state", serviceInstanceName, serviceInstanceID)
+	}
+	scope.IBMPowerVSMachineSnapshot.Status.ServiceInstanceID = *serviceInstance.GUID
+
+	serviceOptions := powervs.ServiceOptions{
+		IBMPIOptions: &ibmpisession.IBMPIOptions{
+			Authenticator: identityAuthenticator,
+			Debug:         params.Logger.V(DEBUGLEVEL).Enabled(),
+			Zone:          *serviceInstance.RegionID,
+		},
+		CloudInstanceID: *serviceInstance.GUID,
+	}
+	// Fetch the service endpoint.
+	if svcEndpoint := endpoints.FetchPVSEndpoint(endpoints.ConstructRegionFromZone(*serviceInstance.RegionID), params.ServiceEndpoint); svcEndpoint != "" {
+		serviceOptions.IBMPIOptions.URL = svcEndpoint
+		params.Logger.V(3).Info("Overriding the default PowerVS service endpoint", "serviceEndpoint", svcEndpoint)
+	}
+	c, err := powervs.NewService(serviceOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PowerVS service")
+	}
+	c.WithClients(serviceOptions)
+	scope.IBMPowerVSClient = c
+
+	return scope, nil
+}
+
+// ReconcileSnapshot creates the snapshot of the volumes of the instance of the machine and, when requested, captures the instance to an image.
+// It returns true when the snapshot or the capture is still in progress.
+func (s *PowerVSMachineSnapshotScope) ReconcileSnapshot(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	snapshotStatus := &s.IBMPowerVSMachineSnapshot.Status
+
+	if snapshotStatus.SnapshotID == "" {
+		snapshotID, err := s.createSnapshot(ctx)
+		if err != nil {
+			record.Warnf(s.IBMPowerVSMachineSnapshot, "FailedCreateSnapshot", "Failed snapshot creation - %v", err)
+			return false, fmt.Errorf("failed to create snapshot: %w", err)
+		}
+		snapshotStatus.SnapshotID = snapshotID
+		snapshotStatus.InstanceID = s.IBMPowerVSMachine.Status.InstanceID
+		record.Eventf(s.IBMPowerVSMachineSnapshot, "SuccessfulCreateSnapshot", "Created snapshot %q of instance %q", snapshotID, snapshotStatus.InstanceID)
+		return true, nil
+	}
+
+	snapshot, err := s.IBMPowerVSClient.GetSnapshot(snapshotStatus.SnapshotID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get snapshot %s: %w", snapshotStatus.SnapshotID, err)
+	}
+	s.setSnapshotStatus(snapshot)
+
+	switch infrav1.PowerVSSnapshotState(snapshot.Status) {
+	case infrav1.PowerVSSnapshotStateAvailable:
+	case infrav1.PowerVSSnapshotStateError:
+		return false, fmt.Errorf("%w: %s", ErrSnapshotFailed, snapshot.StatusDetail)
+	default:
+		log.V(3).Info("Snapshot is not yet available", "snapshotID", snapshotStatus.SnapshotID, "state", snapshot.Status, "percentComplete", snapshot.PercentComplete)
+		return true, nil
+	}
+
+	if !s.IBMPowerVSMachineSnapshot.Spec.CaptureImage || snapshotStatus.ImageID != "" {
+		return false, nil
+	}
+	return s.reconcileImageCapture(ctx)
+}
+
+// createSnapshot creates the snapshot of the instance of the machine, or returns the id of the snapshot with the name of the IBMPowerVSMachineSnapshot.
+func (s *PowerVSMachineSnapshotScope) createSnapshot(ctx context.Context) (string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	instanceID := s.IBMPowerVSMachine.Status.InstanceID
+	name := s.IBMPowerVSMachineSnapshot.Name
+
+	snapshots, err := s.IBMPowerVSClient.GetAllSnapshots()
+	if err != nil {
+		return "", fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	for _, snapshot := range snapshots.Snapshots {
+		if ptr.Deref(snapshot.Name, "") == name && ptr.Deref(snapshot.PvmInstanceID, "") == instanceID {
+			log.Info("Snapshot already exists", "snapshotID", *snapshot.SnapshotID)
+			return *snapshot.SnapshotID, nil
+		}
+	}
+
+	log.Info("Creating snapshot", "instanceID", instanceID, "volumeIDs", s.IBMPowerVSMachineSnapshot.Spec.VolumeIDs)
+	response, err := s.IBMPowerVSClient.CreateInstanceSnapshot(instanceID, &models.SnapshotCreate{
+		Name:        &name,
+		Description: s.IBMPowerVSMachineSnapshot.Spec.Description,
+		VolumeIDs:   s.IBMPowerVSMachineSnapshot.Spec.VolumeIDs,
+	})
+	if err != nil {
+		return "", err
+	}
+	return *response.SnapshotID, nil
+}
+
+// setSnapshotStatus updates the status of the IBMPowerVSMachineSnapshot with the progress of the snapshot.
+func (s *PowerVSMachineSnapshotScope) setSnapshotStatus(snapshot *models.Snapshot) {
+	snapshotStatus := &s.IBMPowerVSMachineSnapshot.Status
+	snapshotStatus.State = infrav1.PowerVSSnapshotState(snapshot.Status)
+	snapshotStatus.PercentComplete = snapshot.PercentComplete
+	snapshotStatus.VolumeSnapshots = snapshot.VolumeSnapshots
+
+	if snapshotStatus.CreationTime == nil && !time.Time(snapshot.CreationDate).IsZero() {
+		snapshotStatus.CreationTime = &metav1.Time{Time: time.Time(snapshot.CreationDate)}
+	}
+	if retention := s.IBMPowerVSMachineSnapshot.Spec.RetentionPeriod; retention != nil && snapshotStatus.CreationTime != nil {
+		snapshotStatus.ExpirationTime = &metav1.Time{Time: snapshotStatus.CreationTime.Add(retention.Duration)}
+	}
+}
+
+// reconcileImageCapture captures the instance of the machine to the image catalog and sets the id of the image.
+func (s *PowerVSMachineSnapshotScope) reconcileImageCapture(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	snapshotStatus := &s.IBMPowerVSMachineSnapshot.Status
+	name := s.IBMPowerVSMachineSnapshot.Name
+
+	if snapshotStatus.CaptureJobID == "" {
+		log.Info("Capturing instance to image", "instanceID", snapshotStatus.InstanceID, "imageName", name)
+		job, err := s.IBMPowerVSClient.CaptureInstance(snapshotStatus.InstanceID, &models.PVMInstanceCapture{
+			CaptureName:        &name,
+			CaptureDestination: ptr.To(powerVSCaptureDestination),
+			CaptureVolumeIDs:   s.IBMPowerVSMachineSnapshot.Spec.VolumeIDs,
+		})
+		if err != nil {
+			record.Warnf(s.IBMPowerVSMachineSnapshot, "FailedCaptureInstance", "Failed instance capture - %v", err)
+			return false, fmt.Errorf("failed to capture instance %s: %w", snapshotStatus.InstanceID, err)
+		}
+		snapshotStatus.CaptureJobID = *job.ID
+		return true, nil
+	}
+
+	job, err := s.IBMPowerVSClient.GetJob(snapshotStatus.CaptureJobID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get capture job %s: %w", snapshotStatus.CaptureJobID, err)
+	}
+	switch infrav1.PowerVSImageState(ptr.Deref(job.Status.State, "")) {
+	case infrav1.PowerVSImageStateCompleted:
+	case infrav1.PowerVSImageStateFailed:
+		return false, fmt.Errorf("%w: %s", ErrImageCaptureFailed, job.Status.Message)
+	default:
+		log.V(3).Info("Capture of the instance is in progress", "jobID", snapshotStatus.CaptureJobID, "state", ptr.Deref(job.Status.State, ""))
+		return true, nil
+	}
+
+	images, err := s.IBMPowerVSClient.GetAllImage()
+	if err != nil {
+		return false, fmt.Errorf("failed to list images: %w", err)
+	}
+	for _, image := range images.Images {
+		if ptr.Deref(image.Name, "") == name {
+			snapshotStatus.ImageID = *image.ImageID
+			record.Eventf(s.IBMPowerVSMachineSnapshot, "SuccessfulCaptureInstance", "Captured instance %q to image %q", snapshotStatus.InstanceID, snapshotStatus.ImageID)
+			return false, nil
+		}
+	}
+	return false, fmt.Errorf("%w: image %s not found after the capture job completed", ErrImageCaptureFailed, name)
+}
+
+// Expired returns true when the retention period of the snapshot has passed.
+func (s *PowerVSMachineSnapshotScope) Expired() bool {
+	expirationTime := s.IBMPowerVSMachineSnapshot.Status.ExpirationTime
+	return expirationTime != nil && !time.Now().Before(expirationTime.Time)
+}
+
+// DeleteSnapshot deletes the snapshot, the image the instance was captured to is kept.
+// It returns true while the snapshot is being deleted.
+func (s *PowerVSMachineSnapshotScope) DeleteSnapshot(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	snapshotID := s.IBMPowerVSMachineSnapshot.Status.SnapshotID
+	if snapshotID == "" {
+		log.Info("Snapshot is not yet created, hence not invoking the PowerVS API to delete the snapshot")
+		return false, nil
+	}
+
+	snapshot, err := s.IBMPowerVSClient.GetSnapshot(snapshotID)
+	if err != nil {
+		if strings.Contains(err.Error(), string(SnapshotNotFound)) {
+			log.Info("Snapshot successfully deleted", "snapshotID", snapshotID)
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get snapshot %s: %w", snapshotID, err)
+	}
+	if snapshot.Status == powerVSSnapshotDeleting {
+		log.V(3).Info("Snapshot is being deleted", "snapshotID", snapshotID)
+		return true, nil
+	}
+
+	log.Info("Deleting snapshot", "snapshotID", snapshotID)
+	if err := s.IBMPowerVSClient.DeleteSnapshot(snapshotID); err != nil {
+		record.Warnf(s.IBMPowerVSMachineSnapshot, "FailedDeleteSnapshot", "Failed snapshot deletion - %v", err)
+		return false, fmt.Errorf("failed to delete snapshot %s: %w", snapshotID, err)
+	}
+	record.Eventf(s.IBMPowerVSMachineSnapshot, "SuccessfulDeleteSnapshot", "Deleted snapshot %q", snapshotID)
+	return true, nil
+}
+
+// SetReady sets the snapshot status as ready.
+func (s *PowerVSMachineSnapshotScope) SetReady() {
+	s.IBMPowerVSMachineSnapshot.Status.Ready = true
+}
+
+// SetNotReady sets the snapshot status as not ready.
+func (s *PowerVSMachineSnapshotScope) SetNotReady() {
+	s.IBMPowerVSMachineSnapshot.Status.Ready = false
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/go-openapi/strfmt"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/test/fakeibmcloud"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewPowerVSMachineSnapshotScope(t *testing.T) {
+	// The clients of the scope are created against the fake IBM Cloud backend, its Power VS region matches the zone of the workspace.
+	setup := func(t *testing.T) (PowerVSMachineSnapshotScopeParams, string) {
+		t.Helper()
+		cloud := fakeibmcloud.New(fakeibmcloud.Options{Region: "dal"})
+		server := cloud.NewServer()
+		t.Cleanup(server.Close)
+		for key, value := range cloud.Environment(server.URL) {
+			t.Setenv(key, value)
+		}
+		serviceEndpoint, err := endpoints.ParseServiceEndpointFlag(cloud.ServiceEndpoints(server.URL))
+		NewWithT(t).Expect(err).ToNot(HaveOccurred())
+		serviceInstanceID := cloud.AddPowerVSWorkspace("workspace", "dal10")
+
+		return PowerVSMachineSnapshotScopeParams{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"},
+			},
+			IBMPowerVSMachineSnapshot: &infrav1.IBMPowerVSMachineSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: "snapshot", Namespace: "default"},
+				Spec:       infrav1.IBMPowerVSMachineSnapshotSpec{MachineName: "machine"},
+				Status:     infrav1.IBMPowerVSMachineSnapshotStatus{ServiceInstanceID: serviceInstanceID},
+			},
+			ServiceEndpoint: serviceEndpoint,
+		}, serviceInstanceID
+	}
+
+	t.Run("Should create the scope without the IBMPowerVSMachine once the snapshot is created", func(t *testing.T) {
+		g := NewWithT(t)
+		params, serviceInstanceID := setup(t)
+		params.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+
+		scope, err := NewPowerVSMachineSnapshotScope(ctx, params)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(scope.IBMPowerVSMachine).To(BeNil())
+		g.Expect(scope.IBMPowerVSClient).ToNot(BeNil())
+		g.Expect(scope.IBMPowerVSMachineSnapshot.Status.ServiceInstanceID).To(Equal(serviceInstanceID))
+	})
+
+	t.Run("Should fail without the IBMPowerVSMachine until the snapshot is created", func(t *testing.T) {
+		g := NewWithT(t)
+		params, _ := setup(t)
+
+		_, err := NewPowerVSMachineSnapshotScope(ctx, params)
+		g.Expect(err).To(MatchError(ContainSubstring("ibmPowerVSMachine is required")))
+	})
+}
+
+func setupPowerVSMachineSnapshotScope(mockpowervs *mock.MockPowerVS) *PowerVSMachineSnapshotScope {
+	return &PowerVSMachineSnapshotScope{
+		IBMPowerVSClient: mockpowervs,
+		IBMPowerVSMachine: &infrav1.IBMPowerVSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"},
+			Status:     infrav1.IBMPowerVSMachineStatus{InstanceID: "instance-id"},
+		},
+		IBMPowerVSMachineSnapshot: &infrav1.IBMPowerVSMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "snapshot", Namespace: "default"},
+			Spec:       infrav1.IBMPowerVSMachineSnapshotSpec{MachineName: "machine"},
+		},
+	}
+}
+
+func TestReconcilePowerVSMachineSnapshot(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Creates the snapshot of the volumes of the instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineSnapshotScope(mockpowervs)
+		scope.IBMPowerVSMachineSnapshot.Spec.VolumeIDs = []string{"volume-id"}
+		mockpowervs.EXPECT().GetAllSnapshots().Return(&models.Snapshots{}, nil)
+		mockpowervs.EXPECT().CreateInstanceSnapshot("instance-id", &models.SnapshotCreate{Name: ptr.To("snapshot"), VolumeIDs: []string{"volume-id"}}).Return(&models.SnapshotCreateResponse{SnapshotID: ptr.To("snapshot-id")}, nil)
+		requeue, err := scope.ReconcileSnapshot(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSMachineSnapshot.Status.SnapshotID).To(Equal("snapshot-id"))
+		g.Expect(scope.IBMPowerVSMachineSnapshot.Status.InstanceID).To(Equal("instance-id"))
+	})
+
+	t.Run("Adopts the snapshot with the same name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineSnapshotScope(mockpowervs)
+		mockpowervs.EXPECT().GetAllSnapshots().Return(&models.Snapshots{Snapshots: []*models.Snapshot{
+			{Name: ptr.To("snapshot"), SnapshotID: ptr.To("snapshot-id"), PvmInstanceID: ptr.To("instance-id")},
+		}}, nil)
+		requeue, err := scope.ReconcileSnapshot(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSMachineSnapshot.Status.SnapshotID).To(Equal("snapshot-id"))
+	})
+
+	t.Run("Tracks the progress of the snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineSnapshotScope(mockpowervs)
+		scope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(&models.Snapshot{Status: "creating", PercentComplete: 40}, nil)
+		requeue, err := scope.ReconcileSnapshot(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSMachineSnapshot.Status.State).To(Equal(infrav1.PowerVSSnapshotStateCreating))
+		g.Expect(scope.IBMPowerVSMachineSnapshot.Status.PercentComplete).To(Equal(int64(40)))
+	})
+
+	t.Run("Sets the expiration time of an available snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineSnapshotScope(mockpowervs)
+		scope.IBMPowerVSMachineSnapshot.Spec.RetentionPeriod = &metav1.Duration{Duration: time.Hour}
+		scope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		creationDate := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(&models.Snapshot{
+			Status:          "available",
+			PercentComplete: 100,
+			CreationDate:    strfmt.DateTime(creationDate),
+			VolumeSnapshots: map[string]string{"volume-id": "volume-snapshot-id"},
+		}, nil)
+		requeue, err := scope.ReconcileSnapshot(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMPowerVSMachineSnapshot.Status.VolumeSnapshots).To(HaveKeyWithValue("volume-id", "volume-snapshot-id"))
+		g.Expect(scope.IBMPowerVSMachineSnapshot.Status.ExpirationTime.Time).To(BeTemporally("==", creationDate.Add(time.Hour)))
+		g.Expect(scope.Expired()).To(BeTrue())
+	})
+
+	t.Run("Reports a failed snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineSnapshotScope(mockpowervs)
+		scope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(&models.Snapshot{Status: "error", StatusDetail: "volume is not available"}, nil)
+		_, err := scope.ReconcileSnapshot(context.Background())
+		g.Expect(errors.Is(err, ErrSnapshotFailed)).To(BeTrue())
+	})
+
+	t.Run("Captures the instance to an image once the snapshot is available", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineSnapshotScope(mockpowervs)
+		scope.IBMPowerVSMachineSnapshot.Spec.CaptureImage = true
+		scope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		scope.IBMPowerVSMachineSnapshot.Status.InstanceID = "instance-id"
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(&models.Snapshot{Status: "available"}, nil).Times(2)
+		mockpowervs.EXPECT().CaptureInstance("instance-id", &models.PVMInstanceCapture{CaptureName: ptr.To("snapshot"), CaptureDestination: ptr.To("image-catalog")}).Return(&models.JobReference{ID: ptr.To("job-id")}, nil)
+		requeue, err := scope.ReconcileSnapshot(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMPowerVSMachineSnapshot.Status.CaptureJobID).To(Equal("job-id"))
+
+		mockpowervs.EXPECT().GetJob("job-id").Return(&models.Job{Status: &models.Status{State: ptr.To("completed")}}, nil)
+		mockpowervs.EXPECT().GetAllImage().Return(&models.Images{Images: []*models.ImageReference{{Name: ptr.To("snapshot"), ImageID: ptr.To("image-id")}}}, nil)
+		requeue, err = scope.ReconcileSnapshot(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMPowerVSMachineSnapshot.Status.ImageID).To(Equal("image-id"))
+	})
+
+	t.Run("Reports a failed capture", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineSnapshotScope(mockpowervs)
+		scope.IBMPowerVSMachineSnapshot.Spec.CaptureImage = true
+		scope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		scope.IBMPowerVSMachineSnapshot.Status.CaptureJobID = "job-id"
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(&models.Snapshot{Status: "available"}, nil)
+		mockpowervs.EXPECT().GetJob("job-id").Return(&models.Job{Status: &models.Status{State: ptr.To("failed"), Message: "insufficient storage"}}, nil)
+		_, err := scope.ReconcileSnapshot(context.Background())
+		g.Expect(errors.Is(err, ErrImageCaptureFailed)).To(BeTrue())
+	})
+}
+
+func TestDeletePowerVSMachineSnapshot(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Snapshot is not yet created", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineSnapshotScope(mockpowervs)
+		requeue, err := scope.DeleteSnapshot(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Deletes the snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineSnapshotScope(mockpowervs)
+		scope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(&models.Snapshot{Status: "available"}, nil)
+		mockpowervs.EXPECT().DeleteSnapshot("snapshot-id").Return(nil)
+		requeue, err := scope.DeleteSnapshot(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Snapshot is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineSnapshotScope(mockpowervs)
+		scope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(nil, errors.New("snapshot does not exist. ID: snapshot-id"))
+		requeue, err := scope.DeleteSnapshot(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("Error deleting the snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineSnapshotScope(mockpowervs)
+		scope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(&models.Snapshot{Status: "available"}, nil)
+		mockpowervs.EXPECT().DeleteSnapshot("snapshot-id").Return(errors.New("error deleting snapshot"))
+		_, err := scope.DeleteSnapshot(context.Background())
+		g.Expect(err).ToNot(BeNil())
+	})
+}
@@ -25,4 +25,19 @@ var (
 
 	// DHCPServerNotFound is the error returned when a DHCP server is not found.
 	DHCPServerNotFound = ResourceNotFound("dhcp server does not exist")
+
+	// VolumeNotFound is the error returned when a volume is not found.
+	VolumeNotFound = ResourceNotFound("volume does not exist")
+
+	// PlacementGroupNotFound is the error returned when a placement group is not found.
+	PlacementGroupNotFound = ResourceNotFound("placement group does not exist")
+
+	// SharedProcessorPoolNotFound is the error returned when a shared processor pool is not found.
+	SharedProcessorPoolNotFound = ResourceNotFound("shared processor pool does not exist")
+
+	// SnapshotNotFound is the error returned when a snapshot is not found.
+	SnapshotNotFound = ResourceNotFound("snapshot does not exist")
+
+	// NetworkNotFound is the error returned when a network is not found.
+	NetworkNotFound = ResourceNotFound("network does not exist")
 )
@@ -20,29 +20,36 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"reflect"
+	"slices"
 
 	"github.com/go-logr/logr"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/IBM/networking-go-sdk/zonesv1"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2/textlogger"
 	"k8s.io/utils/ptr"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch" //nolint:staticcheck
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
-	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cis"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dnsservices"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
@@ -72,6 +79,9 @@ type VPCClusterScopeParams struct {
 	ServiceEndpoint []endpoints.ServiceEndpoint
 
 	IBMVPCClient vpc.Vpc
+
+	// DNSServicesFactory overrides the DNS Services client, which helps in testing.
+	DNSServicesFactory func() (dnsservices.DNSServices, error)
 }
 
 // VPCClusterScope defines a scope defined around a VPC Cluster.
@@ -80,7 +90,9 @@ type VPCClusterScope struct {
 	Client      client.Client
 	patchHelper *v1beta1patch.Helper
 
+	CISClient                cis.CIS
 	COSClient                cos.Cos
+	DNSServicesClient        dnsservices.DNSServices
 	GlobalTaggingClient      globaltagging.GlobalTagging
 	ResourceControllerClient resourcecontroller.ResourceController
 	ResourceManagerClient    resourcemanager.ResourceManager
@@ -114,8 +126,13 @@ func NewVPCClusterScope(params VPCClusterScopeParams) (*VPCClusterScope, error)
 		return nil, fmt.Errorf("error failed to init patch helper: %w", err)
 	}
 
+	auth, err := GetAuthenticator(context.TODO(), params.Client, params.IBMVPCCluster.Namespace, params.IBMVPCCluster.Spec.IdentityRef)
+	if err != nil {
+		return nil, fmt.Errorf("error failed to create authenticator: %w", err)
+	}
+
 	vpcEndpoint := endpoints.FetchVPCEndpoint(params.IBMVPCCluster.Spec.Region, params.ServiceEndpoint)
-	vpcClient, err := vpc.NewService(vpcEndpoint)
+	vpcClient, err := vpc.NewService(vpcEndpoint, auth)
 	if err != nil {
 		return nil, fmt.Errorf("error failed to create IBM VPC client: %w", err)
 	}
@@ -128,11 +145,6 @@ func NewVPCClusterScope(params VPCClusterScopeParams) (*VPCClusterScope, error)
 		core.SetLoggingLevel(core.LevelDebug)
 	}
 
-	auth, err := authenticator.GetAuthenticator()
-	if err != nil {
-		return nil, fmt.Errorf("error failed to create authenticator: %w", err)
-	}
-
 	// Create Global Tagging client.
 	gtOptions := globaltagging.ServiceOptions{
 		GlobalTaggingV1Options: &globaltaggingv1.GlobalTaggingV1Options{
@@ -191,9 +203,51 @@ func NewVPCClusterScope(params VPCClusterScopeParams) (*VPCClusterScope, error)
 		ResourceManagerClient:    resourceManagerClient,
 		VPCClient:                vpcClient,
 	}
+
+	// Create DNS Services client only when the control-plane endpoint is served by a DNS Services zone.
+	if params.IBMVPCCluster.Spec.ControlPlaneDNS != nil {
+		dnsClient, err := params.getDNSServicesClient(&dnssvcsv1.DnsSvcsV1Options{
+			Authenticator: auth,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create DNS Services client: %w", err)
+		}
+		clusterScope.DNSServicesClient = dnsClient
+	}
+
+	// Create CIS client only when the public DNS records of the cluster are managed in a CIS domain.
+	if params.IBMVPCCluster.Spec.DNS != nil {
+		cisOptions := &zonesv1.ZonesV1Options{
+			Authenticator: auth,
+			Crn:           ptr.To(params.IBMVPCCluster.Spec.DNS.CISInstanceCRN),
+		}
+		// Override the CIS endpoint if provided.
+		if cisEndpoint := endpoints.FetchEndpoints(string(endpoints.CIS), params.ServiceEndpoint); cisEndpoint != "" {
+			cisOptions.URL = cisEndpoint
+			params.Logger.V(3).Info("Overriding the default CIS endpoint", "CISEndpoint", cisEndpoint)
+		}
+		cisClient, err := cis.NewService(cisOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CIS client: %w", err)
+		}
+		clusterScope.CISClient = cisClient
+	}
 	return clusterScope, nil
 }
 
+func (params VPCClusterScopeParams) getDNSServicesClient(options *dnssvcsv1.DnsSvcsV1Options) (dnsservices.DNSServices, error) {
+	if params.DNSServicesFactory != nil {
+		return params.DNSServicesFactory()
+	}
+	// Fetch the DNS Services endpoint.
+	dnsEndpoint := endpoints.FetchEndpoints(string(endpoints.DNSServices), params.ServiceEndpoint)
+	if dnsEndpoint != "" {
+		options.URL = dnsEndpoint
+		params.Logger.V(3).Info("Overriding the default DNS Services endpoint", "DNSServicesEndpoint", dnsEndpoint)
+	}
+	return dnsservices.NewService(options)
+}
+
 // PatchObject persists the cluster configuration and status.
 func (s *VPCClusterScope) PatchObject() error {
 	return s.patchHelper.Patch(context.TODO(), s.IBMVPCCluster)
@@ -315,6 +369,65 @@ func (s *VPCClusterScope) GetLoadBalancerHostName() (*string, error) {
 	return nil, fmt.Errorf("error no valid load balancer found to retrieve hostname")
 }
 
+// getPrivateLoadBalancerHostName will return the hostname of the cluster's private Load Balancer, if one was provided.
+func (s *VPCClusterScope) getPrivateLoadBalancerHostName() (*string, error) {
+	if s.NetworkSpec() == nil || s.NetworkStatus() == nil || len(s.NetworkStatus().LoadBalancers) == 0 {
+		return nil, nil
+	}
+
+	for _, loadBalancer := range s.NetworkSpec().LoadBalancers {
+		if loadBalancer.Public == nil || *loadBalancer.Public {
+			continue
+		}
+
+		if loadBalancer.ID != nil {
+			if lb, ok := s.NetworkStatus().LoadBalancers[*loadBalancer.ID]; ok {
+				return lb.Hostname, nil
+			}
+			return nil, nil
+		}
+
+		name := loadBalancer.Name
+		if name == "" {
+			name = fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1.ResourceTypeLoadBalancer), privateLBSuffix)
+		}
+		lbDetails, err := s.VPCClient.GetLoadBalancerByName(name)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving load balancer hostname for %s: %w", name, err)
+		} else if lbDetails == nil {
+			return nil, nil
+		}
+		return lbDetails.Hostname, nil
+	}
+	return nil, nil
+}
+
+// ControlPlaneDNS returns the DNS Services zone serving the control-plane endpoint.
+func (s *VPCClusterScope) ControlPlaneDNS() *infrav1.ControlPlaneDNS {
+	return s.IBMVPCCluster.Spec.ControlPlaneDNS
+}
+
+// GetControlPlaneDNSName returns the DNS name of the control-plane endpoint, or nil if no DNS Services zone is set.
+func (s *VPCClusterScope) GetControlPlaneDNSName() *string {
+	if s.ControlPlaneDNS() == nil {
+		return nil
+	}
+	return ptr.To(controlPlaneDNSName(s.ControlPlaneDNS().Zone))
+}
+
+// DNS returns the CIS domain serving the public DNS records of the cluster.
+func (s *VPCClusterScope) DNS() *infrav1.DNS {
+	return s.IBMVPCCluster.Spec.DNS
+}
+
+// GetDNSAPIName returns the public DNS name of the control-plane endpoint, or nil if no CIS domain is set.
+func (s *VPCClusterScope) GetDNSAPIName() *string {
+	if s.DNS() == nil {
+		return nil
+	}
+	return ptr.To(publicDNSAPIName(s.DNS(), s.IBMVPCCluster.Name))
+}
+
 // GetNetworkResourceGroupID returns the Resource Group ID for the Network Resources if it is present. Otherwise, it defaults to the cluster's Resource Group ID.
 func (s *VPCClusterScope) GetNetworkResourceGroupID() (string, error) {
 	// Check if the ID is available from Status first.
@@ -556,9 +669,10 @@ func (s *VPCClusterScope) SetResourceStatus(resourceType infrav1.ResourceType, r
 	case infrav1.ResourceTypeCustomImage:
 		if s.IBMVPCCluster.Status.Image == nil {
 			s.IBMVPCCluster.Status.Image = &infrav1.ResourceStatus{
-				ID:    resource.ID,
-				Name:  resource.Name,
-				Ready: resource.Ready,
+				ID:                resource.ID,
+				Name:              resource.Name,
+				Ready:             resource.Ready,
+				ControllerCreated: resource.ControllerCreated,
 			}
 			return
 		}
@@ -599,6 +713,18 @@ func (s *VPCClusterScope) SetResourceStatus(resourceType infrav1.ResourceType, r
 		} else {
 			s.IBMVPCCluster.Status.Network.SecurityGroups[*resource.Name] = resource
 		}
+	case infrav1.ResourceTypePublicGateway:
+		if s.NetworkStatus() == nil {
+			s.IBMVPCCluster.Status.Network = &infrav1.VPCNetworkStatus{}
+		}
+		if s.IBMVPCCluster.Status.Network.PublicGateways == nil {
+			s.IBMVPCCluster.Status.Network.PublicGateways = make(map[string]*infrav1.ResourceStatus)
+		}
+		if publicGateway, ok := s.IBMVPCCluster.Status.Network.PublicGateways[*resource.Name]; ok {
+			publicGateway.Set(*resource)
+		} else {
+			s.IBMVPCCluster.Status.Network.PublicGateways[*resource.Name] = resource
+		}
 	default:
 		s.V(3).Info("unsupported resource type", "resourceType", resourceType)
 	}
@@ -716,7 +842,8 @@ func (s *VPCClusterScope) createVPC() error {
 		ID:   *vpcDetails.ID,
 		Name: vpcDetails.Name,
 		// We wait for a followup reconcile loop to set as Ready, to confirm the VPC can be found.
-		Ready: false,
+		Ready:             false,
+		ControllerCreated: ptr.To(true),
 	})
 
 	// NOTE: This tagging is only attempted once. We may wish to refactor in case this single attempt fails.
@@ -879,7 +1006,8 @@ func (s *VPCClusterScope) createCustomImage(ctx context.Context) error {
 		ID:   *imageDetails.ID,
 		Name: imageDetails.Name,
 		// We must wait for the image to be ready, on followup reconciliation loops.
-		Ready: false,
+		Ready:             false,
+		ControllerCreated: ptr.To(true),
 	})
 
 	// NOTE: This tagging is only attempted once. We may wish to refactor in case this single attempt fails.
@@ -1076,6 +1204,84 @@ func (s *VPCClusterScope) buildSubnetsForZones() ([]infrav1.Subnet, error) {
 	return subnets, nil
 }
 
+// ReconcileFailureDomains sets the cluster's failure domains, one per zone containing a Control Plane or Worker subnet.
+// Zones containing a Control Plane subnet are marked as suitable for Control Plane machines.
+func (s *VPCClusterScope) ReconcileFailureDomains() error {
+	var controlPlaneSubnets, workerSubnets []infrav1.Subnet
+	if s.NetworkSpec() != nil {
+		controlPlaneSubnets = s.NetworkSpec().ControlPlaneSubnets
+		workerSubnets = s.NetworkSpec().WorkerSubnets
+	}
+
+	controlPlaneZones, err := s.getSubnetZones(controlPlaneSubnets)
+	if err != nil {
+		return fmt.Errorf("error failed retrieving control plane subnet zones: %w", err)
+	}
+	workerZones, err := s.getSubnetZones(workerSubnets)
+	if err != nil {
+		return fmt.Errorf("error failed retrieving worker subnet zones: %w", err)
+	}
+
+	failureDomains := make(clusterv1beta1.FailureDomains)
+	for _, zone := range controlPlaneZones {
+		failureDomains[zone] = clusterv1beta1.FailureDomainSpec{
+			ControlPlane: true,
+		}
+	}
+	for _, zone := range workerZones {
+		if _, ok := failureDomains[zone]; !ok {
+			failureDomains[zone] = clusterv1beta1.FailureDomainSpec{
+				ControlPlane: false,
+			}
+		}
+	}
+	s.IBMVPCCluster.Status.FailureDomains = failureDomains
+	return nil
+}
+
+// getSubnetZones returns the zones of the provided subnets.
+// If no subnets are provided, a subnet is expected in each zone of the region, matching the behavior of ReconcileSubnets.
+func (s *VPCClusterScope) getSubnetZones(subnets []infrav1.Subnet) ([]string, error) {
+	if len(subnets) == 0 {
+		zones, err := s.VPCClient.GetVPCZonesByRegion(s.IBMVPCCluster.Spec.Region)
+		if err != nil {
+			return nil, fmt.Errorf("error unknown failure retrieving zones for region %s: %w", s.IBMVPCCluster.Spec.Region, err)
+		}
+		return zones, nil
+	}
+
+	zones := make([]string, 0, len(subnets))
+	for _, subnet := range subnets {
+		if subnet.Zone != nil {
+			zones = append(zones, *subnet.Zone)
+			continue
+		}
+
+		// Otherwise, lookup the zone of the existing subnet.
+		subnetID := subnet.ID
+		if subnetID == nil && subnet.Name != nil {
+			var err error
+			subnetID, err = s.GetSubnetID(*subnet.Name)
+			if err != nil {
+				return nil, fmt.Errorf("error failed retrieving subnet id for %s: %w", *subnet.Name, err)
+			}
+		}
+		if subnetID == nil {
+			return nil, fmt.Errorf("error failed to determine subnet id to retrieve zone")
+		}
+		subnetDetails, _, err := s.VPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+			ID: subnetID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error failed retrieving subnet %s: %w", *subnetID, err)
+		} else if subnetDetails == nil || subnetDetails.Zone == nil || subnetDetails.Zone.Name == nil {
+			return nil, fmt.Errorf("error failed retrieving zone for subnet %s", *subnetID)
+		}
+		zones = append(zones, *subnetDetails.Zone.Name)
+	}
+	return zones, nil
+}
+
 // updateSubnetStatus will check the status of a IBM Cloud Subnet and update the Network Status.
 func (s *VPCClusterScope) updateSubnetStatus(subnetDetails *vpcv1.Subnet, isControlPlane bool) (bool, error) {
 	requeue := true
@@ -1160,9 +1366,10 @@ func (s *VPCClusterScope) createSubnet(ctx context.Context, subnet infrav1.Subne
 
 	// Initially populate subnet's status.
 	resourceStatus := &infrav1.ResourceStatus{
-		ID:    *subnetDetails.ID,
-		Name:  subnetDetails.Name,
-		Ready: false,
+		ID:                *subnetDetails.ID,
+		Name:              subnetDetails.Name,
+		Ready:             false,
+		ControllerCreated: ptr.To(true),
 	}
 	if isControlPlane {
 		s.SetResourceStatus(infrav1.ResourceTypeControlPlaneSubnet, resourceStatus)
@@ -1196,6 +1403,11 @@ func (s *VPCClusterScope) findOrCreatePublicGateway(ctx context.Context, zone st
 	// If we found the Public Gateway, with an ID, for the zone, return it.
 	// NOTE(cjschaef): We may wish to confirm the PublicGateway, by checking Tags (Global Tagging), but this might be sufficient, as we don't expect to have duplicate PG's or existing PG's, as we wouldn't create subnets and PG's for existing Network Infrastructure.
 	if publicGateway != nil && publicGateway.ID != nil {
+		s.SetResourceStatus(infrav1.ResourceTypePublicGateway, &infrav1.ResourceStatus{
+			ID:    *publicGateway.ID,
+			Name:  publicGateway.Name,
+			Ready: true,
+		})
 		return publicGateway, nil
 	}
 
@@ -1229,6 +1441,14 @@ func (s *VPCClusterScope) findOrCreatePublicGateway(ctx context.Context, zone st
 
 	log.V(3).Info("created public gateway", "id", publicGatewayDetails.ID)
 
+	// Track the Public Gateway in Status, so it can be removed when the cluster is deleted.
+	s.SetResourceStatus(infrav1.ResourceTypePublicGateway, &infrav1.ResourceStatus{
+		ID:                *publicGatewayDetails.ID,
+		Name:              publicGatewayDetails.Name,
+		Ready:             true,
+		ControllerCreated: ptr.To(true),
+	})
+
 	// Add a tag to the public gateway for the cluster
 	err = s.TagResource(s.IBMVPCCluster.Name, *publicGatewayDetails.CRN)
 	if err != nil {
@@ -1239,32 +1459,38 @@ func (s *VPCClusterScope) findOrCreatePublicGateway(ctx context.Context, zone st
 }
 
 // ReconcileSecurityGroups will attempt to reconcile the defined SecurityGroups and their SecurityGroupRules. Our best option is to perform a first set of passes, creating all the SecurityGroups first, then reconcile the SecurityGroupRules after that, as the SecuirtyGroupRules could be dependent on an IBM Cloud Security Group that must be created first.
-func (s *VPCClusterScope) ReconcileSecurityGroups(ctx context.Context) (bool, error) {
+// When the SecurityGroupRules are reconciled in Enforce mode, the IBM Cloud Security Group Rules which are not defined, in Security Groups not created by the controller, are returned as drift.
+func (s *VPCClusterScope) ReconcileSecurityGroups(ctx context.Context) (bool, []string, error) {
 	log := ctrl.LoggerFrom(ctx)
-	// If no Security Groups were supplied, we have nothing to do.
+	// If no Security Groups were supplied, we have nothing to do, other than forgetting the Rules of the removed ones.
 	if len(s.IBMVPCCluster.Spec.Network.SecurityGroups) == 0 {
-		return false, nil
+		s.pruneSecurityGroupRulesStatus()
+		return false, nil, nil
 	}
 
 	// Reconcile each Security Group first, process rules later.
 	for _, securityGroup := range s.IBMVPCCluster.Spec.Network.SecurityGroups {
 		if err := s.reconcileSecurityGroup(ctx, securityGroup); err != nil {
-			return false, fmt.Errorf("error failed reonciling security groups: %w", err)
+			return false, nil, fmt.Errorf("error failed reonciling security groups: %w", err)
 		}
 	}
 
 	// Reconcile each Security Groups's Rules.
 	requeue := false
+	var drift []string
 	for _, securityGroup := range s.IBMVPCCluster.Spec.Network.SecurityGroups {
-		if requiresRequeue, err := s.reconcileSecurityGroupRules(ctx, securityGroup); err != nil {
-			return false, fmt.Errorf("error failed reconciling security group rules: %w", err)
+		requiresRequeue, securityGroupDrift, err := s.reconcileSecurityGroupRules(ctx, securityGroup)
+		if err != nil {
+			return false, nil, fmt.Errorf("error failed reconciling security group rules: %w", err)
 		} else if requiresRequeue {
 			log.V(3).Info("requeuing for security group rules")
 			requeue = true
 		}
+		drift = append(drift, securityGroupDrift...)
 	}
+	s.pruneSecurityGroupRulesStatus()
 
-	return requeue, nil
+	return requeue, drift, nil
 }
 
 // reconcileSecurityGroup will attempt to reconcile a defined SecurityGroup. By design, we confirm the IBM Cloud Security Group exists first, before attempting to reconcile the defined SecurityGroupRules.
@@ -1354,9 +1580,10 @@ func (s *VPCClusterScope) reconcileSecurityGroup(ctx context.Context, securityGr
 
 	// Security Groups do not have a status, so just assume they are ready immediately after creation.
 	s.SetResourceStatus(infrav1.ResourceTypeSecurityGroup, &infrav1.ResourceStatus{
-		ID:    *securityGroupDetails.ID,
-		Name:  securityGroupDetails.Name,
-		Ready: true,
+		ID:                *securityGroupDetails.ID,
+		Name:              securityGroupDetails.Name,
+		Ready:             true,
+		ControllerCreated: ptr.To(true),
 	})
 
 	// NOTE: This tagging is only attempted once. We may wish to refactor in case this single attempt fails.
@@ -1370,15 +1597,22 @@ func (s *VPCClusterScope) reconcileSecurityGroup(ctx context.Context, securityGr
 }
 
 // reconcile SecurityGroupRules will attempt to reconcile the set of defined SecurityGroupRules for a SecurityGroup, one Rule at a time. Each defined Rule can contain multiple remotes, requiring a unique IBM Cloud Security Group Rule, based on the expected traffic direction, inbound (Source) or outbound (Destination).
-func (s *VPCClusterScope) reconcileSecurityGroupRules(ctx context.Context, securityGroup infrav1.VPCSecurityGroup) (bool, error) {
+// The IDs of the IBM Cloud Security Group Rules matching the defined SecurityGroupRules are recorded in Status. In Enforce mode, any other IBM Cloud Security Group Rule is deleted if the Security Group was created by the controller, otherwise it is returned as drift.
+func (s *VPCClusterScope) reconcileSecurityGroupRules(ctx context.Context, securityGroup infrav1.VPCSecurityGroup) (bool, []string, error) {
 	log := ctrl.LoggerFrom(ctx)
-	// If the SecurityGroup has no rules, we have nothing more to do for this Security Group.
-	if len(securityGroup.Rules) == 0 {
-		return false, nil
+	enforce := s.NetworkSpec().SecurityGroupRuleReconcileMode == infrav1.VPCSecurityGroupRuleReconcileModeEnforce
+	// If the SecurityGroup has no rules, we have nothing more to do for this Security Group, unless its Rules are enforced.
+	if len(securityGroup.Rules) == 0 && !enforce {
+		// Forget the Rules recorded before they were removed from the SecurityGroup.
+		if s.NetworkStatus() != nil {
+			delete(s.NetworkStatus().SecurityGroupRules, securityGroupRulesStatusKey(securityGroup))
+		}
+		return false, nil, nil
 	}
 
 	// Assume that the securityGroup exists in Status, if it doesn't then it should be re-reconciled. Attempt to find it by name and then ID.
 	var securityGroupID *string
+	securityGroupKey := securityGroupRulesStatusKey(securityGroup)
 	if securityGroup.Name != nil {
 		securityGroupID = s.getSecurityGroupIDFromStatus(*securityGroup.Name)
 	} else if securityGroup.ID != nil {
@@ -1388,53 +1622,132 @@ func (s *VPCClusterScope) reconcileSecurityGroupRules(ctx context.Context, secur
 
 	if securityGroupID == nil {
 		log.V(3).Info("security group not found, requeue", "securityGroup", securityGroup)
-		return true, nil
+		return true, nil, nil
+	}
+
+	existingSecurityGroupRules, _, err := s.VPCClient.ListSecurityGroupRules(&vpcv1.ListSecurityGroupRulesOptions{
+		SecurityGroupID: securityGroupID,
+	})
+	if err != nil {
+		return false, nil, fmt.Errorf("error failed listing security group rules during reconcile of security group id=%s: %w", *securityGroupID, err)
+	}
+	if existingSecurityGroupRules == nil {
+		existingSecurityGroupRules = &vpcv1.SecurityGroupRuleCollection{}
 	}
 
 	// Reconcile each SecurityGroupRule in the SecurityGroup.
+	var ruleIDs []*string
 	for _, securityGroupRule := range securityGroup.Rules {
 		log.V(3).Info("reconcile security group rule", "securityGroupID", securityGroupID)
-		if err := s.reconcileSecurityGroupRule(ctx, *securityGroupID, *securityGroupRule); err != nil {
-			return false, fmt.Errorf("error failed to reconcile security group rule: %w", err)
+		matchingRuleIDs, err := s.findOrCreateSecurityGroupRule(ctx, *securityGroupID, *securityGroupRule, existingSecurityGroupRules)
+		if err != nil {
+			return false, nil, fmt.Errorf("error failed to reconcile security group rule: %w", err)
 		}
+		ruleIDs = append(ruleIDs, matchingRuleIDs...)
 	}
 
-	// Since Security Group Rules have no status, assume all Rules have been reconciled (they exist or were created).
-	return false, nil
-}
-
-// reconcileSecurityGroupRule will attempt to reconcile a defined SecurityGroupRule, with one or more Remotes, for a SecurityGroup. If the IBM Cloud Security Group contains no Rules, simply attempt to create the defined Rule (via the Remote(s) provided).
-func (s *VPCClusterScope) reconcileSecurityGroupRule(ctx context.Context, securityGroupID string, securityGroupRule infrav1.VPCSecurityGroupRule) error {
-	log := ctrl.LoggerFrom(ctx)
-	existingSecurityGroupRuleIntfs, _, err := s.VPCClient.ListSecurityGroupRules(&vpcv1.ListSecurityGroupRulesOptions{
-		SecurityGroupID: ptr.To(securityGroupID),
+	controllerCreated := false
+	if securityGroupStatus := s.getSecurityGroupStatusByID(*securityGroupID); securityGroupStatus != nil {
+		controllerCreated = ptr.Deref(securityGroupStatus.ControllerCreated, false)
+	}
+	s.setSecurityGroupRulesStatus(securityGroupKey, &infrav1.VPCSecurityGroupStatus{
+		ID:                securityGroupID,
+		RuleIDs:           ruleIDs,
+		ControllerCreated: ptr.To(controllerCreated),
 	})
-	if err != nil {
-		return fmt.Errorf("error failed listing security group rules during reconcile of security group id=%s: %w", securityGroupID, err)
+
+	if !enforce {
+		// Since Security Group Rules have no status, assume all Rules have been reconciled (they exist or were created).
+		return false, nil, nil
 	}
 
-	// If the Security Group has no Rules at all, we simply create all the Rules
-	if existingSecurityGroupRuleIntfs == nil || len(existingSecurityGroupRuleIntfs.Rules) == 0 {
-		log.V(3).Info("Creating security group rules for security group", "securityGroupID", securityGroupID)
-		err := s.createSecurityGroupRuleAllRemotes(ctx, securityGroupID, securityGroupRule)
-		if err != nil {
-			return fmt.Errorf("error failed creating all security group rule remotes: %w", err)
+	var drift []string
+	for _, existingRuleIntf := range existingSecurityGroupRules.Rules {
+		ruleID := securityGroupRuleID(existingRuleIntf)
+		if ruleID == nil || slices.ContainsFunc(ruleIDs, func(id *string) bool { return *id == *ruleID }) {
+			continue
+		}
+		if !controllerCreated {
+			log.V(3).Info("security group rule is not defined for security group not created by controller", "securityGroupID", securityGroupID, "ruleID", ruleID)
+			drift = append(drift, fmt.Sprintf("security group %s has rule %s which is not defined for it", securityGroupKey, *ruleID))
+			continue
+		}
+		log.Info("Deleting security group rule not defined for security group", "securityGroupID", securityGroupID, "ruleID", ruleID)
+		if _, err := s.VPCClient.DeleteSecurityGroupRule(&vpcv1.DeleteSecurityGroupRuleOptions{
+			SecurityGroupID: securityGroupID,
+			ID:              ruleID,
+		}); err != nil {
+			return false, nil, fmt.Errorf("error failed deleting security group rule %s of security group id=%s: %w", *ruleID, *securityGroupID, err)
 		}
-		log.V(3).Info("Created security group rules", "securityGroupID", securityGroupID, "securityGroupRule", securityGroupRule)
+	}
+	return false, drift, nil
+}
 
-		// Security Group Rules do not have a Status, so assume they are ready immediately.
+// getSecurityGroupStatusByID returns the Status of a Security Group, provided the ID.
+func (s *VPCClusterScope) getSecurityGroupStatusByID(id string) *infrav1.ResourceStatus {
+	if s.NetworkStatus() == nil {
 		return nil
 	}
+	for _, securityGroup := range s.NetworkStatus().SecurityGroups {
+		if securityGroup != nil && securityGroup.ID == id {
+			return securityGroup
+		}
+	}
+	return nil
+}
+
+// setSecurityGroupRulesStatus sets the Status of the Security Group Rules of a Security Group.
+func (s *VPCClusterScope) setSecurityGroupRulesStatus(key string, status *infrav1.VPCSecurityGroupStatus) {
+	if s.NetworkStatus() == nil {
+		s.IBMVPCCluster.Status.Network = &infrav1.VPCNetworkStatus{}
+	}
+	if s.IBMVPCCluster.Status.Network.SecurityGroupRules == nil {
+		s.IBMVPCCluster.Status.Network.SecurityGroupRules = make(map[string]*infrav1.VPCSecurityGroupStatus)
+	}
+	s.IBMVPCCluster.Status.Network.SecurityGroupRules[key] = status
+}
+
+// securityGroupRulesStatusKey returns the key of the Status of the Security Group Rules of a SecurityGroup, its name, or id when it has no name.
+func securityGroupRulesStatusKey(securityGroup infrav1.VPCSecurityGroup) string {
+	if securityGroup.Name != nil {
+		return *securityGroup.Name
+	}
+	return ptr.Deref(securityGroup.ID, "")
+}
+
+// pruneSecurityGroupRulesStatus removes the Status of the Security Group Rules of the SecurityGroups which were removed from the Spec.
+func (s *VPCClusterScope) pruneSecurityGroupRulesStatus() {
+	if s.NetworkStatus() == nil {
+		return
+	}
+	maps.DeleteFunc(s.NetworkStatus().SecurityGroupRules, func(key string, _ *infrav1.VPCSecurityGroupStatus) bool {
+		return !slices.ContainsFunc(s.NetworkSpec().SecurityGroups, func(securityGroup infrav1.VPCSecurityGroup) bool {
+			return securityGroupRulesStatusKey(securityGroup) == key
+		})
+	})
+	if len(s.NetworkStatus().SecurityGroupRules) == 0 {
+		s.NetworkStatus().SecurityGroupRules = nil
+	}
+}
 
-	// Validate the Security Group Rule(s) exist or were created.
-	if err := s.findOrCreateSecurityGroupRule(ctx, securityGroupID, securityGroupRule, existingSecurityGroupRuleIntfs); err != nil {
-		return fmt.Errorf("error failed to find or create security group rule: %w", err)
+// securityGroupRuleID returns the ID of an IBM Cloud Security Group Rule, whatever its protocol.
+func securityGroupRuleID(rule vpcv1.SecurityGroupRuleIntf) *string {
+	switch rule := rule.(type) {
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll:
+		return rule.ID
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp:
+		return rule.ID
+	case *vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp:
+		return rule.ID
+	case *vpcv1.SecurityGroupRule:
+		return rule.ID
 	}
 	return nil
 }
 
 // findOrCreateSecurityGroupRule will attempt to match up the SecurityGroupRule's Remote(s) (multiple Remotes can be supplied per Rule definition), and will create any missing IBM Cloud Security Group Rules based on the SecurityGroupRule and Remote(s). Remotes are defined either by a Destination (outbound) or a Source (inbound), which defines the type of IBM Cloud Security Group Rule that should exist or be created.
-func (s *VPCClusterScope) findOrCreateSecurityGroupRule(ctx context.Context, securityGroupID string, securityGroupRule infrav1.VPCSecurityGroupRule, existingSecurityGroupRules *vpcv1.SecurityGroupRuleCollection) error { //nolint: gocyclo
+// The IDs of the matching or created IBM Cloud Security Group Rules are returned, one per Remote.
+func (s *VPCClusterScope) findOrCreateSecurityGroupRule(ctx context.Context, securityGroupID string, securityGroupRule infrav1.VPCSecurityGroupRule, existingSecurityGroupRules *vpcv1.SecurityGroupRuleCollection) ([]*string, error) { //nolint: gocyclo
 	log := ctrl.LoggerFrom(ctx)
 	// Use either the SecurityGroupRule.Destination or SecurityGroupRule.Source for further details based on SecurityGroupRule.Direction
 	var securityGroupRulePrototype infrav1.VPCSecurityGroupRulePrototype
@@ -1444,15 +1757,19 @@ func (s *VPCClusterScope) findOrCreateSecurityGroupRule(ctx context.Context, sec
 	case infrav1.VPCSecurityGroupRuleDirectionOutbound:
 		securityGroupRulePrototype = *securityGroupRule.Destination
 	default:
-		return fmt.Errorf("error unsupported SecurityGroupRuleDirection defined")
+		return nil, fmt.Errorf("error unsupported SecurityGroupRuleDirection defined")
 	}
 
 	log.V(3).Info("checking security group rules for security group", "securityGroupID", securityGroupID)
 
 	// Each defined SecurityGroupRule can have multiple Remotes specified, each signifying a separate Security Group Rule (with the same Action, Direction, etc.)
+	ruleIDs := make([]*string, 0, len(securityGroupRulePrototype.Remotes))
 	for _, remote := range securityGroupRulePrototype.Remotes {
-		remoteMatch := false
+		var matchingRuleID *string
 		for _, existingRuleIntf := range existingSecurityGroupRules.Rules {
+			if matchingRuleID != nil {
+				break
+			}
 			// Perform analysis of the existingRuleIntf, based on its Protocol type, further analysis is performed based on remaining attributes to find if the specific Rule and Remote match
 			switch reflect.TypeOf(existingRuleIntf).String() {
 			case infrav1.VPCSecurityGroupRuleProtocolAllType:
@@ -1466,13 +1783,12 @@ func (s *VPCClusterScope) findOrCreateSecurityGroupRule(ctx context.Context, sec
 					continue
 				}
 				if found, err := s.checkSecurityGroupRuleProtocolAll(ctx, securityGroupRulePrototype, remote, existingRule); err != nil {
-					return fmt.Errorf("error failure checking security group rule protocol all: %w", err)
+					return nil, fmt.Errorf("error failure checking security group rule protocol all: %w", err)
 				} else if found {
 					// If we found the matching IBM Cloud Security Group Rule for the defined SecurityGroupRule and Remote, we can stop checking IBM Cloud Security Group Rules for this remote and move onto the next remote.
 					// The expectation is that only one IBM Cloud Security Group Rule will match, but if at least one matches the defined SecurityGroupRule, that is sufficient.
 					log.V(3).Info("security group rule all protocol match found")
-					remoteMatch = true
-					break
+					matchingRuleID = existingRule.ID
 				}
 			case infrav1.VPCSecurityGroupRuleProtocolIcmpType:
 				// If our Remote doesn't define ICMP Protocol, we don't need further checks, move on to next Rule
@@ -1485,12 +1801,11 @@ func (s *VPCClusterScope) findOrCreateSecurityGroupRule(ctx context.Context, sec
 					continue
 				}
 				if found, err := s.checkSecurityGroupRuleProtocolIcmp(ctx, securityGroupRulePrototype, remote, existingRule); err != nil {
-					return fmt.Errorf("error failure checking security group rule protocol icmp: %w", err)
+					return nil, fmt.Errorf("error failure checking security group rule protocol icmp: %w", err)
 				} else if found {
 					// If we found the matching IBM Cloud Security Group Rule for the defined SecurityGroupRule and Remote, we can stop checking IBM Cloud Security Group Rules for this remote and move onto the next remote.
 					log.V(3).Info("security group rule icmp match found")
-					remoteMatch = true
-					break
+					matchingRuleID = existingRule.ID
 				}
 			case infrav1.VPCSecurityGroupRuleProtocolTcpudpType:
 				// If our Remote doesn't define TCP/UDP Protocol, we don't need further checks, move on to next Rule
@@ -1503,12 +1818,11 @@ func (s *VPCClusterScope) findOrCreateSecurityGroupRule(ctx context.Context, sec
 					continue
 				}
 				if found, err := s.checkSecurityGroupRuleProtocolTcpudp(ctx, securityGroupRulePrototype, remote, existingRule); err != nil {
-					return fmt.Errorf("error failure checking security group rule protocol tcp-udp: %w", err)
+					return nil, fmt.Errorf("error failure checking security group rule protocol tcp-udp: %w", err)
 				} else if found {
 					// If we found the matching IBM Cloud Security Group Rule for the defined SecurityGroupRule and Remote, we can stop checking IBM Cloud Security Group Rules for this remote and move onto the next remote.
 					log.V(3).Info("security group rule tcp/udp match found")
-					remoteMatch = true
-					break
+					matchingRuleID = existingRule.ID
 				}
 			default:
 				// This is an unexpected IBM Cloud Security Group Rule Prototype, log it and move on
@@ -1517,14 +1831,16 @@ func (s *VPCClusterScope) findOrCreateSecurityGroupRule(ctx context.Context, sec
 		}
 
 		// If we did not find a matching SecurityGroupRule for this defined Remote, create one now.
-		if !remoteMatch {
-			err := s.createSecurityGroupRule(ctx, securityGroupID, securityGroupRule, remote)
+		if matchingRuleID == nil {
+			ruleID, err := s.createSecurityGroupRule(ctx, securityGroupID, securityGroupRule, remote)
 			if err != nil {
-				return fmt.Errorf("error failure creating security group rule: %w", err)
+				return nil, fmt.Errorf("error failure creating security group rule: %w", err)
 			}
+			matchingRuleID = ruleID
 		}
+		ruleIDs = append(ruleIDs, matchingRuleID)
 	}
-	return nil
+	return ruleIDs, nil
 }
 
 // checkSecurityGroupRuleProtocolAll analyzes an IBM Cloud Security Group Rule designated for 'all' protocols, to verify if the supplied Rule and Remote match the attributes from the existing 'ProtocolAll' Rule.
@@ -1669,27 +1985,8 @@ func (s *VPCClusterScope) checkSecurityGroupRulePrototypeRemote(ctx context.Cont
 	return false, nil
 }
 
-// createSecurityGroupRuleAllRemotes will create one or more IBM Cloud Security Group Rules for a specific SecurityGroup, based on the provided SecurityGroupRule and Remotes defined in the SecurityGroupRule definition (one or more Remotes can be defined per SecurityGroupRule definition).
-func (s *VPCClusterScope) createSecurityGroupRuleAllRemotes(ctx context.Context, securityGroupID string, securityGroupRule infrav1.VPCSecurityGroupRule) error {
-	var remotes []infrav1.VPCSecurityGroupRuleRemote
-	switch securityGroupRule.Direction {
-	case infrav1.VPCSecurityGroupRuleDirectionInbound:
-		remotes = securityGroupRule.Source.Remotes
-	case infrav1.VPCSecurityGroupRuleDirectionOutbound:
-		remotes = securityGroupRule.Destination.Remotes
-	}
-	for _, remote := range remotes {
-		err := s.createSecurityGroupRule(ctx, securityGroupID, securityGroupRule, remote)
-		if err != nil {
-			return fmt.Errorf("error failed creating security group rule: %w", err)
-		}
-	}
-
-	return nil
-}
-
 // createSecurityGroupRule will create a new IBM Cloud Security Group Rule for a specific Security Group, based on the provided SecurityGroupRule and Remote definitions.
-func (s *VPCClusterScope) createSecurityGroupRule(ctx context.Context, securityGroupID string, securityGroupRule infrav1.VPCSecurityGroupRule, remote infrav1.VPCSecurityGroupRuleRemote) error {
+func (s *VPCClusterScope) createSecurityGroupRule(ctx context.Context, securityGroupID string, securityGroupRule infrav1.VPCSecurityGroupRule, remote infrav1.VPCSecurityGroupRuleRemote) (*string, error) {
 	log := ctrl.LoggerFrom(ctx)
 	options := &vpcv1.CreateSecurityGroupRuleOptions{
 		SecurityGroupID: &securityGroupID,
@@ -1703,7 +2000,7 @@ func (s *VPCClusterScope) createSecurityGroupRule(ctx context.Context, securityG
 	}
 	prototypeRemote, err := s.createSecurityGroupRuleRemote(remote)
 	if err != nil {
-		return fmt.Errorf("error failed to create security group rule remote: %w", err)
+		return nil, fmt.Errorf("error failed to create security group rule remote: %w", err)
 	}
 	switch securityGroupRulePrototype.Protocol {
 	case infrav1.VPCSecurityGroupRuleProtocolAll:
@@ -1739,32 +2036,20 @@ func (s *VPCClusterScope) createSecurityGroupRule(ctx context.Context, securityG
 		options.SetSecurityGroupRulePrototype(prototype)
 	default:
 		// This should not be possible, provided the strict kubebuilder enforcements
-		return fmt.Errorf("error failed creating security group rule, unknown protocol")
+		return nil, fmt.Errorf("error failed creating security group rule, unknown protocol")
 	}
 
 	log.V(3).Info("Creating Security Group Rule for Security Group", "securityGroupID", securityGroupID, "direction", securityGroupRule.Direction, "protocol", securityGroupRulePrototype.Protocol, "prototypeRemote", prototypeRemote)
 	securityGroupRuleIntfDetails, _, err := s.VPCClient.CreateSecurityGroupRule(options)
 	if err != nil {
-		return fmt.Errorf("error unexpected failure creating security group rule: %w", err)
+		return nil, fmt.Errorf("error unexpected failure creating security group rule: %w", err)
 	} else if securityGroupRuleIntfDetails == nil {
-		return fmt.Errorf("error failed creating security group rule")
-	}
-
-	// Typecast the resulting SecurityGroupRuleIntf, to retrieve the ID for logging
-	var ruleID *string
-	switch reflect.TypeOf(securityGroupRuleIntfDetails).String() {
-	case infrav1.VPCSecurityGroupRuleProtocolAllType:
-		rule := securityGroupRuleIntfDetails.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll)
-		ruleID = rule.ID
-	case infrav1.VPCSecurityGroupRuleProtocolIcmpType:
-		rule := securityGroupRuleIntfDetails.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp)
-		ruleID = rule.ID
-	case infrav1.VPCSecurityGroupRuleProtocolTcpudpType:
-		rule := securityGroupRuleIntfDetails.(*vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp)
-		ruleID = rule.ID
+		return nil, fmt.Errorf("error failed creating security group rule")
 	}
+
+	ruleID := securityGroupRuleID(securityGroupRuleIntfDetails)
 	log.V(3).Info("Created Security Group Rule", "ruleID", ruleID)
-	return nil
+	return ruleID, nil
 }
 
 // createSecurityGroupRuleRemote will create an IBM Cloud SecurityGroupRuleRemotePrototype, which defines the Remote details for an IBM Cloud Security Group Rule, provided by the SecurityGroupRuleRemote. Lookups of Security Group CRN's, by Name, or Subnet CIDRBlock's, by Name, allows the use of CAPI created resources to be defined in the SecurityGroupRuleRemote, when the CRN or CIDRBlock are unknown (runtime defined).
@@ -2186,3 +2471,465 @@ func (s *VPCClusterScope) getDefaultLoadBalancerListeners(defaultBackendPool boo
 	defaultListeners = append(defaultListeners, s.buildLoadBalancerListener(defaultListener))
 	return defaultListeners
 }
+
+// ReconcileControlPlaneDNS reconciles the DNS Services zone, the permitted network of the VPC and
+// the api and api-int records of the control-plane endpoint.
+func (s *VPCClusterScope) ReconcileControlPlaneDNS(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if s.IBMVPCCluster.Status.ControlPlaneDNS == nil {
+		s.IBMVPCCluster.Status.ControlPlaneDNS = &infrav1.ControlPlaneDNSStatus{}
+	}
+	r := &controlPlaneDNSReconciler{
+		client:      s.DNSServicesClient,
+		spec:        *s.ControlPlaneDNS(),
+		status:      s.IBMVPCCluster.Status.ControlPlaneDNS,
+		clusterName: s.IBMVPCCluster.Name,
+	}
+
+	if requeue, err := r.reconcileZone(ctx); err != nil || requeue {
+		return requeue, err
+	}
+
+	if s.IBMVPCCluster.Status.ControlPlaneDNS.PermittedNetwork == nil {
+		vpcID, err := s.GetVPCID()
+		if err != nil {
+			return false, fmt.Errorf("failed to retrieve VPC ID: %w", err)
+		} else if vpcID == nil {
+			return false, fmt.Errorf("failed to retrieve VPC ID, VPC not found")
+		}
+		vpcDetails, _, err := s.VPCClient.GetVPC(&vpcv1.GetVPCOptions{
+			ID: vpcID,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to retrieve VPC: %w", err)
+		}
+		if err := r.reconcilePermittedNetwork(ctx, *vpcDetails.CRN); err != nil {
+			return false, err
+		}
+	}
+
+	apiTarget, err := s.GetLoadBalancerHostName()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve load balancer hostname: %w", err)
+	}
+	apiIntTarget, err := s.getPrivateLoadBalancerHostName()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve private load balancer hostname: %w", err)
+	}
+	if apiIntTarget == nil {
+		apiIntTarget = apiTarget
+	}
+	if apiTarget == nil {
+		log.V(3).Info("Load Balancer hostname is not yet available, requeuing DNS records reconciliation")
+		return true, nil
+	}
+
+	return false, r.reconcileRecords(ctx, *apiTarget, *apiIntTarget)
+}
+
+// DeleteControlPlaneDNS deletes the DNS Services records, permitted network and zone of the control-plane endpoint created by the controller.
+func (s *VPCClusterScope) DeleteControlPlaneDNS(ctx context.Context) (bool, error) {
+	if s.ControlPlaneDNS() == nil || s.IBMVPCCluster.Status.ControlPlaneDNS == nil {
+		return false, nil
+	}
+	r := &controlPlaneDNSReconciler{
+		client:      s.DNSServicesClient,
+		spec:        *s.ControlPlaneDNS(),
+		status:      s.IBMVPCCluster.Status.ControlPlaneDNS,
+		clusterName: s.IBMVPCCluster.Name,
+	}
+	return r.delete(ctx)
+}
+
+// ReconcileDNSRecords reconciles the CIS records of the control-plane endpoint, and of the ingress when requested,
+// pointing to the cluster's Load Balancer.
+func (s *VPCClusterScope) ReconcileDNSRecords(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	target, err := s.GetLoadBalancerHostName()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve load balancer hostname: %w", err)
+	}
+	if target == nil || *target == "" {
+		log.V(3).Info("Load Balancer hostname is not yet available, requeuing CIS DNS records reconciliation")
+		return true, nil
+	}
+
+	if s.IBMVPCCluster.Status.DNS == nil {
+		s.IBMVPCCluster.Status.DNS = &infrav1.DNSStatus{}
+	}
+	r := &publicDNSReconciler{
+		client:      s.CISClient,
+		spec:        *s.DNS(),
+		status:      s.IBMVPCCluster.Status.DNS,
+		clusterName: s.IBMVPCCluster.Name,
+	}
+	return false, r.reconcile(ctx, *target)
+}
+
+// DeleteDNSRecords deletes the CIS records of the cluster created by the controller.
+func (s *VPCClusterScope) DeleteDNSRecords(ctx context.Context) error {
+	if s.DNS() == nil || s.IBMVPCCluster.Status.DNS == nil {
+		return nil
+	}
+	r := &publicDNSReconciler{
+		client:      s.CISClient,
+		spec:        *s.DNS(),
+		status:      s.IBMVPCCluster.Status.DNS,
+		clusterName: s.IBMVPCCluster.Name,
+	}
+	return r.delete(ctx)
+}
+
+// ReconcileVPEGateways reconciles the VPE Gateways of the cluster, bound to the Control Plane and Worker Subnets
+// and the Security Groups, and returns whether they are not yet ready.
+func (s *VPCClusterScope) ReconcileVPEGateways(ctx context.Context) (bool, error) {
+	vpcID, err := s.GetVPCID()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve vpc id: %w", err)
+	} else if vpcID == nil {
+		return false, fmt.Errorf("VPC has not been reconciled")
+	}
+	resourceGroupID, err := s.GetNetworkResourceGroupID()
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve network resource group id: %w", err)
+	}
+	r := &vpeGatewayReconciler{
+		client:          s.VPCClient,
+		clusterName:     s.Name(),
+		region:          s.IBMVPCCluster.Spec.Region,
+		vpcID:           *vpcID,
+		resourceGroupID: resourceGroupID,
+	}
+	if s.NetworkStatus() == nil {
+		s.IBMVPCCluster.Status.Network = &infrav1.VPCNetworkStatus{}
+	}
+	for _, subnets := range []map[string]*infrav1.ResourceStatus{s.NetworkStatus().ControlPlaneSubnets, s.NetworkStatus().WorkerSubnets} {
+		for _, subnet := range subnets {
+			r.subnetIDs = append(r.subnetIDs, subnet.ID)
+		}
+	}
+	for _, securityGroup := range s.NetworkStatus().SecurityGroups {
+		r.securityGroupIDs = append(r.securityGroupIDs, securityGroup.ID)
+	}
+
+	if s.NetworkStatus().VPEGateways == nil {
+		s.NetworkStatus().VPEGateways = make(map[string]*infrav1.ResourceStatus)
+	}
+	requeue := false
+	for _, gateway := range s.NetworkSpec().VPEGateways {
+		name := vpeGatewayName(gateway, r.clusterName)
+		var ref *infrav1.ResourceReference
+		if existing, ok := s.NetworkStatus().VPEGateways[name]; ok {
+			ref = &infrav1.ResourceReference{ID: ptr.To(existing.ID), ControllerCreated: existing.ControllerCreated}
+		}
+		ref, ready, err := r.reconcile(ctx, gateway, ref)
+		if ref != nil {
+			s.NetworkStatus().VPEGateways[name] = &infrav1.ResourceStatus{
+				ID:                *ref.ID,
+				Name:              ptr.To(name),
+				Ready:             ready,
+				ControllerCreated: ref.ControllerCreated,
+			}
+		}
+		if err != nil {
+			return false, err
+		}
+		if !ready {
+			requeue = true
+		}
+	}
+	return requeue, nil
+}
+
+// DeleteVPEGateways deletes the VPE Gateways created by the controller and returns whether the deletion is pending.
+func (s *VPCClusterScope) DeleteVPEGateways(ctx context.Context) (bool, error) {
+	if s.NetworkStatus() == nil {
+		return false, nil
+	}
+	r := &vpeGatewayReconciler{
+		client: s.VPCClient,
+	}
+	var errs []error
+	requeue := false
+	for name, gateway := range s.NetworkStatus().VPEGateways {
+		deleted, err := r.delete(ctx, name, infrav1.ResourceReference{ID: ptr.To(gateway.ID), ControllerCreated: gateway.ControllerCreated})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !deleted {
+			requeue = true
+			continue
+		}
+		delete(s.NetworkStatus().VPEGateways, name)
+	}
+	if len(errs) > 0 {
+		return false, kerrors.NewAggregate(errs)
+	}
+	return requeue, nil
+}
+
+// DeleteLoadBalancers deletes the Load Balancers created by the controller.
+func (s *VPCClusterScope) DeleteLoadBalancers(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if s.NetworkStatus() == nil {
+		return false, nil
+	}
+
+	var errs []error
+	requeue := false
+	for _, lb := range s.NetworkStatus().LoadBalancers {
+		if lb.ID == nil || lb.ControllerCreated == nil || !*lb.ControllerCreated {
+			log.Info("Skipping load balancer deletion as resource is not created by controller")
+			continue
+		}
+
+		loadBalancer, resp, err := s.VPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+			ID: lb.ID,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+				log.Info("Load balancer successfully deleted", "loadBalancerID", *lb.ID)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("failed to fetch load balancer %s: %w", *lb.ID, err))
+			continue
+		}
+
+		if loadBalancer != nil && loadBalancer.ProvisioningStatus != nil && *loadBalancer.ProvisioningStatus == string(infrav1.VPCLoadBalancerStateDeletePending) {
+			log.V(3).Info("Load balancer is currently being deleted", "loadBalancerID", *lb.ID)
+			requeue = true
+			continue
+		}
+
+		if _, err = s.VPCClient.DeleteLoadBalancer(&vpcv1.DeleteLoadBalancerOptions{
+			ID: lb.ID,
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete load balancer %s: %w", *lb.ID, err))
+			continue
+		}
+		requeue = true
+	}
+	if len(errs) > 0 {
+		return false, kerrors.NewAggregate(errs)
+	}
+	return requeue, nil
+}
+
+// DeleteSecurityGroups deletes the Security Groups created by the controller.
+func (s *VPCClusterScope) DeleteSecurityGroups(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	if s.NetworkStatus() == nil {
+		return nil
+	}
+
+	var errs []error
+	for _, securityGroup := range s.NetworkStatus().SecurityGroups {
+		if securityGroup.ID == "" || securityGroup.ControllerCreated == nil || !*securityGroup.ControllerCreated {
+			log.Info("Skipping security group deletion as resource is not created by controller", "securityGroupID", securityGroup.ID)
+			continue
+		}
+
+		if _, resp, err := s.VPCClient.GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{
+			ID: ptr.To(securityGroup.ID),
+		}); err != nil {
+			if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+				log.Info("Security group has been already deleted", "securityGroupID", securityGroup.ID)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("failed to fetch security group %s: %w", securityGroup.ID, err))
+			continue
+		}
+
+		log.V(3).Info("Deleting security group", "securityGroupID", securityGroup.ID)
+		if _, err := s.VPCClient.DeleteSecurityGroup(&vpcv1.DeleteSecurityGroupOptions{
+			ID: ptr.To(securityGroup.ID),
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete security group %s: %w", securityGroup.ID, err))
+			continue
+		}
+		log.Info("Security group successfully deleted", "securityGroupID", securityGroup.ID)
+	}
+	if len(errs) > 0 {
+		return kerrors.NewAggregate(errs)
+	}
+	return nil
+}
+
+// DeleteSubnets deletes the Control Plane and Worker Subnets created by the controller.
+func (s *VPCClusterScope) DeleteSubnets(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if s.NetworkStatus() == nil {
+		return false, nil
+	}
+
+	// The same subnet may be used for both the Control Plane and Workers, so only attempt to delete it once.
+	subnets := make(map[string]*infrav1.ResourceStatus)
+	for _, subnet := range s.NetworkStatus().ControlPlaneSubnets {
+		subnets[subnet.ID] = subnet
+	}
+	for _, subnet := range s.NetworkStatus().WorkerSubnets {
+		subnets[subnet.ID] = subnet
+	}
+
+	var errs []error
+	requeue := false
+	for subnetID, subnet := range subnets {
+		if subnetID == "" || subnet.ControllerCreated == nil || !*subnet.ControllerCreated {
+			log.Info("Skipping subnet deletion as resource is not created by controller", "subnetID", subnetID)
+			continue
+		}
+
+		subnetDetails, resp, err := s.VPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+			ID: ptr.To(subnetID),
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+				log.Info("Subnet successfully deleted", "subnetID", subnetID)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("failed to fetch subnet %s: %w", subnetID, err))
+			continue
+		}
+
+		if subnetDetails != nil && subnetDetails.Status != nil && *subnetDetails.Status == string(infrav1.VPCSubnetStateDeleting) {
+			log.V(3).Info("Subnet is currently being deleted", "subnetID", subnetID)
+			requeue = true
+			continue
+		}
+
+		if _, err = s.VPCClient.DeleteSubnet(&vpcv1.DeleteSubnetOptions{
+			ID: ptr.To(subnetID),
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete subnet %s: %w", subnetID, err))
+			continue
+		}
+		requeue = true
+	}
+	if len(errs) > 0 {
+		return false, kerrors.NewAggregate(errs)
+	}
+	return requeue, nil
+}
+
+// DeletePublicGateways deletes the Public Gateways created by the controller.
+// Public Gateways can only be deleted once no subnets are attached, so subnets must be deleted beforehand.
+func (s *VPCClusterScope) DeletePublicGateways(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if s.NetworkStatus() == nil {
+		return false, nil
+	}
+
+	var errs []error
+	requeue := false
+	for _, publicGateway := range s.NetworkStatus().PublicGateways {
+		if publicGateway.ID == "" || publicGateway.ControllerCreated == nil || !*publicGateway.ControllerCreated {
+			log.Info("Skipping public gateway deletion as resource is not created by controller", "publicGatewayID", publicGateway.ID)
+			continue
+		}
+
+		publicGatewayDetails, resp, err := s.VPCClient.GetPublicGateway(&vpcv1.GetPublicGatewayOptions{
+			ID: ptr.To(publicGateway.ID),
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+				log.Info("Public gateway successfully deleted", "publicGatewayID", publicGateway.ID)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("failed to fetch public gateway %s: %w", publicGateway.ID, err))
+			continue
+		}
+
+		if publicGatewayDetails != nil && publicGatewayDetails.Status != nil && *publicGatewayDetails.Status == string(infrav1.VPCPublicGatewayStateDeleting) {
+			log.V(3).Info("Public gateway is currently being deleted", "publicGatewayID", publicGateway.ID)
+			requeue = true
+			continue
+		}
+
+		if _, err = s.VPCClient.DeletePublicGateway(&vpcv1.DeletePublicGatewayOptions{
+			ID: ptr.To(publicGateway.ID),
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete public gateway %s: %w", publicGateway.ID, err))
+			continue
+		}
+		requeue = true
+	}
+	if len(errs) > 0 {
+		return false, kerrors.NewAggregate(errs)
+	}
+	return requeue, nil
+}
+
+// DeleteVPC deletes the VPC, if created by the controller.
+func (s *VPCClusterScope) DeleteVPC(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if s.NetworkStatus() == nil || s.NetworkStatus().VPC == nil || s.NetworkStatus().VPC.ID == "" {
+		return false, nil
+	}
+
+	vpcStatus := s.NetworkStatus().VPC
+	if vpcStatus.ControllerCreated == nil || !*vpcStatus.ControllerCreated {
+		log.Info("Skipping VPC deletion as resource is not created by controller")
+		return false, nil
+	}
+
+	vpcDetails, resp, err := s.VPCClient.GetVPC(&vpcv1.GetVPCOptions{
+		ID: ptr.To(vpcStatus.ID),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+			log.Info("VPC successfully deleted")
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to fetch VPC: %w", err)
+	}
+
+	if vpcDetails != nil && vpcDetails.Status != nil && *vpcDetails.Status == string(infrav1.VPCStateDeleting) {
+		log.V(3).Info("VPC is currently being deleted")
+		return true, nil
+	}
+
+	if _, err = s.VPCClient.DeleteVPC(&vpcv1.DeleteVPCOptions{
+		ID: ptr.To(vpcStatus.ID),
+	}); err != nil {
+		return false, fmt.Errorf("failed to delete VPC: %w", err)
+	}
+	return true, nil
+}
+
+// DeleteVPCCustomImage deletes the VPC Custom Image, if created by the controller.
+func (s *VPCClusterScope) DeleteVPCCustomImage(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	imageStatus := s.IBMVPCCluster.Status.Image
+	if imageStatus == nil || imageStatus.ID == "" {
+		return false, nil
+	}
+
+	if imageStatus.ControllerCreated == nil || !*imageStatus.ControllerCreated {
+		log.Info("Skipping VPC custom image deletion as resource is not created by controller")
+		return false, nil
+	}
+
+	imageDetails, resp, err := s.VPCClient.GetImage(&vpcv1.GetImageOptions{
+		ID: ptr.To(imageStatus.ID),
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+			log.Info("VPC custom image successfully deleted")
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to fetch VPC custom image: %w", err)
+	}
+
+	if imageDetails != nil && imageDetails.Status != nil && *imageDetails.Status == string(infrav1.VPCImageStateDeleting) {
+		log.V(3).Info("VPC custom image is currently being deleted")
+		return true, nil
+	}
+
+	if _, err = s.VPCClient.DeleteImage(&vpcv1.DeleteImageOptions{
+		ID: ptr.To(imageStatus.ID),
+	}); err != nil {
+		return false, fmt.Errorf("failed to delete VPC custom image: %w", err)
+	}
+	return true, nil
+}
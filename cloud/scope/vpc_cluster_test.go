@@ -0,0 +1,993 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	cismock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cis/mock"
+	dnsmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dnsservices/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/test/fakeibmcloud"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestVPCClusterScopeDeleteLoadBalancers(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	vpcClusterScope := func(controllerCreated bool) *VPCClusterScope {
+		return &VPCClusterScope{
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						LoadBalancers: map[string]*infrav1.VPCLoadBalancerStatus{
+							"lb-id": {
+								ID:                ptr.To("lb-id"),
+								ControllerCreated: ptr.To(controllerCreated),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When load balancer is not created by controller", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(false)
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteLoadBalancers(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When load balancer is not found", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(true)
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteLoadBalancers(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When load balancer is in delete pending state", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(true)
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{ID: ptr.To("lb-id"), ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateDeletePending))}, nil, nil)
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteLoadBalancers(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("When DeleteLoadBalancer returns error", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(true)
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{ID: ptr.To("lb-id"), ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateActive))}, nil, nil)
+		mockVpc.EXPECT().DeleteLoadBalancer(gomock.Any()).Return(nil, errors.New("failed to delete load balancer"))
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteLoadBalancers(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When load balancer is deleted successfully", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(true)
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{ID: ptr.To("lb-id"), ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateActive))}, nil, nil)
+		mockVpc.EXPECT().DeleteLoadBalancer(gomock.Any()).Return(nil, nil)
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteLoadBalancers(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+}
+
+func TestVPCClusterScopeDeleteSubnets(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	vpcClusterScope := func() *VPCClusterScope {
+		subnet := &infrav1.ResourceStatus{
+			ID:                "subnet-id",
+			Name:              ptr.To("subnet"),
+			ControllerCreated: ptr.To(true),
+		}
+		return &VPCClusterScope{
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						// The same subnet used by both Control Plane and Workers is expected to be deleted once.
+						ControlPlaneSubnets: map[string]*infrav1.ResourceStatus{
+							"subnet": subnet,
+						},
+						WorkerSubnets: map[string]*infrav1.ResourceStatus{
+							"subnet": subnet,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When subnet is not found", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope()
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteSubnets(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When subnet is in deleting state", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope()
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id"), Status: ptr.To(string(infrav1.VPCSubnetStateDeleting))}, nil, nil)
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteSubnets(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("When subnet is deleted successfully", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope()
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id"), Status: ptr.To("available")}, nil, nil)
+		mockVpc.EXPECT().DeleteSubnet(gomock.Any()).Return(nil, nil)
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteSubnets(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+}
+
+func TestVPCClusterScopeDeletePublicGateways(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	vpcClusterScope := func(controllerCreated *bool) *VPCClusterScope {
+		return &VPCClusterScope{
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						PublicGateways: map[string]*infrav1.ResourceStatus{
+							"pgateway": {
+								ID:                "pgateway-id",
+								Name:              ptr.To("pgateway"),
+								ControllerCreated: controllerCreated,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When public gateway is not created by controller", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(nil)
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeletePublicGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When public gateway is in deleting state", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(ptr.To(true))
+		mockVpc.EXPECT().GetPublicGateway(gomock.Any()).Return(&vpcv1.PublicGateway{ID: ptr.To("pgateway-id"), Status: ptr.To(string(infrav1.VPCPublicGatewayStateDeleting))}, nil, nil)
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeletePublicGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("When DeletePublicGateway returns error", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(ptr.To(true))
+		mockVpc.EXPECT().GetPublicGateway(gomock.Any()).Return(&vpcv1.PublicGateway{ID: ptr.To("pgateway-id"), Status: ptr.To("available")}, nil, nil)
+		mockVpc.EXPECT().DeletePublicGateway(gomock.Any()).Return(nil, errors.New("failed to delete public gateway"))
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeletePublicGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
+
+func TestVPCClusterScopeDeleteVPC(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	vpcClusterScope := func(controllerCreated *bool) *VPCClusterScope {
+		return &VPCClusterScope{
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						VPC: &infrav1.ResourceStatus{
+							ID:                "vpc-id",
+							ControllerCreated: controllerCreated,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When VPC is not created by controller", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(ptr.To(false))
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPC(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When VPC is not found", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(ptr.To(true))
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPC(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When VPC is in deleting state", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(ptr.To(true))
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), Status: ptr.To(string(infrav1.VPCStateDeleting))}, nil, nil)
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPC(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("When VPC is deleted successfully", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(ptr.To(true))
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), Status: ptr.To("available")}, nil, nil)
+		mockVpc.EXPECT().DeleteVPC(gomock.Any()).Return(nil, nil)
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPC(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+}
+
+func TestVPCClusterScopeDeleteVPCCustomImage(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	vpcClusterScope := func() *VPCClusterScope {
+		return &VPCClusterScope{
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Status: infrav1.IBMVPCClusterStatus{
+					Image: &infrav1.ResourceStatus{
+						ID:                "image-id",
+						ControllerCreated: ptr.To(true),
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When image is in deleting state", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope()
+		mockVpc.EXPECT().GetImage(gomock.Any()).Return(&vpcv1.Image{ID: ptr.To("image-id"), Status: ptr.To(string(infrav1.VPCImageStateDeleting))}, nil, nil)
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCCustomImage(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("When DeleteImage returns error", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope()
+		mockVpc.EXPECT().GetImage(gomock.Any()).Return(&vpcv1.Image{ID: ptr.To("image-id"), Status: ptr.To("available")}, nil, nil)
+		mockVpc.EXPECT().DeleteImage(gomock.Any()).Return(nil, errors.New("failed to delete image"))
+		clusterScope.VPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCCustomImage(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
+
+func TestVPCClusterScopeReconcileFailureDomains(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When no subnets are provided, failure domains are created for each zone", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := &VPCClusterScope{
+			VPCClient: mockVpc,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Region:  "us-south",
+					Network: &infrav1.VPCNetworkSpec{},
+				},
+			},
+		}
+		mockVpc.EXPECT().GetVPCZonesByRegion("us-south").Return([]string{"us-south-1", "us-south-2"}, nil).Times(2)
+		err := clusterScope.ReconcileFailureDomains()
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMVPCCluster.Status.FailureDomains).To(HaveLen(2))
+		g.Expect(clusterScope.IBMVPCCluster.Status.FailureDomains["us-south-1"].ControlPlane).To(BeTrue())
+		g.Expect(clusterScope.IBMVPCCluster.Status.FailureDomains["us-south-2"].ControlPlane).To(BeTrue())
+	})
+
+	t.Run("When subnets are provided, zones of worker only subnets are not used for control plane", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := &VPCClusterScope{
+			VPCClient: mockVpc,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Region: "us-south",
+					Network: &infrav1.VPCNetworkSpec{
+						ControlPlaneSubnets: []infrav1.Subnet{
+							{
+								Name: ptr.To("cp-subnet"),
+								Zone: ptr.To("us-south-1"),
+							},
+						},
+						WorkerSubnets: []infrav1.Subnet{
+							{
+								ID: ptr.To("worker-subnet-id"),
+							},
+						},
+					},
+				},
+			},
+		}
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{ID: ptr.To("worker-subnet-id"), Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-3")}}, nil, nil)
+		err := clusterScope.ReconcileFailureDomains()
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMVPCCluster.Status.FailureDomains).To(HaveLen(2))
+		g.Expect(clusterScope.IBMVPCCluster.Status.FailureDomains["us-south-1"].ControlPlane).To(BeTrue())
+		g.Expect(clusterScope.IBMVPCCluster.Status.FailureDomains["us-south-3"].ControlPlane).To(BeFalse())
+	})
+
+	t.Run("When retrieving the subnet zone fails", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := &VPCClusterScope{
+			VPCClient: mockVpc,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Region: "us-south",
+					Network: &infrav1.VPCNetworkSpec{
+						ControlPlaneSubnets: []infrav1.Subnet{
+							{
+								ID: ptr.To("cp-subnet-id"),
+							},
+						},
+					},
+				},
+			},
+		}
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(nil, nil, errors.New("failed to get subnet"))
+		err := clusterScope.ReconcileFailureDomains()
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMVPCCluster.Status.FailureDomains).To(BeNil())
+	})
+}
+
+func TestVPCClusterScopeReconcileSecurityGroupRules(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	securityGroup := infrav1.VPCSecurityGroup{
+		Name: ptr.To("sg-name"),
+	}
+
+	vpcClusterScope := func(mode infrav1.VPCSecurityGroupRuleReconcileMode, controllerCreated bool) *VPCClusterScope {
+		return &VPCClusterScope{
+			VPCClient: mockVpc,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Network: &infrav1.VPCNetworkSpec{
+						SecurityGroupRuleReconcileMode: mode,
+						SecurityGroups:                 []infrav1.VPCSecurityGroup{securityGroup},
+					},
+				},
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						SecurityGroups: map[string]*infrav1.ResourceStatus{
+							"sg-name": {
+								ID:                "sg-id",
+								ControllerCreated: ptr.To(controllerCreated),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	existingRules := &vpcv1.SecurityGroupRuleCollection{
+		Rules: []vpcv1.SecurityGroupRuleIntf{
+			&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+				ID:        ptr.To("rule-id"),
+				Direction: ptr.To(vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAllDirectionInboundConst),
+				Protocol:  ptr.To(vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAllProtocolAllConst),
+			},
+		},
+	}
+
+	t.Run("When rules are reconciled in Additive mode", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(infrav1.VPCSecurityGroupRuleReconcileModeAdditive, true)
+		securityGroupWithRules := infrav1.VPCSecurityGroup{
+			Name: ptr.To("sg-name"),
+			Rules: []*infrav1.VPCSecurityGroupRule{
+				{
+					Action:    infrav1.VPCSecurityGroupRuleActionAllow,
+					Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+					Source: &infrav1.VPCSecurityGroupRulePrototype{
+						Protocol: infrav1.VPCSecurityGroupRuleProtocolAll,
+						Remotes:  []infrav1.VPCSecurityGroupRuleRemote{{RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny}},
+					},
+				},
+			},
+		}
+		rules := &vpcv1.SecurityGroupRuleCollection{
+			Rules: []vpcv1.SecurityGroupRuleIntf{
+				&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+					ID:        ptr.To("defined-rule-id"),
+					Direction: ptr.To(vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAllDirectionInboundConst),
+					Protocol:  ptr.To(vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAllProtocolAllConst),
+					Remote:    &vpcv1.SecurityGroupRuleRemote{},
+				},
+				&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+					ID:        ptr.To("undefined-rule-id"),
+					Direction: ptr.To(vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudpDirectionOutboundConst),
+					Protocol:  ptr.To(vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudpProtocolTCPConst),
+					Remote:    &vpcv1.SecurityGroupRuleRemote{},
+				},
+			},
+		}
+		mockVpc.EXPECT().ListSecurityGroupRules(gomock.Any()).Return(rules, nil, nil)
+		mockVpc.EXPECT().DeleteSecurityGroupRule(gomock.Any()).Times(0)
+		requeue, drift, err := clusterScope.reconcileSecurityGroupRules(ctx, securityGroupWithRules)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(drift).To(BeEmpty())
+		g.Expect(clusterScope.IBMVPCCluster.Status.Network.SecurityGroupRules["sg-name"].RuleIDs).To(Equal([]*string{ptr.To("defined-rule-id")}))
+	})
+
+	t.Run("When undefined rule exists in security group created by controller", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(infrav1.VPCSecurityGroupRuleReconcileModeEnforce, true)
+		mockVpc.EXPECT().ListSecurityGroupRules(gomock.Any()).Return(existingRules, nil, nil)
+		mockVpc.EXPECT().DeleteSecurityGroupRule(&vpcv1.DeleteSecurityGroupRuleOptions{SecurityGroupID: ptr.To("sg-id"), ID: ptr.To("rule-id")}).Return(nil, nil)
+		requeue, drift, err := clusterScope.reconcileSecurityGroupRules(ctx, securityGroup)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(drift).To(BeEmpty())
+		g.Expect(clusterScope.IBMVPCCluster.Status.Network.SecurityGroupRules["sg-name"].ID).To(Equal(ptr.To("sg-id")))
+	})
+
+	t.Run("When DeleteSecurityGroupRule returns error", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(infrav1.VPCSecurityGroupRuleReconcileModeEnforce, true)
+		mockVpc.EXPECT().ListSecurityGroupRules(gomock.Any()).Return(existingRules, nil, nil)
+		mockVpc.EXPECT().DeleteSecurityGroupRule(gomock.Any()).Return(nil, errors.New("failed to delete security group rule"))
+		_, _, err := clusterScope.reconcileSecurityGroupRules(ctx, securityGroup)
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When undefined rule exists in security group not created by controller", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(infrav1.VPCSecurityGroupRuleReconcileModeEnforce, false)
+		mockVpc.EXPECT().ListSecurityGroupRules(gomock.Any()).Return(existingRules, nil, nil)
+		requeue, drift, err := clusterScope.reconcileSecurityGroupRules(ctx, securityGroup)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(drift).To(HaveLen(1))
+	})
+
+	t.Run("When rules are removed from security group in Additive mode", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(infrav1.VPCSecurityGroupRuleReconcileModeAdditive, true)
+		clusterScope.IBMVPCCluster.Status.Network.SecurityGroupRules = map[string]*infrav1.VPCSecurityGroupStatus{
+			"sg-name": {ID: ptr.To("sg-id"), RuleIDs: []*string{ptr.To("rule-id")}},
+		}
+		requeue, drift, err := clusterScope.reconcileSecurityGroupRules(ctx, securityGroup)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(drift).To(BeEmpty())
+		g.Expect(clusterScope.IBMVPCCluster.Status.Network.SecurityGroupRules).ToNot(HaveKey("sg-name"))
+	})
+
+	t.Run("When all security groups are removed", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope(infrav1.VPCSecurityGroupRuleReconcileModeEnforce, true)
+		clusterScope.IBMVPCCluster.Spec.Network.SecurityGroups = nil
+		clusterScope.IBMVPCCluster.Status.Network.SecurityGroupRules = map[string]*infrav1.VPCSecurityGroupStatus{
+			"sg-name": {ID: ptr.To("sg-id"), RuleIDs: []*string{ptr.To("rule-id")}},
+		}
+		requeue, drift, err := clusterScope.ReconcileSecurityGroups(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(drift).To(BeEmpty())
+		g.Expect(clusterScope.IBMVPCCluster.Status.Network.SecurityGroupRules).To(BeNil())
+	})
+}
+
+func TestVPCClusterScopePruneSecurityGroupRulesStatus(t *testing.T) {
+	g := NewWithT(t)
+	clusterScope := &VPCClusterScope{
+		IBMVPCCluster: &infrav1.IBMVPCCluster{
+			Spec: infrav1.IBMVPCClusterSpec{
+				Network: &infrav1.VPCNetworkSpec{
+					SecurityGroups: []infrav1.VPCSecurityGroup{
+						{Name: ptr.To("sg-name")},
+						{ID: ptr.To("sg-id")},
+					},
+				},
+			},
+			Status: infrav1.IBMVPCClusterStatus{
+				Network: &infrav1.VPCNetworkStatus{
+					SecurityGroupRules: map[string]*infrav1.VPCSecurityGroupStatus{
+						"sg-name":         {ID: ptr.To("sg-name-id")},
+						"sg-id":           {ID: ptr.To("sg-id")},
+						"removed-sg-name": {ID: ptr.To("removed-sg-id")},
+					},
+				},
+			},
+		},
+	}
+	clusterScope.pruneSecurityGroupRulesStatus()
+	g.Expect(clusterScope.IBMVPCCluster.Status.Network.SecurityGroupRules).To(HaveLen(2))
+	g.Expect(clusterScope.IBMVPCCluster.Status.Network.SecurityGroupRules).To(HaveKey("sg-name"))
+	g.Expect(clusterScope.IBMVPCCluster.Status.Network.SecurityGroupRules).To(HaveKey("sg-id"))
+}
+
+func TestVPCClusterScopeReconcileControlPlaneDNS(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockDNS  *dnsmock.MockDNSServices
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+		mockDNS = dnsmock.NewMockDNSServices(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	vpcClusterScope := func() *VPCClusterScope {
+		return &VPCClusterScope{
+			VPCClient:         mockVpc,
+			DNSServicesClient: mockDNS,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					ControlPlaneDNS: &infrav1.ControlPlaneDNS{
+						InstanceID: "dns-instance-id",
+						Zone:       "capi.example.com",
+					},
+					Network: &infrav1.VPCNetworkSpec{
+						LoadBalancers: []infrav1.VPCLoadBalancerSpec{
+							{ID: ptr.To("lb-id")},
+						},
+					},
+				},
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						VPC: &infrav1.ResourceStatus{ID: "vpc-id"},
+						LoadBalancers: map[string]*infrav1.VPCLoadBalancerStatus{
+							"lb-id": {
+								ID:       ptr.To("lb-id"),
+								Hostname: ptr.To("lb.example.com"),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When records point to the only load balancer", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope()
+		mockDNS.EXPECT().GetDnszoneByName("dns-instance-id", "capi.example.com").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id"), State: ptr.To(dnssvcsv1.Dnszone_State_Active)}, nil)
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockDNS.EXPECT().GetPermittedNetworkByVPCCRN("dns-instance-id", "zone-id", "vpc-crn").Return(nil, nil)
+		mockDNS.EXPECT().CreatePermittedNetwork(gomock.Any()).Return(&dnssvcsv1.PermittedNetwork{ID: ptr.To("network-id")}, nil, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", gomock.Any()).Return(nil, nil).Times(2)
+		mockDNS.EXPECT().CreateResourceRecord(gomock.Any()).DoAndReturn(func(options *dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+			g.Expect(*options.Rdata.(*dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord).Cname).To(Equal("lb.example.com"))
+			return &dnssvcsv1.ResourceRecord{ID: ptr.To(*options.Name + "-id")}, nil, nil
+		}).Times(2)
+
+		requeue, err := clusterScope.ReconcileControlPlaneDNS(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(*clusterScope.IBMVPCCluster.Status.ControlPlaneDNS.Zone.ControllerCreated).To(BeFalse())
+		g.Expect(*clusterScope.IBMVPCCluster.Status.ControlPlaneDNS.APIRecord.ID).To(Equal("api.capi.example.com-id"))
+		g.Expect(*clusterScope.IBMVPCCluster.Status.ControlPlaneDNS.APIIntRecord.ID).To(Equal("api-int.capi.example.com-id"))
+	})
+
+	t.Run("When GetVPC returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope()
+		mockDNS.EXPECT().GetDnszoneByName("dns-instance-id", "capi.example.com").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id"), State: ptr.To(dnssvcsv1.Dnszone_State_Active)}, nil)
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get vpc"))
+
+		requeue, err := clusterScope.ReconcileControlPlaneDNS(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
+
+func TestVPCClusterScopeReconcileDNSRecords(t *testing.T) {
+	var (
+		mockCIS  *cismock.MockCIS
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockCIS = cismock.NewMockCIS(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	vpcClusterScope := func() *VPCClusterScope {
+		return &VPCClusterScope{
+			CISClient: mockCIS,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					DNS: &infrav1.DNS{
+						CISInstanceCRN: "cis-crn",
+						Domain:         "example.com",
+						APIRecordName:  ptr.To("api.capi"),
+						Ingress:        &infrav1.DNSIngress{},
+					},
+					Network: &infrav1.VPCNetworkSpec{
+						LoadBalancers: []infrav1.VPCLoadBalancerSpec{
+							{ID: ptr.To("lb-id")},
+						},
+					},
+				},
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						LoadBalancers: map[string]*infrav1.VPCLoadBalancerStatus{
+							"lb-id": {
+								ID:       ptr.To("lb-id"),
+								Hostname: ptr.To("lb.example.com"),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When records point to the load balancer", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope()
+		clusterScope.IBMVPCCluster.Name = "capi"
+		mockCIS.EXPECT().GetZoneByName("example.com").Return(&zonesv1.ZoneDetails{ID: ptr.To("zone-id")}, nil)
+		mockCIS.EXPECT().GetDNSRecordByName("zone-id", "api.capi.example.com").Return(nil, nil)
+		mockCIS.EXPECT().GetDNSRecordByName("zone-id", "*.apps.capi.example.com").Return(nil, nil)
+		mockCIS.EXPECT().CreateDNSRecord("zone-id", gomock.Any()).DoAndReturn(func(_ string, options *dnsrecordsv1.CreateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+			g.Expect(*options.Content).To(Equal("lb.example.com"))
+			return &dnsrecordsv1.DnsrecordResp{Result: &dnsrecordsv1.DnsrecordDetails{ID: ptr.To(*options.Name + "-id")}}, nil, nil
+		}).Times(2)
+
+		requeue, err := clusterScope.ReconcileDNSRecords(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(*clusterScope.IBMVPCCluster.Status.DNS.APIRecord.ID).To(Equal("api.capi.example.com-id"))
+		g.Expect(*clusterScope.IBMVPCCluster.Status.DNS.IngressRecord.ID).To(Equal("*.apps.capi.example.com-id"))
+	})
+
+	t.Run("When CreateDNSRecord returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := vpcClusterScope()
+		clusterScope.IBMVPCCluster.Status.DNS = &infrav1.DNSStatus{ZoneID: ptr.To("zone-id")}
+		mockCIS.EXPECT().GetDNSRecordByName("zone-id", "api.capi.example.com").Return(nil, nil)
+		mockCIS.EXPECT().CreateDNSRecord("zone-id", gomock.Any()).Return(nil, nil, errors.New("failed to create record"))
+
+		requeue, err := clusterScope.ReconcileDNSRecords(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
+
+func TestVPCClusterScopeReconcileVPEGateways(t *testing.T) {
+	// The VPE gateways are reconciled against the fake IBM Cloud backend, which keeps the state of the
+	// resources across the calls and moves them through their lifecycle states like the real service.
+	setup := func(t *testing.T) *VPCClusterScope {
+		t.Helper()
+		g := NewWithT(t)
+		cloud := fakeibmcloud.New(fakeibmcloud.Options{})
+		vpcClient, err := cloud.VPC()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		vpc, _, err := vpcClient.CreateVPC(&vpcv1.CreateVPCOptions{
+			Name:          ptr.To("capi-vpc"),
+			ResourceGroup: &vpcv1.ResourceGroupIdentity{ID: ptr.To(cloud.DefaultResourceGroupID())},
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		subnets := make([]*vpcv1.Subnet, 2)
+		for i := range subnets {
+			subnets[i], _, err = vpcClient.CreateSubnet(&vpcv1.CreateSubnetOptions{
+				SubnetPrototype: &vpcv1.SubnetPrototypeSubnetByTotalCount{
+					Name:                  ptr.To(fmt.Sprintf("capi-subnet-%d", i)),
+					VPC:                   &vpcv1.VPCIdentityByID{ID: vpc.ID},
+					Zone:                  &vpcv1.ZoneIdentityByName{Name: ptr.To(cloud.Zones()[i])},
+					TotalIpv4AddressCount: ptr.To(int64(256)),
+				},
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+		}
+
+		return &VPCClusterScope{
+			VPCClient: vpcClient,
+			Cluster:   &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "capi"}},
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Region: cloud.Region(),
+					Network: &infrav1.VPCNetworkSpec{
+						VPEGateways: []infrav1.VPEGateway{
+							{Service: ptr.To(infrav1.VPEGatewayServiceIAM)},
+						},
+					},
+				},
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						VPC:           &infrav1.ResourceStatus{ID: *vpc.ID},
+						ResourceGroup: &infrav1.ResourceStatus{ID: cloud.DefaultResourceGroupID()},
+						ControlPlaneSubnets: map[string]*infrav1.ResourceStatus{
+							*subnets[0].Name: {ID: *subnets[0].ID},
+						},
+						WorkerSubnets: map[string]*infrav1.ResourceStatus{
+							*subnets[1].Name: {ID: *subnets[1].ID},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When VPE gateway is created in each zone", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := setup(t)
+
+		requeue, err := clusterScope.ReconcileVPEGateways(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(requeue).To(BeTrue(), "the created VPE gateway is pending")
+		g.Expect(clusterScope.IBMVPCCluster.Status.Network.VPEGateways).To(HaveKey("capi-vpe-iam"))
+		status := clusterScope.IBMVPCCluster.Status.Network.VPEGateways["capi-vpe-iam"]
+		g.Expect(status.Ready).To(BeFalse())
+		g.Expect(*status.ControllerCreated).To(BeTrue())
+		id := status.ID
+
+		requeue, err = clusterScope.ReconcileVPEGateways(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(requeue).To(BeFalse())
+		status = clusterScope.IBMVPCCluster.Status.Network.VPEGateways["capi-vpe-iam"]
+		g.Expect(status.ID).To(Equal(id), "the VPE gateway must not be created again")
+		g.Expect(status.Ready).To(BeTrue())
+
+		gateway, err := clusterScope.VPCClient.GetEndpointGatewayByName("capi-vpe-iam")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(gateway.Ips).To(HaveLen(2))
+		g.Expect(*gateway.ResourceGroup.ID).To(Equal(clusterScope.IBMVPCCluster.Status.Network.ResourceGroup.ID))
+	})
+
+	t.Run("When VPE gateway is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := setup(t)
+		g.Eventually(func() (bool, error) { return clusterScope.ReconcileVPEGateways(ctx) }).Should(BeFalse())
+
+		requeue, err := clusterScope.DeleteVPEGateways(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(requeue).To(BeTrue(), "the VPE gateway is being deleted")
+		g.Eventually(func() (bool, error) { return clusterScope.DeleteVPEGateways(ctx) }).Should(BeFalse())
+		g.Expect(clusterScope.IBMVPCCluster.Status.Network.VPEGateways).To(BeEmpty())
+
+		gateway, err := clusterScope.VPCClient.GetEndpointGatewayByName("capi-vpe-iam")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(gateway).To(BeNil())
+	})
+
+	t.Run("When VPE gateway is not created by the controller", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := setup(t)
+		g.Eventually(func() (bool, error) { return clusterScope.ReconcileVPEGateways(ctx) }).Should(BeFalse())
+		clusterScope.IBMVPCCluster.Status.Network.VPEGateways["capi-vpe-iam"].ControllerCreated = ptr.To(false)
+
+		g.Eventually(func() (bool, error) { return clusterScope.DeleteVPEGateways(ctx) }).Should(BeFalse())
+		gateway, err := clusterScope.VPCClient.GetEndpointGatewayByName("capi-vpe-iam")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(gateway).ToNot(BeNil())
+	})
+}
@@ -0,0 +1,847 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/pagingutils"
+)
+
+// VPCMachinePoolScopeParams defines the input parameters used to create a new VPCMachinePoolScope.
+type VPCMachinePoolScopeParams struct {
+	IBMVPCClient      vpc.Vpc
+	Client            client.Client
+	Logger            logr.Logger
+	Cluster           *clusterv1.Cluster
+	MachinePool       *clusterv1.MachinePool
+	IBMVPCCluster     *infrav1.IBMVPCCluster
+	IBMVPCMachinePool *infrav1.IBMVPCMachinePool
+	ServiceEndpoint   []endpoints.ServiceEndpoint
+}
+
+// VPCMachinePoolScope defines a scope defined around a machine pool and its cluster.
+type VPCMachinePoolScope struct {
+	Client client.Client
+
+	IBMVPCClient      vpc.Vpc
+	Cluster           *clusterv1.Cluster
+	MachinePool       *clusterv1.MachinePool
+	IBMVPCCluster     *infrav1.IBMVPCCluster
+	IBMVPCMachinePool *infrav1.IBMVPCMachinePool
+	ServiceEndpoint   []endpoints.ServiceEndpoint
+
+	// identityAuthenticator authenticates with the identity of the cluster, nil when the manager credentials are used.
+	identityAuthenticator core.Authenticator
+}
+
+// NewVPCMachinePoolScope creates a new VPCMachinePoolScope from the supplied parameters.
+func NewVPCMachinePoolScope(params VPCMachinePoolScopeParams) (*VPCMachinePoolScope, error) {
+	if params.MachinePool == nil {
+		return nil, errors.New("failed to generate new scope from nil MachinePool")
+	}
+	if params.IBMVPCMachinePool == nil {
+		return nil, errors.New("failed to generate new scope from nil IBMVPCMachinePool")
+	}
+	if params.IBMVPCCluster == nil {
+		return nil, errors.New("failed to generate new scope from nil IBMVPCCluster")
+	}
+
+	if params.Logger == (logr.Logger{}) {
+		params.Logger = klog.Background()
+	}
+
+	var identityAuthenticator core.Authenticator
+	var err error
+	if params.IBMVPCCluster.Spec.IdentityRef != nil {
+		identityAuthenticator, err = GetAuthenticator(context.TODO(), params.Client, params.IBMVPCCluster.Namespace, params.IBMVPCCluster.Spec.IdentityRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create authenticator: %w", err)
+		}
+	}
+
+	vpcClient := params.IBMVPCClient
+	if vpcClient == nil {
+		// Fetch the service endpoint.
+		svcEndpoint := endpoints.FetchVPCEndpoint(params.IBMVPCCluster.Spec.Region, params.ServiceEndpoint)
+
+		vpcClient, err = vpc.NewService(svcEndpoint, identityAuthenticator)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create IBM VPC session: %w", err)
+		}
+	}
+
+	if params.Logger.V(DEBUGLEVEL).Enabled() {
+		core.SetLoggingLevel(core.LevelDebug)
+	}
+
+	return &VPCMachinePoolScope{
+		Client:            params.Client,
+		IBMVPCClient:      vpcClient,
+		Cluster:           params.Cluster,
+		MachinePool:       params.MachinePool,
+		IBMVPCCluster:     params.IBMVPCCluster,
+		IBMVPCMachinePool: params.IBMVPCMachinePool,
+		ServiceEndpoint:   params.ServiceEndpoint,
+
+		identityAuthenticator: identityAuthenticator,
+	}, nil
+}
+
+// Name returns the name of the IBMVPCMachinePool.
+func (m *VPCMachinePoolScope) Name() string {
+	return m.IBMVPCMachinePool.Name
+}
+
+// DesiredReplicas returns the number of replicas requested by the MachinePool.
+func (m *VPCMachinePoolScope) DesiredReplicas() int64 {
+	return int64(ptr.Deref(m.MachinePool.Spec.Replicas, 0))
+}
+
+// GetBootstrapData returns the bootstrap data from the secret in the MachinePool's bootstrap.dataSecretName.
+func (m *VPCMachinePoolScope) GetBootstrapData(ctx context.Context) (string, error) {
+	return getMachinePoolBootstrapData(ctx, m.Client, m.MachinePool)
+}
+
+// GetZone returns the zone of the machine pool's instances, falling back to the first failure domain of the MachinePool.
+func (m *VPCMachinePoolScope) GetZone() string {
+	if m.IBMVPCMachinePool.Spec.Zone != "" {
+		return m.IBMVPCMachinePool.Spec.Zone
+	}
+	if len(m.MachinePool.Spec.FailureDomains) > 0 {
+		return m.MachinePool.Spec.FailureDomains[0]
+	}
+	return ""
+}
+
+// GetResourceGroupID returns the resource group id of the cluster.
+func (m *VPCMachinePoolScope) GetResourceGroupID() string {
+	if m.IBMVPCCluster.Status.ResourceGroup != nil {
+		return m.IBMVPCCluster.Status.ResourceGroup.ID
+	}
+	return m.IBMVPCCluster.Spec.ResourceGroup
+}
+
+// resourceName returns the name of the instance group of the machine pool, which is unique to the cluster and namespace of the pool.
+func (m *VPCMachinePoolScope) resourceName() string {
+	return machinePoolResourceName(m.IBMVPCMachinePool.Namespace, m.IBMVPCCluster.Name, m.Name(), vpcMachinePoolNameLength)
+}
+
+// instanceTemplatePrefix returns the prefix shared by the names of all the instance templates of the machine pool.
+func (m *VPCMachinePoolScope) instanceTemplatePrefix() string {
+	return m.resourceName() + "-"
+}
+
+// specHash returns the hash of the current spec, which is part of the names of the instance templates.
+func (m *VPCMachinePoolScope) specHash() (string, error) {
+	// The providerIDList is maintained by the controller and is not part of the template.
+	spec := m.IBMVPCMachinePool.Spec.DeepCopy()
+	spec.ProviderIDList = nil
+	return templateHash(spec, m.GetZone())
+}
+
+// instanceTemplateName returns the name of the instance template for the current spec and bootstrap data,
+// which is <prefix><spec hash>-<bootstrap data hash>. As instance templates cannot be updated, a change to either
+// results in a new template, but only a change to the spec makes the existing instances outdated.
+func (m *VPCMachinePoolScope) instanceTemplateName(userData string) (string, error) {
+	specHash, err := m.specHash()
+	if err != nil {
+		return "", err
+	}
+	userDataHash, err := templateHash(userData)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%s-%s", m.instanceTemplatePrefix(), specHash, userDataHash), nil
+}
+
+// ReconcileInstanceTemplate ensures an instance template exists for the current spec and returns its ID.
+func (m *VPCMachinePoolScope) ReconcileInstanceTemplate(ctx context.Context) (string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	userData, err := m.GetBootstrapData(ctx)
+	if err != nil {
+		return "", err
+	}
+	name, err := m.instanceTemplateName(userData)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute instance template name: %w", err)
+	}
+
+	if status := m.IBMVPCMachinePool.Status.InstanceTemplate; status != nil && status.Name != nil && *status.Name == name {
+		if _, _, err := m.IBMVPCClient.GetInstanceTemplate(&vpcv1.GetInstanceTemplateOptions{ID: ptr.To(status.ID)}); err == nil {
+			return status.ID, nil
+		}
+		log.V(3).Info("Instance template from status not found, looking it up by name", "name", name)
+	}
+
+	id, err := m.getInstanceTemplateIDByName(name)
+	if err != nil {
+		return "", err
+	}
+	if id == nil {
+		log.Info("Creating instance template", "name", name)
+		id, err = m.createInstanceTemplate(ctx, name, userData)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	m.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.ResourceStatus{
+		ID:                *id,
+		Name:              ptr.To(name),
+		Ready:             true,
+		ControllerCreated: ptr.To(true),
+	}
+	return *id, nil
+}
+
+func (m *VPCMachinePoolScope) createInstanceTemplate(ctx context.Context, name, userData string) (*string, error) {
+	spec := m.IBMVPCMachinePool.Spec
+	if spec.Profile == "" {
+		return nil, fmt.Errorf("error profile is empty for machine pool %s", m.Name())
+	}
+	if spec.Image == nil {
+		return nil, fmt.Errorf("error image is empty for machine pool %s", m.Name())
+	}
+	zone := m.GetZone()
+	if zone == "" {
+		return nil, fmt.Errorf("error zone is empty for machine pool %s", m.Name())
+	}
+
+	imageID, err := fetchImageID(ctx, spec.Image, m.IBMVPCClient, m.IBMVPCCluster)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching image ID: %w", err)
+	}
+
+	subnetID, err := m.getSubnetID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	primaryNetworkInterface := &vpcv1.NetworkInterfacePrototype{
+		Subnet: &vpcv1.SubnetIdentity{ID: ptr.To(subnetID)},
+	}
+	for _, sg := range spec.PrimaryNetworkInterface.SecurityGroups {
+		sgID, err := m.getSecurityGroupID(sg)
+		if err != nil {
+			return nil, err
+		}
+		primaryNetworkInterface.SecurityGroups = append(primaryNetworkInterface.SecurityGroups, &vpcv1.SecurityGroupIdentityByID{ID: sgID})
+	}
+
+	prototype := &vpcv1.InstanceTemplatePrototypeInstanceTemplateByImage{
+		Name:                    ptr.To(name),
+		Image:                   &vpcv1.ImageIdentity{ID: imageID},
+		Profile:                 &vpcv1.InstanceProfileIdentity{Name: ptr.To(spec.Profile)},
+		PrimaryNetworkInterface: primaryNetworkInterface,
+		ResourceGroup:           &vpcv1.ResourceGroupIdentity{ID: ptr.To(m.GetResourceGroupID())},
+		UserData:                ptr.To(userData),
+		Zone:                    &vpcv1.ZoneIdentity{Name: ptr.To(zone)},
+	}
+	if m.IBMVPCCluster.Status.Network != nil && m.IBMVPCCluster.Status.Network.VPC != nil {
+		prototype.VPC = &vpcv1.VPCIdentityByID{ID: ptr.To(m.IBMVPCCluster.Status.Network.VPC.ID)}
+	}
+	for _, sshKey := range spec.SSHKeys {
+		keyID, err := fetchKeyID(ctx, sshKey, m.IBMVPCClient)
+		if err != nil {
+			return nil, fmt.Errorf("error while fetching SSHKey: %v error: %v", sshKey, err)
+		}
+		prototype.Keys = append(prototype.Keys, &vpcv1.KeyIdentity{ID: keyID})
+	}
+	if spec.BootVolume != nil {
+		prototype.BootVolumeAttachment = volumeToVPCVolumeAttachment(ctx, spec.BootVolume)
+	}
+
+	template, _, err := m.IBMVPCClient.CreateInstanceTemplate(&vpcv1.CreateInstanceTemplateOptions{
+		InstanceTemplatePrototype: prototype,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating instance template %s: %w", name, err)
+	}
+	id, _, err := instanceTemplateIDAndName(template)
+	if err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// getSubnetID returns the ID of the subnet the instances of the pool are attached to.
+func (m *VPCMachinePoolScope) getSubnetID(ctx context.Context) (string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	subnet := m.IBMVPCMachinePool.Spec.PrimaryNetworkInterface.Subnet
+	network := m.IBMVPCCluster.Status.Network
+	if subnet != "" {
+		// If Network Status is available, attempt to retrieve subnet ID from there.
+		if network != nil {
+			if status, ok := network.WorkerSubnets[subnet]; ok {
+				return status.ID, nil
+			}
+			if status, ok := network.ControlPlaneSubnets[subnet]; ok {
+				return status.ID, nil
+			}
+		}
+		subnetDetails, err := m.IBMVPCClient.GetVPCSubnetByName(subnet)
+		if err != nil {
+			return "", fmt.Errorf("error retrieving subnet ID for machine pool %s: %w", m.Name(), err)
+		} else if subnetDetails != nil {
+			return *subnetDetails.ID, nil
+		}
+		// Fall back to using the subnet value directly as an ID.
+		return subnet, nil
+	}
+
+	if m.IBMVPCCluster.Status.Subnet.ID != nil {
+		return *m.IBMVPCCluster.Status.Subnet.ID, nil
+	}
+
+	// Select a worker subnet of the cluster in the pool's zone.
+	if network != nil {
+		zone := m.GetZone()
+		for _, name := range slices.Sorted(maps.Keys(network.WorkerSubnets)) {
+			subnetDetails, _, err := m.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+				ID: ptr.To(network.WorkerSubnets[name].ID),
+			})
+			if err != nil {
+				return "", fmt.Errorf("error failed retrieving subnet %s: %w", name, err)
+			}
+			if subnetDetails != nil && subnetDetails.Zone != nil && subnetDetails.Zone.Name != nil && *subnetDetails.Zone.Name == zone {
+				log.V(3).Info("Selected machine pool subnet", "subnet", name, "zone", zone)
+				return *subnetDetails.ID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("error no subnet found in zone %s for machine pool %s", m.GetZone(), m.Name())
+}
+
+func (m *VPCMachinePoolScope) getSecurityGroupID(sg infrav1.VPCResource) (*string, error) {
+	if sg.ID != nil {
+		return sg.ID, nil
+	}
+	if sg.Name == nil {
+		return nil, fmt.Errorf("error no name or id provided for security group for machine pool %s", m.Name())
+	}
+	if m.IBMVPCCluster.Status.Network != nil {
+		if sgStatus, ok := m.IBMVPCCluster.Status.Network.SecurityGroups[*sg.Name]; ok {
+			return ptr.To(sgStatus.ID), nil
+		}
+	}
+	sgDetails, err := m.IBMVPCClient.GetSecurityGroupByName(*sg.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving security group id with name %s for machine pool %s: %w", *sg.Name, m.Name(), err)
+	} else if sgDetails == nil {
+		return nil, fmt.Errorf("error cannot find security group %s for machine pool %s", *sg.Name, m.Name())
+	}
+	return sgDetails.ID, nil
+}
+
+// listInstanceTemplates returns the ID and name of every instance template which belongs to the machine pool.
+// Only the templates in the resource group and VPC of the cluster are considered.
+func (m *VPCMachinePoolScope) listInstanceTemplates() (map[string]string, error) {
+	templates, _, err := m.IBMVPCClient.ListInstanceTemplates(&vpcv1.ListInstanceTemplatesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing instance templates: %w", err)
+	}
+	prefix := m.instanceTemplatePrefix()
+	resourceGroupID := m.GetResourceGroupID()
+	var vpcID string
+	if m.IBMVPCCluster.Status.Network != nil && m.IBMVPCCluster.Status.Network.VPC != nil {
+		vpcID = m.IBMVPCCluster.Status.Network.VPC.ID
+	}
+	result := map[string]string{}
+	if templates == nil {
+		return result, nil
+	}
+	for _, template := range templates.Templates {
+		reference, err := parseInstanceTemplate(template)
+		if err != nil {
+			return nil, err
+		}
+		if reference.ID == nil || reference.Name == nil || !strings.HasPrefix(*reference.Name, prefix) {
+			continue
+		}
+		// The pool's templates only differ by their hash suffixes, so make sure another pool's prefix doesn't match.
+		rest := strings.TrimPrefix(*reference.Name, prefix)
+		if len(rest) != 2*templateHashLength+1 || rest[templateHashLength] != '-' {
+			continue
+		}
+		if resourceGroupID != "" && reference.ResourceGroup.ID != "" && reference.ResourceGroup.ID != resourceGroupID {
+			continue
+		}
+		if vpcID != "" && reference.VPC.ID != "" && reference.VPC.ID != vpcID {
+			continue
+		}
+		result[*reference.ID] = *reference.Name
+	}
+	return result, nil
+}
+
+func (m *VPCMachinePoolScope) getInstanceTemplateIDByName(name string) (*string, error) {
+	templates, err := m.listInstanceTemplates()
+	if err != nil {
+		return nil, err
+	}
+	for id, templateName := range templates {
+		if templateName == name {
+			return ptr.To(id), nil
+		}
+	}
+	return nil, nil
+}
+
+// ReconcileInstanceGroup ensures the instance group exists with the given instance template and the desired membership count.
+func (m *VPCMachinePoolScope) ReconcileInstanceGroup(ctx context.Context, templateID string) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	desired := m.DesiredReplicas()
+
+	if m.IBMVPCMachinePool.Status.InstanceGroup != nil {
+		group, resp, err := m.IBMVPCClient.GetInstanceGroup(&vpcv1.GetInstanceGroupOptions{
+			ID: ptr.To(m.IBMVPCMachinePool.Status.InstanceGroup.ID),
+		})
+		if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+			return false, fmt.Errorf("error retrieving instance group %s: %w", m.IBMVPCMachinePool.Status.InstanceGroup.ID, err)
+		}
+		if group != nil {
+			return m.updateInstanceGroup(ctx, group, templateID)
+		}
+		log.Info("Instance group not found, recreating it", "id", m.IBMVPCMachinePool.Status.InstanceGroup.ID)
+		m.IBMVPCMachinePool.Status.InstanceGroup = nil
+	}
+
+	subnetID, err := m.getSubnetID(ctx)
+	if err != nil {
+		return false, err
+	}
+	name := m.resourceName()
+	log.Info("Creating instance group", "name", name, "membershipCount", desired)
+	group, _, err := m.IBMVPCClient.CreateInstanceGroup(&vpcv1.CreateInstanceGroupOptions{
+		Name:             ptr.To(name),
+		InstanceTemplate: &vpcv1.InstanceTemplateIdentity{ID: ptr.To(templateID)},
+		Subnets:          []vpcv1.SubnetIdentityIntf{&vpcv1.SubnetIdentity{ID: ptr.To(subnetID)}},
+		MembershipCount:  ptr.To(desired),
+		ResourceGroup:    &vpcv1.ResourceGroupIdentity{ID: ptr.To(m.GetResourceGroupID())},
+	})
+	if err != nil {
+		return false, fmt.Errorf("error creating instance group %s: %w", name, err)
+	}
+	if group == nil || group.ID == nil {
+		return false, fmt.Errorf("error creating instance group %s: no instance group returned", name)
+	}
+	m.IBMVPCMachinePool.Status.InstanceGroup = &infrav1.ResourceStatus{
+		ID:                *group.ID,
+		Name:              group.Name,
+		Ready:             false,
+		ControllerCreated: ptr.To(true),
+	}
+	return true, nil
+}
+
+func (m *VPCMachinePoolScope) updateInstanceGroup(ctx context.Context, group *vpcv1.InstanceGroup, templateID string) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	desired := m.DesiredReplicas()
+
+	patch := &vpcv1.InstanceGroupPatch{}
+	needsUpdate := false
+	if group.InstanceTemplate == nil || group.InstanceTemplate.ID == nil || *group.InstanceTemplate.ID != templateID {
+		log.Info("Updating instance group template", "instanceGroupID", *group.ID, "instanceTemplateID", templateID)
+		patch.InstanceTemplate = &vpcv1.InstanceTemplateIdentity{ID: ptr.To(templateID)}
+		needsUpdate = true
+	}
+	if group.MembershipCount == nil || *group.MembershipCount != desired {
+		log.Info("Updating instance group membership count", "instanceGroupID", *group.ID, "membershipCount", desired)
+		patch.MembershipCount = ptr.To(desired)
+		needsUpdate = true
+	}
+
+	m.IBMVPCMachinePool.Status.InstanceGroup.Ready = !needsUpdate && group.Status != nil && *group.Status == vpcv1.InstanceGroupStatusHealthyConst
+	if !needsUpdate {
+		return !m.IBMVPCMachinePool.Status.InstanceGroup.Ready, nil
+	}
+
+	patchMap, err := patch.AsPatch()
+	if err != nil {
+		return false, fmt.Errorf("error building instance group patch: %w", err)
+	}
+	if _, _, err := m.IBMVPCClient.UpdateInstanceGroup(&vpcv1.UpdateInstanceGroupOptions{
+		ID:                 group.ID,
+		InstanceGroupPatch: patchMap,
+	}); err != nil {
+		return false, fmt.Errorf("error updating instance group %s: %w", *group.ID, err)
+	}
+	return true, nil
+}
+
+// listInstanceGroupMemberships returns all the memberships of the machine pool's instance group.
+func (m *VPCMachinePoolScope) listInstanceGroupMemberships() ([]vpcv1.InstanceGroupMembership, error) {
+	if m.IBMVPCMachinePool.Status.InstanceGroup == nil {
+		return nil, nil
+	}
+	var memberships []vpcv1.InstanceGroupMembership
+	f := func(start string) (bool, string, error) {
+		options := &vpcv1.ListInstanceGroupMembershipsOptions{
+			InstanceGroupID: ptr.To(m.IBMVPCMachinePool.Status.InstanceGroup.ID),
+		}
+		if start != "" {
+			options.Start = &start
+		}
+		membershipList, _, err := m.IBMVPCClient.ListInstanceGroupMemberships(options)
+		if err != nil {
+			return false, "", fmt.Errorf("failed to list instance group memberships: %w", err)
+		}
+		if membershipList == nil {
+			return false, "", fmt.Errorf("instance group membership list returned is nil")
+		}
+		memberships = append(memberships, membershipList.Memberships...)
+
+		if membershipList.Next != nil && *membershipList.Next.Href != "" {
+			return false, *membershipList.Next.Href, nil
+		}
+		return true, "", nil
+	}
+	if err := pagingutils.PagingHelper(f); err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+// ReconcileInstances updates the status of the pool's instances and the providerIDList.
+// Instances created from a template of an older spec are replaced one at a time, once all other instances are healthy.
+// Instances created from a template which only differs by its bootstrap data are kept.
+// It returns true when the instances are not yet up to date and ready.
+func (m *VPCMachinePoolScope) ReconcileInstances(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	memberships, err := m.listInstanceGroupMemberships()
+	if err != nil {
+		return false, err
+	}
+	specHash, err := m.specHash()
+	if err != nil {
+		return false, fmt.Errorf("failed to compute spec hash: %w", err)
+	}
+	upToDatePrefix := m.instanceTemplatePrefix() + specHash + "-"
+
+	instances := make([]infrav1.IBMVPCMachinePoolInstanceStatus, 0, len(memberships))
+	providerIDs := make([]string, 0, len(memberships))
+	var outdated []vpcv1.InstanceGroupMembership
+	allHealthy := true
+	for _, membership := range memberships {
+		if membership.Instance == nil || membership.Instance.ID == nil {
+			allHealthy = false
+			continue
+		}
+		instanceStatus := infrav1.IBMVPCMachinePoolInstanceStatus{
+			InstanceID:    *membership.Instance.ID,
+			Name:          ptr.Deref(membership.Instance.Name, ""),
+			InstanceState: ptr.Deref(membership.Status, ""),
+			UpToDate:      membership.InstanceTemplate != nil && strings.HasPrefix(ptr.Deref(membership.InstanceTemplate.Name, ""), upToDatePrefix),
+		}
+		if !instanceStatus.UpToDate {
+			outdated = append(outdated, membership)
+		}
+		if instanceStatus.InstanceState != vpcv1.InstanceGroupMembershipStatusHealthyConst {
+			allHealthy = false
+		}
+
+		instance, _, err := m.IBMVPCClient.GetInstance(&vpcv1.GetInstanceOptions{ID: membership.Instance.ID})
+		if err != nil {
+			return false, fmt.Errorf("error retrieving instance %s: %w", *membership.Instance.ID, err)
+		}
+		if instance != nil {
+			instanceStatus.Addresses = vpcInstanceAddresses(instance)
+		}
+
+		providerID, err := m.providerID(*membership.Instance.ID)
+		if err != nil {
+			return false, err
+		}
+		instanceStatus.ProviderID = providerID
+		providerIDs = append(providerIDs, providerID)
+		instances = append(instances, instanceStatus)
+	}
+
+	m.IBMVPCMachinePool.Status.Instances = instances
+	m.IBMVPCMachinePool.Status.Replicas = int32(len(instances)) //nolint:gosec
+	m.IBMVPCMachinePool.Spec.ProviderIDList = providerIDs
+
+	if int64(len(memberships)) != m.DesiredReplicas() || !allHealthy {
+		return true, nil
+	}
+	if len(outdated) == 0 {
+		return false, nil
+	}
+
+	// All the instances are healthy, replace the first outdated one, the instance group recreates it from the current template.
+	replace := outdated[0]
+	log.Info("Replacing outdated instance", "instanceID", *replace.Instance.ID, "membershipID", *replace.ID)
+	if _, err := m.IBMVPCClient.DeleteInstanceGroupMembership(&vpcv1.DeleteInstanceGroupMembershipOptions{
+		InstanceGroupID: ptr.To(m.IBMVPCMachinePool.Status.InstanceGroup.ID),
+		ID:              replace.ID,
+	}); err != nil {
+		return false, fmt.Errorf("error deleting instance group membership %s: %w", *replace.ID, err)
+	}
+	return true, nil
+}
+
+// DeleteOutdatedInstanceTemplates deletes the pool's instance templates which are no longer used by the instance group or its instances.
+func (m *VPCMachinePoolScope) DeleteOutdatedInstanceTemplates(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	templates, err := m.listInstanceTemplates()
+	if err != nil {
+		return err
+	}
+	memberships, err := m.listInstanceGroupMemberships()
+	if err != nil {
+		return err
+	}
+	inUse := map[string]bool{}
+	if m.IBMVPCMachinePool.Status.InstanceTemplate != nil {
+		inUse[m.IBMVPCMachinePool.Status.InstanceTemplate.ID] = true
+	}
+	for _, membership := range memberships {
+		if membership.InstanceTemplate != nil && membership.InstanceTemplate.ID != nil {
+			inUse[*membership.InstanceTemplate.ID] = true
+		}
+	}
+
+	var errs []error
+	for _, id := range slices.Sorted(maps.Keys(templates)) {
+		if inUse[id] {
+			continue
+		}
+		log.Info("Deleting outdated instance template", "id", id, "name", templates[id])
+		if _, err := m.IBMVPCClient.DeleteInstanceTemplate(&vpcv1.DeleteInstanceTemplateOptions{ID: ptr.To(id)}); err != nil {
+			errs = append(errs, fmt.Errorf("error deleting instance template %s: %w", id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DeleteInstanceGroup scales the instance group down to zero and deletes it once it has no more members.
+// It returns true when the deletion is still in progress.
+func (m *VPCMachinePoolScope) DeleteInstanceGroup(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if m.IBMVPCMachinePool.Status.InstanceGroup == nil {
+		return false, nil
+	}
+	id := m.IBMVPCMachinePool.Status.InstanceGroup.ID
+
+	group, resp, err := m.IBMVPCClient.GetInstanceGroup(&vpcv1.GetInstanceGroupOptions{ID: ptr.To(id)})
+	if err != nil {
+		if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+			log.Info("Instance group deleted", "id", id)
+			m.IBMVPCMachinePool.Status.InstanceGroup = nil
+			return false, nil
+		}
+		return false, fmt.Errorf("error retrieving instance group %s: %w", id, err)
+	}
+	if group.Status != nil && *group.Status == vpcv1.InstanceGroupStatusDeletingConst {
+		return true, nil
+	}
+
+	// Remove all the instances first, to delete the instance group only once it is empty.
+	if group.MembershipCount != nil && *group.MembershipCount > 0 {
+		log.Info("Scaling down instance group", "id", id)
+		patch, err := (&vpcv1.InstanceGroupPatch{MembershipCount: ptr.To(int64(0))}).AsPatch()
+		if err != nil {
+			return false, fmt.Errorf("error building instance group patch: %w", err)
+		}
+		if _, _, err := m.IBMVPCClient.UpdateInstanceGroup(&vpcv1.UpdateInstanceGroupOptions{
+			ID:                 ptr.To(id),
+			InstanceGroupPatch: patch,
+		}); err != nil {
+			return false, fmt.Errorf("error scaling down instance group %s: %w", id, err)
+		}
+		return true, nil
+	}
+	memberships, err := m.listInstanceGroupMemberships()
+	if err != nil {
+		return false, err
+	}
+	if len(memberships) > 0 {
+		log.V(3).Info("Waiting for instance group members to be deleted", "id", id, "members", len(memberships))
+		return true, nil
+	}
+
+	log.Info("Deleting instance group", "id", id)
+	if _, err := m.IBMVPCClient.DeleteInstanceGroup(&vpcv1.DeleteInstanceGroupOptions{ID: ptr.To(id)}); err != nil {
+		return false, fmt.Errorf("error deleting instance group %s: %w", id, err)
+	}
+	return true, nil
+}
+
+// DeleteInstanceTemplates deletes all the instance templates of the machine pool.
+func (m *VPCMachinePoolScope) DeleteInstanceTemplates(ctx context.Context) error {
+	m.IBMVPCMachinePool.Status.InstanceTemplate = nil
+	return m.DeleteOutdatedInstanceTemplates(ctx)
+}
+
+// SetReady sets the machine pool status as ready.
+func (m *VPCMachinePoolScope) SetReady() {
+	m.IBMVPCMachinePool.Status.Ready = true
+}
+
+// SetNotReady sets the machine pool status as not ready.
+func (m *VPCMachinePoolScope) SetNotReady() {
+	m.IBMVPCMachinePool.Status.Ready = false
+}
+
+func (m *VPCMachinePoolScope) providerID(instanceID string) (string, error) {
+	if options.ProviderIDFormatType(options.ProviderIDFormat) != options.ProviderIDFormatV2 {
+		return "", fmt.Errorf("invalid value for ProviderIDFormat")
+	}
+	accountID, err := getAccountID(m.identityAuthenticator)
+	if err != nil {
+		return "", fmt.Errorf("failed to get cloud account id: %w", err)
+	}
+	return fmt.Sprintf("ibm://%s///%s/%s", accountID, m.MachinePool.Spec.ClusterName, instanceID), nil
+}
+
+func vpcInstanceAddresses(instance *vpcv1.Instance) []corev1.NodeAddress {
+	if instance.Name == nil {
+		return nil
+	}
+	addresses := []corev1.NodeAddress{
+		{
+			Type:    corev1.NodeInternalDNS,
+			Address: *instance.Name,
+		},
+		{
+			Type:    corev1.NodeHostName,
+			Address: *instance.Name,
+		},
+	}
+	if instance.PrimaryNetworkInterface != nil && instance.PrimaryNetworkInterface.PrimaryIP != nil && instance.PrimaryNetworkInterface.PrimaryIP.Address != nil {
+		addresses = append(addresses, corev1.NodeAddress{
+			Type:    corev1.NodeInternalIP,
+			Address: *instance.PrimaryNetworkInterface.PrimaryIP.Address,
+		})
+	}
+	return addresses
+}
+
+// instanceTemplateIDAndName returns the ID and name of an instance template, as the SDK returns one of several template types.
+func instanceTemplateIDAndName(template vpcv1.InstanceTemplateIntf) (*string, *string, error) {
+	reference, err := parseInstanceTemplate(template)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reference.ID, reference.Name, nil
+}
+
+// instanceTemplateReference holds the fields of an instance template shared by all its variants.
+type instanceTemplateReference struct {
+	ID            *string `json:"id"`
+	Name          *string `json:"name"`
+	ResourceGroup struct {
+		ID string `json:"id"`
+	} `json:"resource_group"`
+	VPC struct {
+		ID string `json:"id"`
+	} `json:"vpc"`
+}
+
+// parseInstanceTemplate returns the fields shared by all the variants of an instance template.
+func parseInstanceTemplate(template vpcv1.InstanceTemplateIntf) (*instanceTemplateReference, error) {
+	if template == nil {
+		return nil, errors.New("instance template is nil")
+	}
+	data, err := json.Marshal(template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse instance template: %w", err)
+	}
+	reference := &instanceTemplateReference{}
+	if err := json.Unmarshal(data, reference); err != nil {
+		return nil, fmt.Errorf("failed to parse instance template: %w", err)
+	}
+	return reference, nil
+}
+
+const (
+	// templateHashLength is the length of the hash suffix of the names of the resources created from a machine pool template.
+	templateHashLength = 8
+	// vpcMachinePoolNameLength is the maximum length of the machine pool name used in its instance group and template names.
+	vpcMachinePoolNameLength = 36
+)
+
+// templateHash returns a short hash of the given values, used to detect changes to a machine pool template.
+func templateHash(values ...interface{}) (string, error) {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:templateHashLength], nil
+}
+
+// machinePoolResourceName returns the machine pool name shortened to length, followed by a hash of the namespace,
+// cluster and machine pool names, so the resources of pools with the same name in other clusters or namespaces are told apart.
+func machinePoolResourceName(namespace, clusterName, name string, length int) string {
+	sum := sha256.Sum256([]byte(namespace + "/" + clusterName + "/" + name))
+	return trimName(name, length) + "-" + hex.EncodeToString(sum[:])[:templateHashLength]
+}
+
+// trimName shortens name to at most length characters, without a trailing dash.
+func trimName(name string, length int) string {
+	if len(name) <= length {
+		return name
+	}
+	return strings.TrimRight(name[:length], "-")
+}
+
+// getMachinePoolBootstrapData returns the bootstrap data from the secret in the MachinePool's bootstrap.dataSecretName.
+func getMachinePoolBootstrapData(ctx context.Context, c client.Client, machinePool *clusterv1.MachinePool) (string, error) {
+	if machinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
+		return "", errors.New("error retrieving bootstrap data: linked MachinePool's bootstrap.dataSecretName is nil")
+	}
+
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: machinePool.Namespace, Name: *machinePool.Spec.Template.Spec.Bootstrap.DataSecretName}
+	if err := c.Get(ctx, key, secret); err != nil {
+		return "", fmt.Errorf("failed to retrieve bootstrap data secret for MachinePool %s/%s: %w", machinePool.Namespace, machinePool.Name, err)
+	}
+
+	value, ok := secret.Data["value"]
+	if !ok {
+		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
+	}
+	return string(value), nil
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
+
+	. "github.com/onsi/gomega"
+)
+
+const machinePoolName = "foo-machine-pool"
+
+func newMachinePool(clusterName, machinePoolName string, replicas int32) *clusterv1.MachinePool {
+	return &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      machinePoolName,
+			Namespace: "default",
+		},
+		Spec: clusterv1.MachinePoolSpec{
+			ClusterName: clusterName,
+			Replicas:    ptr.To(replicas),
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To(machinePoolName),
+					},
+				},
+			},
+		},
+	}
+}
+
+func setupVPCMachinePoolScope(clusterName, machinePoolName string, replicas int32, mockvpc *mock.MockVpc) *VPCMachinePoolScope {
+	cluster := newCluster(clusterName)
+	machinePool := newMachinePool(clusterName, machinePoolName, replicas)
+	secret := newBootstrapSecret(clusterName, machinePoolName)
+	vpcCluster := newVPCCluster(clusterName)
+	vpcMachinePool := &infrav1.IBMVPCMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      machinePoolName,
+			Namespace: "default",
+		},
+		Spec: infrav1.IBMVPCMachinePoolSpec{
+			Image:   &infrav1.IBMVPCResourceReference{ID: ptr.To("image-id")},
+			Zone:    "us-south-1",
+			Profile: "bx2-2x8",
+		},
+		Status: infrav1.IBMVPCMachinePoolStatus{
+			InstanceGroup: &infrav1.ResourceStatus{ID: "instance-group-id"},
+		},
+	}
+
+	initObjects := []client.Object{cluster, machinePool, secret, vpcCluster, vpcMachinePool}
+	client := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(initObjects...).Build()
+	return &VPCMachinePoolScope{
+		Client:            client,
+		IBMVPCClient:      mockvpc,
+		Cluster:           cluster,
+		MachinePool:       machinePool,
+		IBMVPCCluster:     vpcCluster,
+		IBMVPCMachinePool: vpcMachinePool,
+	}
+}
+
+func newInstanceGroupMembership(id, instanceID, templateName, status string) vpcv1.InstanceGroupMembership {
+	return vpcv1.InstanceGroupMembership{
+		ID:               ptr.To(id),
+		Instance:         &vpcv1.InstanceReference{ID: ptr.To(instanceID), Name: ptr.To(instanceID)},
+		InstanceTemplate: &vpcv1.InstanceTemplateReference{ID: ptr.To(templateName + "-id"), Name: ptr.To(templateName)},
+		Status:           ptr.To(status),
+	}
+}
+
+func TestInstanceTemplateName(t *testing.T) {
+	t.Run("Name does not change with the providerIDList", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 1, mock.NewMockVpc(gomock.NewController(t)))
+		name, err := scope.instanceTemplateName("user data")
+		g.Expect(err).To(BeNil())
+		g.Expect(name).To(HavePrefix(machinePoolName + "-"))
+		g.Expect(name).To(HaveLen(len(machinePoolName) + 3 + 3*templateHashLength))
+
+		scope.IBMVPCMachinePool.Spec.ProviderIDList = []string{"ibm://account-id///foo-cluster/instance-id"}
+		sameName, err := scope.instanceTemplateName("user data")
+		g.Expect(err).To(BeNil())
+		g.Expect(sameName).To(Equal(name))
+	})
+
+	t.Run("Name changes with the spec and the bootstrap data", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 1, mock.NewMockVpc(gomock.NewController(t)))
+		name, err := scope.instanceTemplateName("user data")
+		g.Expect(err).To(BeNil())
+		specHash, err := scope.specHash()
+		g.Expect(err).To(BeNil())
+
+		// Only the bootstrap data hash changes, so the instances from the previous template stay up to date.
+		newUserDataName, err := scope.instanceTemplateName("new user data")
+		g.Expect(err).To(BeNil())
+		g.Expect(newUserDataName).ToNot(Equal(name))
+		g.Expect(newUserDataName).To(HavePrefix(scope.instanceTemplatePrefix() + specHash + "-"))
+
+		scope.IBMVPCMachinePool.Spec.Profile = "bx2-4x16"
+		newProfileName, err := scope.instanceTemplateName("user data")
+		g.Expect(err).To(BeNil())
+		g.Expect(newProfileName).ToNot(HavePrefix(scope.instanceTemplatePrefix() + specHash + "-"))
+	})
+
+	t.Run("Name is unique to the cluster and namespace of the machine pool", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 1, mock.NewMockVpc(gomock.NewController(t)))
+		otherCluster := setupVPCMachinePoolScope("other-cluster", machinePoolName, 1, mock.NewMockVpc(gomock.NewController(t)))
+		otherNamespace := setupVPCMachinePoolScope(clusterName, machinePoolName, 1, mock.NewMockVpc(gomock.NewController(t)))
+		otherNamespace.IBMVPCMachinePool.Namespace = "other-namespace"
+		g.Expect(otherCluster.instanceTemplatePrefix()).ToNot(Equal(scope.instanceTemplatePrefix()))
+		g.Expect(otherNamespace.instanceTemplatePrefix()).ToNot(Equal(scope.instanceTemplatePrefix()))
+	})
+}
+
+func TestDeleteOutdatedInstanceTemplates(t *testing.T) {
+	t.Run("Only the outdated templates of the machine pool in the cluster are deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		mockvpc := mock.NewMockVpc(gomock.NewController(t))
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 1, mockvpc)
+		scope.IBMVPCCluster.Spec.ResourceGroup = "resource-group-id"
+		scope.IBMVPCMachinePool.Status.InstanceGroup = nil
+		scope.IBMVPCMachinePool.Status.InstanceTemplate = &infrav1.ResourceStatus{ID: "current-id"}
+		otherCluster := setupVPCMachinePoolScope("other-cluster", machinePoolName, 1, mockvpc)
+
+		template := func(id, name, resourceGroupID string) vpcv1.InstanceTemplateIntf {
+			return &vpcv1.InstanceTemplate{
+				ID:            ptr.To(id),
+				Name:          ptr.To(name),
+				ResourceGroup: &vpcv1.ResourceGroupReference{ID: ptr.To(resourceGroupID)},
+			}
+		}
+		hashes := "00000000-00000000"
+		mockvpc.EXPECT().ListInstanceTemplates(gomock.AssignableToTypeOf(&vpcv1.ListInstanceTemplatesOptions{})).Return(&vpcv1.InstanceTemplateCollection{
+			Templates: []vpcv1.InstanceTemplateIntf{
+				template("current-id", scope.instanceTemplatePrefix()+"11111111-11111111", "resource-group-id"),
+				template("outdated-id", scope.instanceTemplatePrefix()+hashes, "resource-group-id"),
+				template("other-resource-group-id", scope.instanceTemplatePrefix()+hashes, "other-resource-group-id"),
+				template("other-cluster-id", otherCluster.instanceTemplatePrefix()+hashes, "resource-group-id"),
+				template("unprefixed-id", machinePoolName+"-"+hashes, "resource-group-id"),
+			},
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().DeleteInstanceTemplate(&vpcv1.DeleteInstanceTemplateOptions{ID: ptr.To("outdated-id")}).Return(&core.DetailedResponse{}, nil)
+
+		g.Expect(scope.DeleteOutdatedInstanceTemplates(context.Background())).To(Succeed())
+	})
+}
+
+func TestReconcileInstanceGroup(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	t.Run("Instance group is up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 2, mockvpc)
+		mockvpc.EXPECT().GetInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.GetInstanceGroupOptions{})).Return(&vpcv1.InstanceGroup{
+			ID:               ptr.To("instance-group-id"),
+			InstanceTemplate: &vpcv1.InstanceTemplateReference{ID: ptr.To("template-id")},
+			MembershipCount:  ptr.To(int64(2)),
+			Status:           ptr.To(vpcv1.InstanceGroupStatusHealthyConst),
+		}, &core.DetailedResponse{}, nil)
+		requeue, err := scope.ReconcileInstanceGroup(context.Background(), "template-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMVPCMachinePool.Status.InstanceGroup.Ready).To(BeTrue())
+	})
+
+	t.Run("Instance group is updated with the new template", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 2, mockvpc)
+		mockvpc.EXPECT().GetInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.GetInstanceGroupOptions{})).Return(&vpcv1.InstanceGroup{
+			ID:               ptr.To("instance-group-id"),
+			InstanceTemplate: &vpcv1.InstanceTemplateReference{ID: ptr.To("old-template-id")},
+			MembershipCount:  ptr.To(int64(2)),
+			Status:           ptr.To(vpcv1.InstanceGroupStatusHealthyConst),
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().UpdateInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.UpdateInstanceGroupOptions{})).DoAndReturn(
+			func(options *vpcv1.UpdateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+				g.Expect(options.InstanceGroupPatch).To(HaveKey("instance_template"))
+				g.Expect(options.InstanceGroupPatch).ToNot(HaveKey("membership_count"))
+				return &vpcv1.InstanceGroup{}, &core.DetailedResponse{}, nil
+			})
+		requeue, err := scope.ReconcileInstanceGroup(context.Background(), "template-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMVPCMachinePool.Status.InstanceGroup.Ready).To(BeFalse())
+	})
+
+	t.Run("Failed to get instance group", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 2, mockvpc)
+		mockvpc.EXPECT().GetInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.GetInstanceGroupOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 500}, errors.New("failed to get instance group"))
+		_, err := scope.ReconcileInstanceGroup(context.Background(), "template-id")
+		g.Expect(err).To(Not(BeNil()))
+	})
+}
+
+func TestReconcileVPCMachinePoolInstances(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		options.ProviderIDFormat = string(options.ProviderIDFormatV2)
+		accounts.GetAccountIDFunc = func() (string, error) {
+			return "account-id", nil
+		}
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+	outdatedTemplateName := machinePoolName + "-00000000-00000000"
+
+	t.Run("Instances are up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 2, mockvpc)
+		templateName, err := scope.instanceTemplateName("user data")
+		g.Expect(err).To(BeNil())
+		mockvpc.EXPECT().ListInstanceGroupMemberships(gomock.AssignableToTypeOf(&vpcv1.ListInstanceGroupMembershipsOptions{})).Return(&vpcv1.InstanceGroupMembershipCollection{
+			Memberships: []vpcv1.InstanceGroupMembership{
+				newInstanceGroupMembership("membership-1", "instance-1", templateName, vpcv1.InstanceGroupMembershipStatusHealthyConst),
+				newInstanceGroupMembership("membership-2", "instance-2", templateName, vpcv1.InstanceGroupMembershipStatusHealthyConst),
+			},
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{}, &core.DetailedResponse{}, nil).Times(2)
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMVPCMachinePool.Status.Replicas).To(Equal(int32(2)))
+		g.Expect(scope.IBMVPCMachinePool.Spec.ProviderIDList).To(Equal([]string{
+			"ibm://account-id///foo-cluster/instance-1",
+			"ibm://account-id///foo-cluster/instance-2",
+		}))
+	})
+
+	t.Run("Outdated instance is replaced once all the instances are healthy", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 2, mockvpc)
+		templateName, err := scope.instanceTemplateName("user data")
+		g.Expect(err).To(BeNil())
+		mockvpc.EXPECT().ListInstanceGroupMemberships(gomock.AssignableToTypeOf(&vpcv1.ListInstanceGroupMembershipsOptions{})).Return(&vpcv1.InstanceGroupMembershipCollection{
+			Memberships: []vpcv1.InstanceGroupMembership{
+				newInstanceGroupMembership("membership-1", "instance-1", outdatedTemplateName, vpcv1.InstanceGroupMembershipStatusHealthyConst),
+				newInstanceGroupMembership("membership-2", "instance-2", templateName, vpcv1.InstanceGroupMembershipStatusHealthyConst),
+			},
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{}, &core.DetailedResponse{}, nil).Times(2)
+		mockvpc.EXPECT().DeleteInstanceGroupMembership(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceGroupMembershipOptions{})).DoAndReturn(
+			func(options *vpcv1.DeleteInstanceGroupMembershipOptions) (*core.DetailedResponse, error) {
+				g.Expect(*options.ID).To(Equal("membership-1"))
+				return &core.DetailedResponse{}, nil
+			})
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(scope.IBMVPCMachinePool.Status.Instances[0].UpToDate).To(BeFalse())
+		g.Expect(scope.IBMVPCMachinePool.Status.Instances[1].UpToDate).To(BeTrue())
+	})
+
+	t.Run("Outdated instance is not replaced while an instance is not healthy", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 2, mockvpc)
+		templateName, err := scope.instanceTemplateName("user data")
+		g.Expect(err).To(BeNil())
+		mockvpc.EXPECT().ListInstanceGroupMemberships(gomock.AssignableToTypeOf(&vpcv1.ListInstanceGroupMembershipsOptions{})).Return(&vpcv1.InstanceGroupMembershipCollection{
+			Memberships: []vpcv1.InstanceGroupMembership{
+				newInstanceGroupMembership("membership-1", "instance-1", outdatedTemplateName, vpcv1.InstanceGroupMembershipStatusHealthyConst),
+				newInstanceGroupMembership("membership-2", "instance-2", templateName, vpcv1.InstanceGroupMembershipStatusPendingConst),
+			},
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{}, &core.DetailedResponse{}, nil).Times(2)
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Instance from a template with older bootstrap data is up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 1, mockvpc)
+		templateName, err := scope.instanceTemplateName("old user data")
+		g.Expect(err).To(BeNil())
+		mockvpc.EXPECT().ListInstanceGroupMemberships(gomock.AssignableToTypeOf(&vpcv1.ListInstanceGroupMembershipsOptions{})).Return(&vpcv1.InstanceGroupMembershipCollection{
+			Memberships: []vpcv1.InstanceGroupMembership{
+				newInstanceGroupMembership("membership-1", "instance-1", templateName, vpcv1.InstanceGroupMembershipStatusHealthyConst),
+			},
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().GetInstance(gomock.AssignableToTypeOf(&vpcv1.GetInstanceOptions{})).Return(&vpcv1.Instance{}, &core.DetailedResponse{}, nil)
+		requeue, err := scope.ReconcileInstances(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMVPCMachinePool.Status.Instances[0].UpToDate).To(BeTrue())
+	})
+}
+
+func TestDeleteInstanceGroup(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	t.Run("Instance group is already deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 2, mockvpc)
+		mockvpc.EXPECT().GetInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.GetInstanceGroupOptions{})).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, errors.New("not found"))
+		requeue, err := scope.DeleteInstanceGroup(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(scope.IBMVPCMachinePool.Status.InstanceGroup).To(BeNil())
+	})
+
+	t.Run("Instance group is scaled down before deletion", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 2, mockvpc)
+		mockvpc.EXPECT().GetInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.GetInstanceGroupOptions{})).Return(&vpcv1.InstanceGroup{
+			ID:              ptr.To("instance-group-id"),
+			MembershipCount: ptr.To(int64(2)),
+			Status:          ptr.To(vpcv1.InstanceGroupStatusHealthyConst),
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().UpdateInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.UpdateInstanceGroupOptions{})).Return(&vpcv1.InstanceGroup{}, &core.DetailedResponse{}, nil)
+		requeue, err := scope.DeleteInstanceGroup(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("Empty instance group is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupVPCMachinePoolScope(clusterName, machinePoolName, 2, mockvpc)
+		mockvpc.EXPECT().GetInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.GetInstanceGroupOptions{})).Return(&vpcv1.InstanceGroup{
+			ID:              ptr.To("instance-group-id"),
+			MembershipCount: ptr.To(int64(0)),
+			Status:          ptr.To(vpcv1.InstanceGroupStatusHealthyConst),
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().ListInstanceGroupMemberships(gomock.AssignableToTypeOf(&vpcv1.ListInstanceGroupMembershipsOptions{})).Return(&vpcv1.InstanceGroupMembershipCollection{}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().DeleteInstanceGroup(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceGroupOptions{})).Return(&core.DetailedResponse{}, nil)
+		requeue, err := scope.DeleteInstanceGroup(context.Background())
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/utils/ptr"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+)
+
+// containerRegistryDomains maps the VPC regions to the domain of the IBM Cloud Container Registry serving the region,
+// the VPE gateway of the registry connects to vpe.<domain>.icr.io.
+var containerRegistryDomains = map[string]string{
+	"au-syd":   "au",
+	"br-sao":   "br",
+	"ca-tor":   "ca",
+	"eu-de":    "de",
+	"eu-gb":    "uk",
+	"jp-osa":   "jp2",
+	"jp-tok":   "jp",
+	"us-south": "us",
+}
+
+// vpeGatewayName returns the name of a VPE gateway, defaulting to <cluster name>-vpe-<service>.
+func vpeGatewayName(gateway infrav1.VPEGateway, clusterName string) string {
+	if gateway.Name != nil {
+		return *gateway.Name
+	}
+	return fmt.Sprintf("%s-vpe-%s", clusterName, ptr.Deref(gateway.Service, ""))
+}
+
+// vpeGatewayTargetCRN returns the CRN of the endpoint of the IBM Cloud service a VPE gateway connects to,
+// resolving the well-known services in the given region.
+func vpeGatewayTargetCRN(gateway infrav1.VPEGateway, region string) (string, error) {
+	if gateway.CRN != nil {
+		return *gateway.CRN, nil
+	}
+	switch service := ptr.Deref(gateway.Service, ""); service {
+	case infrav1.VPEGatewayServiceCOS:
+		return fmt.Sprintf("crn:v1:bluemix:public:cloud-object-storage:global:::endpoint:s3.direct.%s.cloud-object-storage.appdomain.cloud", region), nil
+	case infrav1.VPEGatewayServiceIAM:
+		return "crn:v1:bluemix:public:iam-svcs:global:::endpoint:private.iam.cloud.ibm.com", nil
+	case infrav1.VPEGatewayServiceContainerRegistry:
+		domain, ok := containerRegistryDomains[region]
+		if !ok {
+			return "", fmt.Errorf("VPE gateway service %q is not available in region %q, set the CRN of the endpoint instead", service, region)
+		}
+		return fmt.Sprintf("crn:v1:bluemix:public:container-registry:%s:::endpoint:vpe.%s.icr.io", region, domain), nil
+	case infrav1.VPEGatewayServicePowerVS:
+		return fmt.Sprintf("crn:v1:bluemix:public:power-iaas:%s:::endpoint:%s.power-iaas.cloud.ibm.com", region, region), nil
+	default:
+		return "", fmt.Errorf("unsupported VPE gateway service %q", service)
+	}
+}
+
+// vpeGatewayReconciler reconciles the VPE gateways of a cluster.
+type vpeGatewayReconciler struct {
+	client           vpc.Vpc
+	clusterName      string
+	region           string
+	vpcID            string
+	resourceGroupID  string
+	subnetIDs        []string
+	securityGroupIDs []string
+}
+
+// reconcile ensures the VPE gateway exists, looking it up by the id of ref and then by name before creating it.
+// It returns the reference to the VPE gateway and whether it is ready.
+func (r *vpeGatewayReconciler) reconcile(ctx context.Context, gateway infrav1.VPEGateway, ref *infrav1.ResourceReference) (*infrav1.ResourceReference, bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	name := vpeGatewayName(gateway, r.clusterName)
+	var endpointGateway *vpcv1.EndpointGateway
+	if ref != nil && ref.ID != nil {
+		result, resp, err := r.client.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{
+			ID: ref.ID,
+		})
+		if err != nil {
+			if resp == nil || resp.StatusCode != ResourceNotFoundCode {
+				return ref, false, fmt.Errorf("failed to get VPE gateway %s: %w", *ref.ID, err)
+			}
+			log.Info("VPE gateway not found, recreating it", "name", name, "id", *ref.ID)
+			ref = nil
+		} else {
+			endpointGateway = result
+		}
+	}
+
+	if endpointGateway == nil {
+		result, err := r.client.GetEndpointGatewayByName(name)
+		if err != nil {
+			return ref, false, fmt.Errorf("failed to get VPE gateway %s: %w", name, err)
+		}
+		if result != nil {
+			log.Info("Found existing VPE gateway", "name", name, "id", *result.ID)
+			endpointGateway = result
+			ref = &infrav1.ResourceReference{ID: result.ID, ControllerCreated: ptr.To(false)}
+		}
+	}
+
+	if endpointGateway == nil {
+		result, err := r.create(ctx, gateway, name)
+		if err != nil {
+			return ref, false, err
+		}
+		endpointGateway = result
+		ref = &infrav1.ResourceReference{ID: result.ID, ControllerCreated: ptr.To(true)}
+	}
+
+	switch state := ptr.Deref(endpointGateway.LifecycleState, ""); state {
+	case vpcv1.EndpointGatewayLifecycleStateStableConst:
+		return ref, true, nil
+	case vpcv1.EndpointGatewayLifecycleStateFailedConst, vpcv1.EndpointGatewayLifecycleStateSuspendedConst:
+		return ref, false, fmt.Errorf("VPE gateway %s is in %s state", name, state)
+	default:
+		log.V(3).Info("VPE gateway is not yet ready", "name", name, "state", state)
+		return ref, false, nil
+	}
+}
+
+// create creates the VPE gateway bound to the subnets and security groups of the cluster.
+func (r *vpeGatewayReconciler) create(ctx context.Context, gateway infrav1.VPEGateway, name string) (*vpcv1.EndpointGateway, error) {
+	log := ctrl.LoggerFrom(ctx)
+	crn, err := vpeGatewayTargetCRN(gateway, r.region)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := r.reservedIPs()
+	if err != nil {
+		return nil, err
+	}
+
+	options := &vpcv1.CreateEndpointGatewayOptions{
+		Name: ptr.To(name),
+		Target: &vpcv1.EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypeProviderCloudServicePrototype{
+			ResourceType: ptr.To(vpcv1.EndpointGatewayTargetPrototypeResourceTypeProviderCloudServiceConst),
+			CRN:          ptr.To(crn),
+		},
+		VPC: &vpcv1.VPCIdentityByID{ID: ptr.To(r.vpcID)},
+		Ips: ips,
+	}
+	if r.resourceGroupID != "" {
+		options.ResourceGroup = &vpcv1.ResourceGroupIdentityByID{ID: ptr.To(r.resourceGroupID)}
+	}
+	for _, id := range r.securityGroupIDs {
+		options.SecurityGroups = append(options.SecurityGroups, &vpcv1.SecurityGroupIdentityByID{ID: ptr.To(id)})
+	}
+
+	log.Info("Creating VPE gateway", "name", name, "target", crn)
+	result, _, err := r.client.CreateEndpointGateway(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create VPE gateway %s: %w", name, err)
+	}
+	return result, nil
+}
+
+// reservedIPs returns the reserved IPs binding a VPE gateway to the subnets of the cluster.
+// A VPE gateway can only have one reserved IP per zone, so the first subnet of each zone is used.
+func (r *vpeGatewayReconciler) reservedIPs() ([]vpcv1.EndpointGatewayReservedIPIntf, error) {
+	subnetIDs := slices.Clone(r.subnetIDs)
+	slices.Sort(subnetIDs)
+	subnetIDs = slices.Compact(subnetIDs)
+
+	zones := make(map[string]bool)
+	ips := []vpcv1.EndpointGatewayReservedIPIntf{}
+	for _, id := range subnetIDs {
+		subnet, _, err := r.client.GetSubnet(&vpcv1.GetSubnetOptions{
+			ID: ptr.To(id),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get VPC subnet %s: %w", id, err)
+		}
+		if subnet == nil || subnet.Zone == nil || subnet.Zone.Name == nil || zones[*subnet.Zone.Name] {
+			continue
+		}
+		zones[*subnet.Zone.Name] = true
+		ips = append(ips, &vpcv1.EndpointGatewayReservedIPReservedIPPrototypeTargetContext{
+			Subnet: &vpcv1.SubnetIdentityByID{ID: ptr.To(id)},
+		})
+	}
+	return ips, nil
+}
+
+// delete deletes the referenced VPE gateway when it is created by the controller, and returns whether it is gone.
+func (r *vpeGatewayReconciler) delete(ctx context.Context, name string, ref infrav1.ResourceReference) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if ref.ID == nil || !ptr.Deref(ref.ControllerCreated, false) {
+		log.Info("Skipping VPE gateway deletion as resource is not created by controller", "name", name)
+		return true, nil
+	}
+
+	endpointGateway, resp, err := r.client.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{
+		ID: ref.ID,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+			log.Info("VPE gateway successfully deleted", "name", name, "id", *ref.ID)
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get VPE gateway %s: %w", *ref.ID, err)
+	}
+	if endpointGateway != nil && ptr.Deref(endpointGateway.LifecycleState, "") == vpcv1.EndpointGatewayLifecycleStateDeletingConst {
+		log.V(3).Info("VPE gateway is currently being deleted", "name", name, "id", *ref.ID)
+		return false, nil
+	}
+
+	log.Info("Deleting VPE gateway", "name", name, "id", *ref.ID)
+	if _, err := r.client.DeleteEndpointGateway(&vpcv1.DeleteEndpointGatewayOptions{
+		ID: ref.ID,
+	}); err != nil {
+		return false, fmt.Errorf("failed to delete VPE gateway %s: %w", *ref.ID, err)
+	}
+	return false, nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestVPEGatewayTargetCRN(t *testing.T) {
+	testCases := []struct {
+		name    string
+		gateway infrav1.VPEGateway
+		region  string
+		crn     string
+		wantErr bool
+	}{
+		{
+			name:    "Cloud Object Storage",
+			gateway: infrav1.VPEGateway{Service: ptr.To(infrav1.VPEGatewayServiceCOS)},
+			region:  "us-south",
+			crn:     "crn:v1:bluemix:public:cloud-object-storage:global:::endpoint:s3.direct.us-south.cloud-object-storage.appdomain.cloud",
+		},
+		{
+			name:    "Identity and Access Management",
+			gateway: infrav1.VPEGateway{Service: ptr.To(infrav1.VPEGatewayServiceIAM)},
+			region:  "us-south",
+			crn:     "crn:v1:bluemix:public:iam-svcs:global:::endpoint:private.iam.cloud.ibm.com",
+		},
+		{
+			name:    "Container Registry in us-south",
+			gateway: infrav1.VPEGateway{Service: ptr.To(infrav1.VPEGatewayServiceContainerRegistry)},
+			region:  "us-south",
+			crn:     "crn:v1:bluemix:public:container-registry:us-south:::endpoint:vpe.us.icr.io",
+		},
+		{
+			name:    "Container Registry in eu-de",
+			gateway: infrav1.VPEGateway{Service: ptr.To(infrav1.VPEGatewayServiceContainerRegistry)},
+			region:  "eu-de",
+			crn:     "crn:v1:bluemix:public:container-registry:eu-de:::endpoint:vpe.de.icr.io",
+		},
+		{
+			name:    "Container Registry in eu-gb",
+			gateway: infrav1.VPEGateway{Service: ptr.To(infrav1.VPEGatewayServiceContainerRegistry)},
+			region:  "eu-gb",
+			crn:     "crn:v1:bluemix:public:container-registry:eu-gb:::endpoint:vpe.uk.icr.io",
+		},
+		{
+			name:    "Container Registry in jp-tok",
+			gateway: infrav1.VPEGateway{Service: ptr.To(infrav1.VPEGatewayServiceContainerRegistry)},
+			region:  "jp-tok",
+			crn:     "crn:v1:bluemix:public:container-registry:jp-tok:::endpoint:vpe.jp.icr.io",
+		},
+		{
+			name:    "Container Registry in jp-osa",
+			gateway: infrav1.VPEGateway{Service: ptr.To(infrav1.VPEGatewayServiceContainerRegistry)},
+			region:  "jp-osa",
+			crn:     "crn:v1:bluemix:public:container-registry:jp-osa:::endpoint:vpe.jp2.icr.io",
+		},
+		{
+			name:    "Container Registry in a region without registry",
+			gateway: infrav1.VPEGateway{Service: ptr.To(infrav1.VPEGatewayServiceContainerRegistry)},
+			region:  "unknown-region",
+			wantErr: true,
+		},
+		{
+			name:    "Power Virtual Server",
+			gateway: infrav1.VPEGateway{Service: ptr.To(infrav1.VPEGatewayServicePowerVS)},
+			region:  "us-south",
+			crn:     "crn:v1:bluemix:public:power-iaas:us-south:::endpoint:us-south.power-iaas.cloud.ibm.com",
+		},
+		{
+			name:    "CRN",
+			gateway: infrav1.VPEGateway{CRN: ptr.To("crn:v1:bluemix:public:container-registry:us-east:::endpoint:vpe.us.icr.io")},
+			region:  "us-east",
+			crn:     "crn:v1:bluemix:public:container-registry:us-east:::endpoint:vpe.us.icr.io",
+		},
+		{
+			name:    "Unsupported service",
+			gateway: infrav1.VPEGateway{Service: ptr.To(infrav1.VPEGatewayService("unknown"))},
+			region:  "us-south",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			crn, err := vpeGatewayTargetCRN(tc.gateway, tc.region)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(crn).To(Equal(tc.crn))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package transitgateway contains transit gateway client functions.
+package transitgateway
+
+import (
+	"time"
+
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+)
+
+// apiVersion is the version date of the transit gateway API, the current date uses its latest version.
+var apiVersion = time.Now().Format(time.DateOnly)
+
+// NewClient creates new transit gateway client.
+func NewClient() (*tgapiv1.TransitGatewayApisV1, error) {
+	return tgapiv1.NewTransitGatewayApisV1(&tgapiv1.TransitGatewayApisV1Options{
+		Authenticator: iam.GetIAMAuth(),
+		Version:       ptr.To(apiVersion),
+	})
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cliutils
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+)
+
+const (
+	// StateNotFound is the state of a resource referenced by a cluster which does not exist anymore.
+	StateNotFound = "NotFound"
+	// StateUnknown is the state of a resource which cannot be retrieved.
+	StateUnknown = "Unknown"
+)
+
+// ReadyState returns the state of a cluster object from its ready status.
+func ReadyState(ready bool) string {
+	if ready {
+		return "Ready"
+	}
+	return "NotReady"
+}
+
+// SortedKeys returns the keys of a status map in order, so that the resources are always listed in the same order.
+func SortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Resource is a cloud resource referenced by a cluster, with the resources it contains.
+type Resource struct {
+	Kind              string      `json:"kind"`
+	Name              string      `json:"name,omitempty"`
+	ID                string      `json:"id,omitempty"`
+	State             string      `json:"state,omitempty"`
+	ControllerCreated bool        `json:"controllerCreated"`
+	Warnings          []string    `json:"warnings,omitempty"`
+	Resources         []*Resource `json:"resources,omitempty"`
+}
+
+// Warnf adds a drift warning to the resource.
+func (r *Resource) Warnf(format string, args ...interface{}) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+}
+
+// SetLookupError records the error returned when retrieving the resource.
+func (r *Resource) SetLookupError(response *core.DetailedResponse, err error) {
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		r.State = StateNotFound
+		r.Warnf("%s %s is referenced by the cluster but does not exist", r.Kind, r.ID)
+		return
+	}
+	r.State = StateUnknown
+	r.Warnf("failed to get %s %s: %v", r.Kind, r.ID, err)
+}
+
+// Add adds the resources contained in the resource.
+func (r *Resource) Add(resources ...*Resource) {
+	r.Resources = append(r.Resources, resources...)
+}
+
+// ToTable converts the resource tree to *metav1.Table, with a row per resource indented under its parent.
+func (r *Resource) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "Resource",
+				Type: "string",
+			},
+			{
+				Name: "Name",
+				Type: "string",
+			},
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "State",
+				Type: "string",
+			},
+			{
+				Name: "Controller Created",
+				Type: "bool",
+			},
+			{
+				Name: "Warnings",
+				Type: "string",
+			},
+		},
+	}
+	r.addRows(table, "", "")
+	return table
+}
+
+func (r *Resource) addRows(table *metav1.Table, prefix, childPrefix string) {
+	table.Rows = append(table.Rows, metav1.TableRow{
+		Cells: []interface{}{prefix + r.Kind, r.Name, r.ID, r.State, r.ControllerCreated, strings.Join(r.Warnings, "; ")},
+	})
+	for i, resource := range r.Resources {
+		if i == len(r.Resources)-1 {
+			resource.addRows(table, childPrefix+"└─ ", childPrefix+"   ")
+		} else {
+			resource.addRows(table, childPrefix+"├─ ", childPrefix+"│  ")
+		}
+	}
+}
+
+// PrintTree prints the resource tree in the output format of the command.
+func PrintTree(tree *Resource) error {
+	return printTree(os.Stdout, options.GlobalOptions.Output, tree)
+}
+
+func printTree(writer io.Writer, output printer.PType, tree *Resource) error {
+	pr, err := printer.New(output, writer)
+	if err != nil {
+		return err
+	}
+
+	if output == printer.PrinterTypeJSON {
+		return pr.Print(tree)
+	}
+	return pr.Print(tree.ToTable())
+}
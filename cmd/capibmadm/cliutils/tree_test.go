@@ -0,0 +1,164 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cliutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+
+	. "github.com/onsi/gomega"
+)
+
+func newTree() *Resource {
+	network := &Resource{Kind: "Network", Name: "capi-network", ID: "network-id", State: "vlan", ControllerCreated: true}
+	dhcpServer := &Resource{Kind: "DHCPServer", Name: "capi-dhcp", ID: "dhcp-id", State: "BUILD", ControllerCreated: true}
+	dhcpServer.Warnf("DHCP server is %s", dhcpServer.State)
+	workspace := &Resource{Kind: "Workspace", Name: "capi-workspace", ID: "workspace-id", State: "active"}
+	workspace.Add(network, dhcpServer)
+	vpc := &Resource{Kind: "VPC", Name: "capi-vpc", ID: "vpc-id", State: "available", ControllerCreated: true}
+	vpc.Add(&Resource{Kind: "Subnet", Name: "capi-subnet", ID: "subnet-id", State: "available", ControllerCreated: true})
+
+	tree := &Resource{Kind: "IBMPowerVSCluster", Name: "default/capi", State: ReadyState(true)}
+	tree.Add(workspace, vpc)
+	return tree
+}
+
+func TestToTable(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tree     *Resource
+		expected [][]interface{}
+	}{
+		{
+			name:     "Resource without children",
+			tree:     &Resource{Kind: "IBMPowerVSCluster", Name: "default/capi", State: ReadyState(false)},
+			expected: [][]interface{}{{"IBMPowerVSCluster", "default/capi", "", "NotReady", false, ""}},
+		},
+		{
+			name: "Resources are indented under their parent",
+			tree: newTree(),
+			expected: [][]interface{}{
+				{"IBMPowerVSCluster", "default/capi", "", "Ready", false, ""},
+				{"├─ Workspace", "capi-workspace", "workspace-id", "active", false, ""},
+				{"│  ├─ Network", "capi-network", "network-id", "vlan", true, ""},
+				{"│  └─ DHCPServer", "capi-dhcp", "dhcp-id", "BUILD", true, "DHCP server is BUILD"},
+				{"└─ VPC", "capi-vpc", "vpc-id", "available", true, ""},
+				{"   └─ Subnet", "capi-subnet", "subnet-id", "available", true, ""},
+			},
+		},
+		{
+			name: "Warnings are joined",
+			tree: func() *Resource {
+				r := &Resource{Kind: "VPC", ID: "vpc-id"}
+				r.Warnf("VPC is %s", "pending")
+				r.Warnf("VPC %s differs from %s in the cluster spec", "vpc-id", "other-id")
+				return r
+			}(),
+			expected: [][]interface{}{{"VPC", "", "vpc-id", "", false, "VPC is pending; VPC vpc-id differs from other-id in the cluster spec"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			table := tc.tree.ToTable()
+			g.Expect(table.ColumnDefinitions).To(HaveLen(6))
+			var rows [][]interface{}
+			for _, row := range table.Rows {
+				rows = append(rows, row.Cells)
+			}
+			g.Expect(rows).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestSetLookupError(t *testing.T) {
+	testCases := []struct {
+		name             string
+		response         *core.DetailedResponse
+		expectedState    string
+		expectedWarnings []string
+	}{
+		{
+			name:             "Resource not found",
+			response:         &core.DetailedResponse{StatusCode: http.StatusNotFound},
+			expectedState:    StateNotFound,
+			expectedWarnings: []string{"VPC vpc-id is referenced by the cluster but does not exist"},
+		},
+		{
+			name:             "Request failed",
+			response:         &core.DetailedResponse{StatusCode: http.StatusInternalServerError},
+			expectedState:    StateUnknown,
+			expectedWarnings: []string{"failed to get VPC vpc-id: internal error"},
+		},
+		{
+			name:             "Request failed without response",
+			expectedState:    StateUnknown,
+			expectedWarnings: []string{"failed to get VPC vpc-id: internal error"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			r := &Resource{Kind: "VPC", ID: "vpc-id"}
+			r.SetLookupError(tc.response, errors.New("internal error"))
+			g.Expect(r.State).To(Equal(tc.expectedState))
+			g.Expect(r.Warnings).To(Equal(tc.expectedWarnings))
+		})
+	}
+}
+
+func TestPrintTree(t *testing.T) {
+	t.Run("Table output", func(t *testing.T) {
+		g := NewWithT(t)
+		var out bytes.Buffer
+		g.Expect(printTree(&out, printer.PrinterTypeTable, newTree())).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		g.Expect(lines).To(HaveLen(7))
+		g.Expect(strings.Fields(lines[0])).To(Equal([]string{"RESOURCE", "NAME", "ID", "STATE", "CONTROLLER", "CREATED", "WARNINGS"}))
+		g.Expect(lines[4]).To(HavePrefix("│  └─ DHCPServer"))
+		g.Expect(lines[4]).To(HaveSuffix("DHCP server is BUILD"))
+		g.Expect(lines[6]).To(HavePrefix("   └─ Subnet"))
+	})
+
+	t.Run("JSON output", func(t *testing.T) {
+		g := NewWithT(t)
+		var out bytes.Buffer
+		g.Expect(printTree(&out, printer.PrinterTypeJSON, newTree())).To(Succeed())
+		tree := &Resource{}
+		g.Expect(json.Unmarshal(out.Bytes(), tree)).To(Succeed())
+		g.Expect(tree).To(Equal(newTree()))
+	})
+
+	t.Run("Unknown output", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(printTree(&bytes.Buffer{}, printer.PType("yaml"), newTree())).To(MatchError(printer.ErrUnknowPrinterType))
+	})
+}
+
+func TestSortedKeys(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(SortedKeys(map[string]int{"b": 2, "c": 3, "a": 1})).To(Equal([]string{"a", "b", "c"}))
+	g.Expect(SortedKeys(map[string]int{})).To(BeEmpty())
+}
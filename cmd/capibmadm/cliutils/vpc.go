@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cliutils
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/pointer"
+)
+
+// DescribeVPC returns the VPC with the given ID.
+func DescribeVPC(ctx context.Context, v1 *vpcv1.VpcV1, id string, controllerCreated bool) *Resource {
+	resource := &Resource{Kind: "VPC", ID: id, ControllerCreated: controllerCreated}
+	vpc, response, err := v1.GetVPCWithContext(ctx, &vpcv1.GetVPCOptions{ID: &id})
+	if err != nil {
+		resource.SetLookupError(response, err)
+		return resource
+	}
+	resource.Name = pointer.Dereference(vpc.Name).(string)
+	resource.State = pointer.Dereference(vpc.Status).(string)
+	if resource.State != vpcv1.VPCStatusAvailableConst {
+		resource.Warnf("VPC is %s", resource.State)
+	}
+	return resource
+}
+
+// DescribeSubnet returns the VPC subnet with the given ID, which is expected in the VPC with vpcID.
+func DescribeSubnet(ctx context.Context, v1 *vpcv1.VpcV1, id, vpcID string, controllerCreated bool) *Resource {
+	resource := &Resource{Kind: "Subnet", ID: id, ControllerCreated: controllerCreated}
+	subnet, response, err := v1.GetSubnetWithContext(ctx, &vpcv1.GetSubnetOptions{ID: &id})
+	if err != nil {
+		resource.SetLookupError(response, err)
+		return resource
+	}
+	resource.Name = pointer.Dereference(subnet.Name).(string)
+	resource.State = pointer.Dereference(subnet.Status).(string)
+	if resource.State != vpcv1.SubnetStatusAvailableConst {
+		resource.Warnf("subnet is %s", resource.State)
+	}
+	if subnet.VPC != nil && vpcID != "" && pointer.Dereference(subnet.VPC.ID).(string) != vpcID {
+		resource.Warnf("subnet belongs to VPC %s instead of %s", *subnet.VPC.ID, vpcID)
+	}
+	return resource
+}
+
+// DescribeSecurityGroup returns the VPC security group with the given ID, checking that the rules recorded by the cluster exist.
+func DescribeSecurityGroup(ctx context.Context, v1 *vpcv1.VpcV1, id string, ruleIDs []*string, controllerCreated bool) *Resource {
+	resource := &Resource{Kind: "SecurityGroup", ID: id, ControllerCreated: controllerCreated}
+	securityGroup, response, err := v1.GetSecurityGroupWithContext(ctx, &vpcv1.GetSecurityGroupOptions{ID: &id})
+	if err != nil {
+		resource.SetLookupError(response, err)
+		return resource
+	}
+	resource.Name = pointer.Dereference(securityGroup.Name).(string)
+	resource.State = "available"
+	for _, ruleID := range ruleIDs {
+		if ruleID == nil {
+			continue
+		}
+		_, response, err := v1.GetSecurityGroupRuleWithContext(ctx, &vpcv1.GetSecurityGroupRuleOptions{SecurityGroupID: &id, ID: ruleID})
+		switch {
+		case response != nil && response.StatusCode == http.StatusNotFound:
+			resource.Warnf("rule %s is recorded by the cluster but does not exist", *ruleID)
+		case err != nil:
+			resource.Warnf("failed to get rule %s: %v", *ruleID, err)
+		}
+	}
+	return resource
+}
+
+// DescribeLoadBalancer returns the VPC load balancer with the given ID, checking its hostname against the one recorded by the cluster.
+func DescribeLoadBalancer(ctx context.Context, v1 *vpcv1.VpcV1, id string, hostname *string, controllerCreated bool) *Resource {
+	resource := &Resource{Kind: "LoadBalancer", ID: id, ControllerCreated: controllerCreated}
+	loadBalancer, response, err := v1.GetLoadBalancerWithContext(ctx, &vpcv1.GetLoadBalancerOptions{ID: &id})
+	if err != nil {
+		resource.SetLookupError(response, err)
+		return resource
+	}
+	resource.Name = pointer.Dereference(loadBalancer.Name).(string)
+	resource.State = pointer.Dereference(loadBalancer.ProvisioningStatus).(string)
+	if resource.State != vpcv1.LoadBalancerProvisioningStatusActiveConst {
+		resource.Warnf("load balancer is %s", resource.State)
+	}
+	if operatingStatus := pointer.Dereference(loadBalancer.OperatingStatus).(string); operatingStatus != vpcv1.LoadBalancerOperatingStatusOnlineConst {
+		resource.Warnf("load balancer is %s", operatingStatus)
+	}
+	if hostname != nil && *hostname != pointer.Dereference(loadBalancer.Hostname).(string) {
+		resource.Warnf("hostname %s differs from %s recorded by the cluster", pointer.Dereference(loadBalancer.Hostname).(string), *hostname)
+	}
+	return resource
+}
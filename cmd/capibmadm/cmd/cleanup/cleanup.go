@@ -0,0 +1,64 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cleanup contains the commands to find and delete the cloud resources leaked by the clusters.
+package cleanup
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+)
+
+type cleanupOptions struct {
+	clusterName string
+	dryRun      bool
+	yes         bool
+}
+
+var cleanupOpts = &cleanupOptions{}
+
+// Commands initialises and returns cleanup command.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cleanup",
+		Short: "Commands for cleaning up the cloud resources leaked by deleted clusters",
+		Long: `Find the cloud resources left behind by the failed deletion of a cluster, from the names the controller gives
+to the resources it creates and the tags it attaches to them, and delete them in dependency order.`,
+		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+			apiKey := os.Getenv(options.IBMCloudAPIKeyEnvName)
+			if apiKey == "" {
+				return fmt.Errorf("ibmcloud api key is not provided, set %s environmental variable", options.IBMCloudAPIKeyEnvName)
+			}
+			options.GlobalOptions.IBMCloudAPIKey = apiKey
+			return nil
+		},
+	}
+
+	cmd.PersistentFlags().StringVar(&cleanupOpts.clusterName, "name", "", "Name of the cluster the resources were created for. (Required)")
+	cmd.PersistentFlags().BoolVar(&cleanupOpts.dryRun, "dry-run", false, "Only list the resources which would be deleted")
+	cmd.PersistentFlags().BoolVarP(&cleanupOpts.yes, "yes", "y", false, "Delete the resources without asking for confirmation")
+
+	_ = cmd.MarkPersistentFlagRequired("name")
+
+	cmd.AddCommand(PowerVSCommand())
+	cmd.AddCommand(VPCCommand())
+
+	return cmd
+}
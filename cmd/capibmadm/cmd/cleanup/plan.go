@@ -0,0 +1,205 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cleanup
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+)
+
+const (
+	deletionPollInterval = 10 * time.Second
+	deletionTimeout      = 20 * time.Minute
+)
+
+// orphan is a cloud resource leaked by a cluster.
+type orphan struct {
+	Kind     string `json:"kind"`
+	Name     string `json:"name"`
+	ID       string `json:"id"`
+	Location string `json:"location,omitempty"`
+
+	// delete deletes the resource.
+	delete func(ctx context.Context) (*core.DetailedResponse, error)
+	// get retrieves the resource to wait for its deletion, nil when the deletion is synchronous.
+	get func(ctx context.Context) (*core.DetailedResponse, error)
+}
+
+// plan is the list of leaked resources, in the order they have to be deleted.
+type plan struct {
+	Resources []*orphan `json:"resources"`
+}
+
+// add adds the resource to the plan, unless it was already found.
+func (p *plan) add(o *orphan) {
+	for _, existing := range p.Resources {
+		if existing.Kind == o.Kind && existing.ID == o.ID {
+			return
+		}
+	}
+	p.Resources = append(p.Resources, o)
+}
+
+// has returns whether a resource of the kind was found.
+func (p *plan) has(kind string) bool {
+	for _, o := range p.Resources {
+		if o.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// ToTable converts the plan to *metav1.Table.
+func (p *plan) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "Order",
+				Type: "integer",
+			},
+			{
+				Name: "Resource",
+				Type: "string",
+			},
+			{
+				Name: "Name",
+				Type: "string",
+			},
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "Location",
+				Type: "string",
+			},
+		},
+	}
+
+	for i, o := range p.Resources {
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{i + 1, o.Kind, o.Name, o.ID, o.Location},
+		})
+	}
+	return table
+}
+
+// run prints the plan and, unless running in dry-run mode, deletes the resources once confirmed.
+func run(ctx context.Context, p *plan) error {
+	log := logf.Log
+
+	if len(p.Resources) == 0 {
+		log.Info("No leaked resources found", "cluster", cleanupOpts.clusterName)
+		return nil
+	}
+
+	pr, err := printer.New(options.GlobalOptions.Output, os.Stdout)
+	if err != nil {
+		return err
+	}
+	if options.GlobalOptions.Output == printer.PrinterTypeJSON {
+		err = pr.Print(p)
+	} else {
+		err = pr.Print(p.ToTable())
+	}
+	if err != nil {
+		return err
+	}
+
+	if cleanupOpts.dryRun {
+		return nil
+	}
+	if !cleanupOpts.yes {
+		confirmed, err := confirm(os.Stdin, len(p.Resources))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Cleanup cancelled")
+			return nil
+		}
+	}
+
+	for _, o := range p.Resources {
+		log.Info("Deleting resource", "kind", o.Kind, "name", o.Name, "id", o.ID)
+		if err := o.deleteAndWait(ctx); err != nil {
+			return fmt.Errorf("failed to delete %s %s, rerun the command to resume the cleanup: %w", o.Kind, o.ID, err)
+		}
+		log.Info("Successfully deleted resource", "kind", o.Kind, "name", o.Name, "id", o.ID)
+	}
+	return nil
+}
+
+func confirm(in io.Reader, count int) (bool, error) {
+	fmt.Fprintf(os.Stderr, "Delete the %d resources listed above? [y/N]: ", count)
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
+
+// deleteAndWait deletes the resource and waits until it is gone, as the resources deleted next may depend on it.
+func (o *orphan) deleteAndWait(ctx context.Context) error {
+	if response, err := o.delete(ctx); err != nil {
+		if isNotFound(response) {
+			return nil
+		}
+		return err
+	}
+	if o.get == nil {
+		return nil
+	}
+
+	return wait.PollUntilContextTimeout(ctx, deletionPollInterval, deletionTimeout, false, func(ctx context.Context) (bool, error) {
+		response, err := o.get(ctx)
+		if isNotFound(response) {
+			return true, nil
+		}
+		return false, err
+	})
+}
+
+func isNotFound(response *core.DetailedResponse) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
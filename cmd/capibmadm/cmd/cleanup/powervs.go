@@ -0,0 +1,334 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cleanup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM/go-sdk-core/v5/core"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"k8s.io/utils/ptr"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/platformservices"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/transitgateway"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+)
+
+// PowerVSCommand function to clean up the resources leaked by a PowerVS cluster.
+func PowerVSCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "powervs",
+		Short: "Clean up the resources leaked by a PowerVS cluster",
+		Long: `Clean up the transit gateway, VPC load balancers, security groups, subnets and VPC, DHCP server, PowerVS workspace
+and COS instance of a PowerVS cluster, found from the exact names given to them by the controller.
+The names set in the spec of the cluster are passed with the name flags, the ones generated by the controller are used otherwise.`,
+		Example: `
+# List the resources leaked by a PowerVS cluster
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm cleanup powervs --name <cluster-name> --region <vpc-region> --dry-run
+
+# Delete the resources leaked by a PowerVS cluster, including the DHCP server created in an existing workspace
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm cleanup powervs --name <cluster-name> --region <vpc-region> --service-instance-id <service-instance-id>
+
+# Delete the resources leaked by a PowerVS cluster whose spec sets the name of the transit gateway and of the DHCP server
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm cleanup powervs --name <cluster-name> --region <vpc-region> --transit-gateway-name <name> --dhcp-server-name <name>`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, err := findPowerVSClusterOrphans(cmd.Context(), newPowerVSClusterNames(cleanupOpts.clusterName, powerVSNameOpts))
+			if err != nil {
+				return err
+			}
+			return run(cmd.Context(), p)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.GlobalOptions.VPCRegion, "region", "", "IBM cloud vpc region of the cluster, the VPC resources are not cleaned up when not set")
+	cmd.Flags().StringVar(&options.GlobalOptions.ServiceInstanceID, "service-instance-id", "", "PowerVS service instance id of an existing workspace used by the cluster, to clean up the DHCP server created in it")
+
+	cmd.Flags().StringVar(&powerVSNameOpts.serviceInstance, "service-instance-name", "", "Name of the PowerVS workspace, spec.serviceInstance.name of the cluster")
+	cmd.Flags().StringVar(&powerVSNameOpts.dhcpServer, "dhcp-server-name", "", "Name of the DHCP server, spec.dhcpServer.name of the cluster, or spec.network.name when it is not set")
+	cmd.Flags().StringVar(&powerVSNameOpts.vpc, "vpc-name", "", "Name of the VPC, spec.vpc.name of the cluster")
+	cmd.Flags().StringVar(&powerVSNameOpts.transitGateway, "transit-gateway-name", "", "Name of the transit gateway, spec.transitGateway.name of the cluster")
+	cmd.Flags().StringVar(&powerVSNameOpts.cosInstance, "cos-instance-name", "", "Name of the COS instance, spec.cosInstance.name of the cluster")
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+// powerVSClusterNames are the names of the resources of a PowerVS cluster.
+type powerVSClusterNames struct {
+	cluster         string
+	serviceInstance string
+	dhcpServer      string
+	vpc             string
+	transitGateway  string
+	cosInstance     string
+}
+
+var powerVSNameOpts = &powerVSClusterNames{}
+
+// newPowerVSClusterNames returns the names of the resources of a PowerVS cluster like PowerVSClusterScope.GetServiceName,
+// those set in the spec of the cluster and passed in names, or the names generated from the cluster name otherwise.
+func newPowerVSClusterNames(clusterName string, names *powerVSClusterNames) *powerVSClusterNames {
+	nameOrDefault := func(name, defaultName string) string {
+		if name != "" {
+			return name
+		}
+		return defaultName
+	}
+	return &powerVSClusterNames{
+		cluster:         clusterName,
+		serviceInstance: nameOrDefault(names.serviceInstance, fmt.Sprintf("%s-serviceInstance", clusterName)),
+		dhcpServer:      nameOrDefault(names.dhcpServer, clusterName),
+		vpc:             nameOrDefault(names.vpc, fmt.Sprintf("%s-vpc", clusterName)),
+		transitGateway:  nameOrDefault(names.transitGateway, fmt.Sprintf("%s-transitgateway", clusterName)),
+		cosInstance:     nameOrDefault(names.cosInstance, fmt.Sprintf("%s-cosinstance", clusterName)),
+	}
+}
+
+// findPowerVSClusterOrphans returns the resources leaked by a PowerVS cluster, in the order the controller deletes them.
+// A warning is logged for each kind of resource which is not found, as its name may have been set in the cluster spec.
+func findPowerVSClusterOrphans(ctx context.Context, names *powerVSClusterNames) (*plan, error) {
+	log := logf.Log
+	log.Info("Finding the resources leaked by PowerVS cluster", "cluster", names.cluster, "region", options.GlobalOptions.VPCRegion)
+
+	p := &plan{}
+	if err := findTransitGatewayOrphans(ctx, names.transitGateway, p); err != nil {
+		return nil, err
+	}
+	warnNotFound(p, "TransitGateway", names.transitGateway, "transit-gateway-name")
+
+	if region := options.GlobalOptions.VPCRegion; region != "" {
+		v1, err := vpc.NewV1Client(region)
+		if err != nil {
+			return nil, err
+		}
+		zones, err := listZones(ctx, v1, region)
+		if err != nil {
+			return nil, err
+		}
+		resources, err := listVPCResources(ctx, v1, false)
+		if err != nil {
+			return nil, err
+		}
+		newPowerVSClusterMatcher(names.cluster, names.vpc, zones).plan(v1, region, resources, p)
+		warnNotFound(p, "LoadBalancer", fmt.Sprintf("%s-loadbalancer", names.cluster), "")
+		warnNotFound(p, "Subnet", fmt.Sprintf("%s-vpcsubnet", names.cluster), "")
+		warnNotFound(p, "VPC", names.vpc, "vpc-name")
+	}
+
+	rc, err := platformservices.NewResourceControllerV2Client()
+	if err != nil {
+		return nil, err
+	}
+	if workspaceID := options.GlobalOptions.ServiceInstanceID; workspaceID != "" {
+		if err := findDHCPServerOrphans(ctx, rc, workspaceID, dhcpNetworkName(names.dhcpServer), p); err != nil {
+			return nil, err
+		}
+		warnNotFound(p, "DHCPServer", dhcpNetworkName(names.dhcpServer), "dhcp-server-name")
+	}
+	// The DHCP server and the network of a workspace created by the controller are deleted along with the workspace.
+	if err := findServiceInstanceOrphans(ctx, rc, "Workspace", names.serviceInstance, resourcecontroller.PowerVSResourceID, p); err != nil {
+		return nil, err
+	}
+	warnNotFound(p, "Workspace", names.serviceInstance, "service-instance-name")
+	// The COS bucket is deleted along with the COS instance.
+	if err := findServiceInstanceOrphans(ctx, rc, "COSInstance", names.cosInstance, "", p); err != nil {
+		return nil, err
+	}
+	warnNotFound(p, "COSInstance", names.cosInstance, "cos-instance-name")
+	return p, nil
+}
+
+// warnNotFound logs a warning when no resource of the kind is in the plan, with the flag setting its name if any.
+func warnNotFound(p *plan, kind, name, flag string) {
+	if p.has(kind) {
+		return
+	}
+	keysAndValues := []interface{}{"kind", kind, "name", name}
+	if flag != "" {
+		keysAndValues = append(keysAndValues, "flag", "--"+flag)
+	}
+	logf.Log.Info("Warning: no resource found, it was deleted or the cluster spec sets another name", keysAndValues...)
+}
+
+// newPowerVSClusterMatcher returns the matcher of the VPC resources of a PowerVS cluster, named by
+// PowerVSClusterScope.GetServiceName. The subnets and load balancers of the spec without a name are named after their index.
+func newPowerVSClusterMatcher(clusterName, vpcName string, zones []string) *vpcMatcher {
+	subnet, loadBalancer := fmt.Sprintf("%s-vpcsubnet", clusterName), fmt.Sprintf("%s-loadbalancer", clusterName)
+	m := &vpcMatcher{
+		vpcs:          names{exact: []string{vpcName}},
+		subnets:       names{indexed: []string{subnet}},
+		loadBalancers: names{exact: []string{loadBalancer}, indexed: []string{loadBalancer}},
+	}
+	for _, zone := range zones {
+		m.subnets.exact = append(m.subnets.exact, fmt.Sprintf("%s-%s", subnet, zone))
+	}
+	return m
+}
+
+// dhcpNetworkName returns the name of the network of the DHCP server created by the controller with the given name.
+func dhcpNetworkName(dhcpServerName string) string {
+	return fmt.Sprintf("DHCPSERVER%s_Private", dhcpServerName)
+}
+
+// findTransitGatewayOrphans adds the transit gateways with the given name to the plan, preceded by their connections.
+func findTransitGatewayOrphans(ctx context.Context, name string, p *plan) error {
+	tg, err := transitgateway.NewClient()
+	if err != nil {
+		return err
+	}
+
+	listOptions := &tgapiv1.ListTransitGatewaysOptions{}
+	for {
+		transitGateways, _, err := tg.ListTransitGatewaysWithContext(ctx, listOptions)
+		if err != nil {
+			return fmt.Errorf("failed to list transit gateways: %w", err)
+		}
+		for _, transitGateway := range transitGateways.TransitGateways {
+			if ptr.Deref(transitGateway.Name, "") != name {
+				continue
+			}
+			if err := findTransitGatewayConnectionOrphans(ctx, tg, transitGateway, p); err != nil {
+				return err
+			}
+			options := tg.NewDeleteTransitGatewayOptions(*transitGateway.ID)
+			p.add(&orphan{
+				Kind: "TransitGateway", Name: name, ID: *transitGateway.ID, Location: ptr.Deref(transitGateway.Location, ""),
+				delete: func(ctx context.Context) (*core.DetailedResponse, error) {
+					return tg.DeleteTransitGatewayWithContext(ctx, options)
+				},
+				get: func(ctx context.Context) (*core.DetailedResponse, error) {
+					_, response, err := tg.GetTransitGatewayWithContext(ctx, tg.NewGetTransitGatewayOptions(*options.ID))
+					return response, err
+				},
+			})
+		}
+		if transitGateways.Next == nil || transitGateways.Next.Start == nil {
+			return nil
+		}
+		listOptions.Start = transitGateways.Next.Start
+	}
+}
+
+func findTransitGatewayConnectionOrphans(ctx context.Context, tg *tgapiv1.TransitGatewayApisV1, transitGateway tgapiv1.TransitGateway, p *plan) error {
+	listOptions := tg.NewListTransitGatewayConnectionsOptions(*transitGateway.ID)
+	for {
+		connections, _, err := tg.ListTransitGatewayConnectionsWithContext(ctx, listOptions)
+		if err != nil {
+			return fmt.Errorf("failed to list connections of transit gateway %s: %w", *transitGateway.ID, err)
+		}
+		for _, connection := range connections.Connections {
+			options := tg.NewDeleteTransitGatewayConnectionOptions(*transitGateway.ID, *connection.ID)
+			p.add(&orphan{
+				Kind: "TransitGatewayConnection", Name: ptr.Deref(connection.Name, ""), ID: *connection.ID, Location: ptr.Deref(transitGateway.Location, ""),
+				delete: func(ctx context.Context) (*core.DetailedResponse, error) {
+					return tg.DeleteTransitGatewayConnectionWithContext(ctx, options)
+				},
+				get: func(ctx context.Context) (*core.DetailedResponse, error) {
+					_, response, err := tg.GetTransitGatewayConnectionWithContext(ctx, tg.NewGetTransitGatewayConnectionOptions(*options.TransitGatewayID, *options.ID))
+					return response, err
+				},
+			})
+		}
+		if connections.Next == nil || connections.Next.Start == nil {
+			return nil
+		}
+		listOptions.Start = connections.Next.Start
+	}
+}
+
+// findDHCPServerOrphans adds the DHCP servers of the workspace serving the network with the given name to the plan.
+func findDHCPServerOrphans(ctx context.Context, rc *resourcecontrollerv2.ResourceControllerV2, workspaceID, networkName string, p *plan) error {
+	workspace, _, err := rc.GetResourceInstanceWithContext(ctx, rc.NewGetResourceInstanceOptions(workspaceID))
+	if err != nil {
+		return fmt.Errorf("failed to get PowerVS workspace %s: %w", workspaceID, err)
+	}
+	zone := ptr.Deref(workspace.RegionID, "")
+
+	accountID, err := accounts.GetAccount(iam.GetIAMAuth())
+	if err != nil {
+		return err
+	}
+	sess, err := powervs.NewPISession(accountID, zone, options.GlobalOptions.Debug)
+	if err != nil {
+		return err
+	}
+
+	dhcpClient := v.NewIBMPIDhcpClient(ctx, sess, workspaceID)
+	dhcpServers, err := dhcpClient.GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to list DHCP servers of PowerVS workspace %s: %w", workspaceID, err)
+	}
+	for _, dhcpServer := range dhcpServers {
+		if dhcpServer.Network == nil || ptr.Deref(dhcpServer.Network.Name, "") != networkName {
+			continue
+		}
+		id := *dhcpServer.ID
+		p.add(&orphan{
+			Kind: "DHCPServer", Name: *dhcpServer.Network.Name, ID: id, Location: zone,
+			delete: func(_ context.Context) (*core.DetailedResponse, error) {
+				return nil, dhcpClient.Delete(id)
+			},
+		})
+	}
+	return nil
+}
+
+// findServiceInstanceOrphans adds the resource instances with the given name to the plan.
+func findServiceInstanceOrphans(ctx context.Context, rc *resourcecontrollerv2.ResourceControllerV2, kind, name, resourceID string, p *plan) error {
+	listOptions := &resourcecontrollerv2.ListResourceInstancesOptions{Name: &name}
+	if resourceID != "" {
+		listOptions.ResourceID = &resourceID
+	}
+	pager, err := rc.NewResourceInstancesPager(listOptions)
+	if err != nil {
+		return err
+	}
+	instances, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list %s resource instances: %w", name, err)
+	}
+	for _, instance := range instances {
+		if state := ptr.Deref(instance.State, ""); state == string(infrav1.ServiceInstanceStateRemoved) || state == "pending_reclamation" {
+			continue
+		}
+		options := &resourcecontrollerv2.DeleteResourceInstanceOptions{ID: instance.ID, Recursive: ptr.To(true)}
+		p.add(&orphan{
+			Kind: kind, Name: name, ID: *instance.ID, Location: ptr.Deref(instance.RegionID, ""),
+			delete: func(ctx context.Context) (*core.DetailedResponse, error) {
+				return rc.DeleteResourceInstanceWithContext(ctx, options)
+			},
+		})
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cleanup
+
+import (
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPowerVSClusterMatcher(t *testing.T) {
+	m := newPowerVSClusterMatcher("capi", "capi-vpc", []string{"eu-de-1", "eu-de-2"})
+	testCases := []struct {
+		name         string
+		names        names
+		resourceName string
+		expected     bool
+	}{
+		{name: "VPC of the cluster", names: m.vpcs, resourceName: "capi-vpc", expected: true},
+		{name: "VPC of a cluster whose name starts with the cluster name", names: m.vpcs, resourceName: "capi-vpc-1", expected: false},
+		{name: "Subnet in a zone of the region", names: m.subnets, resourceName: "capi-vpcsubnet-eu-de-1", expected: true},
+		{name: "Subnet of the spec without name", names: m.subnets, resourceName: "capi-vpcsubnet-1", expected: true},
+		{name: "Subnet in a zone outside of the region", names: m.subnets, resourceName: "capi-vpcsubnet-us-south-1", expected: false},
+		{name: "Default load balancer", names: m.loadBalancers, resourceName: "capi-loadbalancer", expected: true},
+		{name: "Load balancer of the spec without name", names: m.loadBalancers, resourceName: "capi-loadbalancer-0", expected: true},
+		{name: "Load balancer named after the cluster", names: m.loadBalancers, resourceName: "capi-loadbalancer-internal", expected: false},
+		{name: "Public gateway named after the cluster", names: m.publicGateways, resourceName: "capi-pgateway-eu-de-1", expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(m.matches(tc.names, ptr.To(tc.resourceName), ptr.To("crn"))).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestPowerVSClusterMatcherPlan(t *testing.T) {
+	g := NewWithT(t)
+	vpcRef := &vpcv1.VPCReference{ID: ptr.To("vpc-id")}
+	resources := &vpcResources{
+		vpcs: []vpcv1.VPC{{ID: ptr.To("vpc-id"), Name: ptr.To("capi-vpc")}},
+		subnets: []vpcv1.Subnet{
+			{ID: ptr.To("subnet-id"), Name: ptr.To("capi-vpcsubnet-eu-de-1"), VPC: vpcRef},
+			{ID: ptr.To("user-subnet-id"), Name: ptr.To("user-subnet"), VPC: vpcRef},
+		},
+		loadBalancers: []vpcv1.LoadBalancer{{ID: ptr.To("lb-id"), Name: ptr.To("capi-loadbalancer")}},
+		securityGroups: []vpcv1.SecurityGroup{
+			{ID: ptr.To("sg-id"), Name: ptr.To("capi-sg"), VPC: vpcRef},
+		},
+	}
+
+	p := &plan{}
+	newPowerVSClusterMatcher("capi", "capi-vpc", []string{"eu-de-1"}).plan(nil, "eu-de", resources, p)
+	var planned []string
+	for _, o := range p.Resources {
+		planned = append(planned, o.Kind+"/"+o.ID)
+	}
+	g.Expect(planned).To(Equal([]string{"LoadBalancer/lb-id", "Subnet/subnet-id", "VPC/vpc-id"}))
+}
+
+func TestNewPowerVSClusterNames(t *testing.T) {
+	testCases := []struct {
+		name     string
+		names    *powerVSClusterNames
+		expected *powerVSClusterNames
+	}{
+		{
+			name:  "Names generated by the controller",
+			names: &powerVSClusterNames{},
+			expected: &powerVSClusterNames{
+				cluster:         "capi",
+				serviceInstance: "capi-serviceInstance",
+				dhcpServer:      "capi",
+				vpc:             "capi-vpc",
+				transitGateway:  "capi-transitgateway",
+				cosInstance:     "capi-cosinstance",
+			},
+		},
+		{
+			name: "Names set in the cluster spec",
+			names: &powerVSClusterNames{
+				serviceInstance: "workspace",
+				dhcpServer:      "dhcp",
+				vpc:             "vpc",
+				transitGateway:  "tg",
+				cosInstance:     "cos",
+			},
+			expected: &powerVSClusterNames{
+				cluster:         "capi",
+				serviceInstance: "workspace",
+				dhcpServer:      "dhcp",
+				vpc:             "vpc",
+				transitGateway:  "tg",
+				cosInstance:     "cos",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(newPowerVSClusterNames("capi", tc.names)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestPowerVSClusterMatcherWithVPCName(t *testing.T) {
+	g := NewWithT(t)
+	m := newPowerVSClusterMatcher("capi", "shared-vpc", []string{"eu-de-1"})
+	g.Expect(m.matches(m.vpcs, ptr.To("shared-vpc"), nil)).To(BeTrue())
+	g.Expect(m.matches(m.vpcs, ptr.To("capi-vpc"), nil)).To(BeFalse())
+	g.Expect(m.matches(m.subnets, ptr.To("capi-vpcsubnet-eu-de-1"), nil)).To(BeTrue())
+	g.Expect(dhcpNetworkName("dhcp")).To(Equal("DHCPSERVERdhcp_Private"))
+}
+
+func TestPlanHas(t *testing.T) {
+	g := NewWithT(t)
+	p := &plan{}
+	g.Expect(p.has("VPC")).To(BeFalse())
+	p.add(&orphan{Kind: "VPC", ID: "vpc-id"})
+	g.Expect(p.has("VPC")).To(BeTrue())
+	g.Expect(p.has("Subnet")).To(BeFalse())
+}
@@ -0,0 +1,380 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/utils/ptr"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/platformservices"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+)
+
+// VPCCommand function to clean up the resources leaked by a VPC cluster.
+func VPCCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vpc",
+		Short: "Clean up the resources leaked by a VPC cluster",
+		Long: `Clean up the instances, load balancers, security groups, subnets, public gateways, VPC and images of a VPC cluster,
+found from the exact names given to them by the controller and from the cluster name tag attached to them.`,
+		Example: `
+# List the resources leaked by a VPC cluster
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm cleanup vpc --name <cluster-name> --region <region> --dry-run
+
+# Delete the resources leaked by a VPC cluster
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm cleanup vpc --name <cluster-name> --region <region>`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			p, err := findVPCClusterOrphans(cmd.Context(), cleanupOpts.clusterName, options.GlobalOptions.VPCRegion)
+			if err != nil {
+				return err
+			}
+			return run(cmd.Context(), p)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.GlobalOptions.VPCRegion, "region", "", "IBM cloud vpc region. (Required)")
+	_ = cmd.MarkFlagRequired("region")
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func findVPCClusterOrphans(ctx context.Context, clusterName, region string) (*plan, error) {
+	log := logf.Log
+	log.Info("Finding the resources leaked by VPC cluster", "cluster", clusterName, "region", region)
+
+	taggedCRNs, err := findTaggedCRNs(ctx, clusterName, region)
+	if err != nil {
+		return nil, err
+	}
+	v1, err := vpc.NewV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	zones, err := listZones(ctx, v1, region)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := listVPCResources(ctx, v1, len(taggedCRNs) > 0)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &plan{}
+	newVPCClusterMatcher(clusterName, zones, taggedCRNs).plan(v1, region, resources, p)
+	return p, nil
+}
+
+// newVPCClusterMatcher returns the matcher of the resources of a VPC cluster, named by VPCClusterScope.GetServiceName.
+func newVPCClusterMatcher(clusterName string, zones []string, taggedCRNs map[string]bool) *vpcMatcher {
+	m := &vpcMatcher{
+		vpcs:          names{exact: []string{fmt.Sprintf("%s-vpc", clusterName)}},
+		loadBalancers: names{exact: []string{fmt.Sprintf("%s-lb-public", clusterName), fmt.Sprintf("%s-lb-private", clusterName)}},
+		taggedCRNs:    taggedCRNs,
+	}
+	for _, zone := range zones {
+		m.subnets.exact = append(m.subnets.exact, fmt.Sprintf("%s-subnet-%s", clusterName, zone))
+		m.publicGateways.exact = append(m.publicGateways.exact, fmt.Sprintf("%s-pgateway-%s", clusterName, zone))
+	}
+	return m
+}
+
+// findTaggedCRNs returns the CRNs of the resources of the region tagged with the given tag.
+func findTaggedCRNs(ctx context.Context, tag, region string) (map[string]bool, error) {
+	gs, err := platformservices.NewGlobalSearchV2Client()
+	if err != nil {
+		return nil, err
+	}
+
+	crns := map[string]bool{}
+	searchOptions := &globalsearchv2.SearchOptions{
+		Query: ptr.To(fmt.Sprintf("tags:%q AND region:%s", tag, region)),
+		Limit: ptr.To[int64](1000),
+	}
+	for {
+		result, _, err := gs.SearchWithContext(ctx, searchOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search the resources tagged with %s: %w", tag, err)
+		}
+		for _, item := range result.Items {
+			if item.CRN != nil && *item.CRN != "" {
+				crns[*item.CRN] = true
+			}
+		}
+		if result.SearchCursor == nil || len(result.Items) < int(ptr.Deref(result.Limit, 0)) {
+			return crns, nil
+		}
+		searchOptions = &globalsearchv2.SearchOptions{SearchCursor: result.SearchCursor}
+	}
+}
+
+// listZones returns the names of the zones of the region.
+func listZones(ctx context.Context, v1 *vpcv1.VpcV1, region string) ([]string, error) {
+	zones, _, err := v1.ListRegionZonesWithContext(ctx, &vpcv1.ListRegionZonesOptions{RegionName: &region})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the zones of region %s: %w", region, err)
+	}
+	var names []string
+	for _, zone := range zones.Zones {
+		names = append(names, ptr.Deref(zone.Name, ""))
+	}
+	return names, nil
+}
+
+// names are the names given by the controller to the resources of a kind: exact names, and names made of a base name
+// and an index, e.g. <cluster>-vpcsubnet-0.
+type names struct {
+	exact   []string
+	indexed []string
+}
+
+func (n names) has(name string) bool {
+	if name == "" {
+		return false
+	}
+	if slices.Contains(n.exact, name) {
+		return true
+	}
+	for _, base := range n.indexed {
+		suffix, found := strings.CutPrefix(name, base+"-")
+		if !found {
+			continue
+		}
+		if index, err := strconv.Atoi(suffix); err == nil && index >= 0 && strconv.Itoa(index) == suffix {
+			return true
+		}
+	}
+	return false
+}
+
+// vpcMatcher selects the VPC resources of a cluster from the exact names given to them by the controller or from the
+// cluster name tag attached to them. The other resources contained in a selected VPC are never selected: they
+// prevent the deletion of the VPC, which has to be cleaned up manually.
+type vpcMatcher struct {
+	vpcs           names
+	subnets        names
+	publicGateways names
+	loadBalancers  names
+	taggedCRNs     map[string]bool
+}
+
+func (m *vpcMatcher) matches(n names, name, crn *string) bool {
+	return n.has(ptr.Deref(name, "")) || (crn != nil && m.taggedCRNs[*crn])
+}
+
+// vpcResources are the VPC resources of a region.
+type vpcResources struct {
+	vpcs           []vpcv1.VPC
+	instances      []vpcv1.Instance
+	subnets        []vpcv1.Subnet
+	loadBalancers  []vpcv1.LoadBalancer
+	securityGroups []vpcv1.SecurityGroup
+	publicGateways []vpcv1.PublicGateway
+	images         []vpcv1.Image
+}
+
+// listVPCResources lists the VPC resources of the region, and its private images when listImages is set.
+func listVPCResources(ctx context.Context, v1 *vpcv1.VpcV1, listImages bool) (*vpcResources, error) {
+	var err error
+	r := &vpcResources{}
+	if r.vpcs, err = listAll(ctx, v1.NewVpcsPager, &vpcv1.ListVpcsOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to list VPCs: %w", err)
+	}
+	if r.instances, err = listAll(ctx, v1.NewInstancesPager, &vpcv1.ListInstancesOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to list instances: %w", err)
+	}
+	if r.subnets, err = listAll(ctx, v1.NewSubnetsPager, &vpcv1.ListSubnetsOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to list subnets: %w", err)
+	}
+	if r.loadBalancers, err = listAll(ctx, v1.NewLoadBalancersPager, &vpcv1.ListLoadBalancersOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to list load balancers: %w", err)
+	}
+	if r.securityGroups, err = listAll(ctx, v1.NewSecurityGroupsPager, &vpcv1.ListSecurityGroupsOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to list security groups: %w", err)
+	}
+	if r.publicGateways, err = listAll(ctx, v1.NewPublicGatewaysPager, &vpcv1.ListPublicGatewaysOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to list public gateways: %w", err)
+	}
+	if listImages {
+		if r.images, err = listAll(ctx, v1.NewImagesPager, &vpcv1.ListImagesOptions{Visibility: ptr.To(vpcv1.ListImagesOptionsVisibilityPrivateConst)}); err != nil {
+			return nil, fmt.Errorf("failed to list images: %w", err)
+		}
+	}
+	return r, nil
+}
+
+// plan adds the VPC resources selected by the matcher to the plan, in the order they have to be deleted:
+// instances, load balancers, security groups, subnets, public gateways, VPCs and images.
+func (m *vpcMatcher) plan(v1 *vpcv1.VpcV1, region string, r *vpcResources, p *plan) {
+	// The default security group is deleted along with its VPC.
+	defaultSecurityGroupIDs := map[string]bool{}
+	for _, v := range r.vpcs {
+		if m.matches(m.vpcs, v.Name, v.CRN) && v.DefaultSecurityGroup != nil {
+			defaultSecurityGroupIDs[ptr.Deref(v.DefaultSecurityGroup.ID, "")] = true
+		}
+	}
+
+	for _, instance := range r.instances {
+		if !m.matches(names{}, instance.Name, instance.CRN) {
+			continue
+		}
+		options := &vpcv1.DeleteInstanceOptions{ID: instance.ID}
+		p.add(&orphan{
+			Kind: "Instance", Name: ptr.Deref(instance.Name, ""), ID: *instance.ID, Location: region,
+			delete: func(ctx context.Context) (*core.DetailedResponse, error) {
+				return v1.DeleteInstanceWithContext(ctx, options)
+			},
+			get: func(ctx context.Context) (*core.DetailedResponse, error) {
+				_, response, err := v1.GetInstanceWithContext(ctx, &vpcv1.GetInstanceOptions{ID: options.ID})
+				return response, err
+			},
+		})
+	}
+
+	for _, loadBalancer := range r.loadBalancers {
+		if !m.matches(m.loadBalancers, loadBalancer.Name, loadBalancer.CRN) {
+			continue
+		}
+		options := &vpcv1.DeleteLoadBalancerOptions{ID: loadBalancer.ID}
+		p.add(&orphan{
+			Kind: "LoadBalancer", Name: ptr.Deref(loadBalancer.Name, ""), ID: *loadBalancer.ID, Location: region,
+			delete: func(ctx context.Context) (*core.DetailedResponse, error) {
+				return v1.DeleteLoadBalancerWithContext(ctx, options)
+			},
+			get: func(ctx context.Context) (*core.DetailedResponse, error) {
+				_, response, err := v1.GetLoadBalancerWithContext(ctx, &vpcv1.GetLoadBalancerOptions{ID: options.ID})
+				return response, err
+			},
+		})
+	}
+
+	for _, securityGroup := range r.securityGroups {
+		if defaultSecurityGroupIDs[*securityGroup.ID] || !m.matches(names{}, securityGroup.Name, securityGroup.CRN) {
+			continue
+		}
+		options := &vpcv1.DeleteSecurityGroupOptions{ID: securityGroup.ID}
+		p.add(&orphan{
+			Kind: "SecurityGroup", Name: ptr.Deref(securityGroup.Name, ""), ID: *securityGroup.ID, Location: region,
+			delete: func(ctx context.Context) (*core.DetailedResponse, error) {
+				return v1.DeleteSecurityGroupWithContext(ctx, options)
+			},
+			get: func(ctx context.Context) (*core.DetailedResponse, error) {
+				_, response, err := v1.GetSecurityGroupWithContext(ctx, &vpcv1.GetSecurityGroupOptions{ID: options.ID})
+				return response, err
+			},
+		})
+	}
+
+	for _, subnet := range r.subnets {
+		if !m.matches(m.subnets, subnet.Name, subnet.CRN) {
+			continue
+		}
+		location := region
+		if subnet.Zone != nil {
+			location = ptr.Deref(subnet.Zone.Name, region)
+		}
+		options := &vpcv1.DeleteSubnetOptions{ID: subnet.ID}
+		p.add(&orphan{
+			Kind: "Subnet", Name: ptr.Deref(subnet.Name, ""), ID: *subnet.ID, Location: location,
+			delete: func(ctx context.Context) (*core.DetailedResponse, error) {
+				return v1.DeleteSubnetWithContext(ctx, options)
+			},
+			get: func(ctx context.Context) (*core.DetailedResponse, error) {
+				_, response, err := v1.GetSubnetWithContext(ctx, &vpcv1.GetSubnetOptions{ID: options.ID})
+				return response, err
+			},
+		})
+	}
+
+	for _, publicGateway := range r.publicGateways {
+		if !m.matches(m.publicGateways, publicGateway.Name, publicGateway.CRN) {
+			continue
+		}
+		location := region
+		if publicGateway.Zone != nil {
+			location = ptr.Deref(publicGateway.Zone.Name, region)
+		}
+		options := &vpcv1.DeletePublicGatewayOptions{ID: publicGateway.ID}
+		p.add(&orphan{
+			Kind: "PublicGateway", Name: ptr.Deref(publicGateway.Name, ""), ID: *publicGateway.ID, Location: location,
+			delete: func(ctx context.Context) (*core.DetailedResponse, error) {
+				return v1.DeletePublicGatewayWithContext(ctx, options)
+			},
+			get: func(ctx context.Context) (*core.DetailedResponse, error) {
+				_, response, err := v1.GetPublicGatewayWithContext(ctx, &vpcv1.GetPublicGatewayOptions{ID: options.ID})
+				return response, err
+			},
+		})
+	}
+
+	for _, v := range r.vpcs {
+		if !m.matches(m.vpcs, v.Name, v.CRN) {
+			continue
+		}
+		options := &vpcv1.DeleteVPCOptions{ID: v.ID}
+		p.add(&orphan{
+			Kind: "VPC", Name: ptr.Deref(v.Name, ""), ID: *v.ID, Location: region,
+			delete: func(ctx context.Context) (*core.DetailedResponse, error) {
+				return v1.DeleteVPCWithContext(ctx, options)
+			},
+			get: func(ctx context.Context) (*core.DetailedResponse, error) {
+				_, response, err := v1.GetVPCWithContext(ctx, &vpcv1.GetVPCOptions{ID: options.ID})
+				return response, err
+			},
+		})
+	}
+
+	for _, image := range r.images {
+		if !m.matches(names{}, image.Name, image.CRN) {
+			continue
+		}
+		options := &vpcv1.DeleteImageOptions{ID: image.ID}
+		p.add(&orphan{
+			Kind: "Image", Name: ptr.Deref(image.Name, ""), ID: *image.ID, Location: region,
+			delete: func(ctx context.Context) (*core.DetailedResponse, error) {
+				return v1.DeleteImageWithContext(ctx, options)
+			},
+		})
+	}
+}
+
+// listAll returns all the resources listed by the pager of a VPC list operation.
+func listAll[O any, P interface {
+	GetAllWithContext(ctx context.Context) ([]R, error)
+}, R any](ctx context.Context, newPager func(*O) (P, error), listOptions *O) ([]R, error) {
+	pager, err := newPager(listOptions)
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(ctx)
+}
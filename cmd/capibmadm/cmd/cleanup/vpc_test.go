@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cleanup
+
+import (
+	"testing"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNamesHas(t *testing.T) {
+	n := names{exact: []string{"capi-loadbalancer"}, indexed: []string{"capi-loadbalancer"}}
+	testCases := []struct {
+		name     string
+		expected bool
+	}{
+		{name: "capi-loadbalancer", expected: true},
+		{name: "capi-loadbalancer-0", expected: true},
+		{name: "capi-loadbalancer-12", expected: true},
+		{name: "", expected: false},
+		{name: "capi-loadbalancer-", expected: false},
+		{name: "capi-loadbalancer-01", expected: false},
+		{name: "capi-loadbalancer--1", expected: false},
+		{name: "capi-loadbalancer-a", expected: false},
+		{name: "capi-loadbalancer2", expected: false},
+		{name: "capi-loadbalancer-0-other", expected: false},
+		{name: "capi-loadbalancer-public", expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(n.has(tc.name)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestVPCClusterMatcher(t *testing.T) {
+	m := newVPCClusterMatcher("capi", []string{"us-south-1", "us-south-2"}, map[string]bool{"crn:tagged": true})
+	testCases := []struct {
+		name         string
+		names        names
+		resourceName string
+		crn          string
+		expected     bool
+	}{
+		{name: "VPC of the cluster", names: m.vpcs, resourceName: "capi-vpc", expected: true},
+		{name: "VPC of another cluster sharing the prefix", names: m.vpcs, resourceName: "capi-vpc-2", expected: false},
+		{name: "VPC of a cluster whose name starts with the cluster name", names: m.vpcs, resourceName: "capi2-vpc", expected: false},
+		{name: "Subnet in a zone of the region", names: m.subnets, resourceName: "capi-subnet-us-south-2", expected: true},
+		{name: "Subnet in a zone outside of the region", names: m.subnets, resourceName: "capi-subnet-us-east-1", expected: false},
+		{name: "Subnet named after the cluster subnet", names: m.subnets, resourceName: "capi-subnet-us-south-1-backup", expected: false},
+		{name: "Public gateway in a zone of the region", names: m.publicGateways, resourceName: "capi-pgateway-us-south-1", expected: true},
+		{name: "Public load balancer", names: m.loadBalancers, resourceName: "capi-lb-public", expected: true},
+		{name: "Private load balancer", names: m.loadBalancers, resourceName: "capi-lb-private", expected: true},
+		{name: "Load balancer named after the cluster", names: m.loadBalancers, resourceName: "capi-lbaas", expected: false},
+		{name: "Resource tagged with the cluster name", names: names{}, resourceName: "instance", crn: "crn:tagged", expected: true},
+		{name: "Resource not tagged with the cluster name", names: names{}, resourceName: "instance", crn: "crn:other", expected: false},
+		{name: "Resource without name nor CRN", names: m.vpcs, expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			var name, crn *string
+			if tc.resourceName != "" {
+				name = ptr.To(tc.resourceName)
+			}
+			if tc.crn != "" {
+				crn = ptr.To(tc.crn)
+			}
+			g.Expect(m.matches(tc.names, name, crn)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestVPCMatcherPlan(t *testing.T) {
+	vpcRef := &vpcv1.VPCReference{ID: ptr.To("vpc-id")}
+	zone := &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}
+	resources := &vpcResources{
+		vpcs: []vpcv1.VPC{
+			{ID: ptr.To("vpc-id"), Name: ptr.To("capi-vpc"), DefaultSecurityGroup: &vpcv1.SecurityGroupReference{ID: ptr.To("default-sg-id")}},
+			{ID: ptr.To("other-vpc-id"), Name: ptr.To("capi-vpc-other")},
+		},
+		instances: []vpcv1.Instance{
+			{ID: ptr.To("instance-id"), Name: ptr.To("capi-control-plane"), CRN: ptr.To("crn:instance"), VPC: vpcRef},
+			{ID: ptr.To("untagged-instance-id"), Name: ptr.To("capi-untagged"), CRN: ptr.To("crn:untagged"), VPC: vpcRef},
+		},
+		subnets: []vpcv1.Subnet{
+			{ID: ptr.To("subnet-id"), Name: ptr.To("capi-subnet-us-south-1"), Zone: zone, VPC: vpcRef},
+			{ID: ptr.To("user-subnet-id"), Name: ptr.To("user-subnet"), Zone: zone, VPC: vpcRef},
+		},
+		loadBalancers: []vpcv1.LoadBalancer{
+			{ID: ptr.To("lb-id"), Name: ptr.To("capi-lb-public"), Subnets: []vpcv1.SubnetReference{{ID: ptr.To("subnet-id")}}},
+			{ID: ptr.To("user-lb-id"), Name: ptr.To("user-lb"), Subnets: []vpcv1.SubnetReference{{ID: ptr.To("subnet-id")}}},
+		},
+		securityGroups: []vpcv1.SecurityGroup{
+			{ID: ptr.To("default-sg-id"), Name: ptr.To("default"), CRN: ptr.To("crn:default-sg"), VPC: vpcRef},
+			{ID: ptr.To("sg-id"), Name: ptr.To("capi-sg"), CRN: ptr.To("crn:sg"), VPC: vpcRef},
+			{ID: ptr.To("user-sg-id"), Name: ptr.To("user-sg"), CRN: ptr.To("crn:user-sg"), VPC: vpcRef},
+		},
+		publicGateways: []vpcv1.PublicGateway{
+			{ID: ptr.To("pgw-id"), Name: ptr.To("capi-pgateway-us-south-1"), Zone: zone, VPC: vpcRef},
+		},
+		images: []vpcv1.Image{
+			{ID: ptr.To("image-id"), Name: ptr.To("capi-image"), CRN: ptr.To("crn:image")},
+			{ID: ptr.To("user-image-id"), Name: ptr.To("user-image"), CRN: ptr.To("crn:user-image")},
+		},
+	}
+
+	type entry struct{ kind, id, location string }
+	testCases := []struct {
+		name     string
+		matcher  *vpcMatcher
+		expected []entry
+	}{
+		{
+			name: "VPC cluster resources are planned in deletion order without the other resources of the VPC",
+			matcher: newVPCClusterMatcher("capi", []string{"us-south-1"}, map[string]bool{
+				"crn:instance": true, "crn:sg": true, "crn:default-sg": true, "crn:image": true,
+			}),
+			expected: []entry{
+				{"Instance", "instance-id", "us-south"},
+				{"LoadBalancer", "lb-id", "us-south"},
+				{"SecurityGroup", "sg-id", "us-south"},
+				{"Subnet", "subnet-id", "us-south-1"},
+				{"PublicGateway", "pgw-id", "us-south-1"},
+				{"VPC", "vpc-id", "us-south"},
+				{"Image", "image-id", "us-south"},
+			},
+		},
+		{
+			name:    "Nothing is planned for a cluster without resources",
+			matcher: newVPCClusterMatcher("other", []string{"us-south-1"}, nil),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			p := &plan{}
+			tc.matcher.plan(nil, "us-south", resources, p)
+			var planned []entry
+			for _, o := range p.Resources {
+				planned = append(planned, entry{o.Kind, o.ID, o.Location})
+			}
+			g.Expect(planned).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestPlanAdd(t *testing.T) {
+	g := NewWithT(t)
+	p := &plan{}
+	p.add(&orphan{Kind: "Subnet", ID: "subnet-id"})
+	p.add(&orphan{Kind: "VPC", ID: "vpc-id"})
+	p.add(&orphan{Kind: "Subnet", ID: "subnet-id"})
+	p.add(&orphan{Kind: "VPC", ID: "subnet-id"})
+
+	g.Expect(p.Resources).To(HaveLen(3))
+	g.Expect(p.ToTable().Rows[2].Cells).To(Equal([]interface{}{3, "VPC", "", "subnet-id", ""}))
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/kubernetes"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/platformservices"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/transitgateway"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cliutils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/pointer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+)
+
+// DescribeCommand function to describe the cloud resources of a PowerVS cluster.
+func DescribeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe <name>",
+		Short: "Describe the cloud resources of a PowerVS cluster",
+		Long: `Describe the cloud resources referenced by an IBMPowerVSCluster, with their live state, whether they were
+created by the controller and the drift between the cluster and the cloud.`,
+		Example: `
+# Describe the cloud resources of a PowerVS cluster
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm powervs cluster describe <name> --namespace <namespace> --kubeconfig <kubeconfig>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return describeCluster(cmd.Context(), args[0])
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+// The clients of the IBM Cloud services, which are replaced in the tests.
+var (
+	newResourceControllerClient = platformservices.NewResourceControllerV2Client
+	newTransitGatewayClient     = transitgateway.NewClient
+	newVPCClient                = vpc.NewV1Client
+	newPowerVSSession           = newPISession
+)
+
+func describeCluster(ctx context.Context, name string) error {
+	log := logf.Log
+
+	c, namespace, err := kubernetes.NewClient()
+	if err != nil {
+		return err
+	}
+	powerVSCluster := &infrav1.IBMPowerVSCluster{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, powerVSCluster); err != nil {
+		return fmt.Errorf("failed to get IBMPowerVSCluster %s/%s: %w", namespace, name, err)
+	}
+	log.Info("Describing PowerVS cluster", "name", name, "namespace", namespace)
+
+	tree, err := describePowerVSCluster(ctx, powerVSCluster)
+	if err != nil {
+		return err
+	}
+	return cliutils.PrintTree(tree)
+}
+
+// describePowerVSCluster returns the tree of the cloud resources referenced by the cluster.
+func describePowerVSCluster(ctx context.Context, powerVSCluster *infrav1.IBMPowerVSCluster) (*cliutils.Resource, error) {
+	tree := &cliutils.Resource{
+		Kind:  "IBMPowerVSCluster",
+		Name:  fmt.Sprintf("%s/%s", powerVSCluster.Namespace, powerVSCluster.Name),
+		State: cliutils.ReadyState(powerVSCluster.Status.Ready),
+	}
+
+	rc, err := newResourceControllerClient()
+	if err != nil {
+		return nil, err
+	}
+	if workspace := describeWorkspace(ctx, rc, powerVSCluster); workspace != nil {
+		tree.Add(workspace)
+	}
+
+	if status := powerVSCluster.Status.VPC; status != nil && status.ID != nil {
+		vpcResource, err := describeVPC(ctx, powerVSCluster)
+		if err != nil {
+			return nil, err
+		}
+		tree.Add(vpcResource)
+	}
+
+	if status := powerVSCluster.Status.TransitGateway; status != nil && status.ID != nil {
+		tg, err := newTransitGatewayClient()
+		if err != nil {
+			return nil, err
+		}
+		tree.Add(describeTransitGateway(ctx, tg, status))
+	}
+
+	if status := powerVSCluster.Status.COSInstance; status != nil && status.ID != nil {
+		cos, _ := describeServiceInstance(ctx, rc, "COSInstance", *status.ID, ptr.Deref(status.ControllerCreated, false))
+		if spec := powerVSCluster.Spec.CosInstance; spec != nil && spec.Name != "" && cos.Name != "" && spec.Name != cos.Name {
+			cos.Warnf("name %s differs from %s in the cluster spec", cos.Name, spec.Name)
+		}
+		tree.Add(cos)
+	}
+	return tree, nil
+}
+
+// describeWorkspace returns the PowerVS workspace of the cluster, with its network and DHCP server.
+func describeWorkspace(ctx context.Context, rc *resourcecontrollerv2.ResourceControllerV2, powerVSCluster *infrav1.IBMPowerVSCluster) *cliutils.Resource {
+	spec, status := powerVSCluster.Spec, powerVSCluster.Status
+
+	var specID string
+	if spec.ServiceInstance != nil && spec.ServiceInstance.ID != nil {
+		specID = *spec.ServiceInstance.ID
+	} else {
+		specID = spec.ServiceInstanceID
+	}
+	id, controllerCreated := specID, false
+	if status.ServiceInstance != nil && status.ServiceInstance.ID != nil {
+		id, controllerCreated = *status.ServiceInstance.ID, ptr.Deref(status.ServiceInstance.ControllerCreated, false)
+	}
+	if id == "" {
+		return nil
+	}
+
+	workspace, workspaceZone := describeServiceInstance(ctx, rc, "Workspace", id, controllerCreated)
+	if specID != "" && specID != id {
+		workspace.Warnf("workspace %s differs from %s in the cluster spec", id, specID)
+	}
+	if workspace.State == cliutils.StateNotFound || workspace.State == cliutils.StateUnknown {
+		return workspace
+	}
+
+	zone := ptr.Deref(spec.Zone, workspaceZone)
+	sess, err := newPowerVSSession(zone)
+	if err != nil {
+		workspace.Warnf("failed to create PowerVS session in zone %s: %v", zone, err)
+		return workspace
+	}
+
+	var networkID string
+	if status.Network != nil && status.Network.ID != nil {
+		networkID = *status.Network.ID
+		workspace.Add(describeNetwork(ctx, sess, id, networkID, ptr.Deref(status.Network.ControllerCreated, false)))
+	} else if spec.Network.ID != nil {
+		networkID = *spec.Network.ID
+		workspace.Add(describeNetwork(ctx, sess, id, networkID, false))
+	}
+
+	if status.DHCPServer != nil && status.DHCPServer.ID != nil {
+		workspace.Add(describeDHCPServer(ctx, sess, id, *status.DHCPServer.ID, networkID, ptr.Deref(status.DHCPServer.ControllerCreated, false)))
+	}
+	return workspace
+}
+
+func newPISession(zone string) (*ibmpisession.IBMPISession, error) {
+	accountID, err := accounts.GetAccount(iam.GetIAMAuth())
+	if err != nil {
+		return nil, err
+	}
+	return powervs.NewPISession(accountID, zone, options.GlobalOptions.Debug)
+}
+
+// describeServiceInstance returns the resource instance with the given ID, e.g. the PowerVS workspace or the COS instance,
+// and the zone it is located in.
+func describeServiceInstance(ctx context.Context, rc *resourcecontrollerv2.ResourceControllerV2, kind, id string, controllerCreated bool) (*cliutils.Resource, string) {
+	resource := &cliutils.Resource{Kind: kind, ID: id, ControllerCreated: controllerCreated}
+	instance, response, err := rc.GetResourceInstanceWithContext(ctx, rc.NewGetResourceInstanceOptions(id))
+	if err != nil {
+		resource.SetLookupError(response, err)
+		return resource, ""
+	}
+	resource.Name = pointer.Dereference(instance.Name).(string)
+	resource.State = pointer.Dereference(instance.State).(string)
+	switch infrav1.ServiceInstanceState(resource.State) {
+	case infrav1.ServiceInstanceStateActive:
+	case infrav1.ServiceInstanceStateRemoved:
+		resource.Warnf("%s is removed but still referenced by the cluster", kind)
+	default:
+		resource.Warnf("%s is %s", kind, resource.State)
+	}
+	return resource, pointer.Dereference(instance.RegionID).(string)
+}
+
+func describeNetwork(ctx context.Context, sess *ibmpisession.IBMPISession, workspaceID, id string, controllerCreated bool) *cliutils.Resource {
+	resource := &cliutils.Resource{Kind: "Network", ID: id, ControllerCreated: controllerCreated}
+	network, err := v.NewIBMPINetworkClient(ctx, sess, workspaceID).Get(id)
+	if err != nil {
+		resource.SetLookupError(nil, err)
+		return resource
+	}
+	resource.Name = pointer.Dereference(network.Name).(string)
+	resource.State = pointer.Dereference(network.Type).(string)
+	return resource
+}
+
+func describeDHCPServer(ctx context.Context, sess *ibmpisession.IBMPISession, workspaceID, id, networkID string, controllerCreated bool) *cliutils.Resource {
+	resource := &cliutils.Resource{Kind: "DHCPServer", ID: id, ControllerCreated: controllerCreated}
+	dhcpServer, err := v.NewIBMPIDhcpClient(ctx, sess, workspaceID).Get(id)
+	if err != nil {
+		resource.SetLookupError(nil, err)
+		return resource
+	}
+	resource.State = pointer.Dereference(dhcpServer.Status).(string)
+	if infrav1.DHCPServerState(resource.State) != infrav1.DHCPServerStateActive {
+		resource.Warnf("DHCP server is %s", resource.State)
+	}
+	if dhcpServer.Network != nil {
+		resource.Name = pointer.Dereference(dhcpServer.Network.Name).(string)
+		if dhcpNetworkID := pointer.Dereference(dhcpServer.Network.ID).(string); networkID != "" && dhcpNetworkID != networkID {
+			resource.Warnf("DHCP server network %s differs from the cluster network %s", dhcpNetworkID, networkID)
+		}
+	}
+	return resource
+}
+
+// describeVPC returns the VPC of the cluster, with its subnets, security groups and load balancers.
+func describeVPC(ctx context.Context, powerVSCluster *infrav1.IBMPowerVSCluster) (*cliutils.Resource, error) {
+	spec, status := powerVSCluster.Spec, powerVSCluster.Status
+	if spec.VPC == nil || spec.VPC.Region == nil {
+		resource := &cliutils.Resource{Kind: "VPC", ID: *status.VPC.ID, State: cliutils.StateUnknown}
+		resource.Warnf("VPC region is not set in the cluster spec")
+		return resource, nil
+	}
+
+	v1, err := newVPCClient(*spec.VPC.Region)
+	if err != nil {
+		return nil, err
+	}
+	vpcID := *status.VPC.ID
+	resource := cliutils.DescribeVPC(ctx, v1, vpcID, ptr.Deref(status.VPC.ControllerCreated, false))
+	if spec.VPC.ID != nil && *spec.VPC.ID != vpcID {
+		resource.Warnf("VPC %s differs from %s in the cluster spec", vpcID, *spec.VPC.ID)
+	}
+
+	for _, name := range cliutils.SortedKeys(status.VPCSubnet) {
+		subnet := status.VPCSubnet[name]
+		if subnet.ID != nil {
+			resource.Add(cliutils.DescribeSubnet(ctx, v1, *subnet.ID, vpcID, ptr.Deref(subnet.ControllerCreated, false)))
+		}
+	}
+	for _, name := range cliutils.SortedKeys(status.VPCSecurityGroups) {
+		securityGroup := status.VPCSecurityGroups[name]
+		if securityGroup.ID != nil {
+			resource.Add(cliutils.DescribeSecurityGroup(ctx, v1, *securityGroup.ID, securityGroup.RuleIDs, ptr.Deref(securityGroup.ControllerCreated, false)))
+		}
+	}
+	for _, name := range cliutils.SortedKeys(status.LoadBalancers) {
+		loadBalancer := status.LoadBalancers[name]
+		if loadBalancer.ID != nil {
+			resource.Add(cliutils.DescribeLoadBalancer(ctx, v1, *loadBalancer.ID, loadBalancer.Hostname, ptr.Deref(loadBalancer.ControllerCreated, false)))
+		}
+	}
+	return resource, nil
+}
+
+// describeTransitGateway returns the transit gateway of the cluster, with its PowerVS and VPC connections.
+func describeTransitGateway(ctx context.Context, tg *tgapiv1.TransitGatewayApisV1, status *infrav1.TransitGatewayStatus) *cliutils.Resource {
+	resource := &cliutils.Resource{Kind: "TransitGateway", ID: *status.ID, ControllerCreated: ptr.Deref(status.ControllerCreated, false)}
+	transitGateway, response, err := tg.GetTransitGatewayWithContext(ctx, tg.NewGetTransitGatewayOptions(*status.ID))
+	if err != nil {
+		resource.SetLookupError(response, err)
+		return resource
+	}
+	resource.Name = pointer.Dereference(transitGateway.Name).(string)
+	resource.State = pointer.Dereference(transitGateway.Status).(string)
+	if infrav1.TransitGatewayState(resource.State) != infrav1.TransitGatewayStateAvailable {
+		resource.Warnf("transit gateway is %s", resource.State)
+	}
+
+	for _, connection := range []struct {
+		kind   string
+		status *infrav1.ResourceReference
+	}{
+		{kind: "PowerVSConnection", status: status.PowerVSConnection},
+		{kind: "VPCConnection", status: status.VPCConnection},
+	} {
+		if connection.status == nil || connection.status.ID == nil {
+			resource.Warnf("%s is not recorded by the cluster", connection.kind)
+			continue
+		}
+		connectionResource := &cliutils.Resource{Kind: connection.kind, ID: *connection.status.ID, ControllerCreated: ptr.Deref(connection.status.ControllerCreated, false)}
+		tgConnection, response, err := tg.GetTransitGatewayConnectionWithContext(ctx, tg.NewGetTransitGatewayConnectionOptions(*status.ID, *connection.status.ID))
+		if err != nil {
+			connectionResource.SetLookupError(response, err)
+		} else {
+			connectionResource.Name = pointer.Dereference(tgConnection.Name).(string)
+			connectionResource.State = pointer.Dereference(tgConnection.Status).(string)
+			if infrav1.TransitGatewayConnectionState(connectionResource.State) != infrav1.TransitGatewayConnectionStateAttached {
+				connectionResource.Warnf("connection is %s", connectionResource.State)
+			}
+		}
+		resource.Add(connectionResource)
+	}
+	return resource
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM/go-sdk-core/v5/core"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+
+	. "github.com/onsi/gomega"
+)
+
+// newFakeCloud starts a server answering the requests of the describe command with the given resources by path.
+// The requests of any other path fail with a 404 status code.
+func newFakeCloud(t *testing.T, resources map[string]interface{}) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		resource, ok := resources[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"errors": []map[string]string{{"code": "not_found", "message": r.URL.Path + " not found"}},
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resource)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// useFakeCloud points the clients of the describe command to the server.
+func useFakeCloud(t *testing.T, server *httptest.Server) {
+	t.Helper()
+	authenticator := &core.NoAuthAuthenticator{}
+	rc, tg, v1, session := newResourceControllerClient, newTransitGatewayClient, newVPCClient, newPowerVSSession
+	t.Cleanup(func() {
+		newResourceControllerClient, newTransitGatewayClient, newVPCClient, newPowerVSSession = rc, tg, v1, session
+	})
+
+	newResourceControllerClient = func() (*resourcecontrollerv2.ResourceControllerV2, error) {
+		return resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           server.URL + "/rc",
+			Authenticator: authenticator,
+		})
+	}
+	newTransitGatewayClient = func() (*tgapiv1.TransitGatewayApisV1, error) {
+		return tgapiv1.NewTransitGatewayApisV1(&tgapiv1.TransitGatewayApisV1Options{
+			URL:           server.URL + "/tg",
+			Authenticator: authenticator,
+			Version:       ptr.To("2025-01-01"),
+		})
+	}
+	newVPCClient = func(string) (*vpcv1.VpcV1, error) {
+		return vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+			URL:           server.URL + "/vpc",
+			Authenticator: authenticator,
+		})
+	}
+	newPowerVSSession = func(zone string) (*ibmpisession.IBMPISession, error) {
+		return ibmpisession.NewIBMPISession(&ibmpisession.IBMPIOptions{
+			Authenticator: authenticator,
+			UserAccount:   "account-id",
+			Zone:          zone,
+			URL:           server.URL,
+		})
+	}
+}
+
+func TestDescribePowerVSCluster(t *testing.T) {
+	resources := map[string]interface{}{
+		"/rc/v2/resource_instances/workspace-id":                      map[string]string{"id": "workspace-id", "name": "capi-workspace", "state": "active", "region_id": "dal10"},
+		"/rc/v2/resource_instances/cos-id":                            map[string]string{"id": "cos-id", "name": "capi-cos", "state": "active"},
+		"/rc/v2/resource_instances/removed-id":                        map[string]string{"id": "removed-id", "name": "capi-workspace", "state": "removed", "region_id": "dal10"},
+		"/pcloud/v1/cloud-instances/workspace-id/networks/network-id": map[string]string{"networkID": "network-id", "name": "capi-network", "type": "vlan"},
+		"/pcloud/v1/cloud-instances/workspace-id/services/dhcp/dhcp-id": map[string]interface{}{
+			"id": "dhcp-id", "status": "ACTIVE", "network": map[string]string{"id": "other-network-id", "name": "capi-dhcp-network"},
+		},
+		"/vpc/vpcs/vpc-id":                                             map[string]string{"id": "vpc-id", "name": "capi-vpc", "status": "available"},
+		"/vpc/subnets/subnet-id":                                       map[string]interface{}{"id": "subnet-id", "name": "capi-subnet", "status": "available", "vpc": map[string]string{"id": "vpc-id"}},
+		"/vpc/security_groups/sg-id":                                   map[string]string{"id": "sg-id", "name": "capi-sg"},
+		"/vpc/load_balancers/lb-id":                                    map[string]string{"id": "lb-id", "name": "capi-lb", "provisioning_status": "active", "operating_status": "online", "hostname": "lb.example.com"},
+		"/tg/transit_gateways/tg-id":                                   map[string]string{"id": "tg-id", "name": "capi-tg", "status": "available"},
+		"/tg/transit_gateways/tg-id/connections/powervs-connection-id": map[string]string{"id": "powervs-connection-id", "name": "capi-powervs", "status": "attached"},
+		"/tg/transit_gateways/tg-id/connections/vpc-connection-id":     map[string]string{"id": "vpc-connection-id", "name": "capi-vpc", "status": "pending"},
+	}
+	resourceReference := func(id string, controllerCreated bool) *infrav1.ResourceReference {
+		return &infrav1.ResourceReference{ID: ptr.To(id), ControllerCreated: ptr.To(controllerCreated)}
+	}
+	newCluster := func(spec infrav1.IBMPowerVSClusterSpec, status infrav1.IBMPowerVSClusterStatus) *infrav1.IBMPowerVSCluster {
+		return &infrav1.IBMPowerVSCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "capi", Namespace: "default"},
+			Spec:       spec,
+			Status:     status,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		cluster  *infrav1.IBMPowerVSCluster
+		expected [][]interface{}
+	}{
+		{
+			name:    "Cluster without resources",
+			cluster: newCluster(infrav1.IBMPowerVSClusterSpec{}, infrav1.IBMPowerVSClusterStatus{}),
+			expected: [][]interface{}{
+				{"IBMPowerVSCluster", "default/capi", "", "NotReady", false, ""},
+			},
+		},
+		{
+			name: "Cluster with all its resources",
+			cluster: newCluster(infrav1.IBMPowerVSClusterSpec{
+				Zone:        ptr.To("dal10"),
+				VPC:         &infrav1.VPCResourceReference{Region: ptr.To("us-south")},
+				CosInstance: &infrav1.CosInstance{Name: "capi-cos"},
+			}, infrav1.IBMPowerVSClusterStatus{
+				Ready:             true,
+				ServiceInstance:   resourceReference("workspace-id", true),
+				Network:           resourceReference("network-id", true),
+				DHCPServer:        resourceReference("dhcp-id", true),
+				VPC:               resourceReference("vpc-id", true),
+				VPCSubnet:         map[string]infrav1.ResourceReference{"capi-subnet": *resourceReference("subnet-id", true)},
+				VPCSecurityGroups: map[string]infrav1.VPCSecurityGroupStatus{"capi-sg": {ID: ptr.To("sg-id"), ControllerCreated: ptr.To(true)}},
+				LoadBalancers:     map[string]infrav1.VPCLoadBalancerStatus{"capi-lb": {ID: ptr.To("lb-id"), Hostname: ptr.To("lb.example.com"), ControllerCreated: ptr.To(true)}},
+				TransitGateway: &infrav1.TransitGatewayStatus{
+					ID:                ptr.To("tg-id"),
+					ControllerCreated: ptr.To(true),
+					PowerVSConnection: resourceReference("powervs-connection-id", true),
+					VPCConnection:     resourceReference("vpc-connection-id", true),
+				},
+				COSInstance: resourceReference("cos-id", true),
+			}),
+			expected: [][]interface{}{
+				{"IBMPowerVSCluster", "default/capi", "", "Ready", false, ""},
+				{"├─ Workspace", "capi-workspace", "workspace-id", "active", true, ""},
+				{"│  ├─ Network", "capi-network", "network-id", "vlan", true, ""},
+				{"│  └─ DHCPServer", "capi-dhcp-network", "dhcp-id", "ACTIVE", true, "DHCP server network other-network-id differs from the cluster network network-id"},
+				{"├─ VPC", "capi-vpc", "vpc-id", "available", true, ""},
+				{"│  ├─ Subnet", "capi-subnet", "subnet-id", "available", true, ""},
+				{"│  ├─ SecurityGroup", "capi-sg", "sg-id", "available", true, ""},
+				{"│  └─ LoadBalancer", "capi-lb", "lb-id", "active", true, ""},
+				{"├─ TransitGateway", "capi-tg", "tg-id", "available", true, ""},
+				{"│  ├─ PowerVSConnection", "capi-powervs", "powervs-connection-id", "attached", true, ""},
+				{"│  └─ VPCConnection", "capi-vpc", "vpc-connection-id", "pending", true, "connection is pending"},
+				{"└─ COSInstance", "capi-cos", "cos-id", "active", true, ""},
+			},
+		},
+		{
+			name: "Cluster referencing resources which do not exist anymore",
+			cluster: newCluster(infrav1.IBMPowerVSClusterSpec{
+				ServiceInstanceID: "workspace-id",
+			}, infrav1.IBMPowerVSClusterStatus{
+				ServiceInstance: resourceReference("removed-id", true),
+				VPC:             resourceReference("vpc-id", true),
+				TransitGateway:  &infrav1.TransitGatewayStatus{ID: ptr.To("missing-tg-id")},
+			}),
+			expected: [][]interface{}{
+				{"IBMPowerVSCluster", "default/capi", "", "NotReady", false, ""},
+				{"├─ Workspace", "capi-workspace", "removed-id", "removed", true, "Workspace is removed but still referenced by the cluster; workspace removed-id differs from workspace-id in the cluster spec"},
+				{"├─ VPC", "", "vpc-id", "Unknown", false, "VPC region is not set in the cluster spec"},
+				{"└─ TransitGateway", "", "missing-tg-id", "NotFound", false, "TransitGateway missing-tg-id is referenced by the cluster but does not exist"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			useFakeCloud(t, newFakeCloud(t, resources))
+
+			tree, err := describePowerVSCluster(context.Background(), tc.cluster)
+			g.Expect(err).ToNot(HaveOccurred())
+			var rows [][]interface{}
+			for _, row := range tree.ToTable().Rows {
+				rows = append(rows, row.Cells)
+			}
+			g.Expect(rows).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestDescribeWorkspaceWithoutPowerVSSession(t *testing.T) {
+	g := NewWithT(t)
+	useFakeCloud(t, newFakeCloud(t, map[string]interface{}{
+		"/rc/v2/resource_instances/workspace-id": map[string]string{"id": "workspace-id", "name": "capi-workspace", "state": "active", "region_id": "dal10"},
+	}))
+	newPowerVSSession = func(string) (*ibmpisession.IBMPISession, error) {
+		return nil, errors.New("invalid account")
+	}
+	rc, err := newResourceControllerClient()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	workspace := describeWorkspace(context.Background(), rc, &infrav1.IBMPowerVSCluster{
+		Spec: infrav1.IBMPowerVSClusterSpec{ServiceInstanceID: "workspace-id"},
+	})
+	g.Expect(workspace.State).To(Equal("active"))
+	g.Expect(workspace.Warnings).To(Equal([]string{"failed to create PowerVS session in zone dal10: invalid account"}))
+	g.Expect(workspace.Resources).To(BeEmpty())
+
+	g.Expect(describeWorkspace(context.Background(), rc, &infrav1.IBMPowerVSCluster{})).To(BeNil())
+}
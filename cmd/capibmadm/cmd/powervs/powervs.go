@@ -22,10 +22,12 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs/cluster"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs/image"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs/key"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs/network"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs/port"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs/snapshot"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
 )
 
@@ -34,13 +36,13 @@ func Commands() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "powervs",
 		Short: "Commands for operations on PowerVS resources",
-		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
 			apiKey := os.Getenv(options.IBMCloudAPIKeyEnvName)
 			if apiKey == "" {
 				return fmt.Errorf("ibmcloud api key is not provided, set %s environmental variable", options.IBMCloudAPIKeyEnvName)
 			}
 			options.GlobalOptions.IBMCloudAPIKey = apiKey
-			return nil
+			return options.ValidateRequiredFlags(cmd, "service-instance-id", "zone")
 		},
 	}
 
@@ -48,13 +50,12 @@ func Commands() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&options.GlobalOptions.PowerVSZone, "zone", options.GlobalOptions.PowerVSZone, "PowerVS service instance location (Required)")
 	cmd.PersistentFlags().BoolVar(&options.GlobalOptions.Debug, "debug", false, "Enable/Disable http transport debugging log")
 
-	_ = cmd.MarkPersistentFlagRequired("service-instance-id")
-	_ = cmd.MarkPersistentFlagRequired("zone")
-
 	cmd.AddCommand(key.Commands())
 	cmd.AddCommand(network.Commands())
 	cmd.AddCommand(port.Commands())
 	cmd.AddCommand(image.Commands())
+	cmd.AddCommand(cluster.Commands())
+	cmd.AddCommand(snapshot.Commands())
 
 	return cmd
 }
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	powerClient "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	"k8s.io/utils/ptr"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+)
+
+type snapshotCreateOptions struct {
+	instanceID  string
+	name        string
+	description string
+	volumeIDs   []string
+}
+
+// CreateCommand powervs snapshot create command.
+func CreateCommand() *cobra.Command {
+	var snapshotCreateOption snapshotCreateOptions
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a snapshot of a PowerVS instance",
+		Example: `
+# Snapshot all the volumes attached to a PowerVS instance
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm powervs snapshot create --instance-id <instance-id> --name <snapshot-name> --service-instance-id <service-instance-id> --zone <zone>
+
+# Snapshot a subset of the volumes attached to a PowerVS instance
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm powervs snapshot create --instance-id <instance-id> --name <snapshot-name> --volume-ids <volume-id-1>,<volume-id-2> --service-instance-id <service-instance-id> --zone <zone>`,
+	}
+
+	cmd.Flags().StringVar(&snapshotCreateOption.instanceID, "instance-id", "", "ID of the PowerVS instance to snapshot.")
+	cmd.Flags().StringVar(&snapshotCreateOption.name, "name", "", "Name of the snapshot.")
+	cmd.Flags().StringVar(&snapshotCreateOption.description, "description", "", "Description of the snapshot.")
+	cmd.Flags().StringSliceVar(&snapshotCreateOption.volumeIDs, "volume-ids", nil, "IDs of the attached volumes to snapshot, defaults to all the volumes attached to the instance.")
+	_ = cmd.MarkFlagRequired("instance-id")
+	_ = cmd.MarkFlagRequired("name")
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return createSnapshot(cmd.Context(), snapshotCreateOption)
+	}
+	return cmd
+}
+
+func createSnapshot(ctx context.Context, snapshotCreateOption snapshotCreateOptions) error {
+	log := logf.Log
+	log.Info("Creating PowerVS snapshot", "instance-id", snapshotCreateOption.instanceID, "name", snapshotCreateOption.name, "service-instance-id", options.GlobalOptions.ServiceInstanceID)
+
+	accountID, err := accounts.GetAccount(iam.GetIAMAuth())
+	if err != nil {
+		return err
+	}
+
+	sess, err := powervs.NewPISession(accountID, options.GlobalOptions.PowerVSZone, options.GlobalOptions.Debug)
+	if err != nil {
+		return err
+	}
+
+	instanceClient := powerClient.NewIBMPIInstanceClient(ctx, sess, options.GlobalOptions.ServiceInstanceID)
+	snapshot, err := instanceClient.CreatePvmSnapShot(snapshotCreateOption.instanceID, &models.SnapshotCreate{
+		Name:        ptr.To(snapshotCreateOption.name),
+		Description: snapshotCreateOption.description,
+		VolumeIDs:   snapshotCreateOption.volumeIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("Successfully requested the PowerVS snapshot, check its progress with 'capibmadm powervs snapshot list'", "snapshot-id", ptr.Deref(snapshot.SnapshotID, ""))
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	powerClient "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/pointer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+)
+
+// ListCommand powervs snapshot list command.
+func ListCommand() *cobra.Command {
+	var instanceID string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List PowerVS snapshots",
+		Example: `
+# List PowerVS snapshots
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm powervs snapshot list --service-instance-id <service-instance-id> --zone <zone>
+
+# List the snapshots of a PowerVS instance
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm powervs snapshot list --instance-id <instance-id> --service-instance-id <service-instance-id> --zone <zone>`,
+	}
+
+	cmd.Flags().StringVar(&instanceID, "instance-id", "", "List only the snapshots of this PowerVS instance.")
+	options.AddCommonFlags(cmd)
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return listSnapshots(cmd.Context(), instanceID)
+	}
+	return cmd
+}
+
+func listSnapshots(ctx context.Context, instanceID string) error {
+	log := logf.Log
+	log.Info("Listing PowerVS snapshots", "service-instance-id", options.GlobalOptions.ServiceInstanceID)
+
+	accountID, err := accounts.GetAccount(iam.GetIAMAuth())
+	if err != nil {
+		return err
+	}
+
+	sess, err := powervs.NewPISession(accountID, options.GlobalOptions.PowerVSZone, options.GlobalOptions.Debug)
+	if err != nil {
+		return err
+	}
+
+	var snapshots *models.Snapshots
+	if instanceID != "" {
+		instanceClient := powerClient.NewIBMPIInstanceClient(ctx, sess, options.GlobalOptions.ServiceInstanceID)
+		snapshots, err = instanceClient.GetSnapShotVM(instanceID)
+	} else {
+		snapshotClient := powerClient.NewIBMPISnapshotClient(ctx, sess, options.GlobalOptions.ServiceInstanceID)
+		snapshots, err = snapshotClient.GetAll()
+	}
+	if err != nil {
+		return err
+	}
+	if len(snapshots.Snapshots) == 0 {
+		fmt.Println("No snapshots found")
+		return nil
+	}
+
+	snapshotList := List{
+		Items: []Snapshot{},
+	}
+
+	for _, snapshot := range snapshots.Snapshots {
+		snapshotList.Items = append(snapshotList.Items, Snapshot{
+			SnapshotID:      pointer.Dereference(snapshot.SnapshotID).(string),
+			Name:            pointer.Dereference(snapshot.Name).(string),
+			InstanceID:      pointer.Dereference(snapshot.PvmInstanceID).(string),
+			Status:          snapshot.Status,
+			PercentComplete: snapshot.PercentComplete,
+			Description:     snapshot.Description,
+			VolumeSnapshots: snapshot.VolumeSnapshots,
+			CreationDate:    snapshot.CreationDate,
+		})
+	}
+
+	printerObj, err := printer.New(options.GlobalOptions.Output, os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	switch options.GlobalOptions.Output {
+	case printer.PrinterTypeJSON:
+		err = printerObj.Print(snapshotList)
+	default:
+		table := snapshotList.ToTable()
+		err = printerObj.Print(table)
+	}
+
+	return err
+}
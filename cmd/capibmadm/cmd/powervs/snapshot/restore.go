@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	powerClient "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+)
+
+type snapshotRestoreOptions struct {
+	instanceID string
+	snapshotID string
+	force      bool
+}
+
+// RestoreCommand powervs snapshot restore command.
+func RestoreCommand() *cobra.Command {
+	var snapshotRestoreOption snapshotRestoreOptions
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a PowerVS instance from a snapshot",
+		Example: `
+# Restore a stopped PowerVS instance from one of its snapshots
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm powervs snapshot restore --instance-id <instance-id> --snapshot-id <snapshot-id> --service-instance-id <service-instance-id> --zone <zone>
+
+# Restore a running PowerVS instance from one of its snapshots
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm powervs snapshot restore --instance-id <instance-id> --snapshot-id <snapshot-id> --force --service-instance-id <service-instance-id> --zone <zone>`,
+	}
+
+	cmd.Flags().StringVar(&snapshotRestoreOption.instanceID, "instance-id", "", "ID of the PowerVS instance to restore.")
+	cmd.Flags().StringVar(&snapshotRestoreOption.snapshotID, "snapshot-id", "", "ID of the snapshot to restore the instance from.")
+	cmd.Flags().BoolVar(&snapshotRestoreOption.force, "force", false, "Restore the instance even if it is not in the SHUTOFF state.")
+	_ = cmd.MarkFlagRequired("instance-id")
+	_ = cmd.MarkFlagRequired("snapshot-id")
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return restoreSnapshot(cmd.Context(), snapshotRestoreOption)
+	}
+	return cmd
+}
+
+func restoreSnapshot(ctx context.Context, snapshotRestoreOption snapshotRestoreOptions) error {
+	log := logf.Log
+	log.Info("Restoring PowerVS instance from snapshot", "instance-id", snapshotRestoreOption.instanceID, "snapshot-id", snapshotRestoreOption.snapshotID, "service-instance-id", options.GlobalOptions.ServiceInstanceID)
+
+	accountID, err := accounts.GetAccount(iam.GetIAMAuth())
+	if err != nil {
+		return err
+	}
+
+	sess, err := powervs.NewPISession(accountID, options.GlobalOptions.PowerVSZone, options.GlobalOptions.Debug)
+	if err != nil {
+		return err
+	}
+
+	instanceClient := powerClient.NewIBMPIInstanceClient(ctx, sess, options.GlobalOptions.ServiceInstanceID)
+	if _, err := instanceClient.RestoreSnapShotVM(snapshotRestoreOption.instanceID, snapshotRestoreOption.snapshotID, "", &models.SnapshotRestore{
+		Force: &snapshotRestoreOption.force,
+	}); err != nil {
+		return err
+	}
+
+	log.Info("Successfully requested the restore of the PowerVS instance", "instance-id", snapshotRestoreOption.instanceID, "snapshot-id", snapshotRestoreOption.snapshotID)
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"github.com/go-openapi/strfmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Snapshot defines a PowerVS instance snapshot.
+type Snapshot struct {
+	SnapshotID      string            `json:"id"`
+	Name            string            `json:"name"`
+	InstanceID      string            `json:"instanceID"`
+	Status          string            `json:"status"`
+	PercentComplete int64             `json:"percentComplete"`
+	Description     string            `json:"description,omitempty"`
+	VolumeSnapshots map[string]string `json:"volumeSnapshots,omitempty"`
+	CreationDate    strfmt.DateTime   `json:"creationDate"`
+}
+
+// List defines a list of Snapshots.
+type List struct {
+	Items []Snapshot `json:"items"`
+}
+
+// ToTable converts List to *metav1.Table.
+func (snapshotList *List) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "ID",
+				Type: "string",
+			},
+			{
+				Name: "NAME",
+				Type: "string",
+			},
+			{
+				Name: "INSTANCE ID",
+				Type: "string",
+			},
+			{
+				Name: "STATUS",
+				Type: "string",
+			},
+			{
+				Name: "PROGRESS",
+				Type: "integer",
+			},
+			{
+				Name: "VOLUMES",
+				Type: "integer",
+			},
+			{
+				Name: "CREATION DATE",
+				Type: "string",
+			},
+		},
+	}
+
+	for _, snapshot := range snapshotList.Items {
+		row := metav1.TableRow{
+			Cells: []interface{}{snapshot.SnapshotID, snapshot.Name, snapshot.InstanceID, snapshot.Status, snapshot.PercentComplete, len(snapshot.VolumeSnapshots), snapshot.CreationDate},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
@@ -28,6 +28,7 @@ import (
 
 	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
 
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/cleanup"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/version"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc"
@@ -48,6 +49,7 @@ func rootCommand() *cobra.Command {
 	cmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
 	cmd.AddCommand(powervs.Commands())
 	cmd.AddCommand(vpc.Commands())
+	cmd.AddCommand(cleanup.Commands())
 	cmd.AddCommand(version.Commands(os.Stdout))
 
 	return cmd
@@ -0,0 +1,192 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/kubernetes"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cliutils"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/pointer"
+)
+
+// DescribeCommand function to describe the cloud resources of a VPC cluster.
+func DescribeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe <name>",
+		Short: "Describe the cloud resources of a VPC cluster",
+		Long: `Describe the cloud resources referenced by an IBMVPCCluster, with their live state, whether they were
+created by the controller and the drift between the cluster and the cloud.`,
+		Example: `
+# Describe the cloud resources of a VPC cluster
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm vpc cluster describe <name> --namespace <namespace> --kubeconfig <kubeconfig>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return describeCluster(cmd.Context(), args[0])
+		},
+	}
+
+	options.AddCommonFlags(cmd)
+	return cmd
+}
+
+func describeCluster(ctx context.Context, name string) error {
+	log := logf.Log
+
+	c, namespace, err := kubernetes.NewClient()
+	if err != nil {
+		return err
+	}
+	vpcCluster := &infrav1.IBMVPCCluster{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, vpcCluster); err != nil {
+		return fmt.Errorf("failed to get IBMVPCCluster %s/%s: %w", namespace, name, err)
+	}
+	log.Info("Describing VPC cluster", "name", name, "namespace", namespace, "region", vpcCluster.Spec.Region)
+
+	v1, err := vpc.NewV1Client(vpcCluster.Spec.Region)
+	if err != nil {
+		return err
+	}
+
+	tree := &cliutils.Resource{
+		Kind:  "IBMVPCCluster",
+		Name:  fmt.Sprintf("%s/%s", namespace, name),
+		State: cliutils.ReadyState(vpcCluster.Status.Ready),
+	}
+	// Determine whether the Cluster is designed for extended Infrastructure support.
+	if vpcCluster.Status.Network != nil {
+		tree.Add(describeNetwork(ctx, v1, vpcCluster)...)
+	} else {
+		tree.Add(describeLegacyNetwork(ctx, v1, vpcCluster)...)
+	}
+
+	if image := vpcCluster.Status.Image; image != nil && image.ID != "" {
+		tree.Add(describeImage(ctx, v1, image))
+	}
+
+	return cliutils.PrintTree(tree)
+}
+
+// describeNetwork returns the VPC network resources of a cluster with extended Infrastructure support.
+func describeNetwork(ctx context.Context, v1 *vpcv1.VpcV1, vpcCluster *infrav1.IBMVPCCluster) []*cliutils.Resource {
+	network := vpcCluster.Status.Network
+	if network.VPC == nil || network.VPC.ID == "" {
+		return nil
+	}
+
+	vpcID := network.VPC.ID
+	resource := cliutils.DescribeVPC(ctx, v1, vpcID, ptr.Deref(network.VPC.ControllerCreated, false))
+	if spec := vpcCluster.Spec.Network; spec != nil && spec.VPC != nil && spec.VPC.ID != nil && *spec.VPC.ID != vpcID {
+		resource.Warnf("VPC %s differs from %s in the cluster spec", vpcID, *spec.VPC.ID)
+	}
+
+	for _, subnets := range []map[string]*infrav1.ResourceStatus{network.ControlPlaneSubnets, network.WorkerSubnets} {
+		for _, name := range cliutils.SortedKeys(subnets) {
+			if subnet := subnets[name]; subnet != nil {
+				resource.Add(cliutils.DescribeSubnet(ctx, v1, subnet.ID, vpcID, ptr.Deref(subnet.ControllerCreated, false)))
+			}
+		}
+	}
+	for _, name := range cliutils.SortedKeys(network.PublicGateways) {
+		if publicGateway := network.PublicGateways[name]; publicGateway != nil {
+			resource.Add(describePublicGateway(ctx, v1, publicGateway.ID, vpcID, ptr.Deref(publicGateway.ControllerCreated, false)))
+		}
+	}
+	for _, name := range cliutils.SortedKeys(network.SecurityGroups) {
+		if securityGroup := network.SecurityGroups[name]; securityGroup != nil {
+			resource.Add(cliutils.DescribeSecurityGroup(ctx, v1, securityGroup.ID, nil, ptr.Deref(securityGroup.ControllerCreated, false)))
+		}
+	}
+	for _, name := range cliutils.SortedKeys(network.LoadBalancers) {
+		if loadBalancer := network.LoadBalancers[name]; loadBalancer != nil && loadBalancer.ID != nil {
+			resource.Add(cliutils.DescribeLoadBalancer(ctx, v1, *loadBalancer.ID, loadBalancer.Hostname, ptr.Deref(loadBalancer.ControllerCreated, false)))
+		}
+	}
+	return []*cliutils.Resource{resource}
+}
+
+// describeLegacyNetwork returns the VPC, subnet and control plane load balancer of a cluster without extended Infrastructure support.
+func describeLegacyNetwork(ctx context.Context, v1 *vpcv1.VpcV1, vpcCluster *infrav1.IBMVPCCluster) []*cliutils.Resource {
+	status := vpcCluster.Status
+	if status.VPC.ID == "" {
+		return nil
+	}
+
+	resource := cliutils.DescribeVPC(ctx, v1, status.VPC.ID, false)
+	if vpcCluster.Spec.VPC != "" && resource.Name != "" && resource.Name != vpcCluster.Spec.VPC {
+		resource.Warnf("VPC name %s differs from %s in the cluster spec", resource.Name, vpcCluster.Spec.VPC)
+	}
+	if status.Subnet.ID != nil {
+		resource.Add(cliutils.DescribeSubnet(ctx, v1, *status.Subnet.ID, status.VPC.ID, false))
+	}
+	if status.VPCEndpoint.LBID != nil {
+		loadBalancer := cliutils.DescribeLoadBalancer(ctx, v1, *status.VPCEndpoint.LBID, status.VPCEndpoint.Address, false)
+		if loadBalancer.State != "" && status.ControlPlaneLoadBalancerState != "" && loadBalancer.State != string(status.ControlPlaneLoadBalancerState) {
+			loadBalancer.Warnf("state %s differs from %s recorded by the cluster", loadBalancer.State, status.ControlPlaneLoadBalancerState)
+		}
+		resource.Add(loadBalancer)
+	}
+	return []*cliutils.Resource{resource}
+}
+
+func describePublicGateway(ctx context.Context, v1 *vpcv1.VpcV1, id, vpcID string, controllerCreated bool) *cliutils.Resource {
+	resource := &cliutils.Resource{Kind: "PublicGateway", ID: id, ControllerCreated: controllerCreated}
+	publicGateway, response, err := v1.GetPublicGatewayWithContext(ctx, &vpcv1.GetPublicGatewayOptions{ID: &id})
+	if err != nil {
+		resource.SetLookupError(response, err)
+		return resource
+	}
+	resource.Name = pointer.Dereference(publicGateway.Name).(string)
+	resource.State = pointer.Dereference(publicGateway.Status).(string)
+	if resource.State != vpcv1.PublicGatewayStatusAvailableConst {
+		resource.Warnf("public gateway is %s", resource.State)
+	}
+	if publicGateway.VPC != nil && pointer.Dereference(publicGateway.VPC.ID).(string) != vpcID {
+		resource.Warnf("public gateway belongs to VPC %s instead of %s", *publicGateway.VPC.ID, vpcID)
+	}
+	return resource
+}
+
+func describeImage(ctx context.Context, v1 *vpcv1.VpcV1, status *infrav1.ResourceStatus) *cliutils.Resource {
+	resource := &cliutils.Resource{Kind: "Image", ID: status.ID, ControllerCreated: ptr.Deref(status.ControllerCreated, false)}
+	image, response, err := v1.GetImageWithContext(ctx, &vpcv1.GetImageOptions{ID: &status.ID})
+	if err != nil {
+		resource.SetLookupError(response, err)
+		return resource
+	}
+	resource.Name = pointer.Dereference(image.Name).(string)
+	resource.State = pointer.Dereference(image.Status).(string)
+	if resource.State != vpcv1.ImageStatusAvailableConst {
+		resource.Warnf("image is %s", resource.State)
+	}
+	return resource
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc/cluster"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc/image"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/cmd/vpc/key"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
@@ -33,23 +34,22 @@ func Commands() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "vpc",
 		Short: "Commands for operations on VPC resources",
-		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
 			apiKey := os.Getenv(options.IBMCloudAPIKeyEnvName)
 			if apiKey == "" {
 				return fmt.Errorf("ibmcloud api key is not provided, set %s environmental variable", options.IBMCloudAPIKeyEnvName)
 			}
 			options.GlobalOptions.IBMCloudAPIKey = apiKey
-			return nil
+			return options.ValidateRequiredFlags(cmd, "region")
 		},
 	}
 
 	cmd.PersistentFlags().StringVar(&options.GlobalOptions.VPCRegion, "region", options.GlobalOptions.VPCRegion, "IBM cloud vpc region. (Required)")
 	cmd.PersistentFlags().StringVar(&options.GlobalOptions.ResourceGroupName, "resource-group-name", options.GlobalOptions.ResourceGroupName, "IBM cloud resource group name")
 
-	_ = cmd.MarkPersistentFlagRequired("region")
-
 	cmd.AddCommand(key.Commands())
 	cmd.AddCommand(image.Commands())
+	cmd.AddCommand(cluster.Commands())
 
 	return cmd
 }
@@ -18,6 +18,9 @@ limitations under the License.
 package options
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
@@ -26,6 +29,10 @@ import (
 // IBMCloudAPIKeyEnvName holds the environmental variable name to set PowerVS service instance ID.
 const IBMCloudAPIKeyEnvName = "IBMCLOUD_API_KEY" //nolint:gosec
 
+// WorkspaceFromClusterAnnotation is the annotation of the commands reading the PowerVS workspace and the VPC region
+// from the cluster objects instead of the flags.
+const WorkspaceFromClusterAnnotation = "capibmadm.ibm.com/workspace-from-cluster"
+
 // GlobalOptions holds the global variable struct.
 var GlobalOptions = &options{}
 
@@ -35,6 +42,8 @@ type options struct {
 	PowerVSZone       string
 	VPCRegion         string
 	ResourceGroupName string
+	Kubeconfig        string
+	Namespace         string
 	Debug             bool
 	Output            printer.PType
 }
@@ -44,3 +53,30 @@ func AddCommonFlags(cmd *cobra.Command) {
 	GlobalOptions.Output = printer.PrinterTypeTable
 	cmd.Flags().VarP(&GlobalOptions.Output, "output", "o", "The output format of the results. Supported printer types: table, json")
 }
+
+// AddKubeconfigFlags will add the flags to read the cluster-api objects from a management cluster.
+func AddKubeconfigFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(&GlobalOptions.Kubeconfig, "kubeconfig", "", "Path to the kubeconfig file of the management cluster, defaults to $KUBECONFIG or $HOME/.kube/config")
+	cmd.PersistentFlags().StringVarP(&GlobalOptions.Namespace, "namespace", "n", "", "Namespace of the cluster, defaults to the namespace of the current kubeconfig context")
+}
+
+// ValidateRequiredFlags checks that the given persistent flags are set, unless the command reads their values from the
+// cluster objects.
+func ValidateRequiredFlags(cmd *cobra.Command, names ...string) error {
+	for c := cmd; c != nil; c = c.Parent() {
+		if _, ok := c.Annotations[WorkspaceFromClusterAnnotation]; ok {
+			return nil
+		}
+	}
+
+	var missing []string
+	for _, name := range names {
+		if flag := cmd.Flags().Lookup(name); flag == nil || !flag.Changed {
+			missing = append(missing, fmt.Sprintf("%q", name))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("required flag(s) %s not set", strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"                              //nolint:staticcheck
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"         //nolint:staticcheck
+	v1beta2conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions/v1beta2" //nolint:staticcheck
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+)
+
+// credentialsObject is a cluster whose CredentialsReady condition is reconciled.
+type credentialsObject interface {
+	client.Object
+	v1beta1conditions.Setter
+	v1beta2conditions.Setter
+}
+
+// credentialsCheckTTL is how long a successful check of the credentials of an identity is reused before an IAM access
+// token is obtained with them again.
+const credentialsCheckTTL = 5 * time.Minute
+
+// credentialsCache holds the time of the last successful check of the credentials of each identity.
+type credentialsCache struct {
+	mu      sync.Mutex
+	checked map[string]time.Time
+	now     func() time.Time
+}
+
+func newCredentialsCache() *credentialsCache {
+	return &credentialsCache{
+		checked: map[string]time.Time{},
+		now:     time.Now,
+	}
+}
+
+// valid returns whether the credentials of the identity were successfully checked less than credentialsCheckTTL ago.
+func (c *credentialsCache) valid(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	checked, ok := c.checked[key]
+	if !ok {
+		return false
+	}
+	if c.now().Sub(checked) >= credentialsCheckTTL {
+		delete(c.checked, key)
+		return false
+	}
+	return true
+}
+
+// set records a successful check of the credentials of the identity, or forgets it when the check failed.
+func (c *credentialsCache) set(key string, valid bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if valid {
+		c.checked[key] = c.now()
+		return
+	}
+	delete(c.checked, key)
+}
+
+// checkedCredentials caches the credentials checks of all the clusters, so that they don't obtain an IAM access token on every reconcile.
+var checkedCredentials = newCredentialsCache()
+
+// credentialsKey returns the cache key of the identity referenced by ref from the namespace, of the manager when it is nil.
+// The namespace is part of the key of every identity, as an IBMCloudClusterIdentity may not allow all the namespaces.
+func credentialsKey(namespace string, ref *infrav1.IBMCloudIdentityReference) string {
+	if ref == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s/%s", namespace, ref.Kind, ref.Name)
+}
+
+// reconcileCredentials checks that an IAM access token can be obtained with the credentials of the cluster, those of
+// the identity referenced by ref or of the manager when it is nil, and reports it with the CredentialsReady condition.
+// The authenticator is the one of the AuthenticatorFactory of factory when it is set.
+// A successful check is reused for credentialsCheckTTL, the identity itself is still resolved on every reconcile.
+func reconcileCredentials(ctx context.Context, c client.Client, factory scope.ClientFactory, cluster credentialsObject, ref *infrav1.IBMCloudIdentityReference) error {
+	key := credentialsKey(cluster.GetNamespace(), ref)
+	auth, err := factory.GetAuthenticator(ctx, c, cluster.GetNamespace(), ref)
+	if err == nil && !checkedCredentials.valid(key) {
+		err = authenticator.CheckCredentials(auth)
+		checkedCredentials.set(key, err == nil)
+	}
+	if err != nil {
+		reason, v1beta2Reason := infrav1.CredentialsReconciliationFailedReason, infrav1.CredentialsNotReadyV1Beta2Reason
+		if errors.Is(err, authenticator.ErrTrustedProfileNotAssumed) {
+			reason, v1beta2Reason = infrav1.TrustedProfileNotAssumedReason, infrav1.CredentialsTrustedProfileNotAssumedV1Beta2Reason
+		}
+		v1beta1conditions.MarkFalse(cluster, infrav1.CredentialsReadyCondition, reason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		v1beta2conditions.Set(cluster, metav1.Condition{
+			Type:    infrav1.CredentialsReadyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1beta2Reason,
+			Message: err.Error(),
+		})
+		return fmt.Errorf("failed to reconcile credentials for %s: %w", klog.KObj(cluster), err)
+	}
+
+	v1beta1conditions.MarkTrue(cluster, infrav1.CredentialsReadyCondition)
+	v1beta2conditions.Set(cluster, metav1.Condition{
+		Type:   infrav1.CredentialsReadyV1Beta2Condition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.CredentialsReadyV1Beta2Reason,
+	})
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions" //nolint:staticcheck
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+
+	. "github.com/onsi/gomega"
+)
+
+// failingAuthenticator is an authenticator which cannot obtain an IAM access token.
+type failingAuthenticator struct {
+	core.NoAuthAuthenticator
+}
+
+func (failingAuthenticator) Authenticate(*http.Request) error {
+	return errors.New("invalid api key")
+}
+
+func TestReconcileCredentials(t *testing.T) {
+	testCases := []struct {
+		name          string
+		authenticator core.Authenticator
+		expectError   bool
+	}{
+		{
+			name:          "Should mark credentials ready when an IAM access token is obtained",
+			authenticator: &core.NoAuthAuthenticator{},
+		},
+		{
+			name:          "Should mark credentials not ready when an IAM access token cannot be obtained",
+			authenticator: failingAuthenticator{},
+			expectError:   true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			checkedCredentials = newCredentialsCache()
+			cluster := &infrav1.IBMPowerVSCluster{ObjectMeta: metav1.ObjectMeta{Name: "capi", Namespace: "default"}}
+			factory := scope.ClientFactory{
+				AuthenticatorFactory: func() (core.Authenticator, error) {
+					return tc.authenticator, nil
+				},
+			}
+
+			err := reconcileCredentials(ctx, fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(), factory, cluster, nil)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(v1beta1conditions.IsFalse(cluster, infrav1.CredentialsReadyCondition)).To(BeTrue())
+				g.Expect(v1beta1conditions.GetReason(cluster, infrav1.CredentialsReadyCondition)).To(Equal(infrav1.CredentialsReconciliationFailedReason))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(v1beta1conditions.IsTrue(cluster, infrav1.CredentialsReadyCondition)).To(BeTrue())
+		})
+	}
+}
+
+func TestReconcileCredentialsCache(t *testing.T) {
+	g := NewWithT(t)
+	checkedCredentials = newCredentialsCache()
+	now := time.Now()
+	checkedCredentials.now = func() time.Time { return now }
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	cluster := &infrav1.IBMPowerVSCluster{ObjectMeta: metav1.ObjectMeta{Name: "capi", Namespace: "default"}}
+	var auth core.Authenticator = &core.NoAuthAuthenticator{}
+	checks := 0
+	factory := scope.ClientFactory{
+		AuthenticatorFactory: func() (core.Authenticator, error) {
+			checks++
+			return auth, nil
+		},
+	}
+
+	g.Expect(reconcileCredentials(ctx, c, factory, cluster, nil)).To(Succeed())
+
+	// The successful check is reused until it expires, even if the credentials are no longer valid.
+	auth = failingAuthenticator{}
+	g.Expect(reconcileCredentials(ctx, c, factory, cluster, nil)).To(Succeed())
+	g.Expect(checks).To(Equal(2))
+
+	now = now.Add(credentialsCheckTTL)
+	g.Expect(reconcileCredentials(ctx, c, factory, cluster, nil)).ToNot(Succeed())
+	g.Expect(v1beta1conditions.IsFalse(cluster, infrav1.CredentialsReadyCondition)).To(BeTrue())
+
+	// A failed check is not cached.
+	auth = &core.NoAuthAuthenticator{}
+	g.Expect(reconcileCredentials(ctx, c, factory, cluster, nil)).To(Succeed())
+	g.Expect(v1beta1conditions.IsTrue(cluster, infrav1.CredentialsReadyCondition)).To(BeTrue())
+}
+
+func TestCredentialsKey(t *testing.T) {
+	g := NewWithT(t)
+	ref := &infrav1.IBMCloudIdentityReference{Kind: infrav1.IBMCloudIdentityKindClusterIdentity, Name: "identity"}
+	g.Expect(credentialsKey("default", nil)).To(Equal(""))
+	g.Expect(credentialsKey("default", ref)).To(Equal("default/IBMCloudClusterIdentity/identity"))
+	g.Expect(credentialsKey("default", ref)).ToNot(Equal(credentialsKey("other", ref)))
+}
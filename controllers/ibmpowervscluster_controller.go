@@ -120,19 +120,6 @@ func (r *IBMPowerVSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, err
 	}
 
-	// Create the scope.
-	clusterScope, err := scope.NewPowerVSClusterScope(scope.PowerVSClusterScopeParams{
-		Client:            r.Client,
-		Cluster:           cluster,
-		IBMPowerVSCluster: ibmPowerVSCluster,
-		ServiceEndpoint:   r.ServiceEndpoint,
-		ClientFactory:     r.ClientFactory,
-	})
-
-	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed to create IBMPowerVSCluster scope: %w", err)
-	}
-
 	// Initialize the patch helper
 	patchHelper, err := v1beta1patch.NewHelper(ibmPowerVSCluster, r.Client)
 	if err != nil {
@@ -146,6 +133,26 @@ func (r *IBMPowerVSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}
 	}()
 
+	// Check the credentials only when the infrastructure is created by the controller with the IBM Cloud clients.
+	if ibmPowerVSCluster.DeletionTimestamp.IsZero() && scope.CheckCreateInfraAnnotation(*ibmPowerVSCluster) {
+		if err := reconcileCredentials(ctx, r.Client, r.ClientFactory, ibmPowerVSCluster, ibmPowerVSCluster.Spec.IdentityRef); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Create the scope.
+	clusterScope, err := scope.NewPowerVSClusterScope(scope.PowerVSClusterScopeParams{
+		Client:            r.Client,
+		Cluster:           cluster,
+		IBMPowerVSCluster: ibmPowerVSCluster,
+		ServiceEndpoint:   r.ServiceEndpoint,
+		ClientFactory:     r.ClientFactory,
+	})
+
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to create IBMPowerVSCluster scope: %w", err)
+	}
+
 	// Handle deleted clusters.
 	if !ibmPowerVSCluster.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, clusterScope)
@@ -156,6 +163,9 @@ func (r *IBMPowerVSClusterReconciler) Reconcile(ctx context.Context, req ctrl.Re
 
 func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScope *scope.PowerVSClusterScope) (ctrl.Result, error) { //nolint:gocyclo
 	log := ctrl.LoggerFrom(ctx)
+	// report the Power VS workspace zone as the cluster's failure domain.
+	clusterScope.SetFailureDomains()
+
 	// check for annotation set for cluster resource and decide on proceeding with infra creation.
 	// do not proceed further if "powervs.cluster.x-k8s.io/create-infra=true" annotation is not set.
 	if !scope.CheckCreateInfraAnnotation(*clusterScope.IBMPowerVSCluster) {
@@ -260,6 +270,33 @@ func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScop
 		})
 	}
 
+	// reconcile VPE gateways
+	if len(clusterScope.IBMPowerVSCluster.Spec.VPEGateways) > 0 {
+		log.Info("Reconciling VPE gateways")
+		if requeue, err := clusterScope.ReconcileVPEGateways(ctx); err != nil {
+			v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+				Type:    infrav1.VPEGatewaysReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.VPEGatewaysNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			return reconcile.Result{}, fmt.Errorf("failed to reconcile VPE gateways: %w", err)
+		} else if requeue {
+			v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+				Type:   infrav1.VPEGatewaysReadyV1Beta2Condition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.VPEGatewaysNotReadyV1Beta2Reason,
+			})
+			log.Info("VPE gateways are not yet ready, requeuing")
+			return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+		v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+			Type:   infrav1.VPEGatewaysReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.VPEGatewaysReadyV1Beta2Reason,
+		})
+	}
+
 	var networkReady, loadBalancerReady bool
 	for _, cond := range clusterScope.IBMPowerVSCluster.Status.Conditions {
 		if cond.Type == infrav1.NetworkReadyCondition && cond.Status == corev1.ConditionTrue {
@@ -275,6 +312,11 @@ func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScop
 		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 	}
 
+	if v1beta2conditions.IsFalse(clusterScope.IBMPowerVSCluster, infrav1.AdditionalNetworksReadyV1Beta2Condition) {
+		log.Info("Additional networks still not ready, requeuing")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
 	log.Info("Getting load balancer host")
 	hostName, err := clusterScope.GetPublicLoadBalancerHostName()
 	if err != nil {
@@ -285,6 +327,64 @@ func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScop
 		return reconcile.Result{RequeueAfter: time.Minute}, nil
 	}
 
+	// reconcile control-plane DNS
+	if clusterScope.ControlPlaneDNS() != nil {
+		log.Info("Reconciling control-plane DNS")
+		if requeue, err := clusterScope.ReconcileControlPlaneDNS(ctx); err != nil {
+			v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+				Type:    infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.ControlPlaneDNSNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			return reconcile.Result{}, fmt.Errorf("failed to reconcile control-plane DNS: %w", err)
+		} else if requeue {
+			v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+				Type:   infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.ControlPlaneDNSNotReadyV1Beta2Reason,
+			})
+			log.Info("Control-plane DNS is not yet ready, requeuing")
+			return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+		v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+			Type:   infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.ControlPlaneDNSReadyV1Beta2Reason,
+		})
+		// serve the control-plane endpoint with the DNS name pointing to the load balancer.
+		hostName = clusterScope.GetControlPlaneDNSName()
+	}
+
+	// reconcile CIS DNS records
+	if clusterScope.DNS() != nil {
+		log.Info("Reconciling CIS DNS records")
+		if requeue, err := clusterScope.ReconcileDNSRecords(ctx); err != nil {
+			v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+				Type:    infrav1.PublicDNSReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.PublicDNSNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			return reconcile.Result{}, fmt.Errorf("failed to reconcile CIS DNS records: %w", err)
+		} else if requeue {
+			v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+				Type:   infrav1.PublicDNSReadyV1Beta2Condition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.PublicDNSNotReadyV1Beta2Reason,
+			})
+			log.Info("CIS DNS records are not yet ready, requeuing")
+			return reconcile.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+		v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+			Type:   infrav1.PublicDNSReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.PublicDNSReadyV1Beta2Reason,
+		})
+		// serve the control-plane endpoint with the public DNS name, it takes precedence over the private one.
+		hostName = clusterScope.GetDNSAPIName()
+	}
+
 	// update cluster object with load balancer host name
 	clusterScope.IBMPowerVSCluster.Spec.ControlPlaneEndpoint.Host = *hostName
 	clusterScope.IBMPowerVSCluster.Spec.ControlPlaneEndpoint.Port = clusterScope.APIServerPort()
@@ -337,6 +437,77 @@ func (r *IBMPowerVSClusterReconciler) reconcilePowerVSResources(ctx context.Cont
 
 	clusterScope.IBMPowerVSClient.WithClients(powervs.ServiceOptions{CloudInstanceID: clusterScope.GetServiceInstanceID()})
 
+	// reconcile placement groups
+	if len(clusterScope.IBMPowerVSCluster.Spec.PlacementGroups) > 0 {
+		log.Info("Reconciling placement groups")
+		if err := clusterScope.ReconcilePlacementGroups(ctx); err != nil {
+			v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+				Type:    infrav1.PlacementGroupsReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.PlacementGroupsNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			ch <- reconcileResult{reconcile.Result{}, fmt.Errorf("failed to reconcile placement groups: %w", err)}
+			return
+		}
+		v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+			Type:   infrav1.PlacementGroupsReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.PlacementGroupsReadyV1Beta2Reason,
+		})
+	}
+
+	// reconcile shared processor pools
+	if len(clusterScope.IBMPowerVSCluster.Spec.SharedProcessorPools) > 0 {
+		log.Info("Reconciling shared processor pools")
+		if err := clusterScope.ReconcileSharedProcessorPools(ctx); err != nil {
+			v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+				Type:    infrav1.SharedProcessorPoolsReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.SharedProcessorPoolsNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			ch <- reconcileResult{reconcile.Result{}, fmt.Errorf("failed to reconcile shared processor pools: %w", err)}
+			return
+		}
+		v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+			Type:   infrav1.SharedProcessorPoolsReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.SharedProcessorPoolsReadyV1Beta2Reason,
+		})
+	}
+
+	// reconcile additional networks
+	if len(clusterScope.IBMPowerVSCluster.Spec.AdditionalNetworks) > 0 {
+		log.Info("Reconciling additional networks")
+		if ready, err := clusterScope.ReconcileAdditionalNetworks(ctx); err != nil {
+			v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+				Type:    infrav1.AdditionalNetworksReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.AdditionalNetworksNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			ch <- reconcileResult{reconcile.Result{}, fmt.Errorf("failed to reconcile additional networks: %w", err)}
+			return
+		} else if !ready {
+			// Do not want to block the reconciliation of the network of the cluster while the DHCP servers are being created,
+			// the cluster is not marked ready until the condition is true.
+			log.Info("Additional networks creation is pending")
+			v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+				Type:    infrav1.AdditionalNetworksReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.AdditionalNetworksNotReadyV1Beta2Reason,
+				Message: "Waiting for the DHCP servers of the additional networks to be active",
+			})
+		} else {
+			v1beta2conditions.Set(powerVSCluster.cluster, metav1.Condition{
+				Type:   infrav1.AdditionalNetworksReadyV1Beta2Condition,
+				Status: metav1.ConditionTrue,
+				Reason: infrav1.AdditionalNetworksReadyV1Beta2Reason,
+			})
+		}
+	}
+
 	// reconcile network
 	log.Info("Reconciling network")
 	if networkActive, err := clusterScope.ReconcileNetwork(ctx); err != nil {
@@ -529,6 +700,33 @@ func (r *IBMPowerVSClusterReconciler) reconcileDelete(ctx context.Context, clust
 	var allErrs []error
 	clusterScope.IBMPowerVSClient.WithClients(powervs.ServiceOptions{CloudInstanceID: clusterScope.GetServiceInstanceID()})
 
+	if clusterScope.DNS() != nil {
+		log.Info("Deleting CIS DNS records")
+		v1beta2conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
+			Type:   infrav1.PublicDNSReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.PublicDNSDeletingV1Beta2Reason,
+		})
+		if err := clusterScope.DeleteDNSRecords(ctx); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("failed to delete CIS DNS records: %w", err))
+		}
+	}
+
+	if clusterScope.ControlPlaneDNS() != nil {
+		log.Info("Deleting control-plane DNS")
+		v1beta2conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
+			Type:   infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.ControlPlaneDNSDeletingV1Beta2Reason,
+		})
+		if requeue, err := clusterScope.DeleteControlPlaneDNS(ctx); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("failed to delete control-plane DNS: %w", err))
+		} else if requeue {
+			log.Info("Control-plane DNS deletion is pending, requeuing")
+			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+	}
+
 	log.Info("Deleting transit gateway")
 	v1beta2conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
 		Type:   infrav1.TransitGatewayReadyV1Beta2Condition,
@@ -555,6 +753,21 @@ func (r *IBMPowerVSClusterReconciler) reconcileDelete(ctx context.Context, clust
 		return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
 	}
 
+	if len(clusterScope.IBMPowerVSCluster.Status.VPEGateways) > 0 {
+		log.Info("Deleting VPE gateways")
+		v1beta2conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
+			Type:   infrav1.VPEGatewaysReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.VPEGatewaysDeletingV1Beta2Reason,
+		})
+		if requeue, err := clusterScope.DeleteVPEGateways(ctx); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("failed to delete VPE gateways: %w", err))
+		} else if requeue {
+			log.Info("VPE gateway deletion is pending, requeuing")
+			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+	}
+
 	log.Info("Deleting VPC security group")
 	v1beta2conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
 		Type:   infrav1.VPCSecurityGroupReadyV1Beta2Condition,
@@ -591,6 +804,42 @@ func (r *IBMPowerVSClusterReconciler) reconcileDelete(ctx context.Context, clust
 		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
 	}
 
+	if len(clusterScope.IBMPowerVSCluster.Status.SharedProcessorPools) > 0 {
+		log.Info("Deleting shared processor pools")
+		v1beta2conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
+			Type:   infrav1.SharedProcessorPoolsReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.SharedProcessorPoolsDeletingV1Beta2Reason,
+		})
+		if err := clusterScope.DeleteSharedProcessorPools(ctx); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("failed to delete shared processor pools: %w", err))
+		}
+	}
+
+	if len(clusterScope.IBMPowerVSCluster.Status.PlacementGroups) > 0 {
+		log.Info("Deleting placement groups")
+		v1beta2conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
+			Type:   infrav1.PlacementGroupsReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.PlacementGroupsDeletingV1Beta2Reason,
+		})
+		if err := clusterScope.DeletePlacementGroups(ctx); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("failed to delete placement groups: %w", err))
+		}
+	}
+
+	if len(clusterScope.IBMPowerVSCluster.Status.AdditionalNetworks) > 0 {
+		log.Info("Deleting additional networks")
+		v1beta2conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
+			Type:   infrav1.AdditionalNetworksReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.AdditionalNetworksDeletingV1Beta2Reason,
+		})
+		if err := clusterScope.DeleteAdditionalNetworks(ctx); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("failed to delete additional networks: %w", err))
+		}
+	}
+
 	log.Info("Deleting DHCP server")
 	v1beta2conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
 		Type:   infrav1.NetworkReadyV1Beta2Condition,
@@ -778,6 +1027,7 @@ func patchIBMPowerVSCluster(ctx context.Context, patchHelper *v1beta1patch.Helpe
 
 	if err := v1beta2conditions.SetSummaryCondition(ibmPowerVSCluster, ibmPowerVSCluster, infrav1.IBMPowerVSClusterReadyV1Beta2Condition,
 		v1beta2conditions.ForConditionTypes{
+			infrav1.CredentialsReadyV1Beta2Condition,
 			infrav1.WorkspaceReadyV1Beta2Condition,
 			infrav1.NetworkReadyV1Beta2Condition,
 			infrav1.VPCReadyV1Beta2Condition,
@@ -786,9 +1036,22 @@ func patchIBMPowerVSCluster(ctx context.Context, patchHelper *v1beta1patch.Helpe
 			infrav1.VPCLoadBalancerReadyV1Beta2Condition,
 			infrav1.TransitGatewayReadyV1Beta2Condition,
 			infrav1.COSInstanceReadyV1Beta2Condition,
+			infrav1.PlacementGroupsReadyV1Beta2Condition,
+			infrav1.SharedProcessorPoolsReadyV1Beta2Condition,
+			infrav1.AdditionalNetworksReadyV1Beta2Condition,
+			infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+			infrav1.PublicDNSReadyV1Beta2Condition,
+			infrav1.VPEGatewaysReadyV1Beta2Condition,
 		},
 		v1beta2conditions.IgnoreTypesIfMissing{
+			infrav1.CredentialsReadyV1Beta2Condition,
 			infrav1.COSInstanceReadyV1Beta2Condition,
+			infrav1.PlacementGroupsReadyV1Beta2Condition,
+			infrav1.SharedProcessorPoolsReadyV1Beta2Condition,
+			infrav1.AdditionalNetworksReadyV1Beta2Condition,
+			infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+			infrav1.PublicDNSReadyV1Beta2Condition,
+			infrav1.VPEGatewaysReadyV1Beta2Condition,
 		},
 		// Using a custom merge strategy to override reasons applied during merge.
 		v1beta2conditions.CustomMergeStrategy{
@@ -809,6 +1072,7 @@ func patchIBMPowerVSCluster(ctx context.Context, patchHelper *v1beta1patch.Helpe
 		v1beta1patch.WithOwnedV1Beta2Conditions{Conditions: []string{
 			clusterv1beta1.PausedV1Beta2Condition,
 			infrav1.IBMPowerVSClusterReadyV1Beta2Condition,
+			infrav1.CredentialsReadyV1Beta2Condition,
 			infrav1.WorkspaceReadyV1Beta2Condition,
 			infrav1.NetworkReadyV1Beta2Condition,
 			infrav1.VPCReadyV1Beta2Condition,
@@ -816,6 +1080,12 @@ func patchIBMPowerVSCluster(ctx context.Context, patchHelper *v1beta1patch.Helpe
 			infrav1.VPCSecurityGroupReadyV1Beta2Condition,
 			infrav1.TransitGatewayReadyV1Beta2Condition,
 			infrav1.COSInstanceReadyV1Beta2Condition,
+			infrav1.PlacementGroupsReadyV1Beta2Condition,
+			infrav1.SharedProcessorPoolsReadyV1Beta2Condition,
+			infrav1.AdditionalNetworksReadyV1Beta2Condition,
+			infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+			infrav1.PublicDNSReadyV1Beta2Condition,
+			infrav1.VPEGatewaysReadyV1Beta2Condition,
 		}},
 	)
 }
@@ -96,6 +96,10 @@ func (r *IBMPowerVSImageReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{}, err
 		}
 		scopeParams.Zone = cluster.Spec.Zone
+		scopeParams.IdentityRef = cluster.Spec.IdentityRef
+	} else if imageCluster, err := scope.GetClusterByName(ctx, r.Client, ibmPowerVSImage.Namespace, ibmPowerVSImage.Spec.ClusterName); err == nil {
+		// Delete the image with the credentials of the cluster when it still exists.
+		scopeParams.IdentityRef = imageCluster.Spec.IdentityRef
 	}
 
 	// Initialize the patch helper
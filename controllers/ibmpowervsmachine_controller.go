@@ -18,9 +18,12 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/IBM-Cloud/power-go-client/power/models"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -66,6 +69,12 @@ type IBMPowerVSMachineReconciler struct {
 	WatchFilterValue string
 }
 
+// networkAddressDiagnosticsTimeout is how long after its creation an instance without a network address has its diagnostics collected.
+const networkAddressDiagnosticsTimeout = 15 * time.Minute
+
+// instancePreflightRequeueAfter is how long to wait before checking again whether an instance that failed preflight can be created.
+const instancePreflightRequeueAfter = 5 * time.Minute
+
 // dhcpCacheStore is a cache store to hold the Power VS VM DHCP IP.
 var dhcpCacheStore cache.Store
 
@@ -216,6 +225,22 @@ func (r *IBMPowerVSMachineReconciler) reconcileDelete(ctx context.Context, scope
 		Reason: infrav1.IBMPowerVSMachineInstanceDeletingV1Beta2Reason,
 	})
 
+	// Delete the additional volumes before the instance, they are otherwise only detached from it.
+	if requeue, err := scope.DeleteAdditionalVolumes(ctx); err != nil {
+		log.Error(err, "error deleting IBMPowerVSMachine additional volumes")
+		v1beta1conditions.MarkFalse(scope.IBMPowerVSMachine, infrav1.InstanceReadyCondition, clusterv1beta1.DeletionFailedReason, clusterv1beta1.ConditionSeverityWarning, "")
+		v1beta2conditions.Set(scope.IBMPowerVSMachine, metav1.Condition{
+			Type:    infrav1.IBMPowerVSMachineInstanceReadyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.IBMPowerVSMachineInstanceDeletingV1Beta2Reason,
+			Message: fmt.Sprintf("failed to delete additional volumes: %v", err),
+		})
+		return ctrl.Result{}, fmt.Errorf("error deleting IBMPowerVSMachine %v additional volumes: %w", klog.KObj(scope.IBMPowerVSMachine), err)
+	} else if requeue {
+		log.Info("IBMPowerVSMachine additional volumes are being deleted, requeue")
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
 	defer func() {
 		if reterr == nil {
 			// PowerVS machine is deleted so remove the finalizer.
@@ -313,6 +338,17 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(ctx context.Context, machi
 	}
 
 	machine, err := machineScope.CreateMachine(ctx)
+	if errors.Is(err, scope.ErrInstancePreflightFailed) {
+		log.Info("PowerVS instance cannot be created, retrying later", "reason", err.Error())
+		v1beta1conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1.InstanceReadyCondition, infrav1.InstancePreflightFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		v1beta2conditions.Set(machineScope.IBMPowerVSMachine, metav1.Condition{
+			Type:    infrav1.IBMPowerVSMachineInstanceReadyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.InstancePreflightFailedReason,
+			Message: err.Error(),
+		})
+		return ctrl.Result{RequeueAfter: instancePreflightRequeueAfter}, nil
+	}
 	if err != nil {
 		log.Error(err, "Unable to create PowerVS machine")
 		v1beta1conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1.InstanceReadyCondition, infrav1.InstanceProvisionFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
@@ -348,6 +384,10 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(ctx context.Context, machi
 	machineScope.SetHealth(instance.Health)
 	machineScope.SetInstanceState(instance.Status)
 
+	if machineScope.DiagnosticsRequested() && r.collectDiagnostics(ctx, machineScope, instance, infrav1.PowerVSDiagnosticsTriggerRequested) {
+		machineScope.ClearDiagnosticsRequest()
+	}
+
 	switch machineScope.GetInstanceState() {
 	case infrav1.PowerVSInstanceStateBUILD:
 		machineScope.SetNotReady()
@@ -366,7 +406,8 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(ctx context.Context, machi
 			Reason: infrav1.InstanceStoppedReason,
 		})
 		return ctrl.Result{}, nil
-	case infrav1.PowerVSInstanceStateACTIVE:
+	case infrav1.PowerVSInstanceStateACTIVE, infrav1.PowerVSInstanceStateRESIZE:
+		// The instance keeps running while it is being resized in place.
 		machineScope.SetReady()
 	case infrav1.PowerVSInstanceStateERROR:
 		msg := ""
@@ -384,6 +425,9 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(ctx context.Context, machi
 			Message: msg,
 		})
 		capibmrecord.Warnf(machineScope.IBMPowerVSMachine, "FailedBuildInstance", "Failed to build the instance %s", msg)
+		if !machineScope.HasDiagnostics(infrav1.PowerVSDiagnosticsTriggerInstanceError) {
+			r.collectDiagnostics(ctx, machineScope, instance, infrav1.PowerVSDiagnosticsTriggerInstanceError)
+		}
 		return ctrl.Result{}, nil
 	default:
 		machineScope.SetNotReady()
@@ -402,6 +446,58 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(ctx context.Context, machi
 		return ctrl.Result{RequeueAfter: 2 * time.Minute}, nil
 	}
 
+	// Create and attach the additional volumes once the instance is active.
+	if requeue, err := machineScope.ReconcileAdditionalVolumes(ctx); err != nil {
+		log.Error(err, "Unable to reconcile additional volumes")
+		v1beta1conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1.InstanceReadyCondition, infrav1.VolumesConfigurationFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+		v1beta2conditions.Set(machineScope.IBMPowerVSMachine, metav1.Condition{
+			Type:    infrav1.IBMPowerVSMachineInstanceReadyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.IBMPowerVSMachineInstanceVolumesConfigurationFailedV1Beta2Reason,
+			Message: fmt.Sprintf("Failed to configure additional volumes: %v", err),
+		})
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile additional volumes: %w", err)
+	} else if requeue {
+		log.Info("Additional volumes are not yet attached, requeue")
+		v1beta1conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1.InstanceReadyCondition, infrav1.WaitingForVolumesReason, clusterv1beta1.ConditionSeverityInfo, "")
+		v1beta2conditions.Set(machineScope.IBMPowerVSMachine, metav1.Condition{
+			Type:   infrav1.IBMPowerVSMachineInstanceReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.IBMPowerVSMachineInstanceWaitingForVolumesV1Beta2Reason,
+		})
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
+	// Resize the instance in place when the processors or memory of the machine have changed.
+	if machineScope.IBMPowerVSMachine.Spec.ResizePolicy == infrav1.PowerVSResizePolicyInPlace {
+		if requeue, err := machineScope.ReconcileResize(ctx, instance); err != nil {
+			log.Error(err, "Unable to resize instance")
+			v1beta2conditions.Set(machineScope.IBMPowerVSMachine, metav1.Condition{
+				Type:    infrav1.IBMPowerVSMachineInstanceResizedV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.IBMPowerVSMachineInstanceResizeFailedV1Beta2Reason,
+				Message: fmt.Sprintf("Failed to resize instance: %v", err),
+			})
+			return ctrl.Result{}, fmt.Errorf("failed to resize instance: %w", err)
+		} else if requeue {
+			log.Info("Instance is being resized, requeue")
+			v1beta2conditions.Set(machineScope.IBMPowerVSMachine, metav1.Condition{
+				Type:   infrav1.IBMPowerVSMachineInstanceResizedV1Beta2Condition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.IBMPowerVSMachineInstanceResizingV1Beta2Reason,
+			})
+			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		}
+		v1beta2conditions.Set(machineScope.IBMPowerVSMachine, metav1.Condition{
+			Type:   infrav1.IBMPowerVSMachineInstanceResizedV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.IBMPowerVSMachineInstanceResizedV1Beta2Reason,
+		})
+	} else {
+		machineScope.IBMPowerVSMachine.Status.Resize = nil
+		v1beta2conditions.Delete(machineScope.IBMPowerVSMachine, infrav1.IBMPowerVSMachineInstanceResizedV1Beta2Condition)
+	}
+
 	if machineScope.IBMPowerVSCluster.Spec.VPC == nil || machineScope.IBMPowerVSCluster.Spec.VPC.Region == nil {
 		log.Info("Skipping configuring machine to load balancer as VPC is not set")
 		v1beta1conditions.MarkTrue(machineScope.IBMPowerVSMachine, infrav1.InstanceReadyCondition)
@@ -425,6 +521,10 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(ctx context.Context, machi
 			Reason:  infrav1.IBMPowerVSMachineInstanceWaitingForNetworkAddressV1Beta2Reason,
 			Message: "Internal IP not yet set",
 		})
+		if creationDate := time.Time(instance.CreationDate); !creationDate.IsZero() && time.Since(creationDate) > networkAddressDiagnosticsTimeout &&
+			!machineScope.HasDiagnostics(infrav1.PowerVSDiagnosticsTriggerWaitingForNetworkAddress) {
+			r.collectDiagnostics(ctx, machineScope, instance, infrav1.PowerVSDiagnosticsTriggerWaitingForNetworkAddress)
+		}
 		return ctrl.Result{}, nil
 	}
 	log.Info("Configuring load balancer for machine", "IP", internalIP)
@@ -448,6 +548,18 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(ctx context.Context, machi
 	return result, nil
 }
 
+// collectDiagnostics collects the diagnostics of the instance and returns whether they were collected.
+// Failing to collect them doesn't fail the reconciliation of the machine.
+func (r *IBMPowerVSMachineReconciler) collectDiagnostics(ctx context.Context, machineScope *scope.PowerVSMachineScope, instance *models.PVMInstance, trigger infrav1.PowerVSDiagnosticsTrigger) bool {
+	log := ctrl.LoggerFrom(ctx)
+	if err := machineScope.CollectDiagnostics(ctx, instance, trigger); err != nil {
+		log.Error(err, "Failed to collect instance diagnostics", "trigger", trigger)
+		return false
+	}
+	log.Info("Collected instance diagnostics", "trigger", trigger)
+	return true
+}
+
 // ibmPowerVSClusterToIBMPowerVSMachines is a handler.ToRequestsFunc to be used to enqueue requests for reconciliation
 // of IBMPowerVSMachines.
 func (r *IBMPowerVSMachineReconciler) ibmPowerVSClusterToIBMPowerVSMachines(ctx context.Context, o client.Object) []ctrl.Request {
@@ -572,6 +684,7 @@ func patchIBMPowerVSMachine(ctx context.Context, patchHelper *v1beta1patch.Helpe
 	return patchHelper.Patch(ctx, ibmPowerVSMachine, v1beta1patch.WithOwnedV1Beta2Conditions{Conditions: []string{
 		infrav1.IBMPowerVSMachineReadyV1Beta2Condition,
 		infrav1.IBMPowerVSMachineInstanceReadyV1Beta2Condition,
+		infrav1.IBMPowerVSMachineInstanceResizedV1Beta2Condition,
 		clusterv1beta1.PausedV1Beta2Condition,
 	}})
 }
@@ -478,6 +478,44 @@ func TestIBMPowerVSMachineReconciler_ReconcileOperations(t *testing.T) {
 			expectConditions(g, machineScope.IBMPowerVSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1beta1.ConditionSeverityError, infrav1.InstanceProvisionFailedReason}})
 		})
 
+		t.Run("Should requeue with preflight failure if the system type is not available in the zone", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			secret := newSecret()
+			machine := newMachine()
+			pvsMachine := newIBMPowerVSMachine()
+			pvsMachine.Spec.SystemType = "e1080"
+			mockClient := fake.NewClientBuilder().WithObjects(secret, pvsMachine, machine).Build()
+			machineScope = &scope.PowerVSMachineScope{
+				Client: mockClient,
+				Cluster: &clusterv1.Cluster{
+					Status: clusterv1.ClusterStatus{
+						Initialization: clusterv1.ClusterInitializationStatus{
+							InfrastructureProvisioned: ptr.To(true),
+						},
+					},
+				},
+				Machine:           machine,
+				IBMPowerVSMachine: pvsMachine,
+				IBMPowerVSImage: &infrav1.IBMPowerVSImage{
+					Status: infrav1.IBMPowerVSImageStatus{
+						Ready:   true,
+						ImageID: "capi-image-id",
+					},
+				},
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+				IBMPowerVSClient:  mockpowervs,
+			}
+			mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{}, nil)
+			mockpowervs.EXPECT().GetSystemPools().Return(models.SystemPools{"s922": {}}, nil)
+
+			result, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result.RequeueAfter).To(Equal(instancePreflightRequeueAfter))
+			expectConditions(g, machineScope.IBMPowerVSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1beta1.ConditionSeverityError, infrav1.InstancePreflightFailedReason}})
+		})
+
 		t.Run("Should fail reconcile if creation of the load balancer pool member is unsuccessful", func(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
@@ -781,10 +819,16 @@ func TestIBMPowerVSMachineReconciler_ReconcileOperations(t *testing.T) {
 				instance.Fault = &models.PVMInstanceFault{Details: "Timeout creating instance"}
 				mockpowervs.EXPECT().GetAllInstance().Return(instanceReferences, nil)
 				mockpowervs.EXPECT().GetInstance(gomock.AssignableToTypeOf("capi-test-machine-id")).Return(instance, nil)
+				mockpowervs.EXPECT().GetEvents("serviceInstanceID", gomock.Any()).Return(&models.Events{Events: []*models.Event{
+					{Level: ptr.To("error"), Message: ptr.To("Virtual server instance capi-test-machine (capi-test-machine-id) failed"), Timestamp: ptr.To(int64(1))},
+				}}, nil)
 				result, err = reconciler.reconcileNormal(ctx, machineScope)
 				g.Expect(err).To(BeNil())
 				g.Expect(result.RequeueAfter).To(BeZero())
 				g.Expect(machineScope.IBMPowerVSMachine.Status.Ready).To(Equal(false))
+				g.Expect(machineScope.IBMPowerVSMachine.Status.Diagnostics.Trigger).To(Equal(infrav1.PowerVSDiagnosticsTriggerInstanceError))
+				g.Expect(machineScope.IBMPowerVSMachine.Status.Diagnostics.Fault).To(Equal("Timeout creating instance"))
+				g.Expect(machineScope.IBMPowerVSMachine.Status.Diagnostics.Events).To(HaveLen(1))
 				g.Expect(machineScope.IBMPowerVSMachine.Finalizers).To(ContainElement(infrav1.IBMPowerVSMachineFinalizer))
 				expectConditions(g, machineScope.IBMPowerVSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1beta1.ConditionSeverityError, infrav1.InstanceErroredReason}})
 			})
@@ -0,0 +1,279 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions" //nolint:staticcheck
+	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"           //nolint:staticcheck
+	"sigs.k8s.io/cluster-api/util/deprecated/v1beta1/paused"
+	"sigs.k8s.io/cluster-api/util/finalizers"
+	"sigs.k8s.io/cluster-api/util/predicates"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+)
+
+// IBMPowerVSMachinePoolReconciler reconciles a IBMPowerVSMachinePool object.
+type IBMPowerVSMachinePoolReconciler struct {
+	client.Client
+	Recorder        record.EventRecorder
+	ServiceEndpoint []endpoints.ServiceEndpoint
+	Scheme          *runtime.Scheme
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsmachinepools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsmachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch
+
+// Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMPowerVSMachinePool.
+func (r *IBMPowerVSMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.Info("Reconciling IBMPowerVSMachinePool")
+	defer log.Info("Finished reconciling IBMPowerVSMachinePool")
+
+	// Fetch the IBMPowerVSMachinePool instance.
+	ibmPowerVSMachinePool := &infrav1.IBMPowerVSMachinePool{}
+	if err := r.Client.Get(ctx, req.NamespacedName, ibmPowerVSMachinePool); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("IBMPowerVSMachinePool not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get IBMPowerVSMachinePool: %w", err)
+	}
+
+	// Add finalizer first if not set to avoid the race condition between init and delete.
+	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, ibmPowerVSMachinePool, infrav1.IBMPowerVSMachinePoolFinalizer); err != nil || finalizerAdded {
+		return ctrl.Result{}, err
+	}
+
+	// Fetch the MachinePool.
+	machinePool, err := util.GetOwnerMachinePool(ctx, r.Client, ibmPowerVSMachinePool.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get machine pool for IBMPowerVSMachinePool: %w", err)
+	}
+	if machinePool == nil {
+		log.Info("Waiting for machine pool controller to set owner ref on IBMPowerVSMachinePool")
+		return ctrl.Result{}, nil
+	}
+	log = log.WithValues("MachinePool", klog.KObj(machinePool))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machinePool.ObjectMeta)
+	if err != nil {
+		log.Info("IBMPowerVSMachinePool owner MachinePool is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+	if cluster == nil {
+		log.Info(fmt.Sprintf("Please associate this machine pool with a cluster using the label %s: <name of cluster>", clusterv1.ClusterNameLabel))
+		return ctrl.Result{}, nil
+	}
+
+	log = log.WithValues("Cluster", klog.KObj(cluster))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	if isPaused, requeue, err := paused.EnsurePausedCondition(ctx, r.Client, cluster, ibmPowerVSMachinePool); err != nil || isPaused || requeue {
+		return ctrl.Result{}, err
+	}
+
+	if !cluster.Spec.InfrastructureRef.IsDefined() {
+		log.Info("Cluster infrastructureRef is not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the IBMPowerVSCluster.
+	ibmPowerVSCluster := &infrav1.IBMPowerVSCluster{}
+	ibmPowerVSClusterName := client.ObjectKey{
+		Namespace: ibmPowerVSMachinePool.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	if err := r.Client.Get(ctx, ibmPowerVSClusterName, ibmPowerVSCluster); err != nil {
+		log.Info("IBMPowerVSCluster is not available yet")
+		return ctrl.Result{}, fmt.Errorf("failed to get IBMPowerVSCluster: %w", err)
+	}
+
+	log = log.WithValues("IBMPowerVSCluster", klog.KObj(ibmPowerVSCluster))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	// Initialize the patch helper
+	patchHelper, err := v1beta1patch.NewHelper(ibmPowerVSMachinePool, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+
+	// Always attempt to Patch the IBMPowerVSMachinePool object and status after each reconciliation.
+	defer func() {
+		if err := patchIBMPowerVSMachinePool(ctx, patchHelper, ibmPowerVSMachinePool); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	// Create the machine pool scope.
+	machinePoolScope, err := scope.NewPowerVSMachinePoolScope(scope.PowerVSMachinePoolScopeParams{
+		Client:                r.Client,
+		Logger:                log,
+		Cluster:               cluster,
+		MachinePool:           machinePool,
+		IBMPowerVSCluster:     ibmPowerVSCluster,
+		IBMPowerVSMachinePool: ibmPowerVSMachinePool,
+		ServiceEndpoint:       r.ServiceEndpoint,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create IBMPowerVS machine pool scope: %w", err)
+	}
+
+	// Handle deleted machine pools.
+	if !ibmPowerVSMachinePool.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, machinePoolScope)
+	}
+
+	// Handle non-deleted machine pools.
+	return r.reconcileNormal(ctx, machinePoolScope)
+}
+
+func (r *IBMPowerVSMachinePoolReconciler) reconcileNormal(ctx context.Context, machinePoolScope *scope.PowerVSMachinePoolScope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	pool := machinePoolScope.IBMPowerVSMachinePool
+
+	if machinePoolScope.Cluster.Status.Initialization.InfrastructureProvisioned == nil || !*machinePoolScope.Cluster.Status.Initialization.InfrastructureProvisioned {
+		log.Info("Cluster infrastructure is not ready yet")
+		v1beta1conditions.MarkFalse(pool, infrav1.InstancesReadyCondition, infrav1.WaitingForClusterInfrastructureReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{}, nil
+	}
+
+	// Make sure bootstrap data is available and populated.
+	if machinePoolScope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
+		log.Info("Bootstrap data secret reference is not yet available")
+		v1beta1conditions.MarkFalse(pool, infrav1.InstancesReadyCondition, infrav1.WaitingForBootstrapDataReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
+	requeue, err := machinePoolScope.ReconcileInstances(ctx)
+	if err != nil {
+		v1beta1conditions.MarkFalse(pool, infrav1.InstancesReadyCondition, infrav1.InstancesReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile instances for IBMPowerVSMachinePool %v: %w", klog.KObj(pool), err)
+	}
+	// The infrastructure is provisioned once the instances are reconciled, scaling and updates are reported through the conditions.
+	machinePoolScope.SetReady()
+	markMachinePoolInstancesCondition(pool, requeue, pool.Status.Replicas, upToDatePowerVSInstances(pool))
+
+	if requeue {
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *IBMPowerVSMachinePoolReconciler) reconcileDelete(ctx context.Context, machinePoolScope *scope.PowerVSMachinePoolScope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Handling deleted IBMPowerVSMachinePool")
+	pool := machinePoolScope.IBMPowerVSMachinePool
+
+	machinePoolScope.SetNotReady()
+	v1beta1conditions.MarkFalse(pool, infrav1.InstancesReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
+
+	requeue, err := machinePoolScope.DeleteInstances(ctx)
+	if err != nil {
+		v1beta1conditions.MarkFalse(pool, infrav1.InstancesReadyCondition, clusterv1beta1.DeletionFailedReason, clusterv1beta1.ConditionSeverityWarning, "")
+		return ctrl.Result{}, fmt.Errorf("error deleting instances of IBMPowerVSMachinePool %v: %w", klog.KObj(pool), err)
+	}
+	if requeue {
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	controllerutil.RemoveFinalizer(pool, infrav1.IBMPowerVSMachinePoolFinalizer)
+	return ctrl.Result{}, nil
+}
+
+func upToDatePowerVSInstances(pool *infrav1.IBMPowerVSMachinePool) int {
+	count := 0
+	for _, instance := range pool.Status.Instances {
+		if instance.UpToDate {
+			count++
+		}
+	}
+	return count
+}
+
+func patchIBMPowerVSMachinePool(ctx context.Context, patchHelper *v1beta1patch.Helper, ibmPowerVSMachinePool *infrav1.IBMPowerVSMachinePool) error {
+	v1beta1conditions.SetSummary(ibmPowerVSMachinePool,
+		v1beta1conditions.WithConditions(
+			infrav1.InstancesReadyCondition,
+		),
+	)
+	setMachinePoolReadyV1Beta2Condition(ibmPowerVSMachinePool, !ibmPowerVSMachinePool.DeletionTimestamp.IsZero())
+
+	// Patch the IBMPowerVSMachinePool resource.
+	return patchHelper.Patch(ctx, ibmPowerVSMachinePool, v1beta1patch.WithOwnedV1Beta2Conditions{Conditions: []string{
+		infrav1.MachinePoolReadyV1Beta2Condition,
+		clusterv1beta1.PausedV1Beta2Condition,
+	}})
+}
+
+// SetupWithManager creates a new IBMPowerVSMachinePool controller for a manager.
+func (r *IBMPowerVSMachinePoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "ibmpowervsmachinepool")
+	clusterToIBMPowerVSMachinePools, err := util.ClusterToTypedObjectsMapper(mgr.GetClient(), &infrav1.IBMPowerVSMachinePoolList{}, mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.IBMPowerVSMachinePool{}).
+		WithEventFilter(predicates.ResourceHasFilterLabel(r.Scheme, predicateLog, r.WatchFilterValue)).
+		Watches(
+			&clusterv1.MachinePool{},
+			handler.EnqueueRequestsFromMapFunc(util.MachinePoolToInfrastructureMapFunc(ctx, infrav1.GroupVersion.WithKind("IBMPowerVSMachinePool"))),
+			builder.WithPredicates(predicates.ResourceIsChanged(r.Scheme, predicateLog)),
+		).
+		Watches(
+			&clusterv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(clusterToIBMPowerVSMachinePools),
+			builder.WithPredicates(predicates.All(r.Scheme, predicateLog,
+				predicates.ResourceIsChanged(r.Scheme, predicateLog),
+				predicates.ClusterPausedTransitionsOrInfrastructureProvisioned(r.Scheme, predicateLog),
+			)),
+		).
+		Complete(r)
+	if err != nil {
+		return fmt.Errorf("could not set up controller for IBMPowerVSMachinePool: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions" //nolint:staticcheck
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIBMPowerVSMachinePoolReconciler_ReconcileNormal(t *testing.T) {
+	var (
+		mockpowervs      *mock.MockPowerVS
+		mockCtrl         *gomock.Controller
+		reconciler       IBMPowerVSMachinePoolReconciler
+		machinePoolScope *scope.PowerVSMachinePoolScope
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		options.ProviderIDFormat = string(options.ProviderIDFormatV2)
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+		reconciler = IBMPowerVSMachinePoolReconciler{}
+		machinePoolScope = &scope.PowerVSMachinePoolScope{
+			Client:           fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newMachinePoolBootstrapSecret("capi-pool")).Build(),
+			IBMPowerVSClient: mockpowervs,
+			Cluster: &clusterv1.Cluster{
+				Status: clusterv1.ClusterStatus{
+					Initialization: clusterv1.ClusterInitializationStatus{InfrastructureProvisioned: ptr.To(true)},
+				},
+			},
+			MachinePool:       newMachinePool("capi-pool", 1),
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+			IBMPowerVSMachinePool: &infrav1.IBMPowerVSMachinePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-pool", Namespace: "default"},
+				Spec: infrav1.IBMPowerVSMachinePoolSpec{
+					Template: infrav1.IBMPowerVSMachineTemplateResource{
+						Spec: infrav1.IBMPowerVSMachineSpec{
+							Image:      &infrav1.IBMPowerVSResourceReference{ID: ptr.To("image-id")},
+							Network:    infrav1.IBMPowerVSResourceReference{ID: ptr.To("network-id")},
+							Processors: intstr.FromString("0.5"),
+							MemoryGiB:  4,
+						},
+					},
+				},
+			},
+		}
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should wait for the cluster infrastructure", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		machinePoolScope.Cluster.Status.Initialization.InfrastructureProvisioned = nil
+		result, err := reconciler.reconcileNormal(ctx, machinePoolScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(BeZero())
+		g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMPowerVSMachinePool, infrav1.InstancesReadyCondition)).To(Equal(infrav1.WaitingForClusterInfrastructureReason))
+	})
+
+	t.Run("Should wait for the bootstrap data", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		machinePoolScope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName = nil
+		result, err := reconciler.reconcileNormal(ctx, machinePoolScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(1 * time.Minute))
+		g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMPowerVSMachinePool, infrav1.InstancesReadyCondition)).To(Equal(infrav1.WaitingForBootstrapDataReason))
+	})
+
+	t.Run("Should create the missing instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{}, nil)
+		mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&models.PVMInstanceCreate{})).Return(&models.PVMInstanceList{}, nil)
+		result, err := reconciler.reconcileNormal(ctx, machinePoolScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+		g.Expect(machinePoolScope.IBMPowerVSMachinePool.Status.Ready).To(BeTrue())
+		g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMPowerVSMachinePool, infrav1.InstancesReadyCondition)).To(Equal(infrav1.InstancesScalingReason))
+	})
+
+	t.Run("Should fail to reconcile the instances", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllInstance().Return(nil, errors.New("failed to list instances"))
+		_, err := reconciler.reconcileNormal(ctx, machinePoolScope)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(machinePoolScope.IBMPowerVSMachinePool.Status.Ready).To(BeFalse())
+		g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMPowerVSMachinePool, infrav1.InstancesReadyCondition)).To(Equal(infrav1.InstancesReconciliationFailedReason))
+	})
+}
+
+func TestIBMPowerVSMachinePoolReconciler_ReconcileDelete(t *testing.T) {
+	var (
+		mockpowervs      *mock.MockPowerVS
+		mockCtrl         *gomock.Controller
+		reconciler       IBMPowerVSMachinePoolReconciler
+		machinePoolScope *scope.PowerVSMachinePoolScope
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		options.ProviderIDFormat = string(options.ProviderIDFormatV2)
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+		reconciler = IBMPowerVSMachinePoolReconciler{}
+		machinePoolScope = &scope.PowerVSMachinePoolScope{
+			IBMPowerVSClient: mockpowervs,
+			MachinePool:      newMachinePool("capi-pool", 1),
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "default"},
+			},
+			IBMPowerVSMachinePool: &infrav1.IBMPowerVSMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "capi-pool",
+					Namespace:  "default",
+					Finalizers: []string{infrav1.IBMPowerVSMachinePoolFinalizer},
+				},
+				Status: infrav1.IBMPowerVSMachinePoolStatus{Ready: true},
+			},
+		}
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	poolInstance := &models.PVMInstanceReference{
+		PvmInstanceID: ptr.To("instance-id"),
+		// The instance names are prefixed with the pool name and the hash of default/capi-cluster/capi-pool.
+		ServerName: ptr.To("capi-pool-76e2a531-00000000-aaaaa"),
+	}
+
+	t.Run("Should delete the instances and wait for them to be gone", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{PvmInstances: []*models.PVMInstanceReference{poolInstance}}, nil)
+		mockpowervs.EXPECT().DeleteInstance("instance-id").Return(nil)
+		result, err := reconciler.reconcileDelete(ctx, machinePoolScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(15 * time.Second))
+		g.Expect(machinePoolScope.IBMPowerVSMachinePool.Status.Ready).To(BeFalse())
+		g.Expect(machinePoolScope.IBMPowerVSMachinePool.Finalizers).To(ContainElement(infrav1.IBMPowerVSMachinePoolFinalizer))
+		g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMPowerVSMachinePool, infrav1.InstancesReadyCondition)).To(Equal(clusterv1beta1.DeletingReason))
+	})
+
+	t.Run("Should remove the finalizer once the instances are gone", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{}, nil)
+		result, err := reconciler.reconcileDelete(ctx, machinePoolScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(BeZero())
+		g.Expect(machinePoolScope.IBMPowerVSMachinePool.Finalizers).ToNot(ContainElement(infrav1.IBMPowerVSMachinePoolFinalizer))
+	})
+
+	t.Run("Should fail to delete the instances", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetAllInstance().Return(&models.PVMInstances{PvmInstances: []*models.PVMInstanceReference{poolInstance}}, nil)
+		mockpowervs.EXPECT().DeleteInstance("instance-id").Return(errors.New("failed to delete instance"))
+		_, err := reconciler.reconcileDelete(ctx, machinePoolScope)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(machinePoolScope.IBMPowerVSMachinePool.Finalizers).To(ContainElement(infrav1.IBMPowerVSMachinePoolFinalizer))
+		g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMPowerVSMachinePool, infrav1.InstancesReadyCondition)).To(Equal(clusterv1beta1.DeletionFailedReason))
+	})
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"         //nolint:staticcheck
+	v1beta2conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions/v1beta2" //nolint:staticcheck
+	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"                   //nolint:staticcheck
+	"sigs.k8s.io/cluster-api/util/deprecated/v1beta1/paused"
+	"sigs.k8s.io/cluster-api/util/finalizers"
+	"sigs.k8s.io/cluster-api/util/predicates"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+)
+
+// snapshotRequeueAfter is the interval the progress of a snapshot or of the capture of an instance is checked at.
+const snapshotRequeueAfter = 30 * time.Second
+
+// IBMPowerVSMachineSnapshotReconciler reconciles a IBMPowerVSMachineSnapshot object.
+type IBMPowerVSMachineSnapshotReconciler struct {
+	client.Client
+	Recorder        record.EventRecorder
+	ServiceEndpoint []endpoints.ServiceEndpoint
+	Scheme          *runtime.Scheme
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsmachinesnapshots,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsmachinesnapshots/status,verbs=get;update;patch
+
+// Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMPowerVSMachineSnapshot.
+func (r *IBMPowerVSMachineSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.Info("Reconciling IBMPowerVSMachineSnapshot")
+	defer log.Info("Finished reconciling IBMPowerVSMachineSnapshot")
+
+	// Fetch the IBMPowerVSMachineSnapshot instance.
+	ibmPowerVSMachineSnapshot := &infrav1.IBMPowerVSMachineSnapshot{}
+	if err := r.Client.Get(ctx, req.NamespacedName, ibmPowerVSMachineSnapshot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("IBMPowerVSMachineSnapshot not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to get IBMPowerVSMachineSnapshot: %w", err)
+	}
+
+	// Add finalizer first if not set to avoid the race condition between init and delete.
+	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, ibmPowerVSMachineSnapshot, infrav1.IBMPowerVSMachineSnapshotFinalizer); err != nil || finalizerAdded {
+		return ctrl.Result{}, err
+	}
+	deleting := !ibmPowerVSMachineSnapshot.DeletionTimestamp.IsZero()
+
+	// Fetch the IBMPowerVSMachine, the snapshot outlives the machine once it is created.
+	ibmPowerVSMachine := &infrav1.IBMPowerVSMachine{}
+	ibmPowerVSMachineName := client.ObjectKey{
+		Namespace: ibmPowerVSMachineSnapshot.Namespace,
+		Name:      ibmPowerVSMachineSnapshot.Spec.MachineName,
+	}
+	if err := r.Client.Get(ctx, ibmPowerVSMachineName, ibmPowerVSMachine); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to get IBMPowerVSMachine: %w", err)
+		}
+		ibmPowerVSMachine = nil
+	}
+	if ibmPowerVSMachine == nil && ibmPowerVSMachineSnapshot.Status.SnapshotID == "" && !deleting {
+		log.Info("IBMPowerVSMachine not found", "IBMPowerVSMachine", ibmPowerVSMachineName.Name)
+		v1beta1conditions.MarkFalse(ibmPowerVSMachineSnapshot, infrav1.SnapshotReadyCondition, infrav1.WaitingForIBMPowerVSMachineReason, clusterv1beta1.ConditionSeverityWarning, "IBMPowerVSMachine %s not found", ibmPowerVSMachineName.Name)
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, r.patch(ctx, ibmPowerVSMachineSnapshot)
+	}
+
+	// Fetch the Cluster, from the label of the snapshot once the machine is deleted.
+	clusterMetadata := ibmPowerVSMachineSnapshot.ObjectMeta
+	if ibmPowerVSMachine != nil {
+		clusterMetadata = ibmPowerVSMachine.ObjectMeta
+	}
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, clusterMetadata)
+	if err != nil && !deleting {
+		log.Info("IBMPowerVSMachineSnapshot is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	var ibmPowerVSCluster *infrav1.IBMPowerVSCluster
+	if cluster != nil {
+		log = log.WithValues("Cluster", klog.KObj(cluster))
+		ctx = ctrl.LoggerInto(ctx, log)
+
+		if isPaused, requeue, err := paused.EnsurePausedCondition(ctx, r.Client, cluster, ibmPowerVSMachineSnapshot); err != nil || isPaused || requeue {
+			return ctrl.Result{}, err
+		}
+
+		// Fetch the IBMPowerVSCluster, externally managed clusters might not be available during snapshot deletion.
+		ibmPowerVSCluster = &infrav1.IBMPowerVSCluster{}
+		ibmPowerVSClusterName := client.ObjectKey{
+			Namespace: ibmPowerVSMachineSnapshot.Namespace,
+			Name:      cluster.Spec.InfrastructureRef.Name,
+		}
+		if err := r.Client.Get(ctx, ibmPowerVSClusterName, ibmPowerVSCluster); err != nil {
+			if !deleting {
+				log.Info("IBMPowerVSCluster is not available yet")
+				return ctrl.Result{}, fmt.Errorf("failed to get IBMPowerVSCluster: %w", err)
+			}
+			ibmPowerVSCluster = nil
+		}
+	} else if !deleting {
+		log.Info(fmt.Sprintf("Please associate the IBMPowerVSMachine of this snapshot with a cluster using the label %s: <name of cluster>", clusterv1.ClusterNameLabel))
+		return ctrl.Result{}, nil
+	}
+
+	// Initialize the patch helper
+	patchHelper, err := v1beta1patch.NewHelper(ibmPowerVSMachineSnapshot, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to init patch helper: %w", err)
+	}
+
+	// Always attempt to Patch the IBMPowerVSMachineSnapshot object and status after each reconciliation.
+	defer func() {
+		if err := patchIBMPowerVSMachineSnapshot(ctx, patchHelper, ibmPowerVSMachineSnapshot); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	// Label the snapshot with the cluster of the machine to find the cluster once the machine is deleted.
+	if cluster != nil {
+		if ibmPowerVSMachineSnapshot.Labels == nil {
+			ibmPowerVSMachineSnapshot.Labels = make(map[string]string)
+		}
+		ibmPowerVSMachineSnapshot.Labels[clusterv1.ClusterNameLabel] = cluster.Name
+	}
+
+	// Without a snapshot there is nothing to delete, so the finalizer is removed without the scope, which can't be created
+	// once the cluster is gone.
+	if deleting && ibmPowerVSMachineSnapshot.Status.SnapshotID == "" {
+		log.Info("Snapshot is not yet created, hence not invoking the PowerVS API to delete the snapshot")
+		controllerutil.RemoveFinalizer(ibmPowerVSMachineSnapshot, infrav1.IBMPowerVSMachineSnapshotFinalizer)
+		return ctrl.Result{}, nil
+	}
+
+	// Create the machine snapshot scope.
+	snapshotScope, err := scope.NewPowerVSMachineSnapshotScope(ctx, scope.PowerVSMachineSnapshotScopeParams{
+		Client:                    r.Client,
+		Logger:                    log,
+		IBMPowerVSCluster:         ibmPowerVSCluster,
+		IBMPowerVSMachine:         ibmPowerVSMachine,
+		IBMPowerVSMachineSnapshot: ibmPowerVSMachineSnapshot,
+		ServiceEndpoint:           r.ServiceEndpoint,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create IBMPowerVS machine snapshot scope: %w", err)
+	}
+
+	// Handle deleted machine snapshots.
+	if deleting {
+		return r.reconcileDelete(ctx, snapshotScope)
+	}
+
+	// Handle non-deleted machine snapshots.
+	return r.reconcileNormal(ctx, snapshotScope)
+}
+
+func (r *IBMPowerVSMachineSnapshotReconciler) reconcileNormal(ctx context.Context, snapshotScope *scope.PowerVSMachineSnapshotScope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	snapshot := snapshotScope.IBMPowerVSMachineSnapshot
+
+	if snapshotScope.Expired() {
+		log.Info("Snapshot retention period expired, deleting IBMPowerVSMachineSnapshot", "expirationTime", snapshot.Status.ExpirationTime)
+		if err := r.Client.Delete(ctx, snapshot); err != nil && !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, fmt.Errorf("failed to delete expired IBMPowerVSMachineSnapshot %v: %w", klog.KObj(snapshot), err)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if snapshot.Status.SnapshotID == "" && snapshotScope.IBMPowerVSMachine.Status.InstanceID == "" {
+		log.Info("Waiting for the instance of the IBMPowerVSMachine to be created")
+		v1beta1conditions.MarkFalse(snapshot, infrav1.SnapshotReadyCondition, infrav1.WaitingForIBMPowerVSMachineReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: snapshotRequeueAfter}, nil
+	}
+
+	requeue, err := snapshotScope.ReconcileSnapshot(ctx)
+	if err != nil {
+		snapshotScope.SetNotReady()
+		switch {
+		case errors.Is(err, scope.ErrSnapshotFailed):
+			v1beta1conditions.MarkFalse(snapshot, infrav1.SnapshotReadyCondition, infrav1.SnapshotFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			return ctrl.Result{}, nil
+		case errors.Is(err, scope.ErrImageCaptureFailed):
+			v1beta1conditions.MarkFalse(snapshot, infrav1.SnapshotReadyCondition, infrav1.ImageCaptureFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			return ctrl.Result{}, nil
+		}
+		v1beta1conditions.MarkFalse(snapshot, infrav1.SnapshotReadyCondition, infrav1.SnapshotReconciliationFailedReason, clusterv1beta1.ConditionSeverityWarning, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile snapshot for IBMPowerVSMachineSnapshot %v: %w", klog.KObj(snapshot), err)
+	}
+	if requeue {
+		snapshotScope.SetNotReady()
+		v1beta1conditions.MarkFalse(snapshot, infrav1.SnapshotReadyCondition, infrav1.SnapshotInProgressReason, clusterv1beta1.ConditionSeverityInfo, "%d%% complete", snapshot.Status.PercentComplete)
+		return ctrl.Result{RequeueAfter: snapshotRequeueAfter}, nil
+	}
+
+	snapshotScope.SetReady()
+	v1beta1conditions.MarkTrue(snapshot, infrav1.SnapshotReadyCondition)
+
+	// Requeue to delete the snapshot once its retention period expires.
+	if snapshot.Status.ExpirationTime != nil {
+		return ctrl.Result{RequeueAfter: time.Until(snapshot.Status.ExpirationTime.Time)}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *IBMPowerVSMachineSnapshotReconciler) reconcileDelete(ctx context.Context, snapshotScope *scope.PowerVSMachineSnapshotScope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Handling deleted IBMPowerVSMachineSnapshot")
+	snapshot := snapshotScope.IBMPowerVSMachineSnapshot
+
+	snapshotScope.SetNotReady()
+	v1beta1conditions.MarkFalse(snapshot, infrav1.SnapshotReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
+
+	requeue, err := snapshotScope.DeleteSnapshot(ctx)
+	if err != nil {
+		v1beta1conditions.MarkFalse(snapshot, infrav1.SnapshotReadyCondition, clusterv1beta1.DeletionFailedReason, clusterv1beta1.ConditionSeverityWarning, "")
+		return ctrl.Result{}, fmt.Errorf("error deleting snapshot of IBMPowerVSMachineSnapshot %v: %w", klog.KObj(snapshot), err)
+	}
+	if requeue {
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	controllerutil.RemoveFinalizer(snapshot, infrav1.IBMPowerVSMachineSnapshotFinalizer)
+	return ctrl.Result{}, nil
+}
+
+// patch persists the IBMPowerVSMachineSnapshot before a scope is created.
+func (r *IBMPowerVSMachineSnapshotReconciler) patch(ctx context.Context, ibmPowerVSMachineSnapshot *infrav1.IBMPowerVSMachineSnapshot) error {
+	patchHelper, err := v1beta1patch.NewHelper(ibmPowerVSMachineSnapshot, r.Client)
+	if err != nil {
+		return fmt.Errorf("failed to init patch helper: %w", err)
+	}
+	return patchIBMPowerVSMachineSnapshot(ctx, patchHelper, ibmPowerVSMachineSnapshot)
+}
+
+func patchIBMPowerVSMachineSnapshot(ctx context.Context, patchHelper *v1beta1patch.Helper, ibmPowerVSMachineSnapshot *infrav1.IBMPowerVSMachineSnapshot) error {
+	v1beta1conditions.SetSummary(ibmPowerVSMachineSnapshot,
+		v1beta1conditions.WithConditions(
+			infrav1.SnapshotReadyCondition,
+		),
+	)
+
+	// Mirror the summary Ready condition to the v1beta2 Ready condition.
+	switch {
+	case !ibmPowerVSMachineSnapshot.DeletionTimestamp.IsZero():
+		v1beta2conditions.Set(ibmPowerVSMachineSnapshot, metav1.Condition{
+			Type:   infrav1.MachineSnapshotReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.MachineSnapshotDeletingV1Beta2Reason,
+		})
+	case v1beta1conditions.IsTrue(ibmPowerVSMachineSnapshot, clusterv1beta1.ReadyCondition):
+		v1beta2conditions.Set(ibmPowerVSMachineSnapshot, metav1.Condition{
+			Type:   infrav1.MachineSnapshotReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.MachineSnapshotReadyV1Beta2Reason,
+		})
+	default:
+		v1beta2conditions.Set(ibmPowerVSMachineSnapshot, metav1.Condition{
+			Type:    infrav1.MachineSnapshotReadyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.MachineSnapshotNotReadyV1Beta2Reason,
+			Message: v1beta1conditions.GetMessage(ibmPowerVSMachineSnapshot, clusterv1beta1.ReadyCondition),
+		})
+	}
+
+	// Patch the IBMPowerVSMachineSnapshot resource.
+	return patchHelper.Patch(ctx, ibmPowerVSMachineSnapshot, v1beta1patch.WithOwnedV1Beta2Conditions{Conditions: []string{
+		infrav1.MachineSnapshotReadyV1Beta2Condition,
+		clusterv1beta1.PausedV1Beta2Condition,
+	}})
+}
+
+// SetupWithManager creates a new IBMPowerVSMachineSnapshot controller for a manager.
+func (r *IBMPowerVSMachineSnapshotReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "ibmpowervsmachinesnapshot")
+	clusterToIBMPowerVSMachineSnapshots, err := util.ClusterToTypedObjectsMapper(mgr.GetClient(), &infrav1.IBMPowerVSMachineSnapshotList{}, mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.IBMPowerVSMachineSnapshot{}).
+		WithEventFilter(predicates.ResourceHasFilterLabel(r.Scheme, predicateLog, r.WatchFilterValue)).
+		Watches(
+			&clusterv1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(clusterToIBMPowerVSMachineSnapshots),
+			builder.WithPredicates(predicates.All(r.Scheme, predicateLog,
+				predicates.ResourceIsChanged(r.Scheme, predicateLog),
+				predicates.ClusterUnpaused(r.Scheme, predicateLog),
+			)),
+		).
+		Complete(r)
+	if err != nil {
+		return fmt.Errorf("could not set up controller for IBMPowerVSMachineSnapshot: %w", err)
+	}
+	return nil
+}
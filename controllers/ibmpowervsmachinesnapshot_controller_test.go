@@ -0,0 +1,220 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/go-openapi/strfmt"
+	"go.uber.org/mock/gomock"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"                      //nolint:staticcheck
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions" //nolint:staticcheck
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIBMPowerVSMachineSnapshotReconciler_ReconcileNormal(t *testing.T) {
+	var (
+		mockpowervs   *mock.MockPowerVS
+		mockCtrl      *gomock.Controller
+		reconciler    IBMPowerVSMachineSnapshotReconciler
+		snapshotScope *scope.PowerVSMachineSnapshotScope
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+		snapshot := &infrav1.IBMPowerVSMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "snapshot", Namespace: "default", Finalizers: []string{infrav1.IBMPowerVSMachineSnapshotFinalizer}},
+			Spec:       infrav1.IBMPowerVSMachineSnapshotSpec{MachineName: "machine"},
+		}
+		reconciler = IBMPowerVSMachineSnapshotReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects([]client.Object{snapshot}...).Build(),
+		}
+		snapshotScope = &scope.PowerVSMachineSnapshotScope{
+			IBMPowerVSClient: mockpowervs,
+			IBMPowerVSMachine: &infrav1.IBMPowerVSMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"},
+			},
+			IBMPowerVSMachineSnapshot: snapshot,
+		}
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should wait for the instance of the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		result, err := reconciler.reconcileNormal(ctx, snapshotScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(snapshotRequeueAfter))
+		g.Expect(v1beta1conditions.GetReason(snapshotScope.IBMPowerVSMachineSnapshot, infrav1.SnapshotReadyCondition)).To(Equal(infrav1.WaitingForIBMPowerVSMachineReason))
+	})
+
+	t.Run("Should requeue while the snapshot is in progress", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		snapshotScope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(&models.Snapshot{Status: "creating", PercentComplete: 60}, nil)
+		result, err := reconciler.reconcileNormal(ctx, snapshotScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(snapshotRequeueAfter))
+		g.Expect(snapshotScope.IBMPowerVSMachineSnapshot.Status.Ready).To(BeFalse())
+		g.Expect(v1beta1conditions.GetReason(snapshotScope.IBMPowerVSMachineSnapshot, infrav1.SnapshotReadyCondition)).To(Equal(infrav1.SnapshotInProgressReason))
+	})
+
+	t.Run("Should not requeue a failed snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		snapshotScope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(&models.Snapshot{Status: "error"}, nil)
+		result, err := reconciler.reconcileNormal(ctx, snapshotScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(BeZero())
+		g.Expect(v1beta1conditions.GetReason(snapshotScope.IBMPowerVSMachineSnapshot, infrav1.SnapshotReadyCondition)).To(Equal(infrav1.SnapshotFailedReason))
+		g.Expect(v1beta1conditions.GetSeverity(snapshotScope.IBMPowerVSMachineSnapshot, infrav1.SnapshotReadyCondition)).To(HaveValue(Equal(clusterv1beta1.ConditionSeverityError)))
+	})
+
+	t.Run("Should be ready once the snapshot is available and requeue until it expires", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		snapshotScope.IBMPowerVSMachineSnapshot.Spec.RetentionPeriod = &metav1.Duration{Duration: time.Hour}
+		snapshotScope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(&models.Snapshot{Status: "available", PercentComplete: 100, CreationDate: strfmt.DateTime(time.Now())}, nil)
+		result, err := reconciler.reconcileNormal(ctx, snapshotScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+		g.Expect(snapshotScope.IBMPowerVSMachineSnapshot.Status.Ready).To(BeTrue())
+		g.Expect(v1beta1conditions.IsTrue(snapshotScope.IBMPowerVSMachineSnapshot, infrav1.SnapshotReadyCondition)).To(BeTrue())
+	})
+
+	t.Run("Should delete the snapshot once its retention period expires", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		snapshotScope.IBMPowerVSMachineSnapshot.Status.SnapshotID = "snapshot-id"
+		snapshotScope.IBMPowerVSMachineSnapshot.Status.ExpirationTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+		_, err := reconciler.reconcileNormal(ctx, snapshotScope)
+		g.Expect(err).To(BeNil())
+		snapshot := &infrav1.IBMPowerVSMachineSnapshot{}
+		err = reconciler.Client.Get(ctx, client.ObjectKeyFromObject(snapshotScope.IBMPowerVSMachineSnapshot), snapshot)
+		g.Expect(err).To(BeNil())
+		g.Expect(snapshot.DeletionTimestamp.IsZero()).To(BeFalse())
+	})
+}
+
+func TestIBMPowerVSMachineSnapshotReconciler_ReconcileDelete(t *testing.T) {
+	var (
+		mockpowervs   *mock.MockPowerVS
+		mockCtrl      *gomock.Controller
+		reconciler    IBMPowerVSMachineSnapshotReconciler
+		snapshotScope *scope.PowerVSMachineSnapshotScope
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+		reconciler = IBMPowerVSMachineSnapshotReconciler{}
+		snapshotScope = &scope.PowerVSMachineSnapshotScope{
+			IBMPowerVSClient: mockpowervs,
+			IBMPowerVSMachineSnapshot: &infrav1.IBMPowerVSMachineSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: "snapshot", Namespace: "default", Finalizers: []string{infrav1.IBMPowerVSMachineSnapshotFinalizer}},
+				Status:     infrav1.IBMPowerVSMachineSnapshotStatus{SnapshotID: "snapshot-id"},
+			},
+		}
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should requeue while the snapshot is being deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(&models.Snapshot{Status: "available"}, nil)
+		mockpowervs.EXPECT().DeleteSnapshot("snapshot-id").Return(nil)
+		result, err := reconciler.reconcileDelete(ctx, snapshotScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).ToNot(BeZero())
+		g.Expect(snapshotScope.IBMPowerVSMachineSnapshot.Finalizers).To(ContainElement(infrav1.IBMPowerVSMachineSnapshotFinalizer))
+	})
+
+	t.Run("Should remove the finalizer once the snapshot is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(nil, errors.New("snapshot does not exist. ID: snapshot-id"))
+		_, err := reconciler.reconcileDelete(ctx, snapshotScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(snapshotScope.IBMPowerVSMachineSnapshot.Finalizers).ToNot(ContainElement(infrav1.IBMPowerVSMachineSnapshotFinalizer))
+	})
+
+	t.Run("Should keep the finalizer if the snapshot fails to delete", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockpowervs.EXPECT().GetSnapshot("snapshot-id").Return(nil, errors.New("internal error"))
+		_, err := reconciler.reconcileDelete(ctx, snapshotScope)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(snapshotScope.IBMPowerVSMachineSnapshot.Finalizers).To(ContainElement(infrav1.IBMPowerVSMachineSnapshotFinalizer))
+	})
+}
+
+func TestIBMPowerVSMachineSnapshotReconciler_Reconcile(t *testing.T) {
+	t.Run("Should remove the finalizer of a deleted snapshot which was not created once the cluster is gone", func(t *testing.T) {
+		g := NewWithT(t)
+		snapshot := &infrav1.IBMPowerVSMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "snapshot",
+				Namespace:         "default",
+				Finalizers:        []string{infrav1.IBMPowerVSMachineSnapshotFinalizer},
+				DeletionTimestamp: &metav1.Time{Time: time.Now()},
+			},
+			Spec: infrav1.IBMPowerVSMachineSnapshotSpec{MachineName: "machine"},
+		}
+		reconciler := &IBMPowerVSMachineSnapshotReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(snapshot).WithStatusSubresource(snapshot).Build(),
+		}
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(snapshot)})
+		g.Expect(err).To(BeNil())
+		err = reconciler.Client.Get(ctx, client.ObjectKeyFromObject(snapshot), &infrav1.IBMPowerVSMachineSnapshot{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"regexp"
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
@@ -40,6 +41,9 @@ import (
 // defaultSMT is the default value of simultaneous multithreading.
 const defaultSMT = 8
 
+// sapProfileRegex matches the ID of a SAP profile, <family>-<cores>x<memory in GiB>.
+var sapProfileRegex = regexp.MustCompile(`^[a-z0-9]+-([0-9]+)x([0-9]+)$`)
+
 // IBMPowerVSMachineTemplateReconciler reconciles a IBMPowerVSMachineTemplate object.
 type IBMPowerVSMachineTemplateReconciler struct {
 	client.Client
@@ -89,7 +93,13 @@ func (r *IBMPowerVSMachineTemplateReconciler) Reconcile(ctx context.Context, req
 	return ctrl.Result{}, nil
 }
 
+// getIBMPowerVSMachineCapacity returns the CPU and memory capacity of the instances created from the machine template.
+// The deployment target and the boot volume storage options only choose the host of the instance and the storage pool of its boot volume,
+// an instance gets the same processors and memory on a dedicated host and on any storage, so they do not change the capacity.
 func getIBMPowerVSMachineCapacity(machineTemplate infrav1.IBMPowerVSMachineTemplate) (corev1.ResourceList, error) {
+	if profile := machineTemplate.Spec.Template.Spec.Profile; profile != "" {
+		return getSAPProfileCapacity(profile)
+	}
 	capacity := make(corev1.ResourceList)
 	memory := strconv.FormatInt(int64(machineTemplate.Spec.Template.Spec.MemoryGiB), 10)
 	capacity[corev1.ResourceMemory] = resource.MustParse(fmt.Sprintf("%sG", memory))
@@ -118,3 +128,20 @@ func getIBMPowerVSMachineCapacity(machineTemplate infrav1.IBMPowerVSMachineTempl
 	capacity[corev1.ResourceCPU] = resource.MustParse(virtualProcessors)
 	return capacity, nil
 }
+
+// getSAPProfileCapacity returns the capacity of an instance with the SAP profile, whose dedicated cores and memory are part of its ID.
+// e.g. ush1-4x128 has 4 cores, seen as 4 * SMT = 32 cpus in OS, and 128 GiB of memory.
+func getSAPProfileCapacity(profile string) (corev1.ResourceList, error) {
+	match := sapProfileRegex.FindStringSubmatch(profile)
+	if match == nil {
+		return nil, fmt.Errorf("invalid SAP profile %s, expected <family>-<cores>x<memory>", profile)
+	}
+	cores, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(strconv.FormatInt(cores*defaultSMT, 10)),
+		corev1.ResourceMemory: resource.MustParse(fmt.Sprintf("%sG", match[2])),
+	}, nil
+}
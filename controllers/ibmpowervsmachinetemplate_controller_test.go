@@ -157,6 +157,27 @@ func TestGetIBMPowerVSMachineCapacity(t *testing.T) {
 				corev1.ResourceMemory: resource.MustParse("8G"),
 			},
 		},
+		{
+			name: "with SAP profile",
+			powerVSMachineTemplate: func() infrav1.IBMPowerVSMachineTemplate {
+				machineTemplate := stubPowerVSMachineTemplate(intstr.IntOrString{}, 0)
+				machineTemplate.Spec.Template.Spec.Profile = "ush1-4x128"
+				return *machineTemplate
+			}(),
+			expectedCapacity: map[corev1.ResourceName]resource.Quantity{
+				corev1.ResourceCPU:    resource.MustParse("32"),
+				corev1.ResourceMemory: resource.MustParse("128G"),
+			},
+		},
+		{
+			name: "with invalid SAP profile",
+			powerVSMachineTemplate: func() infrav1.IBMPowerVSMachineTemplate {
+				machineTemplate := stubPowerVSMachineTemplate(intstr.IntOrString{}, 0)
+				machineTemplate.Spec.Template.Spec.Profile = "ush1"
+				return *machineTemplate
+			}(),
+			expectErr: true,
+		},
 		{
 			name:                   "with invalid cpu",
 			powerVSMachineTemplate: *stubPowerVSMachineTemplate(intstr.FromString("invalid_cpu"), 8),
@@ -182,6 +203,51 @@ func TestGetIBMPowerVSMachineCapacity(t *testing.T) {
 	}
 }
 
+func TestGetIBMPowerVSMachineCapacityWithPlacement(t *testing.T) {
+	sapProfileTemplate := stubPowerVSMachineTemplate(intstr.IntOrString{}, 0)
+	sapProfileTemplate.Spec.Template.Spec.Profile = "ush1-4x128"
+	dedicatedTemplate := stubPowerVSMachineTemplate(intstr.FromInt(2), 16)
+	dedicatedTemplate.Spec.Template.Spec.ProcessorType = infrav1.PowerVSProcessorTypeDedicated
+	machineTemplates := map[string]*infrav1.IBMPowerVSMachineTemplate{
+		"shared processors":    stubPowerVSMachineTemplate(intstr.FromString("0.5"), 4),
+		"dedicated processors": dedicatedTemplate,
+		"SAP profile":          sapProfileTemplate,
+	}
+	placements := map[string]func(*infrav1.IBMPowerVSMachineSpec){
+		"dedicated host": func(spec *infrav1.IBMPowerVSMachineSpec) {
+			spec.DeploymentTarget = &infrav1.PowerVSDeploymentTarget{Type: infrav1.PowerVSDeploymentTargetTypeHost, ID: "host-id"}
+		},
+		"dedicated host group": func(spec *infrav1.IBMPowerVSMachineSpec) {
+			spec.DeploymentTarget = &infrav1.PowerVSDeploymentTarget{Type: infrav1.PowerVSDeploymentTargetTypeHostGroup, ID: "host-group-id"}
+		},
+		"storage type and pool": func(spec *infrav1.IBMPowerVSMachineSpec) {
+			spec.StorageType = "tier1"
+			spec.StoragePool = "pool"
+		},
+		"storage affinity": func(spec *infrav1.IBMPowerVSMachineSpec) {
+			spec.StorageAffinity = &infrav1.PowerVSStorageAffinity{Policy: infrav1.PowerVSVolumeAffinityPolicyAntiAffinity, Instances: []string{"instance"}}
+		},
+	}
+
+	// The deployment target and the boot volume storage only place the instance, so its capacity is the one of the same template without them.
+	for templateName, machineTemplate := range machineTemplates {
+		expectedCapacity, err := getIBMPowerVSMachineCapacity(*machineTemplate)
+		if err != nil {
+			t.Fatalf("getIBMPowerVSMachineCapacity is not expected to return an error, error: %v", err)
+		}
+		for placementName, setPlacement := range placements {
+			t.Run(fmt.Sprintf("%s on %s", templateName, placementName), func(t *testing.T) {
+				g := NewWithT(t)
+				placedTemplate := machineTemplate.DeepCopy()
+				setPlacement(&placedTemplate.Spec.Template.Spec)
+				capacity, err := getIBMPowerVSMachineCapacity(*placedTemplate)
+				g.Expect(err).To(BeNil())
+				g.Expect(capacity).To(Equal(expectedCapacity))
+			})
+		}
+	}
+}
+
 func stubPowerVSMachineTemplate(processor intstr.IntOrString, memory int32) *infrav1.IBMPowerVSMachineTemplate {
 	return &infrav1.IBMPowerVSMachineTemplate{
 		ObjectMeta: metav1.ObjectMeta{
@@ -19,6 +19,9 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -31,6 +34,7 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -49,6 +53,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	capibmrecord "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
 
 // IBMVPCClusterReconciler reconciles a IBMVPCCluster object.
@@ -58,11 +63,21 @@ type IBMVPCClusterReconciler struct {
 	Recorder        record.EventRecorder
 	ServiceEndpoint []endpoints.ServiceEndpoint
 	Scheme          *runtime.Scheme
+
+	ClientFactory scope.ClientFactory
 }
 
+// reportedDrift holds the Security Group Rules drift last reported for each cluster, keyed by namespace/name.
+var reportedDrift sync.Map
+
+// maxReportedDrift is the number of Security Group Rules drift items listed in the drift message, the others are only counted.
+const maxReportedDrift = 5
+
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcclusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcclusters/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmcloudclusteridentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 // Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMVPCCluster.
 func (r *IBMVPCClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -109,16 +124,6 @@ func (r *IBMVPCClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, err
 	}
 
-	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
-		Client:          r.Client,
-		Cluster:         cluster,
-		IBMVPCCluster:   ibmVPCCluster,
-		ServiceEndpoint: r.ServiceEndpoint,
-	})
-	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
-	}
-
 	// Initialize the patch helper.
 	patchHelper, err := v1beta1patch.NewHelper(ibmVPCCluster, r.Client)
 	if err != nil {
@@ -132,6 +137,23 @@ func (r *IBMVPCClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		}
 	}()
 
+	// Check the credentials before creating the IBM Cloud clients of the scope.
+	if ibmVPCCluster.DeletionTimestamp.IsZero() {
+		if err := reconcileCredentials(ctx, r.Client, r.ClientFactory, ibmVPCCluster, ibmVPCCluster.Spec.IdentityRef); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	clusterScope, err := scope.NewClusterScope(scope.ClusterScopeParams{
+		Client:          r.Client,
+		Cluster:         cluster,
+		IBMVPCCluster:   ibmVPCCluster,
+		ServiceEndpoint: r.ServiceEndpoint,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
+	}
+
 	// Handle deleted clusters.
 	if !ibmVPCCluster.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, clusterScope)
@@ -172,17 +194,6 @@ func (r *IBMVPCClusterReconciler) reconcileV2(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
-	clusterScope, err := scope.NewVPCClusterScope(scope.VPCClusterScopeParams{
-		Client:          r.Client,
-		Logger:          log,
-		Cluster:         cluster,
-		IBMVPCCluster:   ibmVPCCluster,
-		ServiceEndpoint: r.ServiceEndpoint,
-	})
-	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
-	}
-
 	// Initialize the patch helper.
 	patchHelper, err := v1beta1patch.NewHelper(ibmVPCCluster, r.Client)
 	if err != nil {
@@ -196,9 +207,28 @@ func (r *IBMVPCClusterReconciler) reconcileV2(ctx context.Context, req ctrl.Requ
 		}
 	}()
 
+	// Check the credentials before creating the IBM Cloud clients of the scope.
+	if ibmVPCCluster.DeletionTimestamp.IsZero() {
+		if err := reconcileCredentials(ctx, r.Client, r.ClientFactory, ibmVPCCluster, ibmVPCCluster.Spec.IdentityRef); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	clusterScope, err := scope.NewVPCClusterScope(scope.VPCClusterScopeParams{
+		Client:             r.Client,
+		Logger:             log,
+		Cluster:            cluster,
+		IBMVPCCluster:      ibmVPCCluster,
+		ServiceEndpoint:    r.ServiceEndpoint,
+		DNSServicesFactory: r.ClientFactory.DNSServicesFactory,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
+	}
+
 	// Handle deleted clusters.
 	if !ibmVPCCluster.DeletionTimestamp.IsZero() {
-		return r.reconcileDeleteV2(clusterScope)
+		return r.reconcileDeleteV2(ctx, clusterScope)
 	}
 
 	return r.reconcileCluster(ctx, clusterScope)
@@ -301,6 +331,58 @@ func (r *IBMVPCClusterReconciler) reconcile(ctx context.Context, clusterScope *s
 	return ctrl.Result{}, nil
 }
 
+// reconcileSecurityGroupRulesDrift reports the Security Group Rules drift found while enforcing the defined Security Group Rules.
+// The drift is not reported in Additive mode, where the Security Group Rules which are not defined are kept.
+// The drift event is only emitted when the drift differs from the one last reported for the cluster.
+func (r *IBMVPCClusterReconciler) reconcileSecurityGroupRulesDrift(clusterScope *scope.VPCClusterScope, drift []string) {
+	key := client.ObjectKeyFromObject(clusterScope.IBMVPCCluster).String()
+	if clusterScope.NetworkSpec().SecurityGroupRuleReconcileMode != infrav1.VPCSecurityGroupRuleReconcileModeEnforce {
+		reportedDrift.Delete(key)
+		// Remove the condition reported before switching back from Enforce mode.
+		v1beta1conditions.Delete(clusterScope.IBMVPCCluster, infrav1.VPCSecurityGroupRulesSyncedCondition)
+		v1beta2conditions.Delete(clusterScope.IBMVPCCluster, infrav1.VPCSecurityGroupRulesSyncedV1Beta2Condition)
+		return
+	}
+	if len(drift) == 0 {
+		reportedDrift.Delete(key)
+		v1beta1conditions.MarkTrue(clusterScope.IBMVPCCluster, infrav1.VPCSecurityGroupRulesSyncedCondition)
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.VPCSecurityGroupRulesSyncedV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.VPCSecurityGroupRulesSyncedV1Beta2Reason,
+		})
+		return
+	}
+
+	drift = slices.Sorted(slices.Values(drift))
+	msg := securityGroupRulesDriftMessage(drift)
+	if securityGroupRulesDriftChanged(key, drift) {
+		capibmrecord.Warnf(clusterScope.IBMVPCCluster, "SecurityGroupRulesDrifted", "Security group rules drifted - %s", msg)
+	}
+	v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.VPCSecurityGroupRulesSyncedCondition, infrav1.VPCSecurityGroupRulesDriftedReason, clusterv1beta1.ConditionSeverityWarning, "%s", msg)
+	v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+		Type:    infrav1.VPCSecurityGroupRulesSyncedV1Beta2Condition,
+		Status:  metav1.ConditionFalse,
+		Reason:  infrav1.VPCSecurityGroupRulesDriftedV1Beta2Reason,
+		Message: msg,
+	})
+}
+
+// securityGroupRulesDriftChanged records the sorted Security Group Rules drift of a cluster, and returns whether it differs from the one last recorded.
+func securityGroupRulesDriftChanged(key string, drift []string) bool {
+	driftSet := strings.Join(drift, "\n")
+	reported, ok := reportedDrift.Swap(key, driftSet)
+	return !ok || reported != driftSet
+}
+
+// securityGroupRulesDriftMessage summarizes the sorted Security Group Rules drift, listing at most maxReportedDrift items.
+func securityGroupRulesDriftMessage(drift []string) string {
+	if len(drift) <= maxReportedDrift {
+		return strings.Join(drift, "; ")
+	}
+	return fmt.Sprintf("%s; and %d more", strings.Join(drift[:maxReportedDrift], "; "), len(drift)-maxReportedDrift)
+}
+
 func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterScope *scope.VPCClusterScope) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	// If the IBMVPCCluster doesn't have our finalizer, add it.
@@ -380,9 +462,15 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 		Reason: infrav1.VPCSubnetReadyV1Beta2Reason,
 	})
 
+	// Populate the cluster's Failure Domains, based on the zones of the VPC Subnets.
+	if err := clusterScope.ReconcileFailureDomains(); err != nil {
+		return reconcile.Result{}, fmt.Errorf("error reconciling failure domains: %w", err)
+	}
+
 	// Reconcile the cluster's Security Groups (and Security Group Rules)
 	log.Info("Reconciling Security Groups")
-	if requeue, err := clusterScope.ReconcileSecurityGroups(ctx); err != nil {
+	requeue, securityGroupRulesDrift, err := clusterScope.ReconcileSecurityGroups(ctx)
+	if err != nil {
 		log.Error(err, "failed to reconcile Security Groups")
 		v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.VPCSecurityGroupReadyCondition, infrav1.VPCSecurityGroupReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
 		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
@@ -403,6 +491,36 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 		Status: metav1.ConditionTrue,
 		Reason: infrav1.VPCSecurityGroupReadyV1Beta2Reason,
 	})
+	r.reconcileSecurityGroupRulesDrift(clusterScope, securityGroupRulesDrift)
+
+	// Reconcile the cluster's VPE Gateways, if requested.
+	if len(clusterScope.NetworkSpec().VPEGateways) > 0 {
+		log.Info("Reconciling VPE Gateways")
+		if requeue, err := clusterScope.ReconcileVPEGateways(ctx); err != nil {
+			log.Error(err, "failed to reconcile VPE Gateways")
+			v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+				Type:    infrav1.VPEGatewaysReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.VPEGatewaysNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			return reconcile.Result{}, err
+		} else if requeue {
+			v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+				Type:   infrav1.VPEGatewaysReadyV1Beta2Condition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.VPEGatewaysNotReadyV1Beta2Reason,
+			})
+			log.Info("VPE Gateways creation is pending, requeueing")
+			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+		log.Info("Reconciliation of VPE Gateways complete")
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.VPEGatewaysReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.VPEGatewaysReadyV1Beta2Reason,
+		})
+	}
 
 	// Reconcile the cluster's Load Balancers
 	log.Info("Reconciling Load Balancers")
@@ -437,6 +555,68 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
 	}
 
+	// Reconcile the cluster's control-plane DNS zone and records, if requested.
+	if clusterScope.ControlPlaneDNS() != nil {
+		log.Info("Reconciling control-plane DNS")
+		if requeue, err := clusterScope.ReconcileControlPlaneDNS(ctx); err != nil {
+			log.Error(err, "failed to reconcile control-plane DNS")
+			v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+				Type:    infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.ControlPlaneDNSNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			return reconcile.Result{}, err
+		} else if requeue {
+			v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+				Type:   infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.ControlPlaneDNSNotReadyV1Beta2Reason,
+			})
+			log.Info("Control-plane DNS is pending, requeueing")
+			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+		log.Info("Reconciliation of control-plane DNS complete")
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.ControlPlaneDNSReadyV1Beta2Reason,
+		})
+		// Serve the control-plane endpoint with the DNS name pointing to the Load Balancer.
+		hostName = clusterScope.GetControlPlaneDNSName()
+	}
+
+	// Reconcile the cluster's public DNS records in Cloud Internet Services, if requested.
+	if clusterScope.DNS() != nil {
+		log.Info("Reconciling CIS DNS records")
+		if requeue, err := clusterScope.ReconcileDNSRecords(ctx); err != nil {
+			log.Error(err, "failed to reconcile CIS DNS records")
+			v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+				Type:    infrav1.PublicDNSReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.PublicDNSNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			return reconcile.Result{}, err
+		} else if requeue {
+			v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+				Type:   infrav1.PublicDNSReadyV1Beta2Condition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.PublicDNSNotReadyV1Beta2Reason,
+			})
+			log.Info("CIS DNS records are pending, requeueing")
+			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+		log.Info("Reconciliation of CIS DNS records complete")
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.PublicDNSReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.PublicDNSReadyV1Beta2Reason,
+		})
+		// Serve the control-plane endpoint with the public DNS name, which takes precedence over the private one.
+		hostName = clusterScope.GetDNSAPIName()
+	}
+
 	// Mark cluster as ready.
 	clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host = *hostName
 	clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Port = clusterScope.GetAPIServerPort()
@@ -521,8 +701,137 @@ func (r *IBMVPCClusterReconciler) reconcileDelete(ctx context.Context, clusterSc
 	return handleFinalizerRemoval(clusterScope)
 }
 
-func (r *IBMVPCClusterReconciler) reconcileDeleteV2(clusterScope *scope.VPCClusterScope) (ctrl.Result, error) { //nolint:unparam
-	clusterScope.Info("Delete cluster is not implemented for reconcile v2")
+func (r *IBMVPCClusterReconciler) reconcileDeleteV2(ctx context.Context, clusterScope *scope.VPCClusterScope) (ctrl.Result, error) { //nolint:gocyclo
+	log := ctrl.LoggerFrom(ctx)
+
+	// Skip deleting the network resources if there are still instances running in the VPC.
+	if clusterScope.NetworkStatus() != nil && clusterScope.NetworkStatus().VPC != nil && clusterScope.NetworkStatus().VPC.ID != "" {
+		instances, _, err := clusterScope.VPCClient.ListInstances(&vpcv1.ListInstancesOptions{
+			VPCID: ptr.To(clusterScope.NetworkStatus().VPC.ID),
+		})
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to list instances in VPC: %w", err)
+		}
+		if instances != nil && instances.TotalCount != nil && *instances.TotalCount != int64(0) {
+			log.Info("Instances still exist in VPC, requeuing")
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+		}
+	}
+
+	if clusterScope.DNS() != nil {
+		log.Info("Deleting CIS DNS records")
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.PublicDNSReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.PublicDNSDeletingV1Beta2Reason,
+		})
+		if err := clusterScope.DeleteDNSRecords(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete CIS DNS records: %w", err)
+		}
+	}
+
+	if clusterScope.ControlPlaneDNS() != nil {
+		log.Info("Deleting control-plane DNS")
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.ControlPlaneDNSDeletingV1Beta2Reason,
+		})
+		if requeue, err := clusterScope.DeleteControlPlaneDNS(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete control-plane DNS: %w", err)
+		} else if requeue {
+			log.Info("Control-plane DNS deletion is pending, requeuing")
+			return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+	}
+
+	log.Info("Deleting Load Balancers")
+	v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+		Type:   infrav1.VPCLoadBalancerReadyV1Beta2Condition,
+		Status: metav1.ConditionFalse,
+		Reason: infrav1.VPCLoadBalancerDeletingV1Beta2Reason,
+	})
+	if requeue, err := clusterScope.DeleteLoadBalancers(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete load balancers: %w", err)
+	} else if requeue {
+		log.Info("Load Balancers deletion is pending, requeuing")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	if clusterScope.NetworkStatus() != nil && len(clusterScope.NetworkStatus().VPEGateways) > 0 {
+		log.Info("Deleting VPE Gateways")
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.VPEGatewaysReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.VPEGatewaysDeletingV1Beta2Reason,
+		})
+		if requeue, err := clusterScope.DeleteVPEGateways(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete VPE gateways: %w", err)
+		} else if requeue {
+			log.Info("VPE Gateways deletion is pending, requeuing")
+			return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+	}
+
+	log.Info("Deleting Security Groups")
+	v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+		Type:   infrav1.VPCSecurityGroupReadyV1Beta2Condition,
+		Status: metav1.ConditionFalse,
+		Reason: infrav1.VPCSecurityGroupDeletingV1Beta2Reason,
+	})
+	if err := clusterScope.DeleteSecurityGroups(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete security groups: %w", err)
+	}
+
+	log.Info("Deleting VPC Subnets")
+	v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+		Type:   infrav1.VPCSubnetReadyV1Beta2Condition,
+		Status: metav1.ConditionFalse,
+		Reason: infrav1.VPCSubnetDeletingV1Beta2Reason,
+	})
+	if requeue, err := clusterScope.DeleteSubnets(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete subnets: %w", err)
+	} else if requeue {
+		log.Info("VPC Subnets deletion is pending, requeuing")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	log.Info("Deleting Public Gateways")
+	if requeue, err := clusterScope.DeletePublicGateways(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete public gateways: %w", err)
+	} else if requeue {
+		log.Info("Public Gateways deletion is pending, requeuing")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	log.Info("Deleting VPC")
+	v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+		Type:   infrav1.VPCReadyV1Beta2Condition,
+		Status: metav1.ConditionFalse,
+		Reason: infrav1.VPCDeletingV1Beta2Reason,
+	})
+	if requeue, err := clusterScope.DeleteVPC(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete VPC: %w", err)
+	} else if requeue {
+		log.Info("VPC deletion is pending, requeuing")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	log.Info("Deleting VPC Custom Image")
+	v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+		Type:   infrav1.VPCImageReadyV1Beta2Condition,
+		Status: metav1.ConditionFalse,
+		Reason: infrav1.VPCImageDeletingV1Beta2Reason,
+	})
+	if requeue, err := clusterScope.DeleteVPCCustomImage(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete VPC custom image: %w", err)
+	} else if requeue {
+		log.Info("VPC Custom Image deletion is pending, requeuing")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	log.Info("IBMVPCCluster deletion completed")
+	reportedDrift.Delete(client.ObjectKeyFromObject(clusterScope.IBMVPCCluster).String())
 	controllerutil.RemoveFinalizer(clusterScope.IBMVPCCluster, infrav1.ClusterFinalizer)
 	return ctrl.Result{}, nil
 }
@@ -595,13 +904,21 @@ func (r *IBMVPCClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl
 func patchIBMVPCCluster(ctx context.Context, patchHelper *v1beta1patch.Helper, ibmVPCCluster *infrav1.IBMVPCCluster) error {
 	if err := v1beta2conditions.SetSummaryCondition(ibmVPCCluster, ibmVPCCluster, infrav1.IBMVPCClusterReadyV1Beta2Condition,
 		v1beta2conditions.ForConditionTypes{
+			infrav1.CredentialsReadyV1Beta2Condition,
 			infrav1.VPCReadyV1Beta2Condition,
 			infrav1.VPCSubnetReadyV1Beta2Condition,
 			infrav1.VPCLoadBalancerReadyV1Beta2Condition,
+			infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+			infrav1.PublicDNSReadyV1Beta2Condition,
+			infrav1.VPEGatewaysReadyV1Beta2Condition,
 		},
 		v1beta2conditions.IgnoreTypesIfMissing{
+			infrav1.CredentialsReadyV1Beta2Condition,
 			infrav1.VPCSecurityGroupReadyV1Beta2Condition,
 			infrav1.VPCImageReadyV1Beta2Condition,
+			infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+			infrav1.PublicDNSReadyV1Beta2Condition,
+			infrav1.VPEGatewaysReadyV1Beta2Condition,
 		},
 		// Using a custom merge strategy to override reasons applied during merge.
 		v1beta2conditions.CustomMergeStrategy{
@@ -622,10 +939,15 @@ func patchIBMVPCCluster(ctx context.Context, patchHelper *v1beta1patch.Helper, i
 	return patchHelper.Patch(ctx, ibmVPCCluster, v1beta1patch.WithOwnedV1Beta2Conditions{Conditions: []string{
 		infrav1.IBMVPCClusterReadyV1Beta2Condition,
 		clusterv1beta1.PausedV1Beta2Condition,
+		infrav1.CredentialsReadyV1Beta2Condition,
 		infrav1.VPCReadyV1Beta2Condition,
 		infrav1.VPCSubnetReadyV1Beta2Condition,
 		infrav1.VPCSecurityGroupReadyV1Beta2Condition,
+		infrav1.VPCSecurityGroupRulesSyncedV1Beta2Condition,
 		infrav1.VPCLoadBalancerReadyV1Beta2Condition,
 		infrav1.VPCImageReadyV1Beta2Condition,
+		infrav1.ControlPlaneDNSReadyV1Beta2Condition,
+		infrav1.PublicDNSReadyV1Beta2Condition,
+		infrav1.VPEGatewaysReadyV1Beta2Condition,
 	}})
 }
@@ -29,13 +29,17 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"         //nolint:staticcheck
+	v1beta2conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions/v1beta2" //nolint:staticcheck
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
@@ -641,6 +645,96 @@ func TestIBMVPCClusterLBReconciler_delete(t *testing.T) {
 	})
 }
 
+func TestIBMVPCClusterReconciler_deleteV2(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc, *scope.VPCClusterScope, IBMVPCClusterReconciler) {
+		t.Helper()
+		mockController := gomock.NewController(t)
+		mockvpc := mock.NewMockVpc(mockController)
+		reconciler := IBMVPCClusterReconciler{
+			Client: testEnv.Client,
+			Log:    klog.Background(),
+		}
+		clusterScope := &scope.VPCClusterScope{
+			VPCClient: mockvpc,
+			Logger:    klog.Background(),
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Finalizers: []string{infrav1.ClusterFinalizer},
+				},
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						VPC: &infrav1.ResourceStatus{
+							ID:                "capi-vpc-id",
+							ControllerCreated: ptr.To(true),
+						},
+						ControlPlaneSubnets: map[string]*infrav1.ResourceStatus{
+							"capi-subnet": {
+								ID:                "capi-subnet-id",
+								Name:              ptr.To("capi-subnet"),
+								ControllerCreated: ptr.To(true),
+							},
+						},
+						LoadBalancers: map[string]*infrav1.VPCLoadBalancerStatus{
+							"capi-lb-id": {
+								ID:                ptr.To("capi-lb-id"),
+								ControllerCreated: ptr.To(false),
+							},
+						},
+					},
+				},
+			},
+		}
+		return mockController, mockvpc, clusterScope, reconciler
+	}
+
+	t.Run("Reconciling deleting IBMVPCCluster with reconcile v2", func(t *testing.T) {
+		t.Run("Should requeue if instances are still running in the VPC", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc, clusterScope, reconciler := setup(t)
+			t.Cleanup(mockController.Finish)
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{TotalCount: ptr.To(int64(1))}, &core.DetailedResponse{}, nil)
+			result, err := reconciler.reconcileDeleteV2(ctx, clusterScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1.ClusterFinalizer))
+		})
+		t.Run("Should requeue while subnet deletion is pending", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc, clusterScope, reconciler := setup(t)
+			t.Cleanup(mockController.Finish)
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{TotalCount: ptr.To(int64(0))}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(gomock.AssignableToTypeOf(&vpcv1.GetSubnetOptions{})).Return(&vpcv1.Subnet{ID: ptr.To("capi-subnet-id"), Status: ptr.To(string(infrav1.VPCSubnetStateDeleting))}, &core.DetailedResponse{}, nil)
+			result, err := reconciler.reconcileDeleteV2(ctx, clusterScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1.ClusterFinalizer))
+		})
+		t.Run("Should fail deleting the VPC", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc, clusterScope, reconciler := setup(t)
+			t.Cleanup(mockController.Finish)
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{TotalCount: ptr.To(int64(0))}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(gomock.AssignableToTypeOf(&vpcv1.GetSubnetOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("subnet not found"))
+			mockvpc.EXPECT().GetVPC(gomock.AssignableToTypeOf(&vpcv1.GetVPCOptions{})).Return(&vpcv1.VPC{ID: ptr.To("capi-vpc-id"), Status: ptr.To("available")}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().DeleteVPC(gomock.AssignableToTypeOf(&vpcv1.DeleteVPCOptions{})).Return(&core.DetailedResponse{}, errors.New("failed to delete VPC"))
+			_, err := reconciler.reconcileDeleteV2(ctx, clusterScope)
+			g.Expect(err).To(Not(BeNil()))
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(ContainElement(infrav1.ClusterFinalizer))
+		})
+		t.Run("Should remove the finalizer once all controller created resources are deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc, clusterScope, reconciler := setup(t)
+			t.Cleanup(mockController.Finish)
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{TotalCount: ptr.To(int64(0))}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetSubnet(gomock.AssignableToTypeOf(&vpcv1.GetSubnetOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("subnet not found"))
+			mockvpc.EXPECT().GetVPC(gomock.AssignableToTypeOf(&vpcv1.GetVPCOptions{})).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("VPC not found"))
+			_, err := reconciler.reconcileDeleteV2(ctx, clusterScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(clusterScope.IBMVPCCluster.Finalizers).To(Not(ContainElement(infrav1.ClusterFinalizer)))
+		})
+	})
+}
+
 func createVPCCluster(g *WithT, vpcCluster *infrav1.IBMVPCCluster, namespace string) {
 	if vpcCluster != nil {
 		vpcCluster.Namespace = namespace
@@ -664,3 +758,140 @@ func cleanupVPCCluster(g *WithT, vpcCluster *infrav1.IBMVPCCluster, namespace *c
 		}(vpcCluster, namespace)
 	}
 }
+
+func TestIBMVPCClusterReconciler_ReconcileCredentials(t *testing.T) {
+	g := NewWithT(t)
+	checkedCredentials = newCredentialsCache()
+
+	ownerCluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "capi-test",
+			Namespace: "default",
+		},
+	}
+	vpcCluster := &infrav1.IBMVPCCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "vpc-test",
+			Namespace:  "default",
+			Finalizers: []string{infrav1.ClusterFinalizer},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "Cluster",
+					Name:       ownerCluster.Name,
+					UID:        "1",
+				}}},
+		Spec: infrav1.IBMVPCClusterSpec{
+			ControlPlaneLoadBalancer: &infrav1.VPCLoadBalancerSpec{Name: "vpc-load-balancer"},
+		},
+	}
+	mockClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(ownerCluster, vpcCluster).WithStatusSubresource(vpcCluster).Build()
+	reconciler := &IBMVPCClusterReconciler{
+		Client: mockClient,
+		Log:    klog.Background(),
+		ClientFactory: scope.ClientFactory{
+			AuthenticatorFactory: func() (core.Authenticator, error) {
+				return failingAuthenticator{}, nil
+			},
+		},
+	}
+
+	// The first reconcile sets the Paused condition and requeues.
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vpcCluster)})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(vpcCluster)})
+	g.Expect(err).To(HaveOccurred())
+
+	updatedCluster := &infrav1.IBMVPCCluster{}
+	g.Expect(mockClient.Get(ctx, client.ObjectKeyFromObject(vpcCluster), updatedCluster)).To(Succeed())
+	g.Expect(v1beta1conditions.IsFalse(updatedCluster, infrav1.CredentialsReadyCondition)).To(BeTrue())
+	g.Expect(v1beta1conditions.GetReason(updatedCluster, infrav1.CredentialsReadyCondition)).To(Equal(infrav1.CredentialsReconciliationFailedReason))
+}
+
+func TestIBMVPCClusterReconciler_reconcileSecurityGroupRulesDrift(t *testing.T) {
+	clusterScope := func(mode infrav1.VPCSecurityGroupRuleReconcileMode) *scope.VPCClusterScope {
+		return &scope.VPCClusterScope{
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Network: &infrav1.VPCNetworkSpec{SecurityGroupRuleReconcileMode: mode},
+				},
+			},
+		}
+	}
+	reconciler := &IBMVPCClusterReconciler{}
+
+	t.Run("Should mark the rules synced when no drift is found in Enforce mode", func(t *testing.T) {
+		g := NewWithT(t)
+		s := clusterScope(infrav1.VPCSecurityGroupRuleReconcileModeEnforce)
+		reconciler.reconcileSecurityGroupRulesDrift(s, nil)
+		g.Expect(v1beta1conditions.IsTrue(s.IBMVPCCluster, infrav1.VPCSecurityGroupRulesSyncedCondition)).To(BeTrue())
+	})
+
+	t.Run("Should mark the rules drifted when drift is found in Enforce mode", func(t *testing.T) {
+		g := NewWithT(t)
+		s := clusterScope(infrav1.VPCSecurityGroupRuleReconcileModeEnforce)
+		reconciler.reconcileSecurityGroupRulesDrift(s, []string{"security group sg has rule rule-id which is not defined for it"})
+		g.Expect(v1beta1conditions.IsFalse(s.IBMVPCCluster, infrav1.VPCSecurityGroupRulesSyncedCondition)).To(BeTrue())
+		g.Expect(v1beta1conditions.GetReason(s.IBMVPCCluster, infrav1.VPCSecurityGroupRulesSyncedCondition)).To(Equal(infrav1.VPCSecurityGroupRulesDriftedReason))
+	})
+
+	t.Run("Should remove the condition when switching from Enforce to Additive mode", func(t *testing.T) {
+		g := NewWithT(t)
+		s := clusterScope(infrav1.VPCSecurityGroupRuleReconcileModeEnforce)
+		reconciler.reconcileSecurityGroupRulesDrift(s, []string{"security group sg has rule rule-id which is not defined for it"})
+
+		s.NetworkSpec().SecurityGroupRuleReconcileMode = infrav1.VPCSecurityGroupRuleReconcileModeAdditive
+		reconciler.reconcileSecurityGroupRulesDrift(s, nil)
+		g.Expect(v1beta1conditions.Get(s.IBMVPCCluster, infrav1.VPCSecurityGroupRulesSyncedCondition)).To(BeNil())
+		g.Expect(v1beta2conditions.Get(s.IBMVPCCluster, infrav1.VPCSecurityGroupRulesSyncedV1Beta2Condition)).To(BeNil())
+	})
+
+	t.Run("Should forget the reported drift once the rules are synced", func(t *testing.T) {
+		g := NewWithT(t)
+		s := clusterScope(infrav1.VPCSecurityGroupRuleReconcileModeEnforce)
+		s.IBMVPCCluster.Name = "synced"
+		key := client.ObjectKeyFromObject(s.IBMVPCCluster).String()
+		reconciler.reconcileSecurityGroupRulesDrift(s, []string{"security group sg has rule rule-id which is not defined for it"})
+		_, ok := reportedDrift.Load(key)
+		g.Expect(ok).To(BeTrue())
+
+		reconciler.reconcileSecurityGroupRulesDrift(s, nil)
+		_, ok = reportedDrift.Load(key)
+		g.Expect(ok).To(BeFalse())
+	})
+
+	t.Run("Should cap the drift message", func(t *testing.T) {
+		g := NewWithT(t)
+		s := clusterScope(infrav1.VPCSecurityGroupRuleReconcileModeEnforce)
+		s.IBMVPCCluster.Name = "capped"
+		t.Cleanup(func() { reportedDrift.Delete(client.ObjectKeyFromObject(s.IBMVPCCluster).String()) })
+		var drift []string
+		for i := 9; i >= 0; i-- {
+			drift = append(drift, fmt.Sprintf("security group sg has rule rule-%d which is not defined for it", i))
+		}
+		reconciler.reconcileSecurityGroupRulesDrift(s, drift)
+		msg := v1beta2conditions.Get(s.IBMVPCCluster, infrav1.VPCSecurityGroupRulesSyncedV1Beta2Condition).Message
+		g.Expect(msg).To(HavePrefix("security group sg has rule rule-0 which is not defined for it"))
+		g.Expect(msg).To(HaveSuffix("; and 5 more"))
+		g.Expect(msg).ToNot(ContainSubstring("rule-9"))
+	})
+}
+
+func TestSecurityGroupRulesDriftChanged(t *testing.T) {
+	g := NewWithT(t)
+	t.Cleanup(func() {
+		reportedDrift.Delete("default/drift")
+		reportedDrift.Delete("default/other-drift")
+	})
+	g.Expect(securityGroupRulesDriftChanged("default/drift", []string{"rule-a", "rule-b"})).To(BeTrue())
+	g.Expect(securityGroupRulesDriftChanged("default/drift", []string{"rule-a", "rule-b"})).To(BeFalse())
+	g.Expect(securityGroupRulesDriftChanged("default/drift", []string{"rule-a"})).To(BeTrue())
+	g.Expect(securityGroupRulesDriftChanged("default/other-drift", []string{"rule-a"})).To(BeTrue())
+}
+
+func TestSecurityGroupRulesDriftMessage(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(securityGroupRulesDriftMessage([]string{"a", "b"})).To(Equal("a; b"))
+	g.Expect(securityGroupRulesDriftMessage([]string{"a", "b", "c", "d", "e"})).To(Equal("a; b; c; d; e"))
+	g.Expect(securityGroupRulesDriftMessage([]string{"a", "b", "c", "d", "e", "f", "g"})).To(Equal("a; b; c; d; e; and 2 more"))
+}
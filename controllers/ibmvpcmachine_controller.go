@@ -187,6 +187,11 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(ctx context.Context, machineSc
 		}
 	}
 
+	// Select the zone, and subnet if necessary, based on the Machine's failure domain.
+	if err := machineScope.ReconcileFailureDomain(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile failure domain for IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
+	}
+
 	instance, err := r.getOrCreate(ctx, machineScope)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile VSI for IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
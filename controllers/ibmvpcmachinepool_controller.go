@@ -0,0 +1,313 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions"         //nolint:staticcheck
+	v1beta2conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions/v1beta2" //nolint:staticcheck
+	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch"                   //nolint:staticcheck
+	"sigs.k8s.io/cluster-api/util/deprecated/v1beta1/paused"
+	"sigs.k8s.io/cluster-api/util/finalizers"
+	"sigs.k8s.io/cluster-api/util/predicates"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+)
+
+// IBMVPCMachinePoolReconciler reconciles a IBMVPCMachinePool object.
+type IBMVPCMachinePoolReconciler struct {
+	client.Client
+	Log             logr.Logger
+	Recorder        record.EventRecorder
+	ServiceEndpoint []endpoints.ServiceEndpoint
+	Scheme          *runtime.Scheme
+
+	// WatchFilterValue is the label value used to filter events prior to reconciliation.
+	WatchFilterValue string
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachinepools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmvpcmachinepools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools;machinepools/status,verbs=get;list;watch
+
+// Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMVPCMachinePool.
+func (r *IBMVPCMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	log.Info("Reconciling IBMVPCMachinePool")
+	defer log.Info("Finished reconciling IBMVPCMachinePool")
+
+	// Fetch the IBMVPCMachinePool instance.
+	ibmVPCMachinePool := &infrav1.IBMVPCMachinePool{}
+	if err := r.Get(ctx, req.NamespacedName, ibmVPCMachinePool); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Fetch the MachinePool.
+	machinePool, err := util.GetOwnerMachinePool(ctx, r.Client, ibmVPCMachinePool.ObjectMeta)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if machinePool == nil {
+		log.Info("MachinePool Controller has not yet set OwnerRef")
+		return ctrl.Result{}, nil
+	}
+
+	// Fetch the Cluster.
+	cluster, err := util.GetClusterFromMetadata(ctx, r.Client, machinePool.ObjectMeta)
+	if err != nil {
+		log.Info("MachinePool is missing cluster label or cluster does not exist")
+		return ctrl.Result{}, nil
+	}
+
+	ibmVPCCluster := &infrav1.IBMVPCCluster{}
+	ibmVPCClusterName := client.ObjectKey{
+		Namespace: ibmVPCMachinePool.Namespace,
+		Name:      cluster.Spec.InfrastructureRef.Name,
+	}
+	if err := r.Client.Get(ctx, ibmVPCClusterName, ibmVPCCluster); err != nil {
+		log.Info("IBMVPCCluster is not available yet")
+		return ctrl.Result{}, nil
+	}
+
+	// Add finalizer first if not set to avoid the race condition between init and delete.
+	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, ibmVPCMachinePool, infrav1.IBMVPCMachinePoolFinalizer); err != nil || finalizerAdded {
+		return ctrl.Result{}, err
+	}
+
+	log = log.WithValues("Cluster", klog.KObj(cluster), "MachinePool", klog.KObj(machinePool))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	// Initialize the patch helper.
+	patchHelper, err := v1beta1patch.NewHelper(ibmVPCMachinePool, r.Client)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to initialize patch helper: %w", err)
+	}
+
+	// Always attempt to Patch the IBMVPCMachinePool object and status after each reconciliation.
+	defer func() {
+		if err := patchIBMVPCMachinePool(ctx, patchHelper, ibmVPCMachinePool); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	if isPaused, requeue, err := paused.EnsurePausedCondition(ctx, r.Client, cluster, ibmVPCMachinePool); err != nil || isPaused || requeue {
+		return ctrl.Result{}, err
+	}
+
+	// Create the machine pool scope.
+	machinePoolScope, err := scope.NewVPCMachinePoolScope(scope.VPCMachinePoolScopeParams{
+		Client:            r.Client,
+		Cluster:           cluster,
+		MachinePool:       machinePool,
+		IBMVPCCluster:     ibmVPCCluster,
+		IBMVPCMachinePool: ibmVPCMachinePool,
+		ServiceEndpoint:   r.ServiceEndpoint,
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to create scope: %w", err)
+	}
+
+	log = log.WithValues("IBMVPCMachinePool", klog.KObj(ibmVPCMachinePool))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	// Handle deleted machine pools.
+	if !ibmVPCMachinePool.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, machinePoolScope)
+	}
+
+	// Handle non-deleted machine pools.
+	return r.reconcileNormal(ctx, machinePoolScope)
+}
+
+// SetupWithManager creates a new IBMVPCMachinePool controller for a manager.
+func (r *IBMVPCMachinePoolReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "ibmvpcmachinepool")
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&infrav1.IBMVPCMachinePool{}).
+		WithEventFilter(predicates.ResourceHasFilterLabel(r.Scheme, predicateLog, r.WatchFilterValue)).
+		Watches(
+			&clusterv1.MachinePool{},
+			handler.EnqueueRequestsFromMapFunc(util.MachinePoolToInfrastructureMapFunc(ctx, infrav1.GroupVersion.WithKind("IBMVPCMachinePool"))),
+		).
+		Complete(r)
+}
+
+func (r *IBMVPCMachinePoolReconciler) reconcileNormal(ctx context.Context, machinePoolScope *scope.VPCMachinePoolScope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	pool := machinePoolScope.IBMVPCMachinePool
+
+	// Make sure bootstrap data is available and populated.
+	if machinePoolScope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName == nil {
+		log.Info("Bootstrap data secret reference is not yet available")
+		v1beta1conditions.MarkFalse(pool, infrav1.InstancesReadyCondition, infrav1.WaitingForBootstrapDataReason, clusterv1beta1.ConditionSeverityInfo, "")
+		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+	}
+
+	templateID, err := machinePoolScope.ReconcileInstanceTemplate(ctx)
+	if err != nil {
+		v1beta1conditions.MarkFalse(pool, infrav1.InstanceGroupReadyCondition, infrav1.InstanceGroupReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile instance template for IBMVPCMachinePool %s/%s: %w", pool.Namespace, pool.Name, err)
+	}
+
+	groupRequeue, err := machinePoolScope.ReconcileInstanceGroup(ctx, templateID)
+	if err != nil {
+		v1beta1conditions.MarkFalse(pool, infrav1.InstanceGroupReadyCondition, infrav1.InstanceGroupReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile instance group for IBMVPCMachinePool %s/%s: %w", pool.Namespace, pool.Name, err)
+	}
+	// The infrastructure is provisioned once the instance group exists, scaling and updates are reported through the conditions.
+	machinePoolScope.SetReady()
+	if groupRequeue {
+		v1beta1conditions.MarkFalse(pool, infrav1.InstanceGroupReadyCondition, infrav1.InstancesScalingReason, clusterv1beta1.ConditionSeverityInfo, "")
+	} else {
+		v1beta1conditions.MarkTrue(pool, infrav1.InstanceGroupReadyCondition)
+	}
+
+	instancesRequeue, err := machinePoolScope.ReconcileInstances(ctx)
+	if err != nil {
+		v1beta1conditions.MarkFalse(pool, infrav1.InstancesReadyCondition, infrav1.InstancesReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile instances for IBMVPCMachinePool %s/%s: %w", pool.Namespace, pool.Name, err)
+	}
+	markMachinePoolInstancesCondition(pool, instancesRequeue, pool.Status.Replicas, upToDateVPCInstances(pool))
+
+	if err := machinePoolScope.DeleteOutdatedInstanceTemplates(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete outdated instance templates for IBMVPCMachinePool %s/%s: %w", pool.Namespace, pool.Name, err)
+	}
+
+	if groupRequeue || instancesRequeue {
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *IBMVPCMachinePoolReconciler) reconcileDelete(ctx context.Context, machinePoolScope *scope.VPCMachinePoolScope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Handling deleted IBMVPCMachinePool")
+	pool := machinePoolScope.IBMVPCMachinePool
+
+	machinePoolScope.SetNotReady()
+	v1beta1conditions.MarkFalse(pool, infrav1.InstancesReadyCondition, clusterv1beta1.DeletingReason, clusterv1beta1.ConditionSeverityInfo, "")
+
+	requeue, err := machinePoolScope.DeleteInstanceGroup(ctx)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete instance group for IBMVPCMachinePool %s/%s: %w", pool.Namespace, pool.Name, err)
+	}
+	if requeue {
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
+	if err := machinePoolScope.DeleteInstanceTemplates(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to delete instance templates for IBMVPCMachinePool %s/%s: %w", pool.Namespace, pool.Name, err)
+	}
+
+	controllerutil.RemoveFinalizer(pool, infrav1.IBMVPCMachinePoolFinalizer)
+	return ctrl.Result{}, nil
+}
+
+func upToDateVPCInstances(pool *infrav1.IBMVPCMachinePool) int {
+	count := 0
+	for _, instance := range pool.Status.Instances {
+		if instance.UpToDate {
+			count++
+		}
+	}
+	return count
+}
+
+// markMachinePoolInstancesCondition sets the InstancesReady condition of a machine pool, based on whether its instances are still being reconciled.
+func markMachinePoolInstancesCondition(pool v1beta1conditions.Setter, inProgress bool, replicas int32, upToDate int) {
+	switch {
+	case !inProgress:
+		v1beta1conditions.MarkTrue(pool, infrav1.InstancesReadyCondition)
+	case upToDate < int(replicas):
+		v1beta1conditions.MarkFalse(pool, infrav1.InstancesReadyCondition, infrav1.InstancesRollingUpdateReason, clusterv1beta1.ConditionSeverityInfo, "%d of %d instances are up to date", upToDate, replicas)
+	default:
+		v1beta1conditions.MarkFalse(pool, infrav1.InstancesReadyCondition, infrav1.InstancesScalingReason, clusterv1beta1.ConditionSeverityInfo, "")
+	}
+}
+
+// machinePoolConditionsSetter is implemented by machine pools exposing both v1beta1 and v1beta2 conditions.
+type machinePoolConditionsSetter interface {
+	v1beta1conditions.Setter
+	v1beta2conditions.Setter
+}
+
+// setMachinePoolReadyV1Beta2Condition mirrors the machine pool's summary Ready condition to its v1beta2 Ready condition.
+func setMachinePoolReadyV1Beta2Condition(pool machinePoolConditionsSetter, deleting bool) {
+	switch {
+	case deleting:
+		v1beta2conditions.Set(pool, metav1.Condition{
+			Type:   infrav1.MachinePoolReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.MachinePoolDeletingV1Beta2Reason,
+		})
+	case v1beta1conditions.IsTrue(pool, clusterv1beta1.ReadyCondition):
+		v1beta2conditions.Set(pool, metav1.Condition{
+			Type:   infrav1.MachinePoolReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.MachinePoolReadyV1Beta2Reason,
+		})
+	default:
+		v1beta2conditions.Set(pool, metav1.Condition{
+			Type:    infrav1.MachinePoolReadyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.MachinePoolNotReadyV1Beta2Reason,
+			Message: v1beta1conditions.GetMessage(pool, clusterv1beta1.ReadyCondition),
+		})
+	}
+}
+
+func patchIBMVPCMachinePool(ctx context.Context, patchHelper *v1beta1patch.Helper, ibmVPCMachinePool *infrav1.IBMVPCMachinePool) error {
+	v1beta1conditions.SetSummary(ibmVPCMachinePool,
+		v1beta1conditions.WithConditions(
+			infrav1.InstanceGroupReadyCondition,
+			infrav1.InstancesReadyCondition,
+		),
+	)
+	setMachinePoolReadyV1Beta2Condition(ibmVPCMachinePool, !ibmVPCMachinePool.DeletionTimestamp.IsZero())
+
+	// Patch the IBMVPCMachinePool resource.
+	return patchHelper.Patch(ctx, ibmVPCMachinePool, v1beta1patch.WithOwnedV1Beta2Conditions{Conditions: []string{
+		infrav1.MachinePoolReadyV1Beta2Condition,
+		clusterv1beta1.PausedV1Beta2Condition,
+	}})
+}
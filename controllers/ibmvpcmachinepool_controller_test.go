@@ -0,0 +1,247 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions" //nolint:staticcheck
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cloud/scope"
+	vpcmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
+
+	. "github.com/onsi/gomega"
+)
+
+// newMachinePoolBootstrapSecret returns the bootstrap data secret of a MachinePool.
+func newMachinePoolBootstrapSecret(name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"value": []byte("user data"),
+		},
+	}
+}
+
+// newMachinePool returns a MachinePool with the given number of replicas, using the bootstrap data secret named after it.
+func newMachinePool(name string, replicas int32) *clusterv1.MachinePool {
+	return &clusterv1.MachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: clusterv1.MachinePoolSpec{
+			ClusterName: "capi-cluster",
+			Replicas:    ptr.To(replicas),
+			Template: clusterv1.MachineTemplateSpec{
+				Spec: clusterv1.MachineSpec{
+					Bootstrap: clusterv1.Bootstrap{
+						DataSecretName: ptr.To(name),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestIBMVPCMachinePoolReconciler_ReconcileNormal(t *testing.T) {
+	var (
+		mockvpc          *vpcmock.MockVpc
+		mockCtrl         *gomock.Controller
+		reconciler       IBMVPCMachinePoolReconciler
+		machinePoolScope *scope.VPCMachinePoolScope
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockvpc = vpcmock.NewMockVpc(mockCtrl)
+		reconciler = IBMVPCMachinePoolReconciler{
+			Log: klog.Background(),
+		}
+		machinePoolScope = &scope.VPCMachinePoolScope{
+			Client:       fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(newMachinePoolBootstrapSecret("capi-pool")).Build(),
+			IBMVPCClient: mockvpc,
+			MachinePool:  newMachinePool("capi-pool", 1),
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Status: infrav1.IBMVPCClusterStatus{
+					ResourceGroup: &infrav1.ResourceStatus{ID: "resource-group-id"},
+					Subnet:        infrav1.Subnet{ID: ptr.To("subnet-id")},
+				},
+			},
+			IBMVPCMachinePool: &infrav1.IBMVPCMachinePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-pool", Namespace: "default"},
+				Spec: infrav1.IBMVPCMachinePoolSpec{
+					Image:   &infrav1.IBMVPCResourceReference{ID: ptr.To("image-id")},
+					Zone:    "us-south-1",
+					Profile: "bx2-2x8",
+				},
+			},
+		}
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should wait for the bootstrap data", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		machinePoolScope.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName = nil
+		result, err := reconciler.reconcileNormal(ctx, machinePoolScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(1 * time.Minute))
+		g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMVPCMachinePool, infrav1.InstancesReadyCondition)).To(Equal(infrav1.WaitingForBootstrapDataReason))
+	})
+
+	t.Run("Should create the instance template and the instance group", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockvpc.EXPECT().ListInstanceTemplates(gomock.Any()).Return(&vpcv1.InstanceTemplateCollection{}, &core.DetailedResponse{}, nil).Times(2)
+		mockvpc.EXPECT().CreateInstanceTemplate(gomock.Any()).DoAndReturn(func(options *vpcv1.CreateInstanceTemplateOptions) (vpcv1.InstanceTemplateIntf, *core.DetailedResponse, error) {
+			prototype := options.InstanceTemplatePrototype.(*vpcv1.InstanceTemplatePrototypeInstanceTemplateByImage)
+			g.Expect(*prototype.UserData).To(Equal("user data"))
+			return &vpcv1.InstanceTemplate{ID: ptr.To("template-id"), Name: prototype.Name}, &core.DetailedResponse{}, nil
+		})
+		mockvpc.EXPECT().CreateInstanceGroup(gomock.Any()).Return(&vpcv1.InstanceGroup{ID: ptr.To("instance-group-id"), Name: ptr.To("capi-pool")}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().ListInstanceGroupMemberships(gomock.Any()).Return(&vpcv1.InstanceGroupMembershipCollection{}, &core.DetailedResponse{}, nil).Times(2)
+		result, err := reconciler.reconcileNormal(ctx, machinePoolScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+		g.Expect(machinePoolScope.IBMVPCMachinePool.Status.Ready).To(BeTrue())
+		g.Expect(machinePoolScope.IBMVPCMachinePool.Status.InstanceTemplate.ID).To(Equal("template-id"))
+		g.Expect(machinePoolScope.IBMVPCMachinePool.Status.InstanceGroup.ID).To(Equal("instance-group-id"))
+		g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMVPCMachinePool, infrav1.InstanceGroupReadyCondition)).To(Equal(infrav1.InstancesScalingReason))
+		g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMVPCMachinePool, infrav1.InstancesReadyCondition)).To(Equal(infrav1.InstancesScalingReason))
+	})
+
+	t.Run("Should fail to reconcile the instance template", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockvpc.EXPECT().ListInstanceTemplates(gomock.Any()).Return(nil, &core.DetailedResponse{}, errors.New("failed to list instance templates"))
+		_, err := reconciler.reconcileNormal(ctx, machinePoolScope)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(machinePoolScope.IBMVPCMachinePool.Status.Ready).To(BeFalse())
+		g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMVPCMachinePool, infrav1.InstanceGroupReadyCondition)).To(Equal(infrav1.InstanceGroupReconciliationFailedReason))
+	})
+}
+
+func TestIBMVPCMachinePoolReconciler_ReconcileDelete(t *testing.T) {
+	var (
+		mockvpc          *vpcmock.MockVpc
+		mockCtrl         *gomock.Controller
+		reconciler       IBMVPCMachinePoolReconciler
+		machinePoolScope *scope.VPCMachinePoolScope
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockvpc = vpcmock.NewMockVpc(mockCtrl)
+		reconciler = IBMVPCMachinePoolReconciler{
+			Log: klog.Background(),
+		}
+		machinePoolScope = &scope.VPCMachinePoolScope{
+			IBMVPCClient: mockvpc,
+			MachinePool:  newMachinePool("capi-pool", 1),
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "default"},
+			},
+			IBMVPCMachinePool: &infrav1.IBMVPCMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "capi-pool",
+					Namespace:  "default",
+					Finalizers: []string{infrav1.IBMVPCMachinePoolFinalizer},
+				},
+				Status: infrav1.IBMVPCMachinePoolStatus{
+					Ready:         true,
+					InstanceGroup: &infrav1.ResourceStatus{ID: "instance-group-id"},
+				},
+			},
+		}
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Should wait for the instance group to be deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockvpc.EXPECT().GetInstanceGroup(gomock.Any()).Return(&vpcv1.InstanceGroup{
+			ID:     ptr.To("instance-group-id"),
+			Status: ptr.To(vpcv1.InstanceGroupStatusDeletingConst),
+		}, &core.DetailedResponse{}, nil)
+		result, err := reconciler.reconcileDelete(ctx, machinePoolScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(Equal(15 * time.Second))
+		g.Expect(machinePoolScope.IBMVPCMachinePool.Status.Ready).To(BeFalse())
+		g.Expect(machinePoolScope.IBMVPCMachinePool.Finalizers).To(ContainElement(infrav1.IBMVPCMachinePoolFinalizer))
+		g.Expect(v1beta1conditions.GetReason(machinePoolScope.IBMVPCMachinePool, infrav1.InstancesReadyCondition)).To(Equal(clusterv1beta1.DeletingReason))
+	})
+
+	t.Run("Should delete the instance templates and remove the finalizer", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockvpc.EXPECT().GetInstanceGroup(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		mockvpc.EXPECT().ListInstanceTemplates(gomock.Any()).Return(&vpcv1.InstanceTemplateCollection{
+			Templates: []vpcv1.InstanceTemplateIntf{
+				// The template names are prefixed with the pool name and the hash of default/capi-cluster/capi-pool.
+				&vpcv1.InstanceTemplate{ID: ptr.To("template-id"), Name: ptr.To("capi-pool-76e2a531-00000000-00000000")},
+				&vpcv1.InstanceTemplate{ID: ptr.To("other-template-id"), Name: ptr.To("other-template")},
+			},
+		}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().DeleteInstanceTemplate(&vpcv1.DeleteInstanceTemplateOptions{ID: ptr.To("template-id")}).Return(&core.DetailedResponse{}, nil)
+		result, err := reconciler.reconcileDelete(ctx, machinePoolScope)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.RequeueAfter).To(BeZero())
+		g.Expect(machinePoolScope.IBMVPCMachinePool.Finalizers).ToNot(ContainElement(infrav1.IBMVPCMachinePoolFinalizer))
+	})
+
+	t.Run("Should fail to delete the instance group", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		mockvpc.EXPECT().GetInstanceGroup(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 500}, errors.New("failed to get instance group"))
+		_, err := reconciler.reconcileDelete(ctx, machinePoolScope)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(machinePoolScope.IBMVPCMachinePool.Finalizers).To(ContainElement(infrav1.IBMVPCMachinePoolFinalizer))
+	})
+}
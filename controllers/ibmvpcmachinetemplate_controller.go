@@ -69,7 +69,7 @@ func (r *IBMVPCMachineTemplateReconciler) Reconcile(ctx context.Context, req ctr
 	// Fetch the service endpoint.
 	svcEndpoint := endpoints.FetchVPCEndpoint(region, r.ServiceEndpoint)
 
-	vpcClient, err := vpc.NewService(svcEndpoint)
+	vpcClient, err := vpc.NewService(svcEndpoint, nil)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to create IBM VPC client: %w", err)
 	}
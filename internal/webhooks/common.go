@@ -18,11 +18,14 @@ package webhooks
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"strconv"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 )
@@ -31,6 +34,10 @@ import (
 var crnRegex = regexp.MustCompile(`^crn:v[0-9]+:[a-z0-9-]+:[a-z0-9-]+:[a-z0-9-]+:[a-z0-9-]*:([a-z]\/[a-z0-9-]+)?:[a-z0-9-]*:[a-z0-9-]*:[a-zA-Z0-9-_\.\/]*$`)
 
 func defaultIBMPowerVSMachineSpec(spec *infrav1.IBMPowerVSMachineSpec) {
+	// The system type, processors and memory of an instance with a SAP profile are set by the profile.
+	if spec.Profile != "" {
+		return
+	}
 	if spec.MemoryGiB == 0 {
 		spec.MemoryGiB = 2
 	}
@@ -59,6 +66,90 @@ func validateIBMPowerVSNetworkReference(res infrav1.IBMPowerVSResourceReference)
 	return true, nil
 }
 
+func validateIBMPowerVSNetworks(spec infrav1.IBMPowerVSMachineSpec, specPath *field.Path, allowIPAddress bool) *field.Error {
+	if len(spec.Networks) == 0 {
+		return nil
+	}
+	if spec.Network.ID != nil || spec.Network.Name != nil || spec.Network.RegEx != nil {
+		return field.Invalid(specPath.Child("network"), spec.Network, "Network must be empty when Networks is specified")
+	}
+	ipAddresses := sets.New[string]()
+	for i, network := range spec.Networks {
+		networkPath := specPath.Child("networks").Index(i)
+		if res, _ := validateIBMPowerVSNetworkReference(network.IBMPowerVSResourceReference); !res {
+			return field.Invalid(networkPath, network.IBMPowerVSResourceReference, "Only one of Network - ID, Name or RegEx can be specified")
+		}
+		if network.IPAddress == nil {
+			continue
+		}
+		if !allowIPAddress {
+			return field.Forbidden(networkPath.Child("ipAddress"), "A fixed IP address would be shared by all the machines created from the template")
+		}
+		if ipAddresses.Has(*network.IPAddress) {
+			return field.Duplicate(networkPath.Child("ipAddress"), *network.IPAddress)
+		}
+		ipAddresses.Insert(*network.IPAddress)
+	}
+	return nil
+}
+
+func validateIBMPowerVSSharedProcessorPool(spec infrav1.IBMPowerVSMachineSpec, specPath *field.Path) *field.Error {
+	if spec.SharedProcessorPool == nil {
+		return nil
+	}
+	if spec.SharedProcessorPool.ID != nil && spec.SharedProcessorPool.Name != nil {
+		return field.Invalid(specPath.Child("sharedProcessorPool"), spec.SharedProcessorPool, "Only one of SharedProcessorPool - ID or Name may be specified")
+	}
+	if spec.SharedProcessorPool.ID == nil && spec.SharedProcessorPool.Name == nil {
+		return field.Invalid(specPath.Child("sharedProcessorPool"), spec.SharedProcessorPool, "One of SharedProcessorPool - ID or Name must be specified")
+	}
+	if spec.ProcessorType == infrav1.PowerVSProcessorTypeDedicated {
+		return field.Invalid(specPath.Child("processorType"), spec.ProcessorType, "ProcessorType must be Shared or Capped when SharedProcessorPool is specified")
+	}
+	return nil
+}
+
+func validateIBMPowerVSPlacement(spec infrav1.IBMPowerVSMachineSpec, specPath *field.Path) *field.Error {
+	if spec.DeploymentTarget != nil && spec.PlacementGroup != nil {
+		return field.Invalid(specPath.Child("placementGroup"), spec.PlacementGroup, "Only one of DeploymentTarget or PlacementGroup may be specified")
+	}
+	if spec.StorageAffinity == nil {
+		return nil
+	}
+	if spec.StoragePool != "" {
+		return field.Invalid(specPath.Child("storagePool"), spec.StoragePool, "Only one of StoragePool or StorageAffinity may be specified")
+	}
+	if spec.StorageAffinity.Policy == infrav1.PowerVSVolumeAffinityPolicyAffinity && len(spec.StorageAffinity.Instances) != 1 {
+		return field.Invalid(specPath.Child("storageAffinity", "instances"), spec.StorageAffinity.Instances, "Exactly one instance must be specified when the StorageAffinity policy is affinity")
+	}
+	return nil
+}
+
+func validateIBMPowerVSProfile(spec infrav1.IBMPowerVSMachineSpec, specPath *field.Path) (allErrs field.ErrorList) {
+	if spec.Profile == "" {
+		return nil
+	}
+	if spec.SystemType != "" {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("systemType"), "SystemType must be empty when Profile is specified"))
+	}
+	if spec.ProcessorType != "" {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("processorType"), "ProcessorType must be empty when Profile is specified"))
+	}
+	if spec.Processors.StrVal != "" || spec.Processors.IntVal != 0 {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("processors"), "Processors must be empty when Profile is specified"))
+	}
+	if spec.MemoryGiB != 0 {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("memoryGiB"), "MemoryGiB must be empty when Profile is specified"))
+	}
+	if spec.SharedProcessorPool != nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("sharedProcessorPool"), "SharedProcessorPool must be empty when Profile is specified"))
+	}
+	if spec.ResizePolicy == infrav1.PowerVSResizePolicyInPlace {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("resizePolicy"), "An instance with a Profile can't be resized in place"))
+	}
+	return allErrs
+}
+
 func validateIBMPowerVSMemoryValues(resValue int32) bool {
 	if val := float64(resValue); val < 2 {
 		return false
@@ -128,6 +219,39 @@ func validateVolumes(spec infrav1.IBMVPCMachineSpec) field.ErrorList {
 	return allErrs
 }
 
+// validateControlPlaneDNSUpdate validates the DNS Services zone of the control-plane endpoint is not changed nor removed,
+// the resources created in the zone are only deleted with the cluster.
+func validateControlPlaneDNSUpdate(oldDNS, newDNS *infrav1.ControlPlaneDNS, fldPath *field.Path) *field.Error {
+	if !reflect.DeepEqual(oldDNS, newDNS) {
+		return field.Forbidden(fldPath, "controlPlaneDNS is immutable")
+	}
+	return nil
+}
+
+// validateVPEGateways validates the VPE gateways of a cluster have a unique name and target, and a valid CRN.
+func validateVPEGateways(gateways []infrav1.VPEGateway, fldPath *field.Path) (allErrs field.ErrorList) {
+	names := sets.New[string]()
+	targets := sets.New[string]()
+	for i, gateway := range gateways {
+		if gateway.CRN != nil && !isValidCRN(*gateway.CRN) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("crn"), *gateway.CRN, "crn not in proper IBM Cloud CRN format"))
+		}
+		if gateway.Name != nil {
+			if names.Has(*gateway.Name) {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("name"), *gateway.Name))
+			}
+			names.Insert(*gateway.Name)
+		}
+		// A VPC can only have one VPE gateway per target.
+		target := ptr.Deref(gateway.CRN, string(ptr.Deref(gateway.Service, "")))
+		if targets.Has(target) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), target))
+		}
+		targets.Insert(target)
+	}
+	return allErrs
+}
+
 // isValidCRN checks whether the provided string is a valid IBM Cloud CRN.
 func isValidCRN(crn string) bool {
 	return crnRegex.MatchString(crn)
@@ -17,9 +17,12 @@ limitations under the License.
 package webhooks
 
 import (
+	"reflect"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 )
@@ -112,6 +115,309 @@ func TestValidateIBMPowerVSProcessorValues(t *testing.T) {
 	}
 }
 
+func TestValidateIBMPowerVSNetworks(t *testing.T) {
+	storage := infrav1.IBMPowerVSResourceReference{Name: ptr.To("storage")}
+	tests := []struct {
+		name           string
+		spec           infrav1.IBMPowerVSMachineSpec
+		allowIPAddress bool
+		wantError      bool
+	}{
+		{
+			name: "Networks is not set",
+			spec: infrav1.IBMPowerVSMachineSpec{Network: storage},
+		},
+		{
+			name: "Networks with the cluster network and fixed IP addresses",
+			spec: infrav1.IBMPowerVSMachineSpec{Networks: []infrav1.PowerVSNetworkAttachment{
+				{IPAddress: ptr.To("192.168.0.10")},
+				{IBMPowerVSResourceReference: storage, IPAddress: ptr.To("10.0.0.10")},
+			}},
+			allowIPAddress: true,
+		},
+		{
+			name: "Networks with Network",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				Network:  storage,
+				Networks: []infrav1.PowerVSNetworkAttachment{{IBMPowerVSResourceReference: storage}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Networks with both ID and Name",
+			spec: infrav1.IBMPowerVSMachineSpec{Networks: []infrav1.PowerVSNetworkAttachment{
+				{IBMPowerVSResourceReference: infrav1.IBMPowerVSResourceReference{ID: ptr.To("network-id"), Name: ptr.To("storage")}},
+			}},
+			wantError: true,
+		},
+		{
+			name: "Networks with a duplicate fixed IP address",
+			spec: infrav1.IBMPowerVSMachineSpec{Networks: []infrav1.PowerVSNetworkAttachment{
+				{IPAddress: ptr.To("10.0.0.10")},
+				{IBMPowerVSResourceReference: storage, IPAddress: ptr.To("10.0.0.10")},
+			}},
+			allowIPAddress: true,
+			wantError:      true,
+		},
+		{
+			name: "Networks with a fixed IP address in a template",
+			spec: infrav1.IBMPowerVSMachineSpec{Networks: []infrav1.PowerVSNetworkAttachment{
+				{IBMPowerVSResourceReference: storage, IPAddress: ptr.To("10.0.0.10")},
+			}},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateIBMPowerVSNetworks(tt.spec, field.NewPath("spec"), tt.allowIPAddress); (err != nil) != tt.wantError {
+				t.Errorf("validateIBMPowerVSNetworks() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestValidateIBMPowerVSSharedProcessorPool(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMPowerVSMachineSpec
+		wantError bool
+	}{
+		{
+			name: "SharedProcessorPool is not set",
+			spec: infrav1.IBMPowerVSMachineSpec{ProcessorType: infrav1.PowerVSProcessorTypeDedicated},
+		},
+		{
+			name: "SharedProcessorPool name with Capped processors",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				ProcessorType:       infrav1.PowerVSProcessorTypeCapped,
+				SharedProcessorPool: &infrav1.IBMPowerVSResourceReference{Name: ptr.To("pool")},
+			},
+		},
+		{
+			name: "SharedProcessorPool with Dedicated processors",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				ProcessorType:       infrav1.PowerVSProcessorTypeDedicated,
+				SharedProcessorPool: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("pool-id")},
+			},
+			wantError: true,
+		},
+		{
+			name: "SharedProcessorPool with both ID and Name",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				ProcessorType:       infrav1.PowerVSProcessorTypeShared,
+				SharedProcessorPool: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("pool-id"), Name: ptr.To("pool")},
+			},
+			wantError: true,
+		},
+		{
+			name: "SharedProcessorPool without ID or Name",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				ProcessorType:       infrav1.PowerVSProcessorTypeShared,
+				SharedProcessorPool: &infrav1.IBMPowerVSResourceReference{},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateIBMPowerVSSharedProcessorPool(tt.spec, field.NewPath("spec")); (err != nil) != tt.wantError {
+				t.Errorf("validateIBMPowerVSSharedProcessorPool() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestValidateIBMPowerVSPlacement(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMPowerVSMachineSpec
+		wantError bool
+	}{
+		{
+			name: "Placement is not set",
+			spec: infrav1.IBMPowerVSMachineSpec{},
+		},
+		{
+			name: "DeploymentTarget with storage type and storage pool",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				DeploymentTarget: &infrav1.PowerVSDeploymentTarget{Type: infrav1.PowerVSDeploymentTargetTypeHost, ID: "host-id"},
+				StorageType:      "tier1",
+				StoragePool:      "pool",
+			},
+		},
+		{
+			name: "DeploymentTarget with PlacementGroup",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				DeploymentTarget: &infrav1.PowerVSDeploymentTarget{Type: infrav1.PowerVSDeploymentTargetTypeHostGroup, ID: "host-group-id"},
+				PlacementGroup:   &infrav1.PowerVSPlacementGroupReference{Name: ptr.To("placement-group")},
+			},
+			wantError: true,
+		},
+		{
+			name: "StorageAffinity with StoragePool",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				StoragePool:     "pool",
+				StorageAffinity: &infrav1.PowerVSStorageAffinity{Policy: infrav1.PowerVSVolumeAffinityPolicyAffinity, Instances: []string{"instance"}},
+			},
+			wantError: true,
+		},
+		{
+			name: "StorageAffinity with anti-affinity to multiple instances",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				StorageAffinity: &infrav1.PowerVSStorageAffinity{Policy: infrav1.PowerVSVolumeAffinityPolicyAntiAffinity, Instances: []string{"instance-1", "instance-2"}},
+			},
+		},
+		{
+			name: "StorageAffinity with affinity to multiple instances",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				StorageAffinity: &infrav1.PowerVSStorageAffinity{Policy: infrav1.PowerVSVolumeAffinityPolicyAffinity, Instances: []string{"instance-1", "instance-2"}},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateIBMPowerVSPlacement(tt.spec, field.NewPath("spec")); (err != nil) != tt.wantError {
+				t.Errorf("validateIBMPowerVSPlacement() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestValidateIBMPowerVSProfile(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       infrav1.IBMPowerVSMachineSpec
+		wantErrors int
+	}{
+		{
+			name: "Profile is not set",
+			spec: infrav1.IBMPowerVSMachineSpec{SystemType: "s922", Processors: intstr.FromString("0.5"), MemoryGiB: 4},
+		},
+		{
+			name: "Profile without processors and memory",
+			spec: infrav1.IBMPowerVSMachineSpec{Profile: "ush1-4x128"},
+		},
+		{
+			name: "Profile with system type, processors and memory",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				Profile:       "ush1-4x128",
+				SystemType:    "e980",
+				ProcessorType: infrav1.PowerVSProcessorTypeDedicated,
+				Processors:    intstr.FromInt(4),
+				MemoryGiB:     128,
+			},
+			wantErrors: 4,
+		},
+		{
+			name: "Profile with shared processor pool and in place resize",
+			spec: infrav1.IBMPowerVSMachineSpec{
+				Profile:             "ush1-4x128",
+				SharedProcessorPool: &infrav1.IBMPowerVSResourceReference{Name: ptr.To("pool")},
+				ResizePolicy:        infrav1.PowerVSResizePolicyInPlace,
+			},
+			wantErrors: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := validateIBMPowerVSProfile(tt.spec, field.NewPath("spec")); len(errs) != tt.wantErrors {
+				t.Errorf("validateIBMPowerVSProfile() = %v, want %d errors", errs, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestValidateControlPlaneDNSUpdate(t *testing.T) {
+	controlPlaneDNS := &infrav1.ControlPlaneDNS{InstanceID: "dns-instance-id", Zone: "capi.example.com"}
+	tests := []struct {
+		name    string
+		oldDNS  *infrav1.ControlPlaneDNS
+		newDNS  *infrav1.ControlPlaneDNS
+		wantErr bool
+	}{
+		{
+			name:   "Unchanged",
+			oldDNS: controlPlaneDNS,
+			newDNS: &infrav1.ControlPlaneDNS{InstanceID: "dns-instance-id", Zone: "capi.example.com"},
+		},
+		{
+			name: "Not set",
+		},
+		{
+			name:    "Added",
+			newDNS:  controlPlaneDNS,
+			wantErr: true,
+		},
+		{
+			name:    "Removed",
+			oldDNS:  controlPlaneDNS,
+			wantErr: true,
+		},
+		{
+			name:    "Zone changed",
+			oldDNS:  controlPlaneDNS,
+			newDNS:  &infrav1.ControlPlaneDNS{InstanceID: "dns-instance-id", Zone: "other.example.com"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateControlPlaneDNSUpdate(tt.oldDNS, tt.newDNS, field.NewPath("spec", "controlPlaneDNS")); (err != nil) != tt.wantErr {
+				t.Errorf("validateControlPlaneDNSUpdate() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateVPEGateways(t *testing.T) {
+	tests := []struct {
+		name       string
+		gateways   []infrav1.VPEGateway
+		wantErrors int
+	}{
+		{
+			name: "Services and CRN",
+			gateways: []infrav1.VPEGateway{
+				{Service: ptr.To(infrav1.VPEGatewayServiceCOS)},
+				{Service: ptr.To(infrav1.VPEGatewayServiceIAM)},
+				{Name: ptr.To("registry"), CRN: ptr.To("crn:v1:bluemix:public:container-registry:us-south:::endpoint:vpe.us.icr.io")},
+			},
+		},
+		{
+			name: "Invalid CRN",
+			gateways: []infrav1.VPEGateway{
+				{Name: ptr.To("registry"), CRN: ptr.To("vpe.us.icr.io")},
+			},
+			wantErrors: 1,
+		},
+		{
+			name: "Duplicate names and targets",
+			gateways: []infrav1.VPEGateway{
+				{Name: ptr.To("cos"), Service: ptr.To(infrav1.VPEGatewayServiceCOS)},
+				{Name: ptr.To("cos"), Service: ptr.To(infrav1.VPEGatewayServiceIAM)},
+				{Service: ptr.To(infrav1.VPEGatewayServiceCOS)},
+			},
+			wantErrors: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := validateVPEGateways(tt.gateways, field.NewPath("spec", "vpeGateways")); len(errs) != tt.wantErrors {
+				t.Errorf("validateVPEGateways() = %v, want %d errors", errs, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestDefaultIBMPowerVSMachineSpecWithProfile(t *testing.T) {
+	spec := infrav1.IBMPowerVSMachineSpec{Profile: "ush1-4x128"}
+	defaultIBMPowerVSMachineSpec(&spec)
+	if !reflect.DeepEqual(spec, infrav1.IBMPowerVSMachineSpec{Profile: "ush1-4x128"}) {
+		t.Errorf("defaultIBMPowerVSMachineSpec() set the fields of a spec with a profile: %+v", spec)
+	}
+}
+
 func Test_validateVolumes(t *testing.T) {
 	tests := []struct {
 		name      string
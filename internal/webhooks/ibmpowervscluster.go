@@ -19,7 +19,9 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
+	"slices"
 	"strconv"
 
 	regionUtil "github.com/ppc64le-cloud/powervs-utils"
@@ -29,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -96,11 +99,22 @@ func validateIBMPowerVSCluster(oldCluster, newCluster *infrav1.IBMPowerVSCluster
 	if err := validateIBMPowerVSClusterCreateInfraPrereq(newCluster); err != nil {
 		allErrs = append(allErrs, err...)
 	}
+
+	if err := validateIBMPowerVSClusterControlPlaneDNS(newCluster); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if err := validateIBMPowerVSClusterDNS(newCluster); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	allErrs = append(allErrs, validateIBMPowerVSClusterVPEGateways(newCluster)...)
 	// Need not validate for create operation
 	if oldCluster != nil {
 		if err := validateAdditionalListenerSelector(newCluster, oldCluster); err != nil {
 			allErrs = append(allErrs, err...)
 		}
+		if err := validateControlPlaneDNSUpdate(oldCluster.Spec.ControlPlaneDNS, newCluster.Spec.ControlPlaneDNS, field.NewPath("spec", "controlPlaneDNS")); err != nil {
+			allErrs = append(allErrs, err)
+		}
 	}
 
 	if len(allErrs) == 0 {
@@ -125,6 +139,42 @@ func validateIBMPowerVSClusterNetwork(cluster *infrav1.IBMPowerVSCluster) *field
 	return nil
 }
 
+func validateIBMPowerVSClusterControlPlaneDNS(cluster *infrav1.IBMPowerVSCluster) *field.Error {
+	if cluster.Spec.ControlPlaneDNS == nil {
+		return nil
+	}
+	if createInfra, err := strconv.ParseBool(cluster.GetAnnotations()[infrav1.CreateInfrastructureAnnotation]); err != nil || !createInfra {
+		return field.Forbidden(field.NewPath("spec", "controlPlaneDNS"), "controlPlaneDNS is only supported when powervs.cluster.x-k8s.io/create-infra annotation is set")
+	}
+	return nil
+}
+
+func validateIBMPowerVSClusterDNS(cluster *infrav1.IBMPowerVSCluster) *field.Error {
+	if cluster.Spec.DNS == nil {
+		return nil
+	}
+	if createInfra, err := strconv.ParseBool(cluster.GetAnnotations()[infrav1.CreateInfrastructureAnnotation]); err != nil || !createInfra {
+		return field.Forbidden(field.NewPath("spec", "dns"), "dns is only supported when powervs.cluster.x-k8s.io/create-infra annotation is set")
+	}
+	// The DNS records point to the public load balancer, a public load balancer is created when none is set.
+	if len(cluster.Spec.LoadBalancers) != 0 && !slices.ContainsFunc(cluster.Spec.LoadBalancers, func(lb infrav1.VPCLoadBalancerSpec) bool {
+		return ptr.Deref(lb.Public, true)
+	}) {
+		return field.Forbidden(field.NewPath("spec", "dns"), "dns is only supported when a public load balancer is set")
+	}
+	return nil
+}
+
+func validateIBMPowerVSClusterVPEGateways(cluster *infrav1.IBMPowerVSCluster) field.ErrorList {
+	if len(cluster.Spec.VPEGateways) == 0 {
+		return nil
+	}
+	if createInfra, err := strconv.ParseBool(cluster.GetAnnotations()[infrav1.CreateInfrastructureAnnotation]); err != nil || !createInfra {
+		return field.ErrorList{field.Forbidden(field.NewPath("spec", "vpeGateways"), "vpeGateways is only supported when powervs.cluster.x-k8s.io/create-infra annotation is set")}
+	}
+	return validateVPEGateways(cluster.Spec.VPEGateways, field.NewPath("spec", "vpeGateways"))
+}
+
 func validateIBMPowerVSClusterLoadBalancers(cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
 	if err := validateIBMPowerVSClusterLoadBalancerNames(cluster); err != nil {
 		allErrs = append(allErrs, err...)
@@ -176,17 +226,40 @@ func validateIBMPowerVSClusterVPCSubnetNames(cluster *infrav1.IBMPowerVSCluster)
 	return allErrs
 }
 
-func validateIBMPowerVSClusterTransitGateway(cluster *infrav1.IBMPowerVSCluster) *field.Error {
+func validateIBMPowerVSClusterTransitGateway(cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
 	if cluster.Spec.Zone == nil && cluster.Spec.VPC == nil {
 		return nil
 	}
-	if cluster.Spec.TransitGateway == nil {
+	transitGateway := cluster.Spec.TransitGateway
+	if transitGateway == nil {
 		return nil
 	}
-	if _, globalRouting, _ := genutil.GetTransitGatewayLocationAndRouting(cluster.Spec.Zone, cluster.Spec.VPC.Region); cluster.Spec.TransitGateway.GlobalRouting != nil && !*cluster.Spec.TransitGateway.GlobalRouting && globalRouting != nil && *globalRouting {
-		return field.Invalid(field.NewPath("spec.transitGateway.globalRouting"), cluster.Spec.TransitGateway.GlobalRouting, "global routing is required since PowerVS and VPC region are from different region")
+	if transitGateway.Shared != nil && *transitGateway.Shared {
+		if transitGateway.ID == nil && transitGateway.Name == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec.transitGateway"), "either id or name of the shared transit gateway must be set"))
+		}
+		if transitGateway.GlobalRouting != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec.transitGateway.globalRouting"), "global routing cannot be set for a shared transit gateway"))
+		}
+	} else if _, globalRouting, _ := genutil.GetTransitGatewayLocationAndRouting(cluster.Spec.Zone, cluster.Spec.VPC.Region); transitGateway.GlobalRouting != nil && !*transitGateway.GlobalRouting && globalRouting != nil && *globalRouting {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec.transitGateway.globalRouting"), transitGateway.GlobalRouting, "global routing is required since PowerVS and VPC region are from different region"))
 	}
-	return nil
+
+	allErrs = append(allErrs, validateTransitGatewayConnectionPrefixFilters(transitGateway.PowerVSConnection, field.NewPath("spec", "transitGateway", "powerVSConnection"))...)
+	allErrs = append(allErrs, validateTransitGatewayConnectionPrefixFilters(transitGateway.VPCConnection, field.NewPath("spec", "transitGateway", "vpcConnection"))...)
+	return allErrs
+}
+
+func validateTransitGatewayConnectionPrefixFilters(connection *infrav1.TransitGatewayConnection, path *field.Path) (allErrs field.ErrorList) {
+	if connection == nil {
+		return nil
+	}
+	for i, filter := range connection.PrefixFilters {
+		if _, ipNet, err := net.ParseCIDR(filter.Prefix); err != nil || ipNet.IP.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("prefixFilters").Index(i).Child("prefix"), filter.Prefix, "prefix must be an IPv4 CIDR"))
+		}
+	}
+	return allErrs
 }
 
 func validateIBMPowerVSClusterCreateInfraPrereq(cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
@@ -241,7 +314,7 @@ func validateIBMPowerVSClusterCreateInfraPrereq(cluster *infrav1.IBMPowerVSClust
 	}
 
 	if err := validateIBMPowerVSClusterTransitGateway(cluster); err != nil {
-		allErrs = append(allErrs, err)
+		allErrs = append(allErrs, err...)
 	}
 
 	return allErrs
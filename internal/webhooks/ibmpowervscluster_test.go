@@ -130,6 +130,53 @@ func TestIBMPowerVSCluster_create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should error if control-plane DNS is set without create infra annotation",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					ControlPlaneDNS: &infrav1.ControlPlaneDNS{
+						InstanceID: "capi-dns-instance-id",
+						Zone:       "capi.example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if CIS DNS is set without create infra annotation",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					DNS: &infrav1.DNS{
+						CISInstanceCRN: "crn:v1:bluemix:public:internet-svcs:global:a/account-id:cis-instance-id::",
+						Domain:         "example.com",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if VPE gateways are set without create infra annotation",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstanceID: "capi-si-id",
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					VPEGateways: []infrav1.VPEGateway{
+						{Service: ptr.To(infrav1.VPEGatewayServiceCOS)},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -423,3 +470,49 @@ func TestIBMPowerVSCluster_update(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIBMPowerVSClusterDNS(t *testing.T) {
+	tests := []struct {
+		name          string
+		loadBalancers []infrav1.VPCLoadBalancerSpec
+		wantErr       bool
+	}{
+		{
+			name: "Should allow the default load balancer",
+		},
+		{
+			name: "Should allow a public load balancer",
+			loadBalancers: []infrav1.VPCLoadBalancerSpec{
+				{Name: "private-lb", Public: ptr.To(false)},
+				{Name: "public-lb", Public: ptr.To(true)},
+			},
+		},
+		{
+			name: "Should error without a public load balancer",
+			loadBalancers: []infrav1.VPCLoadBalancerSpec{
+				{Name: "private-lb", Public: ptr.To(false)},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{infrav1.CreateInfrastructureAnnotation: "true"},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					LoadBalancers: tc.loadBalancers,
+					DNS: &infrav1.DNS{
+						CISInstanceCRN: "crn:v1:bluemix:public:internet-svcs:global:a/account-id:cis-instance-id::",
+						Domain:         "example.com",
+					},
+				},
+			}
+			if err := validateIBMPowerVSClusterDNS(cluster); (err != nil) != tc.wantErr {
+				t.Errorf("validateIBMPowerVSClusterDNS() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
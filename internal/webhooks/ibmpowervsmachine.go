@@ -65,16 +65,20 @@ func (r *IBMPowerVSMachine) ValidateCreate(_ context.Context, obj runtime.Object
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a IBMPowerVSMachine but got a %T", obj))
 	}
-	return validateIBMPowerVSMachine(objValue)
+	return validateIBMPowerVSMachine(nil, objValue)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
-func (r *IBMPowerVSMachine) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (warnings admission.Warnings, err error) {
+func (r *IBMPowerVSMachine) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	oldObjValue, ok := oldObj.(*infrav1.IBMPowerVSMachine)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a IBMPowerVSMachine but got a %T", oldObj))
+	}
 	newObjValue, ok := newObj.(*infrav1.IBMPowerVSMachine)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a IBMPowerVSMachine but got a %T", newObj))
 	}
-	return validateIBMPowerVSMachine(newObjValue)
+	return validateIBMPowerVSMachine(oldObjValue, newObjValue)
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
@@ -82,18 +86,34 @@ func (r *IBMPowerVSMachine) ValidateDelete(_ context.Context, _ runtime.Object)
 	return nil, nil
 }
 
-func validateIBMPowerVSMachine(machine *infrav1.IBMPowerVSMachine) (admission.Warnings, error) {
+func validateIBMPowerVSMachine(oldMachine, machine *infrav1.IBMPowerVSMachine) (admission.Warnings, error) {
 	var allErrs field.ErrorList
+	if oldMachine != nil {
+		allErrs = append(allErrs, validateIBMPowerVSMachineResize(oldMachine, machine)...)
+	}
 	if err := validateIBMPowerVSMachineNetwork(machine); err != nil {
 		allErrs = append(allErrs, err)
 	}
 	if err := validateIBMPowerVSMachineImage(machine); err != nil {
 		allErrs = append(allErrs, err)
 	}
-	if err := validateIBMPowerVSMachineMemory(machine); err != nil {
+	if machine.Spec.Profile != "" {
+		allErrs = append(allErrs, validateIBMPowerVSProfile(machine.Spec, field.NewPath("spec"))...)
+	} else {
+		if err := validateIBMPowerVSMachineMemory(machine); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		if err := validateIBMPowerVSMachineProcessors(machine); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	if err := validateIBMPowerVSNetworks(machine.Spec, field.NewPath("spec"), true); err != nil {
 		allErrs = append(allErrs, err)
 	}
-	if err := validateIBMPowerVSMachineProcessors(machine); err != nil {
+	if err := validateIBMPowerVSSharedProcessorPool(machine.Spec, field.NewPath("spec")); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if err := validateIBMPowerVSPlacement(machine.Spec, field.NewPath("spec")); err != nil {
 		allErrs = append(allErrs, err)
 	}
 	if len(allErrs) == 0 {
@@ -105,6 +125,20 @@ func validateIBMPowerVSMachine(machine *infrav1.IBMPowerVSMachine) (admission.Wa
 		machine.Name, allErrs)
 }
 
+// validateIBMPowerVSMachineResize allows the processors and memory of a machine to change only when its instance is resized in place.
+func validateIBMPowerVSMachineResize(oldMachine, machine *infrav1.IBMPowerVSMachine) (allErrs field.ErrorList) {
+	if machine.Spec.ResizePolicy == infrav1.PowerVSResizePolicyInPlace {
+		return nil
+	}
+	if machine.Spec.Processors != oldMachine.Spec.Processors {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "processors"), "Processors can only be changed when ResizePolicy is InPlace"))
+	}
+	if machine.Spec.MemoryGiB != oldMachine.Spec.MemoryGiB {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "memoryGiB"), "MemoryGiB can only be changed when ResizePolicy is InPlace"))
+	}
+	return allErrs
+}
+
 func validateIBMPowerVSMachineNetwork(machine *infrav1.IBMPowerVSMachine) *field.Error {
 	if res, err := validateIBMPowerVSNetworkReference(machine.Spec.Network); !res {
 		return err
@@ -138,6 +138,50 @@ func TestIBMPowerVSMachine_create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should fail to validate IBMPowerVSMachine - SharedProcessorPool with Dedicated ProcessorType",
+			powerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstanceID: "capi-si-id",
+					SystemType:        "s922",
+					ProcessorType:     infrav1.PowerVSProcessorTypeDedicated,
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+					SharedProcessorPool: &infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi_pool"),
+					},
+					Processors: intstr.FromInt(1),
+					MemoryGiB:  4,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should successfully validate IBMPowerVSMachine - SharedProcessorPool with Shared ProcessorType",
+			powerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstanceID: "capi-si-id",
+					SystemType:        "s922",
+					ProcessorType:     infrav1.PowerVSProcessorTypeShared,
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+					SharedProcessorPool: &infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi_pool"),
+					},
+					Processors: intstr.FromString("0.25"),
+					MemoryGiB:  4,
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Should successfully validate IBMPowerVSMachine - valid spec",
 			powerVSMachine: &infrav1.IBMPowerVSMachine{
@@ -352,6 +396,40 @@ func TestIBMPowerVSMachine_update(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should fail to update IBMPowerVSMachine memory without InPlace ResizePolicy",
+			oldPowerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstanceID: "capi-si-id",
+					SystemType:        "s922",
+					ProcessorType:     infrav1.PowerVSProcessorTypeShared,
+					MemoryGiB:         4,
+					Processors:        intstr.FromString("0.25"),
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+				},
+			},
+			newPowerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstanceID: "capi-si-id",
+					SystemType:        "s922",
+					ProcessorType:     infrav1.PowerVSProcessorTypeShared,
+					MemoryGiB:         8,
+					Processors:        intstr.FromString("0.5"),
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Should successfully update IBMPowerVSMachine",
 			oldPowerVSMachine: &infrav1.IBMPowerVSMachine{
@@ -374,6 +452,7 @@ func TestIBMPowerVSMachine_update(t *testing.T) {
 					ServiceInstanceID: "capi-si-id",
 					SystemType:        "s922",
 					ProcessorType:     infrav1.PowerVSProcessorTypeShared,
+					ResizePolicy:      infrav1.PowerVSResizePolicyInPlace,
 					MemoryGiB:         8,
 					Processors:        intstr.FromString("0.25"),
 					Network: infrav1.IBMPowerVSResourceReference{
@@ -404,3 +483,43 @@ func TestIBMPowerVSMachine_update(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIBMPowerVSMachineResize(t *testing.T) {
+	oldMachine := &infrav1.IBMPowerVSMachine{
+		Spec: infrav1.IBMPowerVSMachineSpec{
+			MemoryGiB:  4,
+			Processors: intstr.FromString("0.25"),
+		},
+	}
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMPowerVSMachineSpec
+		wantError bool
+	}{
+		{
+			name: "Processors and memory are unchanged",
+			spec: oldMachine.Spec,
+		},
+		{
+			name:      "Processors are changed without InPlace ResizePolicy",
+			spec:      infrav1.IBMPowerVSMachineSpec{MemoryGiB: 4, Processors: intstr.FromString("0.5")},
+			wantError: true,
+		},
+		{
+			name:      "Memory is changed with Immutable ResizePolicy",
+			spec:      infrav1.IBMPowerVSMachineSpec{ResizePolicy: infrav1.PowerVSResizePolicyImmutable, MemoryGiB: 8, Processors: intstr.FromString("0.25")},
+			wantError: true,
+		},
+		{
+			name: "Processors and memory are changed with InPlace ResizePolicy",
+			spec: infrav1.IBMPowerVSMachineSpec{ResizePolicy: infrav1.PowerVSResizePolicyInPlace, MemoryGiB: 8, Processors: intstr.FromString("0.5")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := validateIBMPowerVSMachineResize(oldMachine, &infrav1.IBMPowerVSMachine{Spec: tt.spec}); (len(errs) != 0) != tt.wantError {
+				t.Errorf("validateIBMPowerVSMachineResize() = %v, wantError %v", errs, tt.wantError)
+			}
+		})
+	}
+}
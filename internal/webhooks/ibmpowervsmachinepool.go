@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+)
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-infrastructure-cluster-x-k8s-io-v1beta2-ibmpowervsmachinepool,mutating=false,failurePolicy=fail,groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsmachinepools,versions=v1beta2,name=vibmpowervsmachinepool.kb.io,sideEffects=None,admissionReviewVersions=v1;v1beta1
+
+func (r *IBMPowerVSMachinePool) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&infrav1.IBMPowerVSMachinePool{}).
+		WithValidator(r).
+		Complete()
+}
+
+// IBMPowerVSMachinePool implements a validation webhook for IBMPowerVSMachinePool.
+type IBMPowerVSMachinePool struct{}
+
+var _ webhook.CustomValidator = &IBMPowerVSMachinePool{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (r *IBMPowerVSMachinePool) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	objValue, ok := obj.(*infrav1.IBMPowerVSMachinePool)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a IBMPowerVSMachinePool but got a %T", obj))
+	}
+	return validateIBMPowerVSMachinePool(objValue)
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
+func (r *IBMPowerVSMachinePool) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	objValue, ok := newObj.(*infrav1.IBMPowerVSMachinePool)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a IBMPowerVSMachinePool but got a %T", newObj))
+	}
+	return validateIBMPowerVSMachinePool(objValue)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
+func (r *IBMPowerVSMachinePool) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateIBMPowerVSMachinePool(machinePool *infrav1.IBMPowerVSMachinePool) (admission.Warnings, error) {
+	var allErrs field.ErrorList
+	// The machine pool controller does not attach additional volumes to the instances it creates.
+	if len(machinePool.Spec.Template.Spec.AdditionalVolumes) > 0 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "template", "spec", "additionalVolumes"), "additionalVolumes is not supported for machine pools"))
+	}
+	if len(allErrs) == 0 {
+		return nil, nil
+	}
+
+	return nil, apierrors.NewInvalid(
+		schema.GroupKind{Group: "infrastructure.cluster.x-k8s.io", Kind: "IBMPowerVSMachinePool"},
+		machinePool.Name, allErrs)
+}
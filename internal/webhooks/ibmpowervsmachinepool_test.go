@@ -0,0 +1,75 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestIBMPowerVSMachinePool_validate(t *testing.T) {
+	newMachinePool := func(volumes ...infrav1.PowerVSAdditionalVolume) *infrav1.IBMPowerVSMachinePool {
+		return &infrav1.IBMPowerVSMachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-machine-pool",
+				Namespace: "default",
+			},
+			Spec: infrav1.IBMPowerVSMachinePoolSpec{
+				Template: infrav1.IBMPowerVSMachineTemplateResource{
+					Spec: infrav1.IBMPowerVSMachineSpec{
+						Image: &infrav1.IBMPowerVSResourceReference{
+							ID: ptr.To("capi-image"),
+						},
+						AdditionalVolumes: volumes,
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		machinePool *infrav1.IBMPowerVSMachinePool
+		wantErr     bool
+	}{
+		{
+			name:        "Should allow a machine pool without additional volumes",
+			machinePool: newMachinePool(),
+		},
+		{
+			name:        "Should reject a machine pool with additional volumes",
+			machinePool: newMachinePool(infrav1.PowerVSAdditionalVolume{SizeGiB: 10}),
+			wantErr:     true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := (&IBMPowerVSMachinePool{}).ValidateCreate(context.Background(), tc.machinePool)
+			g.Expect(err != nil).To(Equal(tc.wantErr))
+			_, err = (&IBMPowerVSMachinePool{}).ValidateUpdate(context.Background(), newMachinePool(), tc.machinePool)
+			g.Expect(err != nil).To(Equal(tc.wantErr))
+		})
+	}
+}
@@ -90,10 +90,23 @@ func validateIBMPowerVSMachineTemplate(machineTemplate *infrav1.IBMPowerVSMachin
 	if err := validateIBMPowerVSMachineTemplateImage(machineTemplate); err != nil {
 		allErrs = append(allErrs, err)
 	}
-	if err := validateIBMPowerVSMachineTemplateMemory(machineTemplate); err != nil {
+	if machineTemplate.Spec.Template.Spec.Profile != "" {
+		allErrs = append(allErrs, validateIBMPowerVSProfile(machineTemplate.Spec.Template.Spec, field.NewPath("spec", "template", "spec"))...)
+	} else {
+		if err := validateIBMPowerVSMachineTemplateMemory(machineTemplate); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		if err := validateIBMPowerVSMachineTemplateProcessors(machineTemplate); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	if err := validateIBMPowerVSNetworks(machineTemplate.Spec.Template.Spec, field.NewPath("spec", "template", "spec"), false); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if err := validateIBMPowerVSSharedProcessorPool(machineTemplate.Spec.Template.Spec, field.NewPath("spec", "template", "spec")); err != nil {
 		allErrs = append(allErrs, err)
 	}
-	if err := validateIBMPowerVSMachineTemplateProcessors(machineTemplate); err != nil {
+	if err := validateIBMPowerVSPlacement(machineTemplate.Spec.Template.Spec, field.NewPath("spec", "template", "spec")); err != nil {
 		allErrs = append(allErrs, err)
 	}
 	if len(allErrs) == 0 {
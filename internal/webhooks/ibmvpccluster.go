@@ -60,16 +60,20 @@ func (r *IBMVPCCluster) ValidateCreate(_ context.Context, obj runtime.Object) (a
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a IBMVPCCluster but got a %T", obj))
 	}
-	return validateIBMVPCCluster(objValue)
+	return validateIBMVPCCluster(nil, objValue)
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type.
-func (r *IBMVPCCluster) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (warnings admission.Warnings, err error) {
+func (r *IBMVPCCluster) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (warnings admission.Warnings, err error) {
+	oldObjValue, ok := oldObj.(*infrav1.IBMVPCCluster)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a IBMVPCCluster but got a %T", oldObj))
+	}
 	objValue, ok := newObj.(*infrav1.IBMVPCCluster)
 	if !ok {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("expected a IBMVPCCluster but got a %T", objValue))
 	}
-	return validateIBMVPCCluster(objValue)
+	return validateIBMVPCCluster(oldObjValue, objValue)
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type.
@@ -77,11 +81,26 @@ func (r *IBMVPCCluster) ValidateDelete(_ context.Context, _ runtime.Object) (adm
 	return nil, nil
 }
 
-func validateIBMVPCCluster(vpcCluster *infrav1.IBMVPCCluster) (admission.Warnings, error) {
+func validateIBMVPCCluster(oldVPCCluster, vpcCluster *infrav1.IBMVPCCluster) (admission.Warnings, error) {
 	var allErrs field.ErrorList
 	if err := validateIBMVPCClusterControlPlane(vpcCluster); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	if err := validateIBMVPCClusterControlPlaneDNS(vpcCluster); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if err := validateIBMVPCClusterDNS(vpcCluster); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if vpcCluster.Spec.Network != nil {
+		allErrs = append(allErrs, validateVPEGateways(vpcCluster.Spec.Network.VPEGateways, field.NewPath("spec", "network", "vpeGateways"))...)
+	}
+	// Need not validate for create operation
+	if oldVPCCluster != nil {
+		if err := validateControlPlaneDNSUpdate(oldVPCCluster.Spec.ControlPlaneDNS, vpcCluster.Spec.ControlPlaneDNS, field.NewPath("spec", "controlPlaneDNS")); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -97,3 +116,17 @@ func validateIBMVPCClusterControlPlane(vpcCluster *infrav1.IBMVPCCluster) *field
 	}
 	return nil
 }
+
+func validateIBMVPCClusterControlPlaneDNS(vpcCluster *infrav1.IBMVPCCluster) *field.Error {
+	if vpcCluster.Spec.ControlPlaneDNS != nil && vpcCluster.Spec.Network == nil {
+		return field.Forbidden(field.NewPath("spec", "controlPlaneDNS"), "controlPlaneDNS is only supported when network is set")
+	}
+	return nil
+}
+
+func validateIBMVPCClusterDNS(vpcCluster *infrav1.IBMVPCCluster) *field.Error {
+	if vpcCluster.Spec.DNS != nil && vpcCluster.Spec.Network == nil {
+		return field.Forbidden(field.NewPath("spec", "dns"), "dns is only supported when network is set")
+	}
+	return nil
+}
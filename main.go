@@ -47,6 +47,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/controllers"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/internal/webhooks"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
@@ -125,6 +126,41 @@ func initFlags(fs *pflag.FlagSet) {
 		"Set custom service endpoint in semi-colon separated format: ${ServiceRegion1}:${ServiceID1}=${URL1},${ServiceID2}=${URL2};${ServiceRegion2}:${ServiceID1}=${URL1}",
 	)
 
+	fs.StringVar(
+		&authenticator.AuthType,
+		"auth-type",
+		"",
+		"Set the IBM Cloud authentication type overriding IBMCLOUD_AUTH_TYPE of the credentials file: iam, container to assume a trusted profile with the projected service account token, or vpc to assume it with the compute resource token of the VPC instance metadata service",
+	)
+
+	fs.StringVar(
+		&authenticator.IAMProfileID,
+		"iam-profile-id",
+		"",
+		"Set the ID of the IAM trusted profile to assume with the container and vpc authentication types",
+	)
+
+	fs.StringVar(
+		&authenticator.IAMProfileName,
+		"iam-profile-name",
+		"",
+		"Set the name of the IAM trusted profile to assume with the container authentication type",
+	)
+
+	fs.StringVar(
+		&authenticator.IAMProfileCRN,
+		"iam-profile-crn",
+		"",
+		"Set the CRN of the IAM trusted profile to assume with the vpc authentication type",
+	)
+
+	fs.StringVar(
+		&authenticator.CRTokenFilename,
+		"cr-token-filename",
+		"",
+		"Set the file holding the projected service account token used as compute resource token with the container authentication type, defaults to /var/run/secrets/tokens/vault-token or /var/run/secrets/tokens/sa-token",
+	)
+
 	fs.IntVar(&webhookPort,
 		"webhook-port",
 		9443,
@@ -150,6 +186,10 @@ func validateFlags() error {
 		return fmt.Errorf("invalid value for flag provider-id-fmt: %s, Only supported value is %s", options.ProviderIDFormat, options.ProviderIDFormatV2)
 	}
 
+	if err := authenticator.ValidateFlags(); err != nil {
+		return err
+	}
+
 	if err := logsv1.ValidateAndApply(logOptions, nil); err != nil {
 		setupLog.Error(err, "unable to validate and apply log options")
 		return err
@@ -285,6 +325,18 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		os.Exit(1)
 	}
 
+	if err := (&controllers.IBMVPCMachinePoolReconciler{
+		Client:           mgr.GetClient(),
+		Log:              ctrl.Log.WithName("controllers").WithName("IBMVPCMachinePool"),
+		Recorder:         mgr.GetEventRecorderFor("ibmvpcmachinepool-controller"),
+		ServiceEndpoint:  serviceEndpoint,
+		Scheme:           mgr.GetScheme(),
+		WatchFilterValue: watchFilterValue,
+	}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IBMVPCMachinePool")
+		os.Exit(1)
+	}
+
 	if err := (&controllers.IBMPowerVSClusterReconciler{
 		Client:          mgr.GetClient(),
 		Recorder:        mgr.GetEventRecorderFor("ibmpowervscluster-controller"),
@@ -306,6 +358,28 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, serviceEndpoint []e
 		os.Exit(1)
 	}
 
+	if err := (&controllers.IBMPowerVSMachinePoolReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorderFor("ibmpowervsmachinepool-controller"),
+		ServiceEndpoint:  serviceEndpoint,
+		Scheme:           mgr.GetScheme(),
+		WatchFilterValue: watchFilterValue,
+	}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IBMPowerVSMachinePool")
+		os.Exit(1)
+	}
+
+	if err := (&controllers.IBMPowerVSMachineSnapshotReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorderFor("ibmpowervsmachinesnapshot-controller"),
+		ServiceEndpoint:  serviceEndpoint,
+		Scheme:           mgr.GetScheme(),
+		WatchFilterValue: watchFilterValue,
+	}).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "IBMPowerVSMachineSnapshot")
+		os.Exit(1)
+	}
+
 	if err := (&controllers.IBMPowerVSImageReconciler{
 		Client:          mgr.GetClient(),
 		Recorder:        mgr.GetEventRecorderFor("ibmpowervsimage-controller"),
@@ -359,6 +433,10 @@ func setupWebhooks(mgr ctrl.Manager) {
 		setupLog.Error(err, "unable to create webhook", "webhook", "IBMPowerVSMachineTemplate")
 		os.Exit(1)
 	}
+	if err := (&webhooks.IBMPowerVSMachinePool{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "IBMPowerVSMachinePool")
+		os.Exit(1)
+	}
 	if err := (&webhooks.IBMPowerVSImage{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "IBMPowerVSImage")
 		os.Exit(1)
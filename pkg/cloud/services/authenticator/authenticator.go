@@ -17,7 +17,10 @@ limitations under the License.
 package authenticator
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 )
@@ -26,6 +29,26 @@ const (
 	serviceIBMCloud = "IBMCLOUD"
 )
 
+// Prefix is the prefix of the credential properties in the environment and the ibm-credentials.env file, e.g. IBMCLOUD_APIKEY.
+const Prefix = serviceIBMCloud + "_"
+
+// ErrTrustedProfileNotAssumed is returned when an IAM trusted profile cannot be assumed with a compute resource token.
+var ErrTrustedProfileNotAssumed = errors.New("failed to assume IAM trusted profile")
+
+// The flags of the manager overriding the properties of the credentials file and the environment.
+var (
+	// AuthType is the authentication type, one of iam, container or vpc.
+	AuthType string
+	// IAMProfileID is the ID of the IAM trusted profile to assume with the container and vpc authentication types.
+	IAMProfileID string
+	// IAMProfileName is the name of the IAM trusted profile to assume with the container authentication type.
+	IAMProfileName string
+	// IAMProfileCRN is the CRN of the IAM trusted profile to assume with the vpc authentication type.
+	IAMProfileCRN string
+	// CRTokenFilename is the file holding the compute resource token with the container authentication type.
+	CRTokenFilename string
+)
+
 // This expects the credential file in the following search order:
 // 1) ${IBM_CREDENTIALS_FILE}
 // 2) <user-home-dir>/ibm-credentials.env
@@ -36,9 +59,26 @@ const (
 // IBMCLOUD_AUTH_TYPE=iam
 // IBMCLOUD_APIKEY=xxxxxxxxxxxxx
 // IBMCLOUD_AUTH_URL=https://iam.cloud.ibm.com
+//
+// or, to assume a trusted profile with the projected service account token of the manager:
+// IBMCLOUD_AUTH_TYPE=container
+// IBMCLOUD_IAM_PROFILE_NAME=capibm
+// IBMCLOUD_CR_TOKEN_FILENAME=/var/run/secrets/tokens/sa-token
+//
+// or, to assume a trusted profile with the compute resource token of the VPC instance running the manager:
+// IBMCLOUD_AUTH_TYPE=vpc
+// IBMCLOUD_IAM_PROFILE_CRN=crn:v1:bluemix:public:iam-identity::a/xxxxxxxxxxxxx::profile:Profile-xxxxxxxxxxxxx
 
 // GetAuthenticator will get the authenticator for ibmcloud.
 func GetAuthenticator() (core.Authenticator, error) {
+	if flagsSet() {
+		properties, err := GetProperties()
+		if err != nil {
+			return nil, err
+		}
+		return newAuthenticator(properties)
+	}
+
 	auth, err := core.GetAuthenticatorFromEnvironment(serviceIBMCloud)
 	if err != nil {
 		return nil, err
@@ -49,30 +89,121 @@ func GetAuthenticator() (core.Authenticator, error) {
 	return auth, nil
 }
 
-// GetProperties returns a map containing configuration properties for the specified service that are retrieved from external configuration sources.
-func GetProperties() (map[string]string, error) {
-	properties, err := core.GetServiceProperties(serviceIBMCloud)
-	if err != nil {
-		return nil, fmt.Errorf("error while fetching service properties")
+// NewAuthenticator returns the authenticator configured by properties in the ibm-credentials.env format, e.g. IBMCLOUD_APIKEY.
+// The iam, container and vpc authentication types are supported, iam is the default.
+func NewAuthenticator(properties map[string]string) (core.Authenticator, error) {
+	unprefixed := make(map[string]string, len(properties))
+	for name, value := range properties {
+		if strings.HasPrefix(name, Prefix) {
+			unprefixed[strings.TrimPrefix(name, Prefix)] = value
+		}
 	}
-	return properties, nil
+	return newAuthenticator(unprefixed)
 }
 
-// GetIAMAuthenticator will get the IAM authenticator for ibmcloud.
-func GetIAMAuthenticator() (*core.IamAuthenticator, error) {
-	props, err := GetProperties()
+func newAuthenticator(properties map[string]string) (core.Authenticator, error) {
+	authType := properties[core.PROPNAME_AUTH_TYPE]
+	switch {
+	case authType == "" || strings.EqualFold(authType, core.AUTHTYPE_IAM):
+		return core.NewIamAuthenticatorBuilder().
+			SetApiKey(properties[core.PROPNAME_APIKEY]).
+			SetURL(properties[core.PROPNAME_AUTH_URL]).
+			Build()
+	case strings.EqualFold(authType, core.AUTHTYPE_CONTAINER):
+		return core.NewContainerAuthenticatorBuilder().
+			SetIAMProfileID(properties[core.PROPNAME_IAM_PROFILE_ID]).
+			SetIAMProfileName(properties[core.PROPNAME_IAM_PROFILE_NAME]).
+			SetCRTokenFilename(properties[core.PROPNAME_CRTOKEN_FILENAME]).
+			SetURL(properties[core.PROPNAME_AUTH_URL]).
+			Build()
+	case strings.EqualFold(authType, core.AUTHTYPE_VPC):
+		return core.NewVpcInstanceAuthenticatorBuilder().
+			SetIAMProfileID(properties[core.PROPNAME_IAM_PROFILE_ID]).
+			SetIAMProfileCRN(properties[core.PROPNAME_IAM_PROFILE_CRN]).
+			SetURL(properties[core.PROPNAME_AUTH_URL]).
+			Build()
+	default:
+		return nil, fmt.Errorf("unsupported authentication type %q, supported types are %s, %s and %s", authType, core.AUTHTYPE_IAM, core.AUTHTYPE_CONTAINER, core.AUTHTYPE_VPC)
+	}
+}
+
+// CheckCredentials obtains an IAM access token with the authenticator, so that credentials which cannot be used are
+// reported before calling the IBM Cloud APIs.
+func CheckCredentials(auth core.Authenticator) error {
+	if auth == nil {
+		return fmt.Errorf("authenticator can't be nil, please set proper authentication")
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://iam.cloud.ibm.com", http.NoBody)
 	if err != nil {
-		return nil, fmt.Errorf("error while fetching service properties: %w", err)
+		return err
+	}
+	err = auth.Authenticate(req)
+	if err == nil {
+		return nil
 	}
 
-	apiKey := props["APIKEY"]
-	if apiKey == "" {
-		fmt.Printf("ibmcloud api key is not provided, set %s environmental variable", "IBMCLOUD_API_KEY")
+	switch a := auth.(type) {
+	case *core.ContainerAuthenticator:
+		tokenFile := a.CRTokenFilename
+		if tokenFile == "" {
+			tokenFile = "the default compute resource token file"
+		}
+		return fmt.Errorf("%w %s with the compute resource token of %s: %w", ErrTrustedProfileNotAssumed, profile(a.IAMProfileID, a.IAMProfileName, ""), tokenFile, err)
+	case *core.VpcInstanceAuthenticator:
+		return fmt.Errorf("%w %s with the compute resource token of the VPC instance metadata service: %w", ErrTrustedProfileNotAssumed, profile(a.IAMProfileID, "", a.IAMProfileCRN), err)
+	default:
+		return fmt.Errorf("failed to obtain IAM access token with the %s authenticator: %w", auth.AuthenticationType(), err)
 	}
+}
 
-	auth := &core.IamAuthenticator{
-		ApiKey: apiKey,
+func profile(id, name, crn string) string {
+	switch {
+	case id != "":
+		return id
+	case name != "":
+		return name
+	case crn != "":
+		return crn
+	default:
+		return "linked to the compute resource"
 	}
+}
 
-	return auth, nil
+// flagsSet returns whether one of the authentication flags is set.
+func flagsSet() bool {
+	return AuthType != "" || IAMProfileID != "" || IAMProfileName != "" || IAMProfileCRN != "" || CRTokenFilename != ""
+}
+
+// ValidateFlags validates the authentication flags.
+func ValidateFlags() error {
+	switch {
+	case AuthType == "", strings.EqualFold(AuthType, core.AUTHTYPE_IAM), strings.EqualFold(AuthType, core.AUTHTYPE_CONTAINER), strings.EqualFold(AuthType, core.AUTHTYPE_VPC):
+		return nil
+	default:
+		return fmt.Errorf("invalid value for flag auth-type: %s, supported values are %s, %s and %s", AuthType, core.AUTHTYPE_IAM, core.AUTHTYPE_CONTAINER, core.AUTHTYPE_VPC)
+	}
+}
+
+// GetProperties returns a map containing configuration properties for the specified service that are retrieved from external configuration sources,
+// overridden by the authentication flags.
+func GetProperties() (map[string]string, error) {
+	properties, err := core.GetServiceProperties(serviceIBMCloud)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching service properties")
+	}
+	if properties == nil {
+		properties = map[string]string{}
+	}
+	for name, value := range map[string]string{
+		core.PROPNAME_AUTH_TYPE:        AuthType,
+		core.PROPNAME_IAM_PROFILE_ID:   IAMProfileID,
+		core.PROPNAME_IAM_PROFILE_NAME: IAMProfileName,
+		core.PROPNAME_IAM_PROFILE_CRN:  IAMProfileCRN,
+		core.PROPNAME_CRTOKEN_FILENAME: CRTokenFilename,
+	} {
+		if value != "" {
+			properties[name] = value
+		}
+	}
+	return properties, nil
 }
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authenticator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestNewAuthenticator(t *testing.T) {
+	testCases := []struct {
+		name         string
+		properties   map[string]string
+		expectedType string
+		expectError  bool
+	}{
+		{
+			name:         "API key without authentication type",
+			properties:   map[string]string{"IBMCLOUD_APIKEY": "api-key"},
+			expectedType: core.AUTHTYPE_IAM,
+		},
+		{
+			name:         "Container authentication type",
+			properties:   map[string]string{"IBMCLOUD_AUTH_TYPE": "container", "IBMCLOUD_IAM_PROFILE_ID": "Profile-1"},
+			expectedType: core.AUTHTYPE_CONTAINER,
+		},
+		{
+			name:         "VPC authentication type",
+			properties:   map[string]string{"IBMCLOUD_AUTH_TYPE": "VPC", "IBMCLOUD_IAM_PROFILE_CRN": "crn:v1:bluemix:public:iam-identity::a/1::profile:Profile-1"},
+			expectedType: core.AUTHTYPE_VPC,
+		},
+		{
+			name:        "IAM authentication type without API key",
+			properties:  map[string]string{"IBMCLOUD_AUTH_TYPE": "iam"},
+			expectError: true,
+		},
+		{
+			name:        "Container authentication type without trusted profile",
+			properties:  map[string]string{"IBMCLOUD_AUTH_TYPE": "container"},
+			expectError: true,
+		},
+		{
+			name:        "Unsupported authentication type",
+			properties:  map[string]string{"IBMCLOUD_AUTH_TYPE": "basic"},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			auth, err := NewAuthenticator(tc.properties)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(auth.AuthenticationType()).To(Equal(tc.expectedType))
+		})
+	}
+}
+
+func TestGetAuthenticatorFlags(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("IBM_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "ibm-credentials.env"))
+	t.Setenv("IBMCLOUD_AUTH_TYPE", "iam")
+	t.Setenv("IBMCLOUD_APIKEY", "api-key")
+
+	auth, err := GetAuthenticator()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(auth.AuthenticationType()).To(Equal(core.AUTHTYPE_IAM))
+
+	AuthType, IAMProfileName, CRTokenFilename = core.AUTHTYPE_CONTAINER, "capibm", "/var/run/secrets/tokens/capibm"
+	defer func() {
+		AuthType, IAMProfileName, CRTokenFilename = "", "", ""
+	}()
+	g.Expect(ValidateFlags()).To(Succeed())
+
+	auth, err = GetAuthenticator()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(auth).To(BeAssignableToTypeOf(&core.ContainerAuthenticator{}))
+	containerAuth := auth.(*core.ContainerAuthenticator)
+	g.Expect(containerAuth.IAMProfileName).To(Equal("capibm"))
+	g.Expect(containerAuth.CRTokenFilename).To(Equal("/var/run/secrets/tokens/capibm"))
+
+	AuthType = "basic"
+	g.Expect(ValidateFlags()).ToNot(Succeed())
+}
+
+func TestCheckCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		name                    string
+		properties              map[string]string
+		expectProfileNotAssumed bool
+	}{
+		{
+			name: "Container authenticator without compute resource token",
+			properties: map[string]string{
+				"IBMCLOUD_AUTH_TYPE":         "container",
+				"IBMCLOUD_IAM_PROFILE_NAME":  "capibm",
+				"IBMCLOUD_CR_TOKEN_FILENAME": filepath.Join(t.TempDir(), "sa-token"),
+			},
+			expectProfileNotAssumed: true,
+		},
+		{
+			name: "VPC authenticator rejected by the instance metadata service",
+			properties: map[string]string{
+				"IBMCLOUD_AUTH_TYPE": "vpc",
+				"IBMCLOUD_AUTH_URL":  server.URL,
+			},
+			expectProfileNotAssumed: true,
+		},
+		{
+			name: "IAM authenticator with a rejected API key",
+			properties: map[string]string{
+				"IBMCLOUD_APIKEY":   "api-key",
+				"IBMCLOUD_AUTH_URL": server.URL,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			auth, err := NewAuthenticator(tc.properties)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			err = CheckCredentials(auth)
+			g.Expect(err).To(HaveOccurred())
+			if tc.expectProfileNotAssumed {
+				g.Expect(err).To(MatchError(ErrTrustedProfileNotAssumed))
+			} else {
+				g.Expect(err).ToNot(MatchError(ErrTrustedProfileNotAssumed))
+			}
+		})
+	}
+}
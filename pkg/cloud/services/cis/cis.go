@@ -0,0 +1,37 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cis
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+)
+
+//go:generate ../../../../hack/tools/bin/mockgen -source=./cis.go -destination=./mock/cis_generated.go -package=mock
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt ./mock/cis_generated.go > ./mock/_cis_generated.go && mv ./mock/_cis_generated.go ./mock/cis_generated.go"
+
+// CIS interface defines methods that a IBM Cloud Internet Services object should implement
+// to manage the public DNS records of the domains of a CIS instance.
+type CIS interface {
+	GetZoneByName(name string) (*zonesv1.ZoneDetails, error)
+	GetDNSRecord(zoneID string, options *dnsrecordsv1.GetDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error)
+	GetDNSRecordByName(zoneID, name string) (*dnsrecordsv1.DnsrecordDetails, error)
+	CreateDNSRecord(zoneID string, options *dnsrecordsv1.CreateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error)
+	UpdateDNSRecord(zoneID string, options *dnsrecordsv1.UpdateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error)
+	DeleteDNSRecord(zoneID string, options *dnsrecordsv1.DeleteDnsRecordOptions) (*dnsrecordsv1.DeleteDnsrecordResp, *core.DetailedResponse, error)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./cis.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./cis.go -destination=./mock/cis_generated.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	core "github.com/IBM/go-sdk-core/v5/core"
+	dnsrecordsv1 "github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	zonesv1 "github.com/IBM/networking-go-sdk/zonesv1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCIS is a mock of CIS interface.
+type MockCIS struct {
+	ctrl     *gomock.Controller
+	recorder *MockCISMockRecorder
+	isgomock struct{}
+}
+
+// MockCISMockRecorder is the mock recorder for MockCIS.
+type MockCISMockRecorder struct {
+	mock *MockCIS
+}
+
+// NewMockCIS creates a new mock instance.
+func NewMockCIS(ctrl *gomock.Controller) *MockCIS {
+	mock := &MockCIS{ctrl: ctrl}
+	mock.recorder = &MockCISMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCIS) EXPECT() *MockCISMockRecorder {
+	return m.recorder
+}
+
+// CreateDNSRecord mocks base method.
+func (m *MockCIS) CreateDNSRecord(zoneID string, options *dnsrecordsv1.CreateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDNSRecord", zoneID, options)
+	ret0, _ := ret[0].(*dnsrecordsv1.DnsrecordResp)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateDNSRecord indicates an expected call of CreateDNSRecord.
+func (mr *MockCISMockRecorder) CreateDNSRecord(zoneID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDNSRecord", reflect.TypeOf((*MockCIS)(nil).CreateDNSRecord), zoneID, options)
+}
+
+// DeleteDNSRecord mocks base method.
+func (m *MockCIS) DeleteDNSRecord(zoneID string, options *dnsrecordsv1.DeleteDnsRecordOptions) (*dnsrecordsv1.DeleteDnsrecordResp, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDNSRecord", zoneID, options)
+	ret0, _ := ret[0].(*dnsrecordsv1.DeleteDnsrecordResp)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DeleteDNSRecord indicates an expected call of DeleteDNSRecord.
+func (mr *MockCISMockRecorder) DeleteDNSRecord(zoneID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDNSRecord", reflect.TypeOf((*MockCIS)(nil).DeleteDNSRecord), zoneID, options)
+}
+
+// GetDNSRecord mocks base method.
+func (m *MockCIS) GetDNSRecord(zoneID string, options *dnsrecordsv1.GetDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDNSRecord", zoneID, options)
+	ret0, _ := ret[0].(*dnsrecordsv1.DnsrecordResp)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDNSRecord indicates an expected call of GetDNSRecord.
+func (mr *MockCISMockRecorder) GetDNSRecord(zoneID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNSRecord", reflect.TypeOf((*MockCIS)(nil).GetDNSRecord), zoneID, options)
+}
+
+// GetDNSRecordByName mocks base method.
+func (m *MockCIS) GetDNSRecordByName(zoneID, name string) (*dnsrecordsv1.DnsrecordDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDNSRecordByName", zoneID, name)
+	ret0, _ := ret[0].(*dnsrecordsv1.DnsrecordDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDNSRecordByName indicates an expected call of GetDNSRecordByName.
+func (mr *MockCISMockRecorder) GetDNSRecordByName(zoneID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNSRecordByName", reflect.TypeOf((*MockCIS)(nil).GetDNSRecordByName), zoneID, name)
+}
+
+// GetZoneByName mocks base method.
+func (m *MockCIS) GetZoneByName(name string) (*zonesv1.ZoneDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetZoneByName", name)
+	ret0, _ := ret[0].(*zonesv1.ZoneDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetZoneByName indicates an expected call of GetZoneByName.
+func (mr *MockCISMockRecorder) GetZoneByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneByName", reflect.TypeOf((*MockCIS)(nil).GetZoneByName), name)
+}
+
+// UpdateDNSRecord mocks base method.
+func (m *MockCIS) UpdateDNSRecord(zoneID string, options *dnsrecordsv1.UpdateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDNSRecord", zoneID, options)
+	ret0, _ := ret[0].(*dnsrecordsv1.DnsrecordResp)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateDNSRecord indicates an expected call of UpdateDNSRecord.
+func (mr *MockCISMockRecorder) UpdateDNSRecord(zoneID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDNSRecord", reflect.TypeOf((*MockCIS)(nil).UpdateDNSRecord), zoneID, options)
+}
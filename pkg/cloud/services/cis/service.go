@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+)
+
+// listLimit is the maximum number of resources returned by a single list request of the CIS API.
+const listLimit int64 = 100
+
+// Service holds the IBM Cloud Internet Services specific information.
+type Service struct {
+	zonesClient   *zonesv1.ZonesV1
+	crn           *string
+	url           string
+	authenticator core.Authenticator
+}
+
+// NewService returns a new service for the IBM Cloud Internet Services api client.
+// The DNS records clients of the domains are created on demand with the same options.
+func NewService(options *zonesv1.ZonesV1Options) (CIS, error) {
+	if options == nil {
+		options = &zonesv1.ZonesV1Options{}
+	}
+	if options.Authenticator == nil {
+		auth, err := authenticator.GetAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+		options.Authenticator = auth
+	}
+	zonesClient, err := zonesv1.NewZonesV1(options)
+	if err != nil {
+		return nil, err
+	}
+	metrics.InstrumentBaseService(string(endpoints.CIS), metrics.GlobalRegion, zonesClient.Service)
+
+	return &Service{
+		zonesClient:   zonesClient,
+		crn:           options.Crn,
+		url:           options.URL,
+		authenticator: options.Authenticator,
+	}, nil
+}
+
+// dnsRecordsClient returns the DNS records client of the domain with given zone ID.
+func (s *Service) dnsRecordsClient(zoneID string) (*dnsrecordsv1.DnsRecordsV1, error) {
+	client, err := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+		URL:            s.url,
+		Authenticator:  s.authenticator,
+		Crn:            s.crn,
+		ZoneIdentifier: ptr.To(zoneID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	metrics.InstrumentBaseService(string(endpoints.CIS), metrics.GlobalRegion, client.Service)
+	return client, nil
+}
+
+// GetZoneByName returns the domain with given name in the CIS instance. If not found, returns nil.
+func (s *Service) GetZoneByName(name string) (*zonesv1.ZoneDetails, error) {
+	options := &zonesv1.ListZonesOptions{
+		Page:    ptr.To(int64(1)),
+		PerPage: ptr.To(listLimit),
+	}
+	for {
+		zones, _, err := s.zonesClient.ListZones(options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list CIS zones: %w", err)
+		}
+		for _, zone := range zones.Result {
+			if zone.Name != nil && strings.EqualFold(*zone.Name, name) {
+				return &zone, nil
+			}
+		}
+		if zones.ResultInfo == nil || zones.ResultInfo.TotalCount == nil || *options.Page*listLimit >= *zones.ResultInfo.TotalCount {
+			return nil, nil
+		}
+		options.Page = ptr.To(*options.Page + 1)
+	}
+}
+
+// GetDNSRecord returns the specified DNS record of a domain.
+func (s *Service) GetDNSRecord(zoneID string, options *dnsrecordsv1.GetDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+	client, err := s.dnsRecordsClient(zoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.GetDnsRecord(options)
+}
+
+// GetDNSRecordByName returns the DNS record of the domain with given fully qualified name. If not found, returns nil.
+func (s *Service) GetDNSRecordByName(zoneID, name string) (*dnsrecordsv1.DnsrecordDetails, error) {
+	client, err := s.dnsRecordsClient(zoneID)
+	if err != nil {
+		return nil, err
+	}
+	records, _, err := client.ListAllDnsRecords(&dnsrecordsv1.ListAllDnsRecordsOptions{
+		Name:    ptr.To(name),
+		PerPage: ptr.To(listLimit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CIS DNS records: %w", err)
+	}
+	for _, record := range records.Result {
+		if record.Name != nil && strings.EqualFold(*record.Name, name) {
+			return &record, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateDNSRecord creates a DNS record in a domain.
+func (s *Service) CreateDNSRecord(zoneID string, options *dnsrecordsv1.CreateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+	client, err := s.dnsRecordsClient(zoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.CreateDnsRecord(options)
+}
+
+// UpdateDNSRecord updates a DNS record of a domain.
+func (s *Service) UpdateDNSRecord(zoneID string, options *dnsrecordsv1.UpdateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+	client, err := s.dnsRecordsClient(zoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.UpdateDnsRecord(options)
+}
+
+// DeleteDNSRecord deletes a DNS record of a domain.
+func (s *Service) DeleteDNSRecord(zoneID string, options *dnsrecordsv1.DeleteDnsRecordOptions) (*dnsrecordsv1.DeleteDnsrecordResp, *core.DetailedResponse, error) {
+	client, err := s.dnsRecordsClient(zoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.DeleteDnsRecord(options)
+}
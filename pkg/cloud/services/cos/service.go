@@ -17,24 +17,35 @@ limitations under the License.
 package cos
 
 import (
+	"fmt"
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"golang.org/x/net/http/httpproxy"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/request"
 	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 )
 
 // iamEndpoint represent the IAM authorisation URL.
 const (
 	iamEndpoint  = "https://iam.cloud.ibm.com/identity/token"
 	cosURLDomain = "cloud-object-storage.appdomain.cloud"
+
+	// tokenLifetime is the lifetime of the tokens obtained from an authenticator.
+	tokenLifetime = 10 * time.Minute
 )
 
 // Service holds the IBM Cloud Resource Controller Service specific information.
@@ -45,6 +56,9 @@ type Service struct {
 // ServiceOptions holds the IBM Cloud Resource Controller Service Options specific information.
 type ServiceOptions struct {
 	*cosSession.Options
+
+	// Authenticator, when set, authenticates the requests instead of the API key.
+	Authenticator core.Authenticator
 }
 
 // GetBucketByName returns a bucket with the given name.
@@ -121,13 +135,58 @@ func NewService(options ServiceOptions, apikey, serviceInstance string) (Cos, er
 			ExpectContinueTimeout: 1 * time.Second,
 		},
 	}
-	options.Config.Credentials = ibmiam.NewStaticCredentials(aws.NewConfig(), iamEndpoint, apikey, serviceInstance)
+	if options.Authenticator != nil {
+		options.Config.Credentials = ibmiam.NewCustomInitFuncCredentials(aws.NewConfig(), tokenFunc(options.Authenticator), iamTokenEndpoint(), serviceInstance)
+	} else {
+		options.Config.Credentials = ibmiam.NewStaticCredentials(aws.NewConfig(), iamTokenEndpoint(), apikey, serviceInstance)
+	}
 
 	sess, err := cosSession.NewSessionWithOptions(*options.Options)
 	if err != nil {
 		return nil, err
 	}
+	// Instrument the client once the session is created, the custom CA bundle is only loaded into an *http.Transport.
+	sess.Config.HTTPClient = metrics.InstrumentHTTPClient(string(endpoints.COS), aws.StringValue(sess.Config.Region), sess.Config.HTTPClient)
+	// Label the request metrics with the S3 operation name.
+	sess.Handlers.Send.PushFront(func(r *request.Request) {
+		r.HTTPRequest = r.HTTPRequest.WithContext(metrics.WithOperation(r.HTTPRequest.Context(), r.Operation.Name))
+	})
 	return &Service{
 		client: s3.New(sess),
 	}, nil
 }
+
+// tokenFunc returns a function fetching an IAM access token with the given authenticator.
+// The token carries no refresh token, the token manager fetches a new one when the refresh fails.
+func tokenFunc(auth core.Authenticator) func() (*token.Token, error) {
+	return func() (*token.Token, error) {
+		req, err := http.NewRequest(http.MethodGet, "/", nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := auth.Authenticate(req); err != nil {
+			return nil, err
+		}
+		accessToken := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if accessToken == "" {
+			return nil, fmt.Errorf("authenticator %s returned no bearer token", auth.AuthenticationType())
+		}
+		// The authenticator caches and renews the token on its own, expire the copy early to pick up the renewed one.
+		expiresIn := int64(tokenLifetime / time.Second)
+		return &token.Token{
+			AccessToken: accessToken,
+			TokenType:   "Bearer",
+			ExpiresIn:   expiresIn,
+			Expiration:  time.Now().Add(tokenLifetime).Unix(),
+		}, nil
+	}
+}
+
+// iamTokenEndpoint returns the IAM token endpoint, honouring the IBMCLOUD_AUTH_URL override of the authenticator.
+func iamTokenEndpoint() string {
+	props, err := authenticator.GetProperties()
+	if err != nil || props["AUTH_URL"] == "" {
+		return iamEndpoint
+	}
+	return strings.TrimSuffix(props["AUTH_URL"], "/") + "/identity/token"
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnsservices
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+)
+
+//go:generate ../../../../hack/tools/bin/mockgen -source=./dnsservices.go -destination=./mock/dnsservices_generated.go -package=mock
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt ./mock/dnsservices_generated.go > ./mock/_dnsservices_generated.go && mv ./mock/_dnsservices_generated.go ./mock/dnsservices_generated.go"
+
+// DNSServices interface defines methods that a IBM Cloud DNS Services object should implement
+// to manage the private DNS zones, their permitted networks and resource records.
+type DNSServices interface {
+	GetDnszone(options *dnssvcsv1.GetDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error)
+	GetDnszoneByName(instanceID, name string) (*dnssvcsv1.Dnszone, error)
+	CreateDnszone(options *dnssvcsv1.CreateDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error)
+	DeleteDnszone(options *dnssvcsv1.DeleteDnszoneOptions) (*core.DetailedResponse, error)
+	GetPermittedNetwork(options *dnssvcsv1.GetPermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	GetPermittedNetworkByVPCCRN(instanceID, dnszoneID, vpcCRN string) (*dnssvcsv1.PermittedNetwork, error)
+	CreatePermittedNetwork(options *dnssvcsv1.CreatePermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	DeletePermittedNetwork(options *dnssvcsv1.DeletePermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error)
+	GetResourceRecord(options *dnssvcsv1.GetResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	GetResourceRecordByName(instanceID, dnszoneID, name string) (*dnssvcsv1.ResourceRecord, error)
+	CreateResourceRecord(options *dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	UpdateResourceRecord(options *dnssvcsv1.UpdateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	DeleteResourceRecord(options *dnssvcsv1.DeleteResourceRecordOptions) (*core.DetailedResponse, error)
+}
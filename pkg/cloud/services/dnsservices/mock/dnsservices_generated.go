@@ -0,0 +1,260 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./dnsservices.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./dnsservices.go -destination=./mock/dnsservices_generated.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	core "github.com/IBM/go-sdk-core/v5/core"
+	dnssvcsv1 "github.com/IBM/networking-go-sdk/dnssvcsv1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDNSServices is a mock of DNSServices interface.
+type MockDNSServices struct {
+	ctrl     *gomock.Controller
+	recorder *MockDNSServicesMockRecorder
+	isgomock struct{}
+}
+
+// MockDNSServicesMockRecorder is the mock recorder for MockDNSServices.
+type MockDNSServicesMockRecorder struct {
+	mock *MockDNSServices
+}
+
+// NewMockDNSServices creates a new mock instance.
+func NewMockDNSServices(ctrl *gomock.Controller) *MockDNSServices {
+	mock := &MockDNSServices{ctrl: ctrl}
+	mock.recorder = &MockDNSServicesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDNSServices) EXPECT() *MockDNSServicesMockRecorder {
+	return m.recorder
+}
+
+// CreateDnszone mocks base method.
+func (m *MockDNSServices) CreateDnszone(options *dnssvcsv1.CreateDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDnszone", options)
+	ret0, _ := ret[0].(*dnssvcsv1.Dnszone)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateDnszone indicates an expected call of CreateDnszone.
+func (mr *MockDNSServicesMockRecorder) CreateDnszone(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDnszone", reflect.TypeOf((*MockDNSServices)(nil).CreateDnszone), options)
+}
+
+// CreatePermittedNetwork mocks base method.
+func (m *MockDNSServices) CreatePermittedNetwork(options *dnssvcsv1.CreatePermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePermittedNetwork", options)
+	ret0, _ := ret[0].(*dnssvcsv1.PermittedNetwork)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePermittedNetwork indicates an expected call of CreatePermittedNetwork.
+func (mr *MockDNSServicesMockRecorder) CreatePermittedNetwork(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePermittedNetwork", reflect.TypeOf((*MockDNSServices)(nil).CreatePermittedNetwork), options)
+}
+
+// CreateResourceRecord mocks base method.
+func (m *MockDNSServices) CreateResourceRecord(options *dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResourceRecord", options)
+	ret0, _ := ret[0].(*dnssvcsv1.ResourceRecord)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateResourceRecord indicates an expected call of CreateResourceRecord.
+func (mr *MockDNSServicesMockRecorder) CreateResourceRecord(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceRecord", reflect.TypeOf((*MockDNSServices)(nil).CreateResourceRecord), options)
+}
+
+// DeleteDnszone mocks base method.
+func (m *MockDNSServices) DeleteDnszone(options *dnssvcsv1.DeleteDnszoneOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDnszone", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDnszone indicates an expected call of DeleteDnszone.
+func (mr *MockDNSServicesMockRecorder) DeleteDnszone(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDnszone", reflect.TypeOf((*MockDNSServices)(nil).DeleteDnszone), options)
+}
+
+// DeletePermittedNetwork mocks base method.
+func (m *MockDNSServices) DeletePermittedNetwork(options *dnssvcsv1.DeletePermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePermittedNetwork", options)
+	ret0, _ := ret[0].(*dnssvcsv1.PermittedNetwork)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DeletePermittedNetwork indicates an expected call of DeletePermittedNetwork.
+func (mr *MockDNSServicesMockRecorder) DeletePermittedNetwork(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermittedNetwork", reflect.TypeOf((*MockDNSServices)(nil).DeletePermittedNetwork), options)
+}
+
+// DeleteResourceRecord mocks base method.
+func (m *MockDNSServices) DeleteResourceRecord(options *dnssvcsv1.DeleteResourceRecordOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourceRecord", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourceRecord indicates an expected call of DeleteResourceRecord.
+func (mr *MockDNSServicesMockRecorder) DeleteResourceRecord(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceRecord", reflect.TypeOf((*MockDNSServices)(nil).DeleteResourceRecord), options)
+}
+
+// GetDnszone mocks base method.
+func (m *MockDNSServices) GetDnszone(options *dnssvcsv1.GetDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDnszone", options)
+	ret0, _ := ret[0].(*dnssvcsv1.Dnszone)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDnszone indicates an expected call of GetDnszone.
+func (mr *MockDNSServicesMockRecorder) GetDnszone(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDnszone", reflect.TypeOf((*MockDNSServices)(nil).GetDnszone), options)
+}
+
+// GetDnszoneByName mocks base method.
+func (m *MockDNSServices) GetDnszoneByName(instanceID, name string) (*dnssvcsv1.Dnszone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDnszoneByName", instanceID, name)
+	ret0, _ := ret[0].(*dnssvcsv1.Dnszone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDnszoneByName indicates an expected call of GetDnszoneByName.
+func (mr *MockDNSServicesMockRecorder) GetDnszoneByName(instanceID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDnszoneByName", reflect.TypeOf((*MockDNSServices)(nil).GetDnszoneByName), instanceID, name)
+}
+
+// GetPermittedNetwork mocks base method.
+func (m *MockDNSServices) GetPermittedNetwork(options *dnssvcsv1.GetPermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPermittedNetwork", options)
+	ret0, _ := ret[0].(*dnssvcsv1.PermittedNetwork)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPermittedNetwork indicates an expected call of GetPermittedNetwork.
+func (mr *MockDNSServicesMockRecorder) GetPermittedNetwork(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermittedNetwork", reflect.TypeOf((*MockDNSServices)(nil).GetPermittedNetwork), options)
+}
+
+// GetPermittedNetworkByVPCCRN mocks base method.
+func (m *MockDNSServices) GetPermittedNetworkByVPCCRN(instanceID, dnszoneID, vpcCRN string) (*dnssvcsv1.PermittedNetwork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPermittedNetworkByVPCCRN", instanceID, dnszoneID, vpcCRN)
+	ret0, _ := ret[0].(*dnssvcsv1.PermittedNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPermittedNetworkByVPCCRN indicates an expected call of GetPermittedNetworkByVPCCRN.
+func (mr *MockDNSServicesMockRecorder) GetPermittedNetworkByVPCCRN(instanceID, dnszoneID, vpcCRN any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPermittedNetworkByVPCCRN", reflect.TypeOf((*MockDNSServices)(nil).GetPermittedNetworkByVPCCRN), instanceID, dnszoneID, vpcCRN)
+}
+
+// GetResourceRecord mocks base method.
+func (m *MockDNSServices) GetResourceRecord(options *dnssvcsv1.GetResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceRecord", options)
+	ret0, _ := ret[0].(*dnssvcsv1.ResourceRecord)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetResourceRecord indicates an expected call of GetResourceRecord.
+func (mr *MockDNSServicesMockRecorder) GetResourceRecord(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceRecord", reflect.TypeOf((*MockDNSServices)(nil).GetResourceRecord), options)
+}
+
+// GetResourceRecordByName mocks base method.
+func (m *MockDNSServices) GetResourceRecordByName(instanceID, dnszoneID, name string) (*dnssvcsv1.ResourceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceRecordByName", instanceID, dnszoneID, name)
+	ret0, _ := ret[0].(*dnssvcsv1.ResourceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceRecordByName indicates an expected call of GetResourceRecordByName.
+func (mr *MockDNSServicesMockRecorder) GetResourceRecordByName(instanceID, dnszoneID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceRecordByName", reflect.TypeOf((*MockDNSServices)(nil).GetResourceRecordByName), instanceID, dnszoneID, name)
+}
+
+// UpdateResourceRecord mocks base method.
+func (m *MockDNSServices) UpdateResourceRecord(options *dnssvcsv1.UpdateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResourceRecord", options)
+	ret0, _ := ret[0].(*dnssvcsv1.ResourceRecord)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateResourceRecord indicates an expected call of UpdateResourceRecord.
+func (mr *MockDNSServicesMockRecorder) UpdateResourceRecord(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceRecord", reflect.TypeOf((*MockDNSServices)(nil).UpdateResourceRecord), options)
+}
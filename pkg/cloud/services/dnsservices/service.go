@@ -0,0 +1,179 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnsservices
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
+)
+
+// listLimit is the maximum number of resources returned by a single list request of the DNS Services API.
+const listLimit int64 = 100
+
+// Service holds the IBM Cloud DNS Services specific information.
+type Service struct {
+	client *dnssvcsv1.DnsSvcsV1
+}
+
+// NewService returns a new service for the IBM Cloud DNS Services api client.
+func NewService(options *dnssvcsv1.DnsSvcsV1Options) (DNSServices, error) {
+	if options == nil {
+		options = &dnssvcsv1.DnsSvcsV1Options{}
+	}
+	if options.Authenticator == nil {
+		auth, err := authenticator.GetAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+		options.Authenticator = auth
+	}
+	client, err := dnssvcsv1.NewDnsSvcsV1(options)
+	if err != nil {
+		return nil, err
+	}
+	metrics.InstrumentBaseService(string(endpoints.DNSServices), metrics.GlobalRegion, client.Service)
+
+	return &Service{
+		client: client,
+	}, nil
+}
+
+// GetDnszone returns the specified DNS zone.
+func (s *Service) GetDnszone(options *dnssvcsv1.GetDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error) {
+	return s.client.GetDnszone(options)
+}
+
+// GetDnszoneByName returns the DNS zone with given name in the DNS Services instance. If not found, returns nil.
+func (s *Service) GetDnszoneByName(instanceID, name string) (*dnssvcsv1.Dnszone, error) {
+	options := &dnssvcsv1.ListDnszonesOptions{
+		InstanceID: ptr.To(instanceID),
+		Limit:      ptr.To(listLimit),
+		Offset:     ptr.To(int64(0)),
+	}
+	for {
+		zones, _, err := s.client.ListDnszones(options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list DNS zones: %w", err)
+		}
+		for _, zone := range zones.Dnszones {
+			if zone.Name != nil && *zone.Name == name {
+				return &zone, nil
+			}
+		}
+		if zones.Next == nil || int64(len(zones.Dnszones)) < listLimit {
+			return nil, nil
+		}
+		options.Offset = ptr.To(*options.Offset + listLimit)
+	}
+}
+
+// CreateDnszone creates a DNS zone.
+func (s *Service) CreateDnszone(options *dnssvcsv1.CreateDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error) {
+	return s.client.CreateDnszone(options)
+}
+
+// DeleteDnszone deletes a DNS zone.
+func (s *Service) DeleteDnszone(options *dnssvcsv1.DeleteDnszoneOptions) (*core.DetailedResponse, error) {
+	return s.client.DeleteDnszone(options)
+}
+
+// GetPermittedNetwork returns the specified permitted network of a DNS zone.
+func (s *Service) GetPermittedNetwork(options *dnssvcsv1.GetPermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error) {
+	return s.client.GetPermittedNetwork(options)
+}
+
+// GetPermittedNetworkByVPCCRN returns the permitted network of the DNS zone for the VPC with given CRN. If not found, returns nil.
+func (s *Service) GetPermittedNetworkByVPCCRN(instanceID, dnszoneID, vpcCRN string) (*dnssvcsv1.PermittedNetwork, error) {
+	networks, _, err := s.client.ListPermittedNetworks(&dnssvcsv1.ListPermittedNetworksOptions{
+		InstanceID: ptr.To(instanceID),
+		DnszoneID:  ptr.To(dnszoneID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list permitted networks: %w", err)
+	}
+	for _, network := range networks.PermittedNetworks {
+		if network.PermittedNetwork != nil && network.PermittedNetwork.VpcCrn != nil && *network.PermittedNetwork.VpcCrn == vpcCRN {
+			return &network, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreatePermittedNetwork adds a permitted network to a DNS zone.
+func (s *Service) CreatePermittedNetwork(options *dnssvcsv1.CreatePermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error) {
+	return s.client.CreatePermittedNetwork(options)
+}
+
+// DeletePermittedNetwork removes a permitted network from a DNS zone.
+func (s *Service) DeletePermittedNetwork(options *dnssvcsv1.DeletePermittedNetworkOptions) (*dnssvcsv1.PermittedNetwork, *core.DetailedResponse, error) {
+	return s.client.DeletePermittedNetwork(options)
+}
+
+// GetResourceRecord returns the specified resource record of a DNS zone.
+func (s *Service) GetResourceRecord(options *dnssvcsv1.GetResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+	return s.client.GetResourceRecord(options)
+}
+
+// GetResourceRecordByName returns the resource record of the DNS zone with given fully qualified name. If not found, returns nil.
+func (s *Service) GetResourceRecordByName(instanceID, dnszoneID, name string) (*dnssvcsv1.ResourceRecord, error) {
+	options := &dnssvcsv1.ListResourceRecordsOptions{
+		InstanceID: ptr.To(instanceID),
+		DnszoneID:  ptr.To(dnszoneID),
+		Name:       ptr.To(name),
+		Limit:      ptr.To(listLimit),
+		Offset:     ptr.To(int64(0)),
+	}
+	for {
+		records, _, err := s.client.ListResourceRecords(options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resource records: %w", err)
+		}
+		for _, record := range records.ResourceRecords {
+			if record.Name != nil && strings.EqualFold(*record.Name, name) {
+				return &record, nil
+			}
+		}
+		if records.Next == nil || int64(len(records.ResourceRecords)) < listLimit {
+			return nil, nil
+		}
+		options.Offset = ptr.To(*options.Offset + listLimit)
+	}
+}
+
+// CreateResourceRecord creates a resource record in a DNS zone.
+func (s *Service) CreateResourceRecord(options *dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+	return s.client.CreateResourceRecord(options)
+}
+
+// UpdateResourceRecord updates a resource record of a DNS zone.
+func (s *Service) UpdateResourceRecord(options *dnssvcsv1.UpdateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+	return s.client.UpdateResourceRecord(options)
+}
+
+// DeleteResourceRecord deletes a resource record of a DNS zone.
+func (s *Service) DeleteResourceRecord(options *dnssvcsv1.DeleteResourceRecordOptions) (*core.DetailedResponse, error) {
+	return s.client.DeleteResourceRecord(options)
+}
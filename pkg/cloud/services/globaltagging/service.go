@@ -27,6 +27,8 @@ import (
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 )
 
 // Service holds the IBM Cloud Global Tagging Service specific information.
@@ -51,7 +53,7 @@ func (s *Service) AttachTag(options *globaltaggingv1.AttachTagOptions) (*globalt
 
 // GetTagByName returns the Tag with the provided name, if found.
 func (s *Service) GetTagByName(tagName string) (*globaltaggingv1.Tag, error) {
-	accountID, err := accounts.GetAccountID()
+	accountID, err := accounts.GetAccount(s.client.Service.Options.Authenticator)
 	if err != nil {
 		return nil, err
 	}
@@ -91,6 +93,7 @@ func NewService(options ServiceOptions) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	metrics.InstrumentBaseService(string(endpoints.GlobalTagging), metrics.GlobalRegion, service.Service)
 	return &Service{
 		client: service,
 	}, nil
@@ -28,7 +28,7 @@ import (
 // once in 2 reconciliations.
 const CacheTTL = time.Duration(20) * time.Minute
 
-// VMip holds the vm name and corresponding dhcp ip used to cache the dhcp ip.
+// VMip holds the vm name and the corresponding dhcp ip of its primary network used to cache the dhcp ip.
 type VMip struct {
 	Name string
 	IP   string
@@ -26,6 +26,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	models "github.com/IBM-Cloud/power-go-client/power/models"
 	gomock "go.uber.org/mock/gomock"
@@ -56,6 +57,35 @@ func (m *MockPowerVS) EXPECT() *MockPowerVSMockRecorder {
 	return m.recorder
 }
 
+// AttachVolume mocks base method.
+func (m *MockPowerVS) AttachVolume(instanceID, volumeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachVolume", instanceID, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachVolume indicates an expected call of AttachVolume.
+func (mr *MockPowerVSMockRecorder) AttachVolume(instanceID, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolume", reflect.TypeOf((*MockPowerVS)(nil).AttachVolume), instanceID, volumeID)
+}
+
+// CaptureInstance mocks base method.
+func (m *MockPowerVS) CaptureInstance(instanceID string, body *models.PVMInstanceCapture) (*models.JobReference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureInstance", instanceID, body)
+	ret0, _ := ret[0].(*models.JobReference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureInstance indicates an expected call of CaptureInstance.
+func (mr *MockPowerVSMockRecorder) CaptureInstance(instanceID, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureInstance", reflect.TypeOf((*MockPowerVS)(nil).CaptureInstance), instanceID, body)
+}
+
 // CreateCosImage mocks base method.
 func (m *MockPowerVS) CreateCosImage(body *models.CreateCosImageImportJob) (*models.JobReference, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +131,96 @@ func (mr *MockPowerVSMockRecorder) CreateInstance(body any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstance", reflect.TypeOf((*MockPowerVS)(nil).CreateInstance), body)
 }
 
+// CreateInstanceSnapshot mocks base method.
+func (m *MockPowerVS) CreateInstanceSnapshot(instanceID string, body *models.SnapshotCreate) (*models.SnapshotCreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInstanceSnapshot", instanceID, body)
+	ret0, _ := ret[0].(*models.SnapshotCreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInstanceSnapshot indicates an expected call of CreateInstanceSnapshot.
+func (mr *MockPowerVSMockRecorder) CreateInstanceSnapshot(instanceID, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceSnapshot", reflect.TypeOf((*MockPowerVS)(nil).CreateInstanceSnapshot), instanceID, body)
+}
+
+// CreateNetwork mocks base method.
+func (m *MockPowerVS) CreateNetwork(body *models.NetworkCreate) (*models.Network, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", body)
+	ret0, _ := ret[0].(*models.Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MockPowerVSMockRecorder) CreateNetwork(body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockPowerVS)(nil).CreateNetwork), body)
+}
+
+// CreatePlacementGroup mocks base method.
+func (m *MockPowerVS) CreatePlacementGroup(body *models.PlacementGroupCreate) (*models.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlacementGroup", body)
+	ret0, _ := ret[0].(*models.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlacementGroup indicates an expected call of CreatePlacementGroup.
+func (mr *MockPowerVSMockRecorder) CreatePlacementGroup(body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlacementGroup", reflect.TypeOf((*MockPowerVS)(nil).CreatePlacementGroup), body)
+}
+
+// CreateSAPInstance mocks base method.
+func (m *MockPowerVS) CreateSAPInstance(body *models.SAPCreate) (*models.PVMInstanceList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSAPInstance", body)
+	ret0, _ := ret[0].(*models.PVMInstanceList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSAPInstance indicates an expected call of CreateSAPInstance.
+func (mr *MockPowerVSMockRecorder) CreateSAPInstance(body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSAPInstance", reflect.TypeOf((*MockPowerVS)(nil).CreateSAPInstance), body)
+}
+
+// CreateSharedProcessorPool mocks base method.
+func (m *MockPowerVS) CreateSharedProcessorPool(body *models.SharedProcessorPoolCreate) (*models.SharedProcessorPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSharedProcessorPool", body)
+	ret0, _ := ret[0].(*models.SharedProcessorPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSharedProcessorPool indicates an expected call of CreateSharedProcessorPool.
+func (mr *MockPowerVSMockRecorder) CreateSharedProcessorPool(body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSharedProcessorPool", reflect.TypeOf((*MockPowerVS)(nil).CreateSharedProcessorPool), body)
+}
+
+// CreateVolume mocks base method.
+func (m *MockPowerVS) CreateVolume(body *models.CreateDataVolume) (*models.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolume", body)
+	ret0, _ := ret[0].(*models.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolume indicates an expected call of CreateVolume.
+func (mr *MockPowerVSMockRecorder) CreateVolume(body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockPowerVS)(nil).CreateVolume), body)
+}
+
 // DeleteDHCPServer mocks base method.
 func (m *MockPowerVS) DeleteDHCPServer(id string) error {
 	m.ctrl.T.Helper()
@@ -157,6 +277,90 @@ func (mr *MockPowerVSMockRecorder) DeleteJob(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJob", reflect.TypeOf((*MockPowerVS)(nil).DeleteJob), id)
 }
 
+// DeleteNetwork mocks base method.
+func (m *MockPowerVS) DeleteNetwork(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetwork", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNetwork indicates an expected call of DeleteNetwork.
+func (mr *MockPowerVSMockRecorder) DeleteNetwork(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetwork", reflect.TypeOf((*MockPowerVS)(nil).DeleteNetwork), id)
+}
+
+// DeletePlacementGroup mocks base method.
+func (m *MockPowerVS) DeletePlacementGroup(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlacementGroup", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlacementGroup indicates an expected call of DeletePlacementGroup.
+func (mr *MockPowerVSMockRecorder) DeletePlacementGroup(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlacementGroup", reflect.TypeOf((*MockPowerVS)(nil).DeletePlacementGroup), id)
+}
+
+// DeleteSharedProcessorPool mocks base method.
+func (m *MockPowerVS) DeleteSharedProcessorPool(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSharedProcessorPool", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSharedProcessorPool indicates an expected call of DeleteSharedProcessorPool.
+func (mr *MockPowerVSMockRecorder) DeleteSharedProcessorPool(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSharedProcessorPool", reflect.TypeOf((*MockPowerVS)(nil).DeleteSharedProcessorPool), id)
+}
+
+// DeleteSnapshot mocks base method.
+func (m *MockPowerVS) DeleteSnapshot(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshot", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshot indicates an expected call of DeleteSnapshot.
+func (mr *MockPowerVSMockRecorder) DeleteSnapshot(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockPowerVS)(nil).DeleteSnapshot), id)
+}
+
+// DeleteVolume mocks base method.
+func (m *MockPowerVS) DeleteVolume(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolume", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVolume indicates an expected call of DeleteVolume.
+func (mr *MockPowerVSMockRecorder) DeleteVolume(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockPowerVS)(nil).DeleteVolume), id)
+}
+
+// DetachVolume mocks base method.
+func (m *MockPowerVS) DetachVolume(instanceID, volumeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachVolume", instanceID, volumeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachVolume indicates an expected call of DetachVolume.
+func (mr *MockPowerVSMockRecorder) DetachVolume(instanceID, volumeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolume", reflect.TypeOf((*MockPowerVS)(nil).DetachVolume), instanceID, volumeID)
+}
+
 // GetAllDHCPServers mocks base method.
 func (m *MockPowerVS) GetAllDHCPServers() (models.DHCPServers, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +421,81 @@ func (mr *MockPowerVSMockRecorder) GetAllNetwork() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNetwork", reflect.TypeOf((*MockPowerVS)(nil).GetAllNetwork))
 }
 
+// GetAllPlacementGroups mocks base method.
+func (m *MockPowerVS) GetAllPlacementGroups() (*models.PlacementGroups, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPlacementGroups")
+	ret0, _ := ret[0].(*models.PlacementGroups)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPlacementGroups indicates an expected call of GetAllPlacementGroups.
+func (mr *MockPowerVSMockRecorder) GetAllPlacementGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPlacementGroups", reflect.TypeOf((*MockPowerVS)(nil).GetAllPlacementGroups))
+}
+
+// GetAllSharedProcessorPools mocks base method.
+func (m *MockPowerVS) GetAllSharedProcessorPools() (*models.SharedProcessorPools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSharedProcessorPools")
+	ret0, _ := ret[0].(*models.SharedProcessorPools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSharedProcessorPools indicates an expected call of GetAllSharedProcessorPools.
+func (mr *MockPowerVSMockRecorder) GetAllSharedProcessorPools() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSharedProcessorPools", reflect.TypeOf((*MockPowerVS)(nil).GetAllSharedProcessorPools))
+}
+
+// GetAllSnapshots mocks base method.
+func (m *MockPowerVS) GetAllSnapshots() (*models.Snapshots, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSnapshots")
+	ret0, _ := ret[0].(*models.Snapshots)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSnapshots indicates an expected call of GetAllSnapshots.
+func (mr *MockPowerVSMockRecorder) GetAllSnapshots() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSnapshots", reflect.TypeOf((*MockPowerVS)(nil).GetAllSnapshots))
+}
+
+// GetAllVolume mocks base method.
+func (m *MockPowerVS) GetAllVolume() (*models.Volumes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllVolume")
+	ret0, _ := ret[0].(*models.Volumes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllVolume indicates an expected call of GetAllVolume.
+func (mr *MockPowerVSMockRecorder) GetAllVolume() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVolume", reflect.TypeOf((*MockPowerVS)(nil).GetAllVolume))
+}
+
+// GetCloudInstance mocks base method.
+func (m *MockPowerVS) GetCloudInstance(id string) (*models.CloudInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCloudInstance", id)
+	ret0, _ := ret[0].(*models.CloudInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCloudInstance indicates an expected call of GetCloudInstance.
+func (mr *MockPowerVSMockRecorder) GetCloudInstance(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCloudInstance", reflect.TypeOf((*MockPowerVS)(nil).GetCloudInstance), id)
+}
+
 // GetCosImages mocks base method.
 func (m *MockPowerVS) GetCosImages(id string) (*models.Job, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +541,21 @@ func (mr *MockPowerVSMockRecorder) GetDatacenterCapabilities(zone any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatacenterCapabilities", reflect.TypeOf((*MockPowerVS)(nil).GetDatacenterCapabilities), zone)
 }
 
+// GetEvents mocks base method.
+func (m *MockPowerVS) GetEvents(cloudInstanceID string, fromTime time.Time) (*models.Events, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvents", cloudInstanceID, fromTime)
+	ret0, _ := ret[0].(*models.Events)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockPowerVSMockRecorder) GetEvents(cloudInstanceID, fromTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockPowerVS)(nil).GetEvents), cloudInstanceID, fromTime)
+}
+
 // GetImage mocks base method.
 func (m *MockPowerVS) GetImage(id string) (*models.Image, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +631,141 @@ func (mr *MockPowerVSMockRecorder) GetNetworkByName(networkName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkByName", reflect.TypeOf((*MockPowerVS)(nil).GetNetworkByName), networkName)
 }
 
+// GetPlacementGroup mocks base method.
+func (m *MockPowerVS) GetPlacementGroup(id string) (*models.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlacementGroup", id)
+	ret0, _ := ret[0].(*models.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlacementGroup indicates an expected call of GetPlacementGroup.
+func (mr *MockPowerVSMockRecorder) GetPlacementGroup(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlacementGroup", reflect.TypeOf((*MockPowerVS)(nil).GetPlacementGroup), id)
+}
+
+// GetSAPProfile mocks base method.
+func (m *MockPowerVS) GetSAPProfile(id string) (*models.SAPProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSAPProfile", id)
+	ret0, _ := ret[0].(*models.SAPProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSAPProfile indicates an expected call of GetSAPProfile.
+func (mr *MockPowerVSMockRecorder) GetSAPProfile(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSAPProfile", reflect.TypeOf((*MockPowerVS)(nil).GetSAPProfile), id)
+}
+
+// GetSharedProcessorPool mocks base method.
+func (m *MockPowerVS) GetSharedProcessorPool(id string) (*models.SharedProcessorPoolDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedProcessorPool", id)
+	ret0, _ := ret[0].(*models.SharedProcessorPoolDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSharedProcessorPool indicates an expected call of GetSharedProcessorPool.
+func (mr *MockPowerVSMockRecorder) GetSharedProcessorPool(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedProcessorPool", reflect.TypeOf((*MockPowerVS)(nil).GetSharedProcessorPool), id)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockPowerVS) GetSnapshot(id string) (*models.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", id)
+	ret0, _ := ret[0].(*models.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockPowerVSMockRecorder) GetSnapshot(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockPowerVS)(nil).GetSnapshot), id)
+}
+
+// GetStorageTiers mocks base method.
+func (m *MockPowerVS) GetStorageTiers() (models.RegionStorageTiers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageTiers")
+	ret0, _ := ret[0].(models.RegionStorageTiers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageTiers indicates an expected call of GetStorageTiers.
+func (mr *MockPowerVSMockRecorder) GetStorageTiers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageTiers", reflect.TypeOf((*MockPowerVS)(nil).GetStorageTiers))
+}
+
+// GetSystemPools mocks base method.
+func (m *MockPowerVS) GetSystemPools() (models.SystemPools, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSystemPools")
+	ret0, _ := ret[0].(models.SystemPools)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSystemPools indicates an expected call of GetSystemPools.
+func (mr *MockPowerVSMockRecorder) GetSystemPools() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemPools", reflect.TypeOf((*MockPowerVS)(nil).GetSystemPools))
+}
+
+// GetVolume mocks base method.
+func (m *MockPowerVS) GetVolume(id string) (*models.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", id)
+	ret0, _ := ret[0].(*models.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockPowerVSMockRecorder) GetVolume(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockPowerVS)(nil).GetVolume), id)
+}
+
+// RestoreInstanceSnapshot mocks base method.
+func (m *MockPowerVS) RestoreInstanceSnapshot(instanceID, snapshotID string, force bool) (*models.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreInstanceSnapshot", instanceID, snapshotID, force)
+	ret0, _ := ret[0].(*models.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreInstanceSnapshot indicates an expected call of RestoreInstanceSnapshot.
+func (mr *MockPowerVSMockRecorder) RestoreInstanceSnapshot(instanceID, snapshotID, force any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreInstanceSnapshot", reflect.TypeOf((*MockPowerVS)(nil).RestoreInstanceSnapshot), instanceID, snapshotID, force)
+}
+
+// UpdateInstance mocks base method.
+func (m *MockPowerVS) UpdateInstance(id string, body *models.PVMInstanceUpdate) (*models.PVMInstanceUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstance", id, body)
+	ret0, _ := ret[0].(*models.PVMInstanceUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInstance indicates an expected call of UpdateInstance.
+func (mr *MockPowerVSMockRecorder) UpdateInstance(id, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstance", reflect.TypeOf((*MockPowerVS)(nil).UpdateInstance), id, body)
+}
+
 // WithClients mocks base method.
 func (m *MockPowerVS) WithClients(options powervs.ServiceOptions) *powervs.Service {
 	m.ctrl.T.Helper()
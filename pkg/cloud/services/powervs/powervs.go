@@ -17,6 +17,8 @@ limitations under the License.
 package powervs
 
 import (
+	"time"
+
 	"github.com/IBM-Cloud/power-go-client/power/models"
 )
 
@@ -31,7 +33,10 @@ type PowerVS interface {
 	GetAllImage() (*models.Images, error)
 	GetAllNetwork() (*models.Networks, error)
 	GetNetworkByID(id string) (*models.Network, error)
+	CreateNetwork(body *models.NetworkCreate) (*models.Network, error)
+	DeleteNetwork(id string) error
 	GetInstance(id string) (*models.PVMInstance, error)
+	UpdateInstance(id string, body *models.PVMInstanceUpdate) (*models.PVMInstanceUpdateResponse, error)
 	GetImage(id string) (*models.Image, error)
 	DeleteImage(id string) error
 	CreateCosImage(body *models.CreateCosImageImportJob) (*models.JobReference, error)
@@ -45,4 +50,30 @@ type PowerVS interface {
 	WithClients(options ServiceOptions) *Service
 	GetNetworkByName(networkName string) (*models.NetworkReference, error)
 	GetDatacenterCapabilities(zone string) (map[string]bool, error)
+	CreateVolume(body *models.CreateDataVolume) (*models.Volume, error)
+	GetVolume(id string) (*models.Volume, error)
+	GetAllVolume() (*models.Volumes, error)
+	DeleteVolume(id string) error
+	AttachVolume(instanceID, volumeID string) error
+	DetachVolume(instanceID, volumeID string) error
+	GetAllPlacementGroups() (*models.PlacementGroups, error)
+	GetPlacementGroup(id string) (*models.PlacementGroup, error)
+	CreatePlacementGroup(body *models.PlacementGroupCreate) (*models.PlacementGroup, error)
+	DeletePlacementGroup(id string) error
+	GetAllSharedProcessorPools() (*models.SharedProcessorPools, error)
+	GetSharedProcessorPool(id string) (*models.SharedProcessorPoolDetail, error)
+	CreateSharedProcessorPool(body *models.SharedProcessorPoolCreate) (*models.SharedProcessorPool, error)
+	DeleteSharedProcessorPool(id string) error
+	GetEvents(cloudInstanceID string, fromTime time.Time) (*models.Events, error)
+	GetSAPProfile(id string) (*models.SAPProfile, error)
+	CreateSAPInstance(body *models.SAPCreate) (*models.PVMInstanceList, error)
+	GetSystemPools() (models.SystemPools, error)
+	GetStorageTiers() (models.RegionStorageTiers, error)
+	GetCloudInstance(id string) (*models.CloudInstance, error)
+	CreateInstanceSnapshot(instanceID string, body *models.SnapshotCreate) (*models.SnapshotCreateResponse, error)
+	GetSnapshot(id string) (*models.Snapshot, error)
+	GetAllSnapshots() (*models.Snapshots, error)
+	DeleteSnapshot(id string) error
+	RestoreInstanceSnapshot(instanceID, snapshotID string, force bool) (*models.Snapshot, error)
+	CaptureInstance(instanceID string, body *models.PVMInstanceCapture) (*models.JobReference, error)
 }
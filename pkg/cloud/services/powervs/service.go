@@ -19,15 +19,24 @@ package powervs
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client"
 	"github.com/IBM-Cloud/power-go-client/power/client/datacenters"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_events"
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_images"
 	"github.com/IBM-Cloud/power-go-client/power/models"
+	httptransport "github.com/go-openapi/runtime/client"
+
+	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 )
 
 var _ PowerVS = &Service{}
@@ -40,6 +49,15 @@ type Service struct {
 	imageClient    *instance.IBMPIImageClient
 	jobClient      *instance.IBMPIJobClient
 	dhcpClient     *instance.IBMPIDhcpClient
+	volumeClient   *instance.IBMPIVolumeClient
+
+	placementGroupClient      *instance.IBMPIPlacementGroupClient
+	sharedProcessorPoolClient *instance.IBMPISharedProcessorPoolClient
+	sapClient                 *instance.IBMPISAPInstanceClient
+	systemPoolClient          *instance.IBMPISystemPoolClient
+	storageTierClient         *instance.IBMPIStorageTierClient
+	cloudInstanceClient       *instance.IBMPICloudInstanceClient
+	snapshotClient            *instance.IBMPISnapshotClient
 }
 
 // ServiceOptions holds the PowerVS Service Options specific information.
@@ -70,12 +88,27 @@ func NewService(options ServiceOptions) (PowerVS, error) {
 	if err != nil {
 		return nil, err
 	}
+	instrumentSession(session)
 
 	return &Service{
 		session: session,
 	}, nil
 }
 
+// instrumentSession makes the session record the metrics of its requests, labelled by the Power VS API operation ID.
+func instrumentSession(session *ibmpisession.IBMPISession) {
+	runtime, ok := session.Power.Transport.(*httptransport.Runtime)
+	if !ok {
+		return
+	}
+	region := session.Options.Region
+	if region == "" {
+		region = endpoints.ConstructRegionFromZone(session.Options.Zone)
+	}
+	runtime.Transport = metrics.NewRoundTripper(string(endpoints.PowerVS), region, runtime.Transport)
+	session.Power = client.New(metrics.NewClientTransport(runtime), nil)
+}
+
 // WithClients attach the clients to service.
 func (s *Service) WithClients(options ServiceOptions) *Service {
 	ctx := context.Background()
@@ -84,6 +117,14 @@ func (s *Service) WithClients(options ServiceOptions) *Service {
 	s.imageClient = instance.NewIBMPIImageClient(ctx, s.session, options.CloudInstanceID)
 	s.jobClient = instance.NewIBMPIJobClient(ctx, s.session, options.CloudInstanceID)
 	s.dhcpClient = instance.NewIBMPIDhcpClient(ctx, s.session, options.CloudInstanceID)
+	s.volumeClient = instance.NewIBMPIVolumeClient(ctx, s.session, options.CloudInstanceID)
+	s.placementGroupClient = instance.NewIBMPIPlacementGroupClient(ctx, s.session, options.CloudInstanceID)
+	s.sharedProcessorPoolClient = instance.NewIBMPISharedProcessorPoolClient(ctx, s.session, options.CloudInstanceID)
+	s.sapClient = instance.NewIBMPISAPInstanceClient(ctx, s.session, options.CloudInstanceID)
+	s.systemPoolClient = instance.NewIBMPISystemPoolClient(ctx, s.session, options.CloudInstanceID)
+	s.storageTierClient = instance.NewIBMPIStorageTierClient(ctx, s.session, options.CloudInstanceID)
+	s.cloudInstanceClient = instance.NewIBMPICloudInstanceClient(ctx, s.session, options.CloudInstanceID)
+	s.snapshotClient = instance.NewIBMPISnapshotClient(ctx, s.session, options.CloudInstanceID)
 	return s
 }
 
@@ -107,6 +148,11 @@ func (s *Service) GetInstance(id string) (*models.PVMInstance, error) {
 	return s.instanceClient.Get(id)
 }
 
+// UpdateInstance updates the virtual machine in the Power VS service instance, e.g. to resize its processors and memory.
+func (s *Service) UpdateInstance(id string, body *models.PVMInstanceUpdate) (*models.PVMInstanceUpdateResponse, error) {
+	return s.instanceClient.Update(id, body)
+}
+
 // GetImage returns the image in the Power VS service instance.
 func (s *Service) GetImage(id string) (*models.Image, error) {
 	return s.imageClient.Get(id)
@@ -157,6 +203,16 @@ func (s *Service) GetNetworkByID(id string) (*models.Network, error) {
 	return s.networkClient.Get(id)
 }
 
+// CreateNetwork creates a network in the Power VS service instance.
+func (s *Service) CreateNetwork(body *models.NetworkCreate) (*models.Network, error) {
+	return s.networkClient.Create(body)
+}
+
+// DeleteNetwork deletes the network in the Power VS service instance.
+func (s *Service) DeleteNetwork(id string) error {
+	return s.networkClient.Delete(id)
+}
+
 // GetAllDHCPServers returns all the DHCP servers in the Power VS service instance.
 func (s *Service) GetAllDHCPServers() (models.DHCPServers, error) {
 	return s.dhcpClient.GetAll()
@@ -206,3 +262,143 @@ func (s *Service) GetDatacenterCapabilities(zone string) (map[string]bool, error
 	}
 	return datacenter.Payload.Capabilities, nil
 }
+
+// CreateVolume creates the data volume in the Power VS service instance.
+func (s *Service) CreateVolume(body *models.CreateDataVolume) (*models.Volume, error) {
+	return s.volumeClient.CreateVolume(body)
+}
+
+// GetVolume returns the volume in the Power VS service instance.
+func (s *Service) GetVolume(id string) (*models.Volume, error) {
+	return s.volumeClient.Get(id)
+}
+
+// GetAllVolume returns all the volumes in the Power VS service instance.
+func (s *Service) GetAllVolume() (*models.Volumes, error) {
+	return s.volumeClient.GetAll()
+}
+
+// DeleteVolume deletes the volume in the Power VS service instance.
+func (s *Service) DeleteVolume(id string) error {
+	return s.volumeClient.DeleteVolume(id)
+}
+
+// AttachVolume attaches the volume to the virtual machine in the Power VS service instance.
+func (s *Service) AttachVolume(instanceID, volumeID string) error {
+	return s.volumeClient.Attach(instanceID, volumeID)
+}
+
+// DetachVolume detaches the volume from the virtual machine in the Power VS service instance.
+func (s *Service) DetachVolume(instanceID, volumeID string) error {
+	return s.volumeClient.Detach(instanceID, volumeID)
+}
+
+// GetAllPlacementGroups returns all the server placement groups in the Power VS service instance.
+func (s *Service) GetAllPlacementGroups() (*models.PlacementGroups, error) {
+	return s.placementGroupClient.GetAll()
+}
+
+// GetPlacementGroup returns the server placement group in the Power VS service instance.
+func (s *Service) GetPlacementGroup(id string) (*models.PlacementGroup, error) {
+	return s.placementGroupClient.Get(id)
+}
+
+// CreatePlacementGroup creates the server placement group in the Power VS service instance.
+func (s *Service) CreatePlacementGroup(body *models.PlacementGroupCreate) (*models.PlacementGroup, error) {
+	return s.placementGroupClient.Create(body)
+}
+
+// DeletePlacementGroup deletes the server placement group in the Power VS service instance.
+func (s *Service) DeletePlacementGroup(id string) error {
+	return s.placementGroupClient.Delete(id)
+}
+
+// GetAllSharedProcessorPools returns all the shared processor pools in the Power VS service instance.
+func (s *Service) GetAllSharedProcessorPools() (*models.SharedProcessorPools, error) {
+	return s.sharedProcessorPoolClient.GetAll()
+}
+
+// GetSharedProcessorPool returns the shared processor pool and its servers in the Power VS service instance.
+func (s *Service) GetSharedProcessorPool(id string) (*models.SharedProcessorPoolDetail, error) {
+	return s.sharedProcessorPoolClient.Get(id)
+}
+
+// CreateSharedProcessorPool creates the shared processor pool in the Power VS service instance.
+func (s *Service) CreateSharedProcessorPool(body *models.SharedProcessorPoolCreate) (*models.SharedProcessorPool, error) {
+	return s.sharedProcessorPoolClient.Create(body)
+}
+
+// DeleteSharedProcessorPool deletes the shared processor pool in the Power VS service instance.
+func (s *Service) DeleteSharedProcessorPool(id string) error {
+	return s.sharedProcessorPoolClient.Delete(id)
+}
+
+// GetEvents returns the events of the Power VS service instance since fromTime.
+func (s *Service) GetEvents(cloudInstanceID string, fromTime time.Time) (*models.Events, error) {
+	params := p_cloud_events.NewPcloudEventsGetqueryParams().WithCloudInstanceID(cloudInstanceID).
+		WithFromTime(ptr.To(strconv.FormatInt(fromTime.Unix(), 10)))
+	resp, err := s.session.Power.PCloudEvents.PcloudEventsGetquery(params, s.session.AuthInfo(cloudInstanceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events of cloud instance %s: %w", cloudInstanceID, err)
+	}
+	if resp == nil || resp.Payload == nil {
+		return nil, fmt.Errorf("failed to get events of cloud instance %s", cloudInstanceID)
+	}
+	return resp.Payload, nil
+}
+
+// GetSAPProfile returns the SAP profile available in the zone of the Power VS service instance.
+func (s *Service) GetSAPProfile(id string) (*models.SAPProfile, error) {
+	return s.sapClient.GetSAPProfile(id)
+}
+
+// CreateSAPInstance creates the virtual machine with a SAP profile in the Power VS service instance.
+func (s *Service) CreateSAPInstance(body *models.SAPCreate) (*models.PVMInstanceList, error) {
+	return s.sapClient.Create(body)
+}
+
+// GetSystemPools returns the system pools, the available cores and memory of each system type, in the zone of the Power VS service instance.
+func (s *Service) GetSystemPools() (models.SystemPools, error) {
+	return s.systemPoolClient.GetSystemPools()
+}
+
+// GetStorageTiers returns the storage tiers in the zone of the Power VS service instance.
+func (s *Service) GetStorageTiers() (models.RegionStorageTiers, error) {
+	return s.storageTierClient.GetAll()
+}
+
+// GetCloudInstance returns the Power VS service instance with its usage and limits.
+func (s *Service) GetCloudInstance(id string) (*models.CloudInstance, error) {
+	return s.cloudInstanceClient.Get(id)
+}
+
+// CreateInstanceSnapshot creates a snapshot of the volumes of the virtual machine.
+func (s *Service) CreateInstanceSnapshot(instanceID string, body *models.SnapshotCreate) (*models.SnapshotCreateResponse, error) {
+	return s.instanceClient.CreatePvmSnapShot(instanceID, body)
+}
+
+// GetSnapshot returns the snapshot of a virtual machine.
+func (s *Service) GetSnapshot(id string) (*models.Snapshot, error) {
+	return s.snapshotClient.Get(id)
+}
+
+// GetAllSnapshots returns all the snapshots in the Power VS service instance.
+func (s *Service) GetAllSnapshots() (*models.Snapshots, error) {
+	return s.snapshotClient.GetAll()
+}
+
+// DeleteSnapshot deletes the snapshot of a virtual machine.
+func (s *Service) DeleteSnapshot(id string) error {
+	return s.snapshotClient.Delete(id)
+}
+
+// RestoreInstanceSnapshot restores the volumes of the virtual machine from the snapshot.
+// force restores the volumes even if the virtual machine is running.
+func (s *Service) RestoreInstanceSnapshot(instanceID, snapshotID string, force bool) (*models.Snapshot, error) {
+	return s.instanceClient.RestoreSnapShotVM(instanceID, snapshotID, "", &models.SnapshotRestore{Force: &force})
+}
+
+// CaptureInstance captures the virtual machine to an image.
+func (s *Service) CaptureInstance(instanceID string, body *models.PVMInstanceCapture) (*models.JobReference, error) {
+	return s.instanceClient.CaptureInstanceToImageCatalogV2(instanceID, body)
+}
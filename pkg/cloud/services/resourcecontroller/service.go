@@ -25,6 +25,8 @@ import (
 	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/pagingutils"
 )
 
@@ -212,6 +214,7 @@ func NewService(options ServiceOptions) (ResourceController, error) {
 	if err != nil {
 		return nil, err
 	}
+	metrics.InstrumentBaseService(string(endpoints.RC), metrics.GlobalRegion, service.Service)
 	return &Service{
 		client: service,
 	}, nil
@@ -25,6 +25,8 @@ import (
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 )
 
 // Service holds the IBM Cloud Resource Manager Service specific information.
@@ -48,6 +50,7 @@ func NewService(options *resourcemanagerv2.ResourceManagerV2Options) (ResourceMa
 	if err != nil {
 		return nil, err
 	}
+	metrics.InstrumentBaseService(string(endpoints.RM), metrics.GlobalRegion, rmClient.Service)
 	return &Service{
 		client: rmClient,
 	}, nil
@@ -65,7 +68,7 @@ func (s *Service) ListResourceGroups(listResourceGroupsOptions *resourcemanagerv
 
 // GetResourceGroupByName returns the Resource Group with the provided name, if found.
 func (s *Service) GetResourceGroupByName(rgName string) (*resourcemanagerv2.ResourceGroup, error) {
-	accountID, err := accounts.GetAccountID()
+	accountID, err := accounts.GetAccount(s.client.Service.Options.Authenticator)
 	if err != nil {
 		return nil, fmt.Errorf("failed getting account id for resource group lookup: %w", err)
 	}
@@ -26,6 +26,8 @@ import (
 	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/pagingutils"
 )
 
@@ -53,6 +55,7 @@ func NewService(options *tgapiv1.TransitGatewayApisV1Options) (TransitGateway, e
 	if err != nil {
 		return nil, err
 	}
+	metrics.InstrumentBaseService(string(endpoints.TransitGateway), metrics.GlobalRegion, tgClient.Service)
 
 	return &Service{
 		tgClient: tgClient,
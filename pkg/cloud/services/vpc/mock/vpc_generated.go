@@ -72,6 +72,22 @@ func (mr *MockVpcMockRecorder) AttachVolumeToInstance(options any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolumeToInstance", reflect.TypeOf((*MockVpc)(nil).AttachVolumeToInstance), options)
 }
 
+// CreateEndpointGateway mocks base method.
+func (m *MockVpc) CreateEndpointGateway(options *vpcv1.CreateEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEndpointGateway", options)
+	ret0, _ := ret[0].(*vpcv1.EndpointGateway)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateEndpointGateway indicates an expected call of CreateEndpointGateway.
+func (mr *MockVpcMockRecorder) CreateEndpointGateway(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEndpointGateway", reflect.TypeOf((*MockVpc)(nil).CreateEndpointGateway), options)
+}
+
 // CreateImage mocks base method.
 func (m *MockVpc) CreateImage(options *vpcv1.CreateImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -104,6 +120,38 @@ func (mr *MockVpcMockRecorder) CreateInstance(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstance", reflect.TypeOf((*MockVpc)(nil).CreateInstance), options)
 }
 
+// CreateInstanceGroup mocks base method.
+func (m *MockVpc) CreateInstanceGroup(options *vpcv1.CreateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInstanceGroup", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceGroup)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateInstanceGroup indicates an expected call of CreateInstanceGroup.
+func (mr *MockVpcMockRecorder) CreateInstanceGroup(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceGroup", reflect.TypeOf((*MockVpc)(nil).CreateInstanceGroup), options)
+}
+
+// CreateInstanceTemplate mocks base method.
+func (m *MockVpc) CreateInstanceTemplate(options *vpcv1.CreateInstanceTemplateOptions) (vpcv1.InstanceTemplateIntf, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInstanceTemplate", options)
+	ret0, _ := ret[0].(vpcv1.InstanceTemplateIntf)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateInstanceTemplate indicates an expected call of CreateInstanceTemplate.
+func (mr *MockVpcMockRecorder) CreateInstanceTemplate(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceTemplate", reflect.TypeOf((*MockVpc)(nil).CreateInstanceTemplate), options)
+}
+
 // CreateLoadBalancer mocks base method.
 func (m *MockVpc) CreateLoadBalancer(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +280,36 @@ func (mr *MockVpcMockRecorder) CreateVolume(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockVpc)(nil).CreateVolume), options)
 }
 
+// DeleteEndpointGateway mocks base method.
+func (m *MockVpc) DeleteEndpointGateway(options *vpcv1.DeleteEndpointGatewayOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpointGateway", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEndpointGateway indicates an expected call of DeleteEndpointGateway.
+func (mr *MockVpcMockRecorder) DeleteEndpointGateway(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpointGateway", reflect.TypeOf((*MockVpc)(nil).DeleteEndpointGateway), options)
+}
+
+// DeleteImage mocks base method.
+func (m *MockVpc) DeleteImage(options *vpcv1.DeleteImageOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImage", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImage indicates an expected call of DeleteImage.
+func (mr *MockVpcMockRecorder) DeleteImage(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockVpc)(nil).DeleteImage), options)
+}
+
 // DeleteInstance mocks base method.
 func (m *MockVpc) DeleteInstance(options *vpcv1.DeleteInstanceOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +325,51 @@ func (mr *MockVpcMockRecorder) DeleteInstance(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstance", reflect.TypeOf((*MockVpc)(nil).DeleteInstance), options)
 }
 
+// DeleteInstanceGroup mocks base method.
+func (m *MockVpc) DeleteInstanceGroup(options *vpcv1.DeleteInstanceGroupOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstanceGroup", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInstanceGroup indicates an expected call of DeleteInstanceGroup.
+func (mr *MockVpcMockRecorder) DeleteInstanceGroup(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceGroup", reflect.TypeOf((*MockVpc)(nil).DeleteInstanceGroup), options)
+}
+
+// DeleteInstanceGroupMembership mocks base method.
+func (m *MockVpc) DeleteInstanceGroupMembership(options *vpcv1.DeleteInstanceGroupMembershipOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstanceGroupMembership", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInstanceGroupMembership indicates an expected call of DeleteInstanceGroupMembership.
+func (mr *MockVpcMockRecorder) DeleteInstanceGroupMembership(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceGroupMembership", reflect.TypeOf((*MockVpc)(nil).DeleteInstanceGroupMembership), options)
+}
+
+// DeleteInstanceTemplate mocks base method.
+func (m *MockVpc) DeleteInstanceTemplate(options *vpcv1.DeleteInstanceTemplateOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstanceTemplate", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInstanceTemplate indicates an expected call of DeleteInstanceTemplate.
+func (mr *MockVpcMockRecorder) DeleteInstanceTemplate(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceTemplate", reflect.TypeOf((*MockVpc)(nil).DeleteInstanceTemplate), options)
+}
+
 // DeleteLoadBalancer mocks base method.
 func (m *MockVpc) DeleteLoadBalancer(options *vpcv1.DeleteLoadBalancerOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +430,21 @@ func (mr *MockVpcMockRecorder) DeleteSecurityGroup(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockVpc)(nil).DeleteSecurityGroup), options)
 }
 
+// DeleteSecurityGroupRule mocks base method.
+func (m *MockVpc) DeleteSecurityGroupRule(options *vpcv1.DeleteSecurityGroupRuleOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecurityGroupRule", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSecurityGroupRule indicates an expected call of DeleteSecurityGroupRule.
+func (mr *MockVpcMockRecorder) DeleteSecurityGroupRule(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroupRule", reflect.TypeOf((*MockVpc)(nil).DeleteSecurityGroupRule), options)
+}
+
 // DeleteSubnet mocks base method.
 func (m *MockVpc) DeleteSubnet(options *vpcv1.DeleteSubnetOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +490,37 @@ func (mr *MockVpcMockRecorder) GetDedicatedHostByName(dHostName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDedicatedHostByName", reflect.TypeOf((*MockVpc)(nil).GetDedicatedHostByName), dHostName)
 }
 
+// GetEndpointGateway mocks base method.
+func (m *MockVpc) GetEndpointGateway(options *vpcv1.GetEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndpointGateway", options)
+	ret0, _ := ret[0].(*vpcv1.EndpointGateway)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEndpointGateway indicates an expected call of GetEndpointGateway.
+func (mr *MockVpcMockRecorder) GetEndpointGateway(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndpointGateway", reflect.TypeOf((*MockVpc)(nil).GetEndpointGateway), options)
+}
+
+// GetEndpointGatewayByName mocks base method.
+func (m *MockVpc) GetEndpointGatewayByName(name string) (*vpcv1.EndpointGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndpointGatewayByName", name)
+	ret0, _ := ret[0].(*vpcv1.EndpointGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEndpointGatewayByName indicates an expected call of GetEndpointGatewayByName.
+func (mr *MockVpcMockRecorder) GetEndpointGatewayByName(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndpointGatewayByName", reflect.TypeOf((*MockVpc)(nil).GetEndpointGatewayByName), name)
+}
+
 // GetImage mocks base method.
 func (m *MockVpc) GetImage(options *vpcv1.GetImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -399,6 +568,22 @@ func (mr *MockVpcMockRecorder) GetInstance(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstance", reflect.TypeOf((*MockVpc)(nil).GetInstance), options)
 }
 
+// GetInstanceGroup mocks base method.
+func (m *MockVpc) GetInstanceGroup(options *vpcv1.GetInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceGroup", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceGroup)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInstanceGroup indicates an expected call of GetInstanceGroup.
+func (mr *MockVpcMockRecorder) GetInstanceGroup(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceGroup", reflect.TypeOf((*MockVpc)(nil).GetInstanceGroup), options)
+}
+
 // GetInstanceProfile mocks base method.
 func (m *MockVpc) GetInstanceProfile(options *vpcv1.GetInstanceProfileOptions) (*vpcv1.InstanceProfile, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -415,6 +600,22 @@ func (mr *MockVpcMockRecorder) GetInstanceProfile(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceProfile", reflect.TypeOf((*MockVpc)(nil).GetInstanceProfile), options)
 }
 
+// GetInstanceTemplate mocks base method.
+func (m *MockVpc) GetInstanceTemplate(options *vpcv1.GetInstanceTemplateOptions) (vpcv1.InstanceTemplateIntf, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceTemplate", options)
+	ret0, _ := ret[0].(vpcv1.InstanceTemplateIntf)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInstanceTemplate indicates an expected call of GetInstanceTemplate.
+func (mr *MockVpcMockRecorder) GetInstanceTemplate(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceTemplate", reflect.TypeOf((*MockVpc)(nil).GetInstanceTemplate), options)
+}
+
 // GetLoadBalancer mocks base method.
 func (m *MockVpc) GetLoadBalancer(options *vpcv1.GetLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -477,6 +678,22 @@ func (mr *MockVpcMockRecorder) GetLoadBalancerPoolByName(loadBalancerID, poolNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerPoolByName", reflect.TypeOf((*MockVpc)(nil).GetLoadBalancerPoolByName), loadBalancerID, poolName)
 }
 
+// GetPublicGateway mocks base method.
+func (m *MockVpc) GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicGateway", options)
+	ret0, _ := ret[0].(*vpcv1.PublicGateway)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPublicGateway indicates an expected call of GetPublicGateway.
+func (mr *MockVpcMockRecorder) GetPublicGateway(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicGateway", reflect.TypeOf((*MockVpc)(nil).GetPublicGateway), options)
+}
+
 // GetSecurityGroup mocks base method.
 func (m *MockVpc) GetSecurityGroup(options *vpcv1.GetSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -664,6 +881,22 @@ func (mr *MockVpcMockRecorder) GetVolumeAttachments(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeAttachments", reflect.TypeOf((*MockVpc)(nil).GetVolumeAttachments), options)
 }
 
+// ListEndpointGateways mocks base method.
+func (m *MockVpc) ListEndpointGateways(options *vpcv1.ListEndpointGatewaysOptions) (*vpcv1.EndpointGatewayCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndpointGateways", options)
+	ret0, _ := ret[0].(*vpcv1.EndpointGatewayCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListEndpointGateways indicates an expected call of ListEndpointGateways.
+func (mr *MockVpcMockRecorder) ListEndpointGateways(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointGateways", reflect.TypeOf((*MockVpc)(nil).ListEndpointGateways), options)
+}
+
 // ListImages mocks base method.
 func (m *MockVpc) ListImages(options *vpcv1.ListImagesOptions) (*vpcv1.ImageCollection, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -680,6 +913,38 @@ func (mr *MockVpcMockRecorder) ListImages(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockVpc)(nil).ListImages), options)
 }
 
+// ListInstanceGroupMemberships mocks base method.
+func (m *MockVpc) ListInstanceGroupMemberships(options *vpcv1.ListInstanceGroupMembershipsOptions) (*vpcv1.InstanceGroupMembershipCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceGroupMemberships", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceGroupMembershipCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListInstanceGroupMemberships indicates an expected call of ListInstanceGroupMemberships.
+func (mr *MockVpcMockRecorder) ListInstanceGroupMemberships(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceGroupMemberships", reflect.TypeOf((*MockVpc)(nil).ListInstanceGroupMemberships), options)
+}
+
+// ListInstanceTemplates mocks base method.
+func (m *MockVpc) ListInstanceTemplates(options *vpcv1.ListInstanceTemplatesOptions) (*vpcv1.InstanceTemplateCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceTemplates", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceTemplateCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListInstanceTemplates indicates an expected call of ListInstanceTemplates.
+func (mr *MockVpcMockRecorder) ListInstanceTemplates(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceTemplates", reflect.TypeOf((*MockVpc)(nil).ListInstanceTemplates), options)
+}
+
 // ListInstances mocks base method.
 func (m *MockVpc) ListInstances(options *vpcv1.ListInstancesOptions) (*vpcv1.InstanceCollection, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -854,3 +1119,19 @@ func (mr *MockVpcMockRecorder) UnsetSubnetPublicGateway(options any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetSubnetPublicGateway", reflect.TypeOf((*MockVpc)(nil).UnsetSubnetPublicGateway), options)
 }
+
+// UpdateInstanceGroup mocks base method.
+func (m *MockVpc) UpdateInstanceGroup(options *vpcv1.UpdateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceGroup", options)
+	ret0, _ := ret[0].(*vpcv1.InstanceGroup)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateInstanceGroup indicates an expected call of UpdateInstanceGroup.
+func (mr *MockVpcMockRecorder) UpdateInstanceGroup(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceGroup", reflect.TypeOf((*MockVpc)(nil).UpdateInstanceGroup), options)
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/metrics"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/pagingutils"
 )
 
@@ -144,6 +146,11 @@ func (s *Service) DeletePublicGateway(options *vpcv1.DeletePublicGatewayOptions)
 	return s.vpcService.DeletePublicGateway(options)
 }
 
+// GetPublicGateway returns a public gateway.
+func (s *Service) GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	return s.vpcService.GetPublicGateway(options)
+}
+
 // UnsetSubnetPublicGateway detaches a public gateway from the subnet.
 func (s *Service) UnsetSubnetPublicGateway(options *vpcv1.UnsetSubnetPublicGatewayOptions) (*core.DetailedResponse, error) {
 	return s.vpcService.UnsetSubnetPublicGateway(options)
@@ -224,6 +231,11 @@ func (s *Service) GetImage(options *vpcv1.GetImageOptions) (*vpcv1.Image, *core.
 	return s.vpcService.GetImage(options)
 }
 
+// DeleteImage deletes a VPC Custom Image.
+func (s *Service) DeleteImage(options *vpcv1.DeleteImageOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteImage(options)
+}
+
 // GetInstanceProfile returns instance profile.
 func (s *Service) GetInstanceProfile(options *vpcv1.GetInstanceProfileOptions) (*vpcv1.InstanceProfile, *core.DetailedResponse, error) {
 	return s.vpcService.GetInstanceProfile(options)
@@ -512,6 +524,11 @@ func (s *Service) ListSecurityGroupRules(options *vpcv1.ListSecurityGroupRulesOp
 	return s.vpcService.ListSecurityGroupRules(options)
 }
 
+// DeleteSecurityGroupRule deletes a security group rule.
+func (s *Service) DeleteSecurityGroupRule(options *vpcv1.DeleteSecurityGroupRuleOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteSecurityGroupRule(options)
+}
+
 // GetVPCZonesByRegion gets the VPC availability zones for a specific IBM Cloud region.
 func (s *Service) GetVPCZonesByRegion(region string) ([]string, error) {
 	zones := make([]string, 0)
@@ -546,18 +563,109 @@ func (s *Service) GetVolume(options *vpcv1.GetVolumeOptions) (result *vpcv1.Volu
 	return s.vpcService.GetVolume(options)
 }
 
-// NewService returns a new VPC Service.
-func NewService(svcEndpoint string) (Vpc, error) {
-	service := &Service{}
-	auth, err := authenticator.GetAuthenticator()
+// CreateInstanceTemplate creates a new instance template.
+func (s *Service) CreateInstanceTemplate(options *vpcv1.CreateInstanceTemplateOptions) (vpcv1.InstanceTemplateIntf, *core.DetailedResponse, error) {
+	return s.vpcService.CreateInstanceTemplate(options)
+}
+
+// GetInstanceTemplate returns an instance template.
+func (s *Service) GetInstanceTemplate(options *vpcv1.GetInstanceTemplateOptions) (vpcv1.InstanceTemplateIntf, *core.DetailedResponse, error) {
+	return s.vpcService.GetInstanceTemplate(options)
+}
+
+// ListInstanceTemplates returns the instance templates in the region.
+func (s *Service) ListInstanceTemplates(options *vpcv1.ListInstanceTemplatesOptions) (*vpcv1.InstanceTemplateCollection, *core.DetailedResponse, error) {
+	return s.vpcService.ListInstanceTemplates(options)
+}
+
+// DeleteInstanceTemplate deletes an instance template.
+func (s *Service) DeleteInstanceTemplate(options *vpcv1.DeleteInstanceTemplateOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteInstanceTemplate(options)
+}
+
+// CreateInstanceGroup creates a new instance group.
+func (s *Service) CreateInstanceGroup(options *vpcv1.CreateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	return s.vpcService.CreateInstanceGroup(options)
+}
+
+// GetInstanceGroup returns an instance group.
+func (s *Service) GetInstanceGroup(options *vpcv1.GetInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	return s.vpcService.GetInstanceGroup(options)
+}
+
+// UpdateInstanceGroup updates an instance group.
+func (s *Service) UpdateInstanceGroup(options *vpcv1.UpdateInstanceGroupOptions) (*vpcv1.InstanceGroup, *core.DetailedResponse, error) {
+	return s.vpcService.UpdateInstanceGroup(options)
+}
+
+// DeleteInstanceGroup deletes an instance group.
+func (s *Service) DeleteInstanceGroup(options *vpcv1.DeleteInstanceGroupOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteInstanceGroup(options)
+}
+
+// ListInstanceGroupMemberships returns the memberships of an instance group.
+func (s *Service) ListInstanceGroupMemberships(options *vpcv1.ListInstanceGroupMembershipsOptions) (*vpcv1.InstanceGroupMembershipCollection, *core.DetailedResponse, error) {
+	return s.vpcService.ListInstanceGroupMemberships(options)
+}
+
+// DeleteInstanceGroupMembership deletes a membership from an instance group along with its instance.
+func (s *Service) DeleteInstanceGroupMembership(options *vpcv1.DeleteInstanceGroupMembershipOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteInstanceGroupMembership(options)
+}
+
+// CreateEndpointGateway creates a new VPE gateway.
+func (s *Service) CreateEndpointGateway(options *vpcv1.CreateEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error) {
+	return s.vpcService.CreateEndpointGateway(options)
+}
+
+// GetEndpointGateway returns a VPE gateway.
+func (s *Service) GetEndpointGateway(options *vpcv1.GetEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error) {
+	return s.vpcService.GetEndpointGateway(options)
+}
+
+// ListEndpointGateways returns a list of VPE gateways.
+func (s *Service) ListEndpointGateways(options *vpcv1.ListEndpointGatewaysOptions) (*vpcv1.EndpointGatewayCollection, *core.DetailedResponse, error) {
+	return s.vpcService.ListEndpointGateways(options)
+}
+
+// DeleteEndpointGateway deletes a VPE gateway.
+func (s *Service) DeleteEndpointGateway(options *vpcv1.DeleteEndpointGatewayOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteEndpointGateway(options)
+}
+
+// GetEndpointGatewayByName returns the VPE gateway with given name, or nil when it does not exist.
+func (s *Service) GetEndpointGatewayByName(name string) (*vpcv1.EndpointGateway, error) {
+	endpointGateways, _, err := s.vpcService.ListEndpointGateways(&vpcv1.ListEndpointGatewaysOptions{
+		Name: &name,
+	})
 	if err != nil {
 		return nil, err
 	}
+	if endpointGateways == nil || len(endpointGateways.EndpointGateways) == 0 {
+		return nil, nil
+	}
+	return &endpointGateways.EndpointGateways[0], nil
+}
+
+// NewService returns a new VPC Service, authenticating with the credentials of the environment when auth is nil.
+func NewService(svcEndpoint string, auth core.Authenticator) (Vpc, error) {
+	service := &Service{}
+	var err error
+	if auth == nil {
+		auth, err = authenticator.GetAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	service.vpcService, err = vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
 		Authenticator: auth,
 		URL:           svcEndpoint,
 	})
+	if err != nil {
+		return nil, err
+	}
+	metrics.InstrumentBaseService(string(endpoints.VPC), "", service.vpcService.Service)
 
-	return service, err
+	return service, nil
 }
@@ -41,11 +41,15 @@ const (
 	RM serviceID = "rm"
 	// GlobalTagging used to identify the Global Tagging service.
 	GlobalTagging serviceID = "globaltagging"
+	// DNSServices used to identify the DNS Services service.
+	DNSServices serviceID = "dnsservices"
+	// CIS used to identify the Cloud Internet Services service.
+	CIS serviceID = "cis"
 )
 
 type serviceID string
 
-var serviceIDs = []serviceID{VPC, PowerVS, RC, TransitGateway, COS, RM, GlobalTagging}
+var serviceIDs = []serviceID{VPC, PowerVS, RC, TransitGateway, COS, RM, GlobalTagging, DNSServices, CIS}
 
 // ServiceEndpoint holds the Service endpoint specific information.
 type ServiceEndpoint struct {
@@ -0,0 +1,235 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	subsystem = "capibm_cloud_api"
+
+	// GlobalRegion is the region label value of the IBM Cloud services which are not regional.
+	GlobalRegion = "global"
+
+	// unknownLabel is the label value used when the region of a request can't be determined.
+	unknownLabel = "unknown"
+)
+
+// ErrorClass classifies a failed IBM Cloud API call.
+type ErrorClass string
+
+const (
+	// ErrorClassThrottled is a request rejected by the API rate limiting, with a 429 status code.
+	ErrorClassThrottled = ErrorClass("throttled")
+	// ErrorClassClient is a request which failed with a 4xx status code, other than 429.
+	ErrorClassClient = ErrorClass("client_error")
+	// ErrorClassServer is a request which failed with a 5xx status code.
+	ErrorClassServer = ErrorClass("server_error")
+	// ErrorClassTimeout is a request which didn't complete before its deadline.
+	ErrorClassTimeout = ErrorClass("timeout")
+	// ErrorClassNetwork is a request which failed without a response from the API.
+	ErrorClassNetwork = ErrorClass("network_error")
+)
+
+var (
+	// requestsTotal counts the IBM Cloud API calls by their HTTP status code.
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "requests_total",
+		Help:      "Total number of IBM Cloud API requests, partitioned by service, operation, region and HTTP status code.",
+	}, []string{"service", "operation", "region", "code"})
+
+	// requestDuration observes the latency of the IBM Cloud API calls.
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: subsystem,
+		Name:      "request_duration_seconds",
+		Help:      "Latency of IBM Cloud API requests in seconds, partitioned by service, operation and region.",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"service", "operation", "region"})
+
+	// requestErrorsTotal counts the failed IBM Cloud API calls by their error class.
+	requestErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "request_errors_total",
+		Help:      "Total number of failed IBM Cloud API requests, partitioned by service, operation, region and error class.",
+	}, []string{"service", "operation", "region", "class"})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(requestsTotal, requestDuration, requestErrorsTotal)
+}
+
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx carrying the name of the API operation, which is used as operation label of the request metrics.
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+func operationFromContext(ctx context.Context) string {
+	if operation, ok := ctx.Value(operationKey{}).(string); ok {
+		return operation
+	}
+	return ""
+}
+
+// roundTripper records the metrics of each request sent through the wrapped http.RoundTripper.
+type roundTripper struct {
+	service string
+	region  string
+	next    http.RoundTripper
+}
+
+// NewRoundTripper returns an http.RoundTripper recording the metrics of the requests to the given service and region.
+// When next is nil, http.DefaultTransport is used.
+func NewRoundTripper(service, region string, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &roundTripper{
+		service: service,
+		region:  region,
+		next:    next,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	operation := operationFromContext(req.Context())
+	if operation == "" {
+		operation = operationFromRequest(r.service, req)
+	}
+
+	start := time.Now()
+	resp, err := r.next.RoundTrip(req)
+	requestDuration.WithLabelValues(r.service, operation, r.region).Observe(time.Since(start).Seconds())
+
+	code := "error"
+	if err == nil && resp != nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	requestsTotal.WithLabelValues(r.service, operation, r.region, code).Inc()
+	if class := classify(resp, err); class != "" {
+		requestErrorsTotal.WithLabelValues(r.service, operation, r.region, string(class)).Inc()
+	}
+	return resp, err
+}
+
+// classify returns the error class of a request, or an empty class when it succeeded.
+func classify(resp *http.Response, err error) ErrorClass {
+	switch {
+	case err != nil && (errors.Is(err, context.DeadlineExceeded) || isTimeout(err)):
+		return ErrorClassTimeout
+	case err != nil || resp == nil:
+		return ErrorClassNetwork
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return ErrorClassThrottled
+	case resp.StatusCode >= 500:
+		return ErrorClassServer
+	case resp.StatusCode >= 400:
+		return ErrorClassClient
+	}
+	return ""
+}
+
+func isTimeout(err error) bool {
+	var timeoutErr interface{ Timeout() bool }
+	return errors.As(err, &timeoutErr) && timeoutErr.Timeout()
+}
+
+// InstrumentHTTPClient returns a copy of client which records the metrics of its requests to the given service and region.
+func InstrumentHTTPClient(service, region string, client *http.Client) *http.Client {
+	if client == nil {
+		client = &http.Client{}
+	}
+	instrumented := *client
+	instrumented.Transport = NewRoundTripper(service, region, client.Transport)
+	return &instrumented
+}
+
+// InstrumentBaseService makes an IBM Cloud SDK service record the metrics of its requests.
+// When region is empty, it is taken from the service URL.
+func InstrumentBaseService(service, region string, baseService *core.BaseService) {
+	if baseService == nil {
+		return
+	}
+	if region == "" {
+		region = RegionFromURL(baseService.GetServiceURL())
+	}
+	httpClient := baseService.GetHTTPClient()
+	if httpClient == nil {
+		httpClient = core.DefaultHTTPClient()
+	}
+	baseService.SetHTTPClient(InstrumentHTTPClient(service, region, httpClient))
+}
+
+// clientTransport passes the ID of each go-openapi client operation to the request metrics.
+type clientTransport struct {
+	next runtime.ClientTransport
+}
+
+// NewClientTransport returns a go-openapi runtime.ClientTransport which labels the request metrics with the operation ID.
+// The requests are only recorded when the http.RoundTripper of next is instrumented with NewRoundTripper.
+func NewClientTransport(next runtime.ClientTransport) runtime.ClientTransport {
+	return &clientTransport{next: next}
+}
+
+// Submit implements runtime.ClientTransport.
+func (c *clientTransport) Submit(operation *runtime.ClientOperation) (interface{}, error) {
+	ctx := operation.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	operation.Context = WithOperation(ctx, operation.ID)
+	return c.next.Submit(operation)
+}
+
+// endpointHostPrefixes are the host prefixes which precede the region in IBM Cloud service endpoints.
+var endpointHostPrefixes = []string{"private.", "direct.", "s3."}
+
+// RegionFromURL returns the region of a regional IBM Cloud service endpoint, e.g. us-south for https://us-south.iaas.cloud.ibm.com/v1.
+func RegionFromURL(serviceURL string) string {
+	u, err := url.Parse(serviceURL)
+	if err != nil || u.Hostname() == "" {
+		return unknownLabel
+	}
+	host := u.Hostname()
+	for trimmed := true; trimmed; {
+		trimmed = false
+		for _, prefix := range endpointHostPrefixes {
+			if strings.HasPrefix(host, prefix) {
+				host = strings.TrimPrefix(host, prefix)
+				trimmed = true
+			}
+		}
+	}
+	region, _, _ := strings.Cut(host, ".")
+	return region
+}
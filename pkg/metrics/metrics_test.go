@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationFromRequest(t *testing.T) {
+	testCases := []struct {
+		name              string
+		service           string
+		method            string
+		url               string
+		expectedOperation string
+	}{
+		{
+			name:              "VPC collection",
+			service:           "vpc",
+			method:            http.MethodGet,
+			url:               "https://us-south.iaas.cloud.ibm.com/v1/instances?version=2024-01-01",
+			expectedOperation: "GET /v1/instances",
+		},
+		{
+			name:              "VPC resource",
+			service:           "vpc",
+			method:            http.MethodDelete,
+			url:               "https://us-south.iaas.cloud.ibm.com/v1/load_balancers/r006-5d9e1c3a-6f6b-4a37-9b6e-0a3c4c7e5f1a/pools/r006-1b2c/members/r006-3d4e",
+			expectedOperation: "DELETE /v1/load_balancers/{id}/pools/{id}/members/{id}",
+		},
+		{
+			name:              "VPC resource with a name only made of letters",
+			service:           "vpc",
+			method:            http.MethodGet,
+			url:               "https://us-south.iaas.cloud.ibm.com/v1/regions/mycustomregion/zones",
+			expectedOperation: "GET /v1/regions/{id}/zones",
+		},
+		{
+			name:              "VPC endpoint with a base path",
+			service:           "vpc",
+			method:            http.MethodGet,
+			url:               "https://proxy.example.com/ibmcloud/vpc/v1/vpcs/r006-1b2c",
+			expectedOperation: "GET /v1/vpcs/{id}",
+		},
+		{
+			name:              "Resource controller instance CRN",
+			service:           "rc",
+			method:            http.MethodGet,
+			url:               "https://resource-controller.cloud.ibm.com/v2/resource_instances/crn:v1:bluemix:public:power-iaas:osa21:a%2F1234::",
+			expectedOperation: "GET /v2/resource_instances/{id}",
+		},
+		{
+			name:              "Global tagging action",
+			service:           "globaltagging",
+			method:            http.MethodPost,
+			url:               "https://tags.global-search-tagging.cloud.ibm.com/v3/tags/attach",
+			expectedOperation: "POST /v3/tags/attach",
+		},
+		{
+			name:              "DNS Services resource record",
+			service:           "dnsservices",
+			method:            http.MethodPut,
+			url:               "https://api.dns-svcs.cloud.ibm.com/v1/instances/1234/dnszones/example.com:5678/resource_records/record",
+			expectedOperation: "PUT /v1/instances/{id}/dnszones/{id}/resource_records/{id}",
+		},
+		{
+			name:              "Unknown path",
+			service:           "vpc",
+			method:            http.MethodGet,
+			url:               "https://us-south.iaas.cloud.ibm.com/v1/instances/r006-1b2c/network_interfaces/mynic",
+			expectedOperation: otherOperation,
+		},
+		{
+			name:              "Unknown method",
+			service:           "vpc",
+			method:            http.MethodPatch,
+			url:               "https://us-south.iaas.cloud.ibm.com/v1/vpcs/r006-1b2c",
+			expectedOperation: otherOperation,
+		},
+		{
+			name:              "Unknown service",
+			service:           "test",
+			method:            http.MethodGet,
+			url:               "https://us-south.iaas.cloud.ibm.com/v1/instances",
+			expectedOperation: otherOperation,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(tc.method, tc.url, nil)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedOperation, operationFromRequest(tc.service, req))
+		})
+	}
+}
+
+func TestRegionFromURL(t *testing.T) {
+	testCases := []struct {
+		url            string
+		expectedRegion string
+	}{
+		{url: "https://us-south.iaas.cloud.ibm.com/v1", expectedRegion: "us-south"},
+		{url: "https://private.eu-de.iaas.cloud.ibm.com/v1", expectedRegion: "eu-de"},
+		{url: "https://s3.direct.jp-tok.cloud-object-storage.appdomain.cloud", expectedRegion: "jp-tok"},
+		{url: "not a url", expectedRegion: unknownLabel},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			require.Equal(t, tc.expectedRegion, RegionFromURL(tc.url))
+		})
+	}
+}
+
+func TestRoundTripper(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/vpcs":
+			w.WriteHeader(http.StatusTooManyRequests)
+		case "/v1/subnets":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	client := InstrumentHTTPClient("vpc", "us-south", server.Client())
+	do := func(ctx context.Context, path string) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	t.Run("Successful request", func(t *testing.T) {
+		do(context.Background(), "/v1/instances")
+		require.InDelta(t, 1, testutil.ToFloat64(requestsTotal.WithLabelValues("vpc", "GET /v1/instances", "us-south", "200")), 0)
+		require.InDelta(t, 0, testutil.ToFloat64(requestErrorsTotal.WithLabelValues("vpc", "GET /v1/instances", "us-south", string(ErrorClassClient))), 0)
+	})
+
+	t.Run("Throttled request", func(t *testing.T) {
+		do(context.Background(), "/v1/vpcs")
+		require.InDelta(t, 1, testutil.ToFloat64(requestsTotal.WithLabelValues("vpc", "GET /v1/vpcs", "us-south", "429")), 0)
+		require.InDelta(t, 1, testutil.ToFloat64(requestErrorsTotal.WithLabelValues("vpc", "GET /v1/vpcs", "us-south", string(ErrorClassThrottled))), 0)
+	})
+
+	t.Run("Failed request with the operation from the context", func(t *testing.T) {
+		do(WithOperation(context.Background(), "pcloud.pvminstances.getall"), "/v1/subnets")
+		require.InDelta(t, 1, testutil.ToFloat64(requestsTotal.WithLabelValues("vpc", "pcloud.pvminstances.getall", "us-south", "500")), 0)
+		require.InDelta(t, 1, testutil.ToFloat64(requestErrorsTotal.WithLabelValues("vpc", "pcloud.pvminstances.getall", "us-south", string(ErrorClassServer))), 0)
+	})
+
+	t.Run("Request without response", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://127.0.0.1:1/v1/instances", nil)
+		require.NoError(t, err)
+		_, err = InstrumentHTTPClient("vpc", "us-south", nil).Do(req) //nolint:bodyclose
+		require.Error(t, err)
+		require.InDelta(t, 1, testutil.ToFloat64(requestsTotal.WithLabelValues("vpc", "GET /v1/instances", "us-south", "error")), 0)
+		require.InDelta(t, 1, testutil.ToFloat64(requestErrorsTotal.WithLabelValues("vpc", "GET /v1/instances", "us-south", string(ErrorClassNetwork))), 0)
+	})
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// otherOperation is the operation label of the requests which don't match any known operation template.
+const otherOperation = "other"
+
+// idSegment is the placeholder of a path template matching any single path segment.
+const idSegment = "{id}"
+
+// operationTemplates are the API operations called by the provider, by service ID.
+// Keeping the operation label to this fixed set bounds the cardinality of the request metrics,
+// as the resource IDs and names in the request paths never end up in a label value.
+var operationTemplates = map[string][]string{
+	"vpc": {
+		"DELETE /v1/endpoint_gateways/{id}",
+		"DELETE /v1/images/{id}",
+		"DELETE /v1/instance/templates/{id}",
+		"DELETE /v1/instance_groups/{id}",
+		"DELETE /v1/instance_groups/{id}/memberships/{id}",
+		"DELETE /v1/instances/{id}",
+		"DELETE /v1/load_balancers/{id}",
+		"DELETE /v1/load_balancers/{id}/pools/{id}/members/{id}",
+		"DELETE /v1/public_gateways/{id}",
+		"DELETE /v1/security_groups/{id}",
+		"DELETE /v1/security_groups/{id}/rules/{id}",
+		"DELETE /v1/subnets/{id}",
+		"DELETE /v1/subnets/{id}/public_gateway",
+		"DELETE /v1/vpcs/{id}",
+		"GET /v1/dedicated_hosts",
+		"GET /v1/endpoint_gateways",
+		"GET /v1/endpoint_gateways/{id}",
+		"GET /v1/images",
+		"GET /v1/images/{id}",
+		"GET /v1/instance/profiles/{id}",
+		"GET /v1/instance/templates",
+		"GET /v1/instance/templates/{id}",
+		"GET /v1/instance_groups/{id}",
+		"GET /v1/instance_groups/{id}/memberships",
+		"GET /v1/instances",
+		"GET /v1/instances/{id}",
+		"GET /v1/instances/{id}/volume_attachments",
+		"GET /v1/keys",
+		"GET /v1/load_balancers",
+		"GET /v1/load_balancers/{id}",
+		"GET /v1/load_balancers/{id}/listeners/{id}",
+		"GET /v1/load_balancers/{id}/pools",
+		"GET /v1/load_balancers/{id}/pools/{id}/members",
+		"GET /v1/public_gateways",
+		"GET /v1/public_gateways/{id}",
+		"GET /v1/regions/{id}/zones",
+		"GET /v1/security_groups",
+		"GET /v1/security_groups/{id}",
+		"GET /v1/security_groups/{id}/rules",
+		"GET /v1/security_groups/{id}/rules/{id}",
+		"GET /v1/subnets",
+		"GET /v1/subnets/{id}",
+		"GET /v1/subnets/{id}/public_gateway",
+		"GET /v1/volumes/{id}",
+		"GET /v1/vpcs",
+		"GET /v1/vpcs/{id}",
+		"GET /v1/vpcs/{id}/address_prefixes",
+		"PATCH /v1/instance_groups/{id}",
+		"POST /v1/endpoint_gateways",
+		"POST /v1/images",
+		"POST /v1/instance/templates",
+		"POST /v1/instance_groups",
+		"POST /v1/instances",
+		"POST /v1/instances/{id}/volume_attachments",
+		"POST /v1/load_balancers",
+		"POST /v1/load_balancers/{id}/pools/{id}/members",
+		"POST /v1/public_gateways",
+		"POST /v1/security_groups",
+		"POST /v1/security_groups/{id}/rules",
+		"POST /v1/subnets",
+		"POST /v1/volumes",
+		"POST /v1/vpcs",
+		"PUT /v1/subnets/{id}/public_gateway",
+	},
+	"rc": {
+		"DELETE /v2/resource_instances/{id}",
+		"GET /v2/resource_instances",
+		"GET /v2/resource_instances/{id}",
+		"POST /v2/resource_instances",
+		"POST /v2/resource_keys",
+	},
+	"rm": {
+		"GET /v2/resource_groups",
+		"GET /v2/resource_groups/{id}",
+	},
+	"globaltagging": {
+		"GET /v3/tags",
+		"POST /v3/tags",
+		"POST /v3/tags/attach",
+	},
+	"transitgateway": {
+		"DELETE /v1/transit_gateways/{id}",
+		"DELETE /v1/transit_gateways/{id}/connections/{id}",
+		"GET /v1/transit_gateways",
+		"GET /v1/transit_gateways/{id}",
+		"GET /v1/transit_gateways/{id}/connections",
+		"GET /v1/transit_gateways/{id}/connections/{id}",
+		"POST /v1/transit_gateways",
+		"POST /v1/transit_gateways/{id}/connections",
+	},
+	"dnsservices": {
+		"DELETE /v1/instances/{id}/dnszones/{id}",
+		"DELETE /v1/instances/{id}/dnszones/{id}/permitted_networks/{id}",
+		"DELETE /v1/instances/{id}/dnszones/{id}/resource_records/{id}",
+		"GET /v1/instances/{id}/dnszones",
+		"GET /v1/instances/{id}/dnszones/{id}",
+		"GET /v1/instances/{id}/dnszones/{id}/permitted_networks",
+		"GET /v1/instances/{id}/dnszones/{id}/permitted_networks/{id}",
+		"GET /v1/instances/{id}/dnszones/{id}/resource_records",
+		"GET /v1/instances/{id}/dnszones/{id}/resource_records/{id}",
+		"POST /v1/instances/{id}/dnszones",
+		"POST /v1/instances/{id}/dnszones/{id}/permitted_networks",
+		"POST /v1/instances/{id}/dnszones/{id}/resource_records",
+		"PUT /v1/instances/{id}/dnszones/{id}/resource_records/{id}",
+	},
+	"cis": {
+		"DELETE /v1/{id}/zones/{id}/dns_records/{id}",
+		"GET /v1/{id}/zones",
+		"GET /v1/{id}/zones/{id}/dns_records",
+		"GET /v1/{id}/zones/{id}/dns_records/{id}",
+		"POST /v1/{id}/zones/{id}/dns_records",
+		"PUT /v1/{id}/zones/{id}/dns_records/{id}",
+	},
+}
+
+// operationTemplate is a parsed operation template.
+type operationTemplate struct {
+	label    string
+	method   string
+	segments []string
+	literals int
+}
+
+// matches returns whether the path segments of a request end with the segments of the template.
+// The service endpoints may have a base path, which is why only the end of the path is matched.
+func (o operationTemplate) matches(method string, segments []string) bool {
+	if method != o.method || len(segments) < len(o.segments) {
+		return false
+	}
+	offset := len(segments) - len(o.segments)
+	for i, segment := range o.segments {
+		if segment != idSegment && segment != segments[offset+i] {
+			return false
+		}
+	}
+	return true
+}
+
+// parsedOperationTemplates are the operationTemplates by service ID, with the most specific templates first.
+var parsedOperationTemplates = parseOperationTemplates(operationTemplates)
+
+func parseOperationTemplates(templates map[string][]string) map[string][]operationTemplate {
+	parsed := make(map[string][]operationTemplate, len(templates))
+	for service, labels := range templates {
+		operations := make([]operationTemplate, 0, len(labels))
+		for _, label := range labels {
+			method, path, _ := strings.Cut(label, " ")
+			operation := operationTemplate{
+				label:    label,
+				method:   method,
+				segments: strings.Split(strings.Trim(path, "/"), "/"),
+			}
+			for _, segment := range operation.segments {
+				if segment != idSegment {
+					operation.literals++
+				}
+			}
+			operations = append(operations, operation)
+		}
+		sort.SliceStable(operations, func(i, j int) bool {
+			if len(operations[i].segments) != len(operations[j].segments) {
+				return len(operations[i].segments) > len(operations[j].segments)
+			}
+			return operations[i].literals > operations[j].literals
+		})
+		parsed[service] = operations
+	}
+	return parsed
+}
+
+// operationFromRequest returns the operation template of a request to the given service, or otherOperation when the
+// request doesn't match any of its known operations.
+func operationFromRequest(service string, req *http.Request) string {
+	if req.URL == nil {
+		return otherOperation
+	}
+	segments := strings.Split(strings.Trim(req.URL.EscapedPath(), "/"), "/")
+	for _, operation := range parsedOperationTemplates[service] {
+		if operation.matches(req.Method, segments) {
+			return operation.label
+		}
+	}
+	return otherOperation
+}
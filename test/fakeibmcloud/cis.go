@@ -0,0 +1,234 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeibmcloud
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	cisPrefix = "/cis"
+
+	kindCISZone      = "cis_zones"
+	kindCISDNSRecord = "cis_dns_records"
+)
+
+// The domains of any CIS instance are served, the instance itself isn't checked. Domains are only added with
+// AddCISZone, like real domains which are added and activated out of band.
+// Domains are keyed by <instance CRN>/<zone ID>, their DNS records by <instance CRN>/<zone ID>/<ID>.
+
+func (c *Cloud) registerCIS() {
+	zones := cisPrefix + "/v1/{crn}/zones"
+	c.handle("GET "+zones, writeCISError, c.listCISZones)
+	c.handle("GET "+zones+"/{zone}", writeCISError, c.getCISZone)
+	c.handle("POST "+zones+"/{zone}/dns_records", writeCISError, c.createCISDNSRecord)
+	c.handle("GET "+zones+"/{zone}/dns_records", writeCISError, c.listCISDNSRecords)
+	c.handle("GET "+zones+"/{zone}/dns_records/{id}", writeCISError, c.getCISDNSRecord)
+	c.handle("PUT "+zones+"/{zone}/dns_records/{id}", writeCISError, c.updateCISDNSRecord)
+	c.handle("DELETE "+zones+"/{zone}/dns_records/{id}", writeCISError, c.deleteCISDNSRecord)
+}
+
+// writeCISError writes an error in the envelope of the CIS API.
+func writeCISError(w http.ResponseWriter, err *apiError) {
+	writeJSON(w, err.status, resource{
+		"success":  false,
+		"errors":   []resource{{"code": err.status, "message": err.message}},
+		"messages": []interface{}{},
+		"result":   nil,
+	})
+}
+
+// cisResult returns a CIS response holding result.
+func cisResult(result interface{}) resource {
+	return resource{
+		"success":  true,
+		"errors":   []interface{}{},
+		"messages": []interface{}{},
+		"result":   result,
+	}
+}
+
+// cisPage returns a CIS list response holding all the resources, the cloud never pages.
+func cisPage(resources []resource) resource {
+	page := cisResult(resources)
+	page["result_info"] = resource{
+		"page":        1,
+		"per_page":    len(resources),
+		"count":       len(resources),
+		"total_count": len(resources),
+	}
+	return page
+}
+
+// AddCISZone adds an active domain to the CIS instance with the given CRN and returns its ID.
+func (c *Cloud) AddCISZone(crn, name string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := strings.ReplaceAll(newID(""), "-", "")
+	c.store.insert(kindCISZone, crn+"/"+id, resource{
+		"id":           id,
+		"name":         strings.ToLower(name),
+		"status":       "active",
+		"paused":       false,
+		"type":         "full",
+		"name_servers": []string{"ns001.name.cloud.ibm.com", "ns002.name.cloud.ibm.com"},
+		"created_on":   now(),
+		"modified_on":  now(),
+	}, nil)
+	return id
+}
+
+// cisZone returns the key and the domain of a request, without counting a read.
+func (c *Cloud) cisZone(r *http.Request) (string, resource, *apiError) {
+	key := r.PathValue("crn") + "/" + r.PathValue("zone")
+	zone, ok := c.store.peek(kindCISZone, key)
+	if !ok {
+		return "", nil, notFound("zone", r.PathValue("zone"))
+	}
+	return key, zone, nil
+}
+
+func (c *Cloud) listCISZones(r *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, cisPage(c.store.list(kindCISZone, r.PathValue("crn")+"/")), nil
+}
+
+func (c *Cloud) getCISZone(r *http.Request) (int, interface{}, *apiError) {
+	zone, ok := c.store.get(kindCISZone, r.PathValue("crn")+"/"+r.PathValue("zone"))
+	if !ok {
+		return 0, nil, notFound("zone", r.PathValue("zone"))
+	}
+	return http.StatusOK, cisResult(zone), nil
+}
+
+func (c *Cloud) createCISDNSRecord(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	key, zone, err := c.cisZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if str(body, "name") == "" || str(body, "type") == "" || str(body, "content") == "" {
+		return 0, nil, badRequest("the name, the type and the content of the DNS record are required")
+	}
+	name := recordName(str(body, "name"), str(zone, "name"))
+	recordType := strings.ToUpper(str(body, "type"))
+	for _, record := range c.store.all(kindCISDNSRecord, key+"/") {
+		// Like the real API, a CNAME record can't coexist with another record of the same name.
+		if str(record, "name") == name && (recordType == "CNAME" || str(record, "type") == "CNAME") {
+			return 0, nil, &apiError{status: http.StatusBadRequest, message: "a DNS record named " + name + " already exists"}
+		}
+	}
+	ttl := num(body, "ttl")
+	if ttl == 0 {
+		// A TTL of 1 means automatic.
+		ttl = 1
+	}
+	id := strings.ReplaceAll(newID(""), "-", "")
+	record := resource{
+		"id":          id,
+		"name":        name,
+		"type":        recordType,
+		"content":     str(body, "content"),
+		"ttl":         ttl,
+		"zone_id":     str(zone, "id"),
+		"zone_name":   str(zone, "name"),
+		"proxiable":   true,
+		"proxied":     false,
+		"created_on":  now(),
+		"modified_on": now(),
+	}
+	c.store.insert(kindCISDNSRecord, key+"/"+id, record, nil)
+	return http.StatusOK, cisResult(deepCopy(record)), nil
+}
+
+func (c *Cloud) listCISDNSRecords(r *http.Request) (int, interface{}, *apiError) {
+	key, zone, err := c.cisZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	query := r.URL.Query()
+	records := []resource{}
+	for _, record := range c.store.list(kindCISDNSRecord, key+"/") {
+		if name := query.Get("name"); name != "" && str(record, "name") != recordName(name, str(zone, "name")) {
+			continue
+		}
+		if recordType := query.Get("type"); recordType != "" && str(record, "type") != strings.ToUpper(recordType) {
+			continue
+		}
+		records = append(records, record)
+	}
+	return http.StatusOK, cisPage(records), nil
+}
+
+func (c *Cloud) getCISDNSRecord(r *http.Request) (int, interface{}, *apiError) {
+	key, _, err := c.cisZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	record, ok := c.store.get(kindCISDNSRecord, key+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, notFound("DNS record", r.PathValue("id"))
+	}
+	return http.StatusOK, cisResult(record), nil
+}
+
+func (c *Cloud) updateCISDNSRecord(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	key, zone, err := c.cisZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	record, ok := c.store.peek(kindCISDNSRecord, key+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, notFound("DNS record", r.PathValue("id"))
+	}
+	if name := str(body, "name"); name != "" {
+		record["name"] = recordName(name, str(zone, "name"))
+	}
+	if recordType := str(body, "type"); recordType != "" {
+		record["type"] = strings.ToUpper(recordType)
+	}
+	if content := str(body, "content"); content != "" {
+		record["content"] = content
+	}
+	if ttl := num(body, "ttl"); ttl != 0 {
+		record["ttl"] = ttl
+	}
+	if proxied, ok := lookup(body, "proxied").(bool); ok {
+		record["proxied"] = proxied
+	}
+	record["modified_on"] = now()
+	return http.StatusOK, cisResult(deepCopy(record)), nil
+}
+
+func (c *Cloud) deleteCISDNSRecord(r *http.Request) (int, interface{}, *apiError) {
+	key, _, err := c.cisZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if _, ok := c.store.peek(kindCISDNSRecord, key+"/"+r.PathValue("id")); !ok {
+		return 0, nil, notFound("DNS record", r.PathValue("id"))
+	}
+	c.store.drop(kindCISDNSRecord, key+"/"+r.PathValue("id"))
+	return http.StatusOK, cisResult(resource{"id": r.PathValue("id")}), nil
+}
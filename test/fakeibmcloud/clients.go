@@ -0,0 +1,818 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeibmcloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/datacenters"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_events"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_images"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	httptransport "github.com/go-openapi/runtime/client"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
+	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/networking-go-sdk/zonesv1"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cis"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dnsservices"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+)
+
+// The in-process clients embed the SDK clients, whose methods already match most of the service interfaces,
+// and only add the helpers of the pkg/cloud/services wrappers. The helpers do not page, the cloud never does.
+
+var (
+	_ vpc.Vpc                               = &vpcClient{}
+	_ powervs.PowerVS                       = &powerVSClient{}
+	_ transitgateway.TransitGateway         = &transitGatewayClient{}
+	_ resourcecontroller.ResourceController = &resourceControllerClient{}
+	_ resourcemanager.ResourceManager       = &resourceManagerClient{}
+	_ globaltagging.GlobalTagging           = &globalTaggingClient{}
+	_ cos.Cos                               = &cosClient{}
+	_ dnsservices.DNSServices               = &dnsServicesClient{}
+	_ cis.CIS                               = &cisClient{}
+)
+
+// VPC returns an in-process client of the VPC API.
+func (c *Cloud) VPC() (vpc.Vpc, error) {
+	service, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           inProcessURL + vpcPrefix,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.Service.SetHTTPClient(c.inProcessClient())
+	return &vpcClient{VpcV1: service}, nil
+}
+
+// PowerVS returns an in-process client of the Power VS API. Like the real client, it has to be
+// pointed to a workspace with WithClients before use.
+func (c *Cloud) PowerVS() (powervs.PowerVS, error) {
+	session, err := ibmpisession.NewIBMPISession(&ibmpisession.IBMPIOptions{
+		Authenticator: &core.NoAuthAuthenticator{},
+		UserAccount:   c.accountID,
+		Zone:          DefaultPowerVSZone,
+		URL:           inProcessURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	runtime, ok := session.Power.Transport.(*httptransport.Runtime)
+	if !ok {
+		return nil, fmt.Errorf("unexpected Power VS session transport %T", session.Power.Transport)
+	}
+	runtime.Transport = c.inProcessClient().Transport
+	return &powerVSClient{session: session}, nil
+}
+
+// TransitGateway returns an in-process client of the Transit Gateway API.
+func (c *Cloud) TransitGateway() (transitgateway.TransitGateway, error) {
+	service, err := transitgatewayapisv1.NewTransitGatewayApisV1(&transitgatewayapisv1.TransitGatewayApisV1Options{
+		URL:           inProcessURL + tgPrefix,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Version:       ptr.To("2024-12-05"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.Service.SetHTTPClient(c.inProcessClient())
+	return &transitGatewayClient{TransitGatewayApisV1: service}, nil
+}
+
+// ResourceController returns an in-process client of the Resource Controller API.
+func (c *Cloud) ResourceController() (resourcecontroller.ResourceController, error) {
+	service, err := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		URL:           inProcessURL + rcPrefix,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.Service.SetHTTPClient(c.inProcessClient())
+	return &resourceControllerClient{ResourceControllerV2: service}, nil
+}
+
+// ResourceManager returns an in-process client of the Resource Manager API.
+func (c *Cloud) ResourceManager() (resourcemanager.ResourceManager, error) {
+	service, err := resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+		URL:           inProcessURL + rmPrefix,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.Service.SetHTTPClient(c.inProcessClient())
+	return &resourceManagerClient{ResourceManagerV2: service, accountID: c.accountID}, nil
+}
+
+// GlobalTagging returns an in-process client of the Global Tagging API.
+func (c *Cloud) GlobalTagging() (globaltagging.GlobalTagging, error) {
+	service, err := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+		URL:           inProcessURL + globalTaggingPrefix,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.Service.SetHTTPClient(c.inProcessClient())
+	return &globalTaggingClient{GlobalTaggingV1: service, accountID: c.accountID}, nil
+}
+
+// COS returns an in-process client of the Cloud Object Storage API, authorized for the COS instance with the given GUID.
+func (c *Cloud) COS(serviceInstanceID string) (cos.Cos, error) {
+	httpClient := c.inProcessClient()
+	provider := ibmiam.NewProvider(ibmiam.StaticProviderName, aws.NewConfig(), "fake-api-key",
+		inProcessURL+iamPrefix+"/identity/token", serviceInstanceID, httpClient)
+	sess, err := cosSession.NewSessionWithOptions(cosSession.Options{
+		Config: aws.Config{
+			Endpoint:         aws.String(inProcessURL + cosPrefix),
+			Region:           aws.String(c.region),
+			S3ForcePathStyle: aws.Bool(true),
+			Credentials:      credentials.NewCredentials(provider),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The HTTP client is only set on the S3 client, the session would fail to load an AWS_CA_BUNDLE into it.
+	return &cosClient{S3: s3.New(sess, &aws.Config{HTTPClient: httpClient})}, nil
+}
+
+// DNSServices returns an in-process client of the DNS Services API.
+func (c *Cloud) DNSServices() (dnsservices.DNSServices, error) {
+	service, err := dnssvcsv1.NewDnsSvcsV1(&dnssvcsv1.DnsSvcsV1Options{
+		URL:           inProcessURL + dnsPrefix,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.Service.SetHTTPClient(c.inProcessClient())
+	return &dnsServicesClient{DnsSvcsV1: service}, nil
+}
+
+// CIS returns an in-process client of the Cloud Internet Services API, for the CIS instance with the given CRN.
+func (c *Cloud) CIS(crn string) (cis.CIS, error) {
+	service, err := zonesv1.NewZonesV1(&zonesv1.ZonesV1Options{
+		URL:           inProcessURL + cisPrefix,
+		Authenticator: &core.NoAuthAuthenticator{},
+		Crn:           ptr.To(crn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.Service.SetHTTPClient(c.inProcessClient())
+	return &cisClient{ZonesV1: service, cloud: c}, nil
+}
+
+type vpcClient struct {
+	*vpcv1.VpcV1
+}
+
+func (v *vpcClient) GetDedicatedHostByName(name string) (*vpcv1.DedicatedHost, error) {
+	result, _, err := v.ListDedicatedHosts(&vpcv1.ListDedicatedHostsOptions{Name: &name})
+	if err != nil || len(result.DedicatedHosts) == 0 {
+		return nil, err
+	}
+	return &result.DedicatedHosts[0], nil
+}
+
+func (v *vpcClient) GetVPCByName(name string) (*vpcv1.VPC, error) {
+	result, _, err := v.ListVpcs(&vpcv1.ListVpcsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range result.Vpcs {
+		if *result.Vpcs[i].Name == name {
+			return &result.Vpcs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (v *vpcClient) GetImageByName(name string) (*vpcv1.Image, error) {
+	result, _, err := v.ListImages(&vpcv1.ListImagesOptions{Name: &name})
+	if err != nil || len(result.Images) == 0 {
+		return nil, err
+	}
+	return &result.Images[0], nil
+}
+
+func (v *vpcClient) GetVPCPublicGatewayByName(name string, resourceGroupID string) (*vpcv1.PublicGateway, error) {
+	result, _, err := v.ListPublicGateways(&vpcv1.ListPublicGatewaysOptions{ResourceGroupID: &resourceGroupID})
+	if err != nil {
+		return nil, err
+	}
+	for i := range result.PublicGateways {
+		if *result.PublicGateways[i].Name == name {
+			return &result.PublicGateways[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (v *vpcClient) GetVPCSubnetByName(name string) (*vpcv1.Subnet, error) {
+	result, _, err := v.ListSubnets(&vpcv1.ListSubnetsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range result.Subnets {
+		if *result.Subnets[i].Name == name {
+			return &result.Subnets[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (v *vpcClient) GetLoadBalancerPoolByName(loadBalancerID string, name string) (*vpcv1.LoadBalancerPool, error) {
+	result, _, err := v.ListLoadBalancerPools(&vpcv1.ListLoadBalancerPoolsOptions{LoadBalancerID: &loadBalancerID})
+	if err != nil {
+		return nil, err
+	}
+	for i := range result.Pools {
+		if *result.Pools[i].Name == name {
+			return &result.Pools[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (v *vpcClient) GetLoadBalancerByName(name string) (*vpcv1.LoadBalancer, error) {
+	result, _, err := v.ListLoadBalancers(&vpcv1.ListLoadBalancersOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range result.LoadBalancers {
+		if *result.LoadBalancers[i].Name == name {
+			return &result.LoadBalancers[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (v *vpcClient) GetSecurityGroupByName(name string) (*vpcv1.SecurityGroup, error) {
+	result, _, err := v.ListSecurityGroups(&vpcv1.ListSecurityGroupsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range result.SecurityGroups {
+		if *result.SecurityGroups[i].Name == name {
+			return &result.SecurityGroups[i], nil
+		}
+	}
+	return nil, &vpc.SecurityGroupByNameNotFound{Name: name}
+}
+
+func (v *vpcClient) GetEndpointGatewayByName(name string) (*vpcv1.EndpointGateway, error) {
+	result, _, err := v.ListEndpointGateways(&vpcv1.ListEndpointGatewaysOptions{Name: &name})
+	if err != nil || len(result.EndpointGateways) == 0 {
+		return nil, err
+	}
+	return &result.EndpointGateways[0], nil
+}
+
+func (v *vpcClient) GetVPCZonesByRegion(region string) ([]string, error) {
+	zones := make([]string, 0)
+	result, _, err := v.ListRegionZones(v.NewListRegionZonesOptions(region))
+	if err != nil {
+		return zones, err
+	}
+	for _, zone := range result.Zones {
+		zones = append(zones, *zone.Name)
+	}
+	return zones, nil
+}
+
+func (v *vpcClient) GetVolumeAttachments(options *vpcv1.ListInstanceVolumeAttachmentsOptions) (*vpcv1.VolumeAttachmentCollection, *core.DetailedResponse, error) {
+	return v.ListInstanceVolumeAttachments(options)
+}
+
+func (v *vpcClient) AttachVolumeToInstance(options *vpcv1.CreateInstanceVolumeAttachmentOptions) (*vpcv1.VolumeAttachment, *core.DetailedResponse, error) {
+	return v.CreateInstanceVolumeAttachment(options)
+}
+
+type powerVSClient struct {
+	session        *ibmpisession.IBMPISession
+	instanceClient *instance.IBMPIInstanceClient
+	networkClient  *instance.IBMPINetworkClient
+	imageClient    *instance.IBMPIImageClient
+	jobClient      *instance.IBMPIJobClient
+	dhcpClient     *instance.IBMPIDhcpClient
+	volumeClient   *instance.IBMPIVolumeClient
+
+	placementGroupClient      *instance.IBMPIPlacementGroupClient
+	sharedProcessorPoolClient *instance.IBMPISharedProcessorPoolClient
+	sapClient                 *instance.IBMPISAPInstanceClient
+	systemPoolClient          *instance.IBMPISystemPoolClient
+	storageTierClient         *instance.IBMPIStorageTierClient
+	cloudInstanceClient       *instance.IBMPICloudInstanceClient
+	snapshotClient            *instance.IBMPISnapshotClient
+}
+
+// WithClients points the client to the workspace. The returned *powervs.Service is always nil, callers ignore it.
+func (p *powerVSClient) WithClients(options powervs.ServiceOptions) *powervs.Service {
+	ctx := context.Background()
+	p.instanceClient = instance.NewIBMPIInstanceClient(ctx, p.session, options.CloudInstanceID)
+	p.networkClient = instance.NewIBMPINetworkClient(ctx, p.session, options.CloudInstanceID)
+	p.imageClient = instance.NewIBMPIImageClient(ctx, p.session, options.CloudInstanceID)
+	p.jobClient = instance.NewIBMPIJobClient(ctx, p.session, options.CloudInstanceID)
+	p.dhcpClient = instance.NewIBMPIDhcpClient(ctx, p.session, options.CloudInstanceID)
+	p.volumeClient = instance.NewIBMPIVolumeClient(ctx, p.session, options.CloudInstanceID)
+	p.placementGroupClient = instance.NewIBMPIPlacementGroupClient(ctx, p.session, options.CloudInstanceID)
+	p.sharedProcessorPoolClient = instance.NewIBMPISharedProcessorPoolClient(ctx, p.session, options.CloudInstanceID)
+	p.sapClient = instance.NewIBMPISAPInstanceClient(ctx, p.session, options.CloudInstanceID)
+	p.systemPoolClient = instance.NewIBMPISystemPoolClient(ctx, p.session, options.CloudInstanceID)
+	p.storageTierClient = instance.NewIBMPIStorageTierClient(ctx, p.session, options.CloudInstanceID)
+	p.cloudInstanceClient = instance.NewIBMPICloudInstanceClient(ctx, p.session, options.CloudInstanceID)
+	p.snapshotClient = instance.NewIBMPISnapshotClient(ctx, p.session, options.CloudInstanceID)
+	return nil
+}
+
+func (p *powerVSClient) CreateInstance(body *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
+	return p.instanceClient.Create(body)
+}
+
+func (p *powerVSClient) DeleteInstance(id string) error {
+	return p.instanceClient.Delete(id)
+}
+
+func (p *powerVSClient) GetAllInstance() (*models.PVMInstances, error) {
+	return p.instanceClient.GetAll()
+}
+
+func (p *powerVSClient) GetInstance(id string) (*models.PVMInstance, error) {
+	return p.instanceClient.Get(id)
+}
+
+func (p *powerVSClient) UpdateInstance(id string, body *models.PVMInstanceUpdate) (*models.PVMInstanceUpdateResponse, error) {
+	return p.instanceClient.Update(id, body)
+}
+
+func (p *powerVSClient) GetImage(id string) (*models.Image, error) {
+	return p.imageClient.Get(id)
+}
+
+func (p *powerVSClient) GetAllImage() (*models.Images, error) {
+	return p.imageClient.GetAll()
+}
+
+func (p *powerVSClient) DeleteImage(id string) error {
+	return p.imageClient.Delete(id)
+}
+
+func (p *powerVSClient) CreateCosImage(body *models.CreateCosImageImportJob) (*models.JobReference, error) {
+	return p.imageClient.CreateCosImage(body)
+}
+
+func (p *powerVSClient) GetCosImages(id string) (*models.Job, error) {
+	params := p_cloud_images.NewPcloudV1CloudinstancesCosimagesGetParams().WithCloudInstanceID(id)
+	resp, err := p.session.Power.PCloudImages.PcloudV1CloudinstancesCosimagesGet(params, p.session.AuthInfo(id))
+	if err != nil || resp.Payload == nil {
+		return nil, err
+	}
+	return resp.Payload, nil
+}
+
+func (p *powerVSClient) GetJob(id string) (*models.Job, error) {
+	return p.jobClient.Get(id)
+}
+
+func (p *powerVSClient) DeleteJob(id string) error {
+	return p.jobClient.Delete(id)
+}
+
+func (p *powerVSClient) GetAllNetwork() (*models.Networks, error) {
+	return p.networkClient.GetAll()
+}
+
+func (p *powerVSClient) GetNetworkByID(id string) (*models.Network, error) {
+	return p.networkClient.Get(id)
+}
+
+func (p *powerVSClient) CreateNetwork(body *models.NetworkCreate) (*models.Network, error) {
+	return p.networkClient.Create(body)
+}
+
+func (p *powerVSClient) DeleteNetwork(id string) error {
+	return p.networkClient.Delete(id)
+}
+
+func (p *powerVSClient) GetNetworkByName(name string) (*models.NetworkReference, error) {
+	networks, err := p.GetAllNetwork()
+	if err != nil {
+		return nil, err
+	}
+	for _, network := range networks.Networks {
+		if *network.Name == name {
+			return network, nil
+		}
+	}
+	return nil, nil
+}
+
+func (p *powerVSClient) GetAllDHCPServers() (models.DHCPServers, error) {
+	return p.dhcpClient.GetAll()
+}
+
+func (p *powerVSClient) GetDHCPServer(id string) (*models.DHCPServerDetail, error) {
+	return p.dhcpClient.Get(id)
+}
+
+func (p *powerVSClient) CreateDHCPServer(options *models.DHCPServerCreate) (*models.DHCPServer, error) {
+	return p.dhcpClient.Create(options)
+}
+
+func (p *powerVSClient) DeleteDHCPServer(id string) error {
+	return p.dhcpClient.Delete(id)
+}
+
+func (p *powerVSClient) GetDatacenterCapabilities(zone string) (map[string]bool, error) {
+	params := datacenters.NewV1DatacentersGetParamsWithContext(context.TODO()).WithDatacenterRegion(zone)
+	datacenter, err := p.session.Power.Datacenters.V1DatacentersGet(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get datacenter details for zone: %s err:%w", zone, err)
+	}
+	return datacenter.Payload.Capabilities, nil
+}
+
+func (p *powerVSClient) CreateVolume(body *models.CreateDataVolume) (*models.Volume, error) {
+	return p.volumeClient.CreateVolume(body)
+}
+
+func (p *powerVSClient) GetVolume(id string) (*models.Volume, error) {
+	return p.volumeClient.Get(id)
+}
+
+func (p *powerVSClient) GetAllVolume() (*models.Volumes, error) {
+	return p.volumeClient.GetAll()
+}
+
+func (p *powerVSClient) DeleteVolume(id string) error {
+	return p.volumeClient.DeleteVolume(id)
+}
+
+func (p *powerVSClient) AttachVolume(instanceID, volumeID string) error {
+	return p.volumeClient.Attach(instanceID, volumeID)
+}
+
+func (p *powerVSClient) DetachVolume(instanceID, volumeID string) error {
+	return p.volumeClient.Detach(instanceID, volumeID)
+}
+
+func (p *powerVSClient) GetAllPlacementGroups() (*models.PlacementGroups, error) {
+	return p.placementGroupClient.GetAll()
+}
+
+func (p *powerVSClient) GetPlacementGroup(id string) (*models.PlacementGroup, error) {
+	return p.placementGroupClient.Get(id)
+}
+
+func (p *powerVSClient) CreatePlacementGroup(body *models.PlacementGroupCreate) (*models.PlacementGroup, error) {
+	return p.placementGroupClient.Create(body)
+}
+
+func (p *powerVSClient) DeletePlacementGroup(id string) error {
+	return p.placementGroupClient.Delete(id)
+}
+
+func (p *powerVSClient) GetAllSharedProcessorPools() (*models.SharedProcessorPools, error) {
+	return p.sharedProcessorPoolClient.GetAll()
+}
+
+func (p *powerVSClient) GetSharedProcessorPool(id string) (*models.SharedProcessorPoolDetail, error) {
+	return p.sharedProcessorPoolClient.Get(id)
+}
+
+func (p *powerVSClient) CreateSharedProcessorPool(body *models.SharedProcessorPoolCreate) (*models.SharedProcessorPool, error) {
+	return p.sharedProcessorPoolClient.Create(body)
+}
+
+func (p *powerVSClient) DeleteSharedProcessorPool(id string) error {
+	return p.sharedProcessorPoolClient.Delete(id)
+}
+
+func (p *powerVSClient) GetSAPProfile(id string) (*models.SAPProfile, error) {
+	return p.sapClient.GetSAPProfile(id)
+}
+
+func (p *powerVSClient) CreateSAPInstance(body *models.SAPCreate) (*models.PVMInstanceList, error) {
+	return p.sapClient.Create(body)
+}
+
+func (p *powerVSClient) GetSystemPools() (models.SystemPools, error) {
+	return p.systemPoolClient.GetSystemPools()
+}
+
+func (p *powerVSClient) GetStorageTiers() (models.RegionStorageTiers, error) {
+	return p.storageTierClient.GetAll()
+}
+
+func (p *powerVSClient) GetCloudInstance(id string) (*models.CloudInstance, error) {
+	return p.cloudInstanceClient.Get(id)
+}
+
+func (p *powerVSClient) CreateInstanceSnapshot(instanceID string, body *models.SnapshotCreate) (*models.SnapshotCreateResponse, error) {
+	return p.instanceClient.CreatePvmSnapShot(instanceID, body)
+}
+
+func (p *powerVSClient) GetSnapshot(id string) (*models.Snapshot, error) {
+	return p.snapshotClient.Get(id)
+}
+
+func (p *powerVSClient) GetAllSnapshots() (*models.Snapshots, error) {
+	return p.snapshotClient.GetAll()
+}
+
+func (p *powerVSClient) DeleteSnapshot(id string) error {
+	return p.snapshotClient.Delete(id)
+}
+
+func (p *powerVSClient) RestoreInstanceSnapshot(instanceID, snapshotID string, force bool) (*models.Snapshot, error) {
+	return p.instanceClient.RestoreSnapShotVM(instanceID, snapshotID, "", &models.SnapshotRestore{Force: &force})
+}
+
+func (p *powerVSClient) CaptureInstance(instanceID string, body *models.PVMInstanceCapture) (*models.JobReference, error) {
+	return p.instanceClient.CaptureInstanceToImageCatalogV2(instanceID, body)
+}
+
+func (p *powerVSClient) GetEvents(cloudInstanceID string, fromTime time.Time) (*models.Events, error) {
+	params := p_cloud_events.NewPcloudEventsGetqueryParams().WithCloudInstanceID(cloudInstanceID).
+		WithFromTime(ptr.To(strconv.FormatInt(fromTime.Unix(), 10)))
+	resp, err := p.session.Power.PCloudEvents.PcloudEventsGetquery(params, p.session.AuthInfo(cloudInstanceID))
+	if err != nil {
+		return nil, err
+	}
+	return resp.Payload, nil
+}
+
+type transitGatewayClient struct {
+	*transitgatewayapisv1.TransitGatewayApisV1
+}
+
+func (t *transitGatewayClient) GetTransitGatewayByName(name string) (*transitgatewayapisv1.TransitGateway, error) {
+	result, _, err := t.ListTransitGateways(&transitgatewayapisv1.ListTransitGatewaysOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transit gateway %w", err)
+	}
+	for i := range result.TransitGateways {
+		if *result.TransitGateways[i].Name == name {
+			return &result.TransitGateways[i], nil
+		}
+	}
+	// Like the real client, an empty gateway is returned when none is found.
+	return &transitgatewayapisv1.TransitGateway{}, nil
+}
+
+type resourceControllerClient struct {
+	*resourcecontrollerv2.ResourceControllerV2
+}
+
+func (r *resourceControllerClient) GetServiceInstance(id, name string, zone *string) (*resourcecontrollerv2.ResourceInstance, error) {
+	options := &resourcecontrollerv2.ListResourceInstancesOptions{
+		ResourceID:     ptr.To(resourcecontroller.PowerVSResourceID),
+		ResourcePlanID: ptr.To(resourcecontroller.PowerVSResourcePlanID),
+	}
+	if id != "" {
+		options.GUID = &id
+	}
+	if name != "" {
+		options.Name = &name
+	}
+	result, _, err := r.ListResourceInstances(options)
+	if err != nil {
+		return nil, fmt.Errorf("error listing service instances %v", err)
+	}
+	var instances []resourcecontrollerv2.ResourceInstance
+	for _, instance := range result.Resources {
+		if zone == nil || *zone == "" || *instance.RegionID == *zone {
+			instances = append(instances, instance)
+		}
+	}
+	return single(instances, "service instance", name)
+}
+
+func (r *resourceControllerClient) GetInstanceByName(name, resourceID, planID string) (*resourcecontrollerv2.ResourceInstance, error) {
+	result, _, err := r.ListResourceInstances(&resourcecontrollerv2.ListResourceInstancesOptions{
+		Name:           &name,
+		ResourceID:     &resourceID,
+		ResourcePlanID: &planID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing COS instances %v", err)
+	}
+	return single(result.Resources, "COS instance", name)
+}
+
+func single(instances []resourcecontrollerv2.ResourceInstance, kind, name string) (*resourcecontrollerv2.ResourceInstance, error) {
+	switch len(instances) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &instances[0], nil
+	default:
+		return nil, fmt.Errorf("there exist more than one %s ID with same name %s, Try setting serviceInstance.ID", kind, name)
+	}
+}
+
+type resourceManagerClient struct {
+	*resourcemanagerv2.ResourceManagerV2
+	accountID string
+}
+
+func (r *resourceManagerClient) GetResourceGroupByName(name string) (*resourcemanagerv2.ResourceGroup, error) {
+	result, _, err := r.ListResourceGroups(&resourcemanagerv2.ListResourceGroupsOptions{AccountID: &r.accountID, Name: &name})
+	if err != nil {
+		return nil, fmt.Errorf("failed listing Resource Groups: %w", err)
+	}
+	if len(result.Resources) != 1 {
+		return nil, fmt.Errorf("failed to find Resource Group")
+	}
+	return &result.Resources[0], nil
+}
+
+type globalTaggingClient struct {
+	*globaltaggingv1.GlobalTaggingV1
+	accountID string
+}
+
+func (g *globalTaggingClient) GetTagByName(name string) (*globaltaggingv1.Tag, error) {
+	options := g.NewListTagsOptions()
+	options.SetTagType(globaltaggingv1.AttachTagOptionsTagTypeUserConst)
+	options.SetAccountID(g.accountID)
+	result, _, err := g.ListTags(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing user tags: %w", err)
+	}
+	for i := range result.Items {
+		if *result.Items[i].Name == name {
+			return &result.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+type cosClient struct {
+	*s3.S3
+}
+
+func (c *cosClient) GetBucketByName(name string) (*s3.HeadBucketOutput, error) {
+	return c.HeadBucket(&s3.HeadBucketInput{Bucket: &name})
+}
+
+type dnsServicesClient struct {
+	*dnssvcsv1.DnsSvcsV1
+}
+
+func (d *dnsServicesClient) GetDnszoneByName(instanceID, name string) (*dnssvcsv1.Dnszone, error) {
+	result, _, err := d.ListDnszones(&dnssvcsv1.ListDnszonesOptions{InstanceID: &instanceID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DNS zones: %w", err)
+	}
+	for i := range result.Dnszones {
+		if *result.Dnszones[i].Name == name {
+			return &result.Dnszones[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (d *dnsServicesClient) GetPermittedNetworkByVPCCRN(instanceID, dnszoneID, vpcCRN string) (*dnssvcsv1.PermittedNetwork, error) {
+	result, _, err := d.ListPermittedNetworks(&dnssvcsv1.ListPermittedNetworksOptions{InstanceID: &instanceID, DnszoneID: &dnszoneID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list permitted networks: %w", err)
+	}
+	for i := range result.PermittedNetworks {
+		if network := result.PermittedNetworks[i].PermittedNetwork; network != nil && *network.VpcCrn == vpcCRN {
+			return &result.PermittedNetworks[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (d *dnsServicesClient) GetResourceRecordByName(instanceID, dnszoneID, name string) (*dnssvcsv1.ResourceRecord, error) {
+	result, _, err := d.ListResourceRecords(&dnssvcsv1.ListResourceRecordsOptions{InstanceID: &instanceID, DnszoneID: &dnszoneID, Name: &name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource records: %w", err)
+	}
+	if len(result.ResourceRecords) == 0 {
+		return nil, nil
+	}
+	return &result.ResourceRecords[0], nil
+}
+
+type cisClient struct {
+	*zonesv1.ZonesV1
+	cloud *Cloud
+}
+
+func (c *cisClient) dnsRecords(zoneID string) (*dnsrecordsv1.DnsRecordsV1, error) {
+	service, err := dnsrecordsv1.NewDnsRecordsV1(&dnsrecordsv1.DnsRecordsV1Options{
+		URL:            inProcessURL + cisPrefix,
+		Authenticator:  &core.NoAuthAuthenticator{},
+		Crn:            c.Crn,
+		ZoneIdentifier: ptr.To(zoneID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	service.Service.SetHTTPClient(c.cloud.inProcessClient())
+	return service, nil
+}
+
+func (c *cisClient) GetZoneByName(name string) (*zonesv1.ZoneDetails, error) {
+	result, _, err := c.ListZones(&zonesv1.ListZonesOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range result.Result {
+		if *result.Result[i].Name == name {
+			return &result.Result[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *cisClient) GetDNSRecord(zoneID string, options *dnsrecordsv1.GetDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+	service, err := c.dnsRecords(zoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return service.GetDnsRecord(options)
+}
+
+func (c *cisClient) GetDNSRecordByName(zoneID, name string) (*dnsrecordsv1.DnsrecordDetails, error) {
+	service, err := c.dnsRecords(zoneID)
+	if err != nil {
+		return nil, err
+	}
+	result, _, err := service.ListAllDnsRecords(&dnsrecordsv1.ListAllDnsRecordsOptions{Name: &name})
+	if err != nil || len(result.Result) == 0 {
+		return nil, err
+	}
+	return &result.Result[0], nil
+}
+
+func (c *cisClient) CreateDNSRecord(zoneID string, options *dnsrecordsv1.CreateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+	service, err := c.dnsRecords(zoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return service.CreateDnsRecord(options)
+}
+
+func (c *cisClient) UpdateDNSRecord(zoneID string, options *dnsrecordsv1.UpdateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+	service, err := c.dnsRecords(zoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return service.UpdateDnsRecord(options)
+}
+
+func (c *cisClient) DeleteDNSRecord(zoneID string, options *dnsrecordsv1.DeleteDnsRecordOptions) (*dnsrecordsv1.DeleteDnsrecordResp, *core.DetailedResponse, error) {
+	service, err := c.dnsRecords(zoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return service.DeleteDnsRecord(options)
+}
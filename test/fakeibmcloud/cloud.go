@@ -0,0 +1,367 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeibmcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultRegion is the VPC region served by default.
+	DefaultRegion = "us-south"
+	// DefaultPowerVSZone is the zone of the Power VS workspaces created by default.
+	DefaultPowerVSZone = "dal10"
+	// DefaultAccountID is the account owning the resources by default.
+	DefaultAccountID = "fakeaccount"
+	// DefaultResourceGroupName is the name of the default resource group of the account.
+	DefaultResourceGroupName = "Default"
+
+	// inProcessURL is the base URL of the in-process clients, their requests never leave the process.
+	inProcessURL = "http://fake.ibmcloud.local"
+)
+
+// Options configures a Cloud.
+type Options struct {
+	// Region is the VPC region, defaults to DefaultRegion. Its zones are <Region>-1, <Region>-2 and <Region>-3.
+	Region string
+	// AccountID is the ID of the account owning the resources, defaults to DefaultAccountID.
+	AccountID string
+	// Reads is the number of times a resource is read before an asynchronous operation on it completes,
+	// e.g. before a created VPC goes from pending to available. Zero defaults to one read,
+	// a negative value completes the operations synchronously.
+	Reads int
+}
+
+// Cloud is a stateful, in-memory IBM Cloud backend, safe for concurrent use.
+type Cloud struct {
+	mu        sync.Mutex
+	region    string
+	accountID string
+	store     *store
+	mux       *http.ServeMux
+
+	// ipAllocations counts the IP addresses allocated in the VPC subnets and Power VS networks.
+	ipAllocations map[string]int
+	// tags are the user tags attached to the resources, by resource ID.
+	tags map[string][]string
+	// buckets are the COS buckets, by name.
+	buckets map[string]*bucket
+
+	defaultResourceGroupID string
+}
+
+// New returns an empty Cloud, with the default resource group of the account.
+func New(options Options) *Cloud {
+	if options.Region == "" {
+		options.Region = DefaultRegion
+	}
+	if options.AccountID == "" {
+		options.AccountID = DefaultAccountID
+	}
+	if options.Reads == 0 {
+		options.Reads = 1
+	}
+	c := &Cloud{
+		region:        options.Region,
+		accountID:     options.AccountID,
+		store:         newStore(options.Reads),
+		mux:           http.NewServeMux(),
+		ipAllocations: map[string]int{},
+		tags:          map[string][]string{},
+		buckets:       map[string]*bucket{},
+	}
+	c.registerVPC()
+	c.registerPowerVS()
+	c.registerTransitGateway()
+	c.registerResourceController()
+	c.registerResourceManager()
+	c.registerGlobalTagging()
+	c.registerCOS()
+	c.registerDNSServices()
+	c.registerCIS()
+	c.registerIAM()
+	c.defaultResourceGroupID = c.AddResourceGroup(DefaultResourceGroupName)
+	return c
+}
+
+// ServeHTTP implements http.Handler, serving the APIs of all the services.
+func (c *Cloud) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mux.ServeHTTP(w, r)
+}
+
+// Region returns the VPC region of the cloud.
+func (c *Cloud) Region() string {
+	return c.region
+}
+
+// Zones returns the VPC zones of the cloud.
+func (c *Cloud) Zones() []string {
+	return []string{c.region + "-1", c.region + "-2", c.region + "-3"}
+}
+
+// AccountID returns the ID of the account owning the resources.
+func (c *Cloud) AccountID() string {
+	return c.accountID
+}
+
+// DefaultResourceGroupID returns the ID of the default resource group of the account.
+func (c *Cloud) DefaultResourceGroupID() string {
+	return c.defaultResourceGroupID
+}
+
+// NewServer starts an httptest.Server serving the cloud. The caller must close it.
+func (c *Cloud) NewServer() *httptest.Server {
+	return httptest.NewServer(c)
+}
+
+// ServiceEndpoints returns the value of the manager --service-endpoint flag pointing all the services to the cloud served at serverURL.
+func (c *Cloud) ServiceEndpoints(serverURL string) string {
+	serverURL = strings.TrimSuffix(serverURL, "/")
+	endpoints := []string{
+		"vpc=" + serverURL + vpcPrefix,
+		"powervs=" + serverURL,
+		"rc=" + serverURL + rcPrefix,
+		"rm=" + serverURL + rmPrefix,
+		"transitgateway=" + serverURL + tgPrefix,
+		"cos=" + serverURL + cosPrefix,
+		"globaltagging=" + serverURL + globalTaggingPrefix,
+		"dnsservices=" + serverURL + dnsPrefix,
+		"cis=" + serverURL + cisPrefix,
+	}
+	return c.region + ":" + strings.Join(endpoints, ",")
+}
+
+// Environment returns the environment variables configuring the IBM Cloud authenticator of the manager
+// to get its tokens from the cloud served at serverURL.
+func (c *Cloud) Environment(serverURL string) map[string]string {
+	return map[string]string{
+		"IBMCLOUD_AUTH_TYPE": "iam",
+		"IBMCLOUD_APIKEY":    "fake-api-key",
+		"IBMCLOUD_AUTH_URL":  strings.TrimSuffix(serverURL, "/") + iamPrefix,
+	}
+}
+
+// apiError is an error returned by the API, serialized in the error format of each service.
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func notFound(kind, id string) *apiError {
+	return &apiError{status: http.StatusNotFound, code: "not_found", message: fmt.Sprintf("%s with ID %s not found", kind, id)}
+}
+
+func conflict(code, format string, args ...interface{}) *apiError {
+	return &apiError{status: http.StatusConflict, code: code, message: fmt.Sprintf(format, args...)}
+}
+
+func badRequest(format string, args ...interface{}) *apiError {
+	return &apiError{status: http.StatusBadRequest, code: "bad_request", message: fmt.Sprintf(format, args...)}
+}
+
+// handlerFunc serves a request, returning the status code and the body of the response.
+type handlerFunc func(r *http.Request) (int, interface{}, *apiError)
+
+// errorWriter writes an API error in the format of a service.
+type errorWriter func(w http.ResponseWriter, err *apiError)
+
+// handle registers a JSON API handler, serialized with the other requests to the cloud.
+func (c *Cloud) handle(pattern string, writeError errorWriter, fn handlerFunc) {
+	c.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		c.mu.Lock()
+		status, body, err := fn(r)
+		c.mu.Unlock()
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, status, body)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writePlatformError writes an error in the format of the VPC and platform services APIs.
+func writePlatformError(w http.ResponseWriter, err *apiError) {
+	writeJSON(w, err.status, resource{
+		"errors": []resource{{"code": err.code, "message": err.message}},
+		"trace":  uuid.NewString(),
+	})
+}
+
+// decode reads the JSON body of a request.
+func decode(r *http.Request) (resource, *apiError) {
+	body := resource{}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, badRequest("failed to read the request body: %v", err)
+	}
+	if len(data) == 0 {
+		return body, nil
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, badRequest("invalid request body: %v", err)
+	}
+	return body, nil
+}
+
+// lookup returns the value at a dot separated path of a resource, e.g. vpc.id.
+func lookup(r resource, path ...string) interface{} {
+	var value interface{} = r
+	for _, p := range path {
+		for _, field := range strings.Split(p, ".") {
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			value = m[field]
+		}
+	}
+	return value
+}
+
+// str returns the string at a path of a resource, or an empty string.
+func str(r resource, path ...string) string {
+	if s, ok := lookup(r, path...).(string); ok {
+		return s
+	}
+	return ""
+}
+
+// num returns the number at a path of a resource, or zero.
+func num(r resource, path ...string) int64 {
+	switch n := lookup(r, path...).(type) {
+	case float64:
+		return int64(n)
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	}
+	return 0
+}
+
+// float returns the floating point number at a path of a resource, or zero.
+func float(r resource, path ...string) float64 {
+	switch n := lookup(r, path...).(type) {
+	case float64:
+		return n
+	case int64:
+		return float64(n)
+	case int:
+		return float64(n)
+	}
+	return 0
+}
+
+// items returns the list of objects at a path of a resource.
+func items(r resource, path ...string) []resource {
+	var out []resource
+	switch list := lookup(r, path...).(type) {
+	case []interface{}:
+		for _, e := range list {
+			if m, ok := e.(map[string]interface{}); ok {
+				out = append(out, m)
+			}
+		}
+	case []resource:
+		out = append(out, list...)
+	}
+	return out
+}
+
+// filter returns the resources matching the query parameters of a request on the given fields.
+func filter(resources []resource, query url.Values, fields ...string) []resource {
+	out := []resource{}
+	for _, r := range resources {
+		matches := true
+		for _, field := range fields {
+			if want := query.Get(field); want != "" && fmt.Sprint(lookup(r, field)) != want {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// sortedKeys returns the keys of a map in order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func newID(prefix string) string {
+	if prefix == "" {
+		return uuid.NewString()
+	}
+	return prefix + "-" + uuid.NewString()
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// inProcessClient returns an http.Client sending its requests straight to the cloud handler.
+func (c *Cloud) inProcessClient() *http.Client {
+	return &http.Client{Transport: &handlerTransport{handler: c}}
+}
+
+// handlerTransport is an http.RoundTripper serving the requests with an http.Handler.
+type handlerTransport struct {
+	handler http.Handler
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorder := httptest.NewRecorder()
+	t.handler.ServeHTTP(recorder, req)
+	resp := recorder.Result()
+	resp.Request = req
+	return resp, nil
+}
@@ -0,0 +1,617 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeibmcloud
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	"github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestStoreTransitions(t *testing.T) {
+	g := NewWithT(t)
+	s := newStore(2)
+	s.insert("vpc", "a", resource{"status": "pending"}, resource{"status": "available"})
+
+	r, _ := s.get("vpc", "a")
+	g.Expect(r["status"]).To(Equal("pending"))
+	r, _ = s.peek("vpc", "a")
+	g.Expect(r["status"]).To(Equal("pending"), "peeking must not advance the transition")
+	r, _ = s.get("vpc", "a")
+	g.Expect(r["status"]).To(Equal("available"))
+
+	s.remove("vpc", "a", resource{"status": "deleting"})
+	r, ok := s.get("vpc", "a")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(r["status"]).To(Equal("deleting"))
+	_, ok = s.get("vpc", "a")
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestVPC(t *testing.T) {
+	g := NewWithT(t)
+	cloud := New(Options{})
+	client, err := cloud.VPC()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	zones, err := client.GetVPCZonesByRegion(DefaultRegion)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(zones).To(Equal(cloud.Zones()))
+
+	created, _, err := client.CreateVPC(&vpcv1.CreateVPCOptions{
+		Name:          ptr.To("vpc"),
+		ResourceGroup: &vpcv1.ResourceGroupIdentity{ID: ptr.To(cloud.DefaultResourceGroupID())},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*created.Status).To(Equal(vpcv1.VPCStatusPendingConst))
+	got, _, err := client.GetVPC(&vpcv1.GetVPCOptions{ID: created.ID})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*got.Status).To(Equal(vpcv1.VPCStatusAvailableConst))
+
+	_, response, err := client.CreateVPC(&vpcv1.CreateVPCOptions{Name: ptr.To("vpc")})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(response.StatusCode).To(Equal(http.StatusConflict))
+
+	byName, err := client.GetVPCByName("vpc")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*byName.ID).To(Equal(*created.ID))
+	byName, err = client.GetVPCByName("missing")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(byName).To(BeNil())
+
+	subnet, _, err := client.CreateSubnet(&vpcv1.CreateSubnetOptions{
+		SubnetPrototype: &vpcv1.SubnetPrototypeSubnetByTotalCount{
+			Name:                  ptr.To("subnet"),
+			VPC:                   &vpcv1.VPCIdentityByID{ID: created.ID},
+			Zone:                  &vpcv1.ZoneIdentityByName{Name: ptr.To(cloud.Zones()[0])},
+			TotalIpv4AddressCount: ptr.To(int64(256)),
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	// Besides the reserved addresses, the broadcast address is not available.
+	g.Expect(*subnet.AvailableIpv4AddressCount).To(Equal(int64(256 - reservedSubnetAddresses - 1)))
+
+	_, err = client.GetSecurityGroupByName("missing")
+	var notFound *vpc.SecurityGroupByNameNotFound
+	g.Expect(errors.As(err, &notFound)).To(BeTrue())
+
+	gateway, _, err := client.CreateEndpointGateway(&vpcv1.CreateEndpointGatewayOptions{
+		Name: ptr.To("gateway"),
+		VPC:  &vpcv1.VPCIdentityByID{ID: created.ID},
+		Target: &vpcv1.EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypeProviderCloudServicePrototype{
+			ResourceType: ptr.To(vpcv1.EndpointGatewayTargetPrototypeResourceTypeProviderCloudServiceConst),
+			CRN:          ptr.To("crn:v1:bluemix:public:iam-svcs:global:::endpoint:private.iam.cloud.ibm.com"),
+		},
+		Ips: []vpcv1.EndpointGatewayReservedIPIntf{
+			&vpcv1.EndpointGatewayReservedIPReservedIPPrototypeTargetContext{Subnet: &vpcv1.SubnetIdentityByID{ID: subnet.ID}},
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*gateway.LifecycleState).To(Equal(vpcv1.EndpointGatewayLifecycleStatePendingConst))
+	g.Expect(gateway.Ips).To(HaveLen(1))
+	gateway, err = client.GetEndpointGatewayByName("gateway")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*gateway.LifecycleState).To(Equal(vpcv1.EndpointGatewayLifecycleStateStableConst))
+	_, err = client.DeleteSubnet(&vpcv1.DeleteSubnetOptions{ID: subnet.ID})
+	g.Expect(err).To(HaveOccurred(), "a subnet with endpoint gateway reserved IPs cannot be deleted")
+	_, err = client.DeleteEndpointGateway(&vpcv1.DeleteEndpointGatewayOptions{ID: gateway.ID})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = client.DeleteVPC(&vpcv1.DeleteVPCOptions{ID: created.ID})
+	g.Expect(err).To(HaveOccurred(), "a VPC with subnets cannot be deleted")
+}
+
+func TestPowerVS(t *testing.T) {
+	g := NewWithT(t)
+	cloud := New(Options{Reads: 2})
+	workspace := cloud.AddPowerVSWorkspace("workspace", DefaultPowerVSZone)
+	imageID := cloud.AddPowerVSImage(workspace, "image")
+	networkID := cloud.AddPowerVSNetwork(workspace, "network", "192.168.0.0/24")
+	storageNetworkID := cloud.AddPowerVSNetwork(workspace, "storage", "10.0.0.0/24")
+
+	client, err := cloud.PowerVS()
+	g.Expect(err).ToNot(HaveOccurred())
+	client.WithClients(powervs.ServiceOptions{CloudInstanceID: workspace})
+
+	network, err := client.GetNetworkByName("network")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*network.NetworkID).To(Equal(networkID))
+
+	replication, err := client.CreateNetwork(&models.NetworkCreate{Name: "replication", Type: ptr.To("vlan"), Cidr: "10.1.0.0/24", Mtu: ptr.To(int64(9000))})
+	g.Expect(err).ToNot(HaveOccurred())
+	replication, err = client.GetNetworkByID(*replication.NetworkID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*replication.Mtu).To(Equal(int64(9000)))
+	_, err = client.CreateNetwork(&models.NetworkCreate{Name: "replication", Type: ptr.To("vlan"), Cidr: "10.2.0.0/24"})
+	g.Expect(err).To(HaveOccurred(), "network names are unique in a workspace")
+	_, err = client.CreateNetwork(&models.NetworkCreate{Name: "private", Type: ptr.To("vlan")})
+	g.Expect(err).To(HaveOccurred(), "a vlan network requires a cidr")
+	public, err := client.CreateNetwork(&models.NetworkCreate{Name: "public", Type: ptr.To("pub-vlan")})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client.DeleteNetwork(*public.NetworkID)).To(Succeed())
+	g.Expect(client.DeleteNetwork(*replication.NetworkID)).To(Succeed())
+	_, err = client.GetNetworkByID(*replication.NetworkID)
+	g.Expect(err).To(MatchError(ContainSubstring("network does not exist")))
+
+	placementGroup, err := client.CreatePlacementGroup(&models.PlacementGroupCreate{Name: ptr.To("placement-group"), Policy: ptr.To("anti-affinity")})
+	g.Expect(err).ToNot(HaveOccurred())
+	pool, err := client.CreateSharedProcessorPool(&models.SharedProcessorPoolCreate{Name: ptr.To("pool"), HostGroup: ptr.To("s922"), ReservedCores: ptr.To(int64(1))})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	instances, err := client.CreateInstance(&models.PVMInstanceCreate{
+		PlacementGroup:      *placementGroup.ID,
+		SharedProcessorPool: *pool.ID,
+		ServerName:          ptr.To("machine"),
+		ImageID:             &imageID,
+		Networks:            []*models.PVMInstanceAddNetwork{{NetworkID: &networkID}, {NetworkID: &storageNetworkID, IPAddress: "10.0.0.10"}},
+		Memory:              ptr.To(float64(4)),
+		Processors:          ptr.To(0.25),
+		ProcType:            ptr.To(models.PVMInstanceCreateProcTypeShared),
+		SysType:             "s922",
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*instances).To(HaveLen(1))
+	id := *(*instances)[0].PvmInstanceID
+
+	instance, err := client.GetInstance(id)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*instance.Status).To(Equal("BUILD"))
+	instance, err = client.GetInstance(id)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*instance.Status).To(Equal("ACTIVE"))
+	g.Expect(instance.Networks).To(HaveLen(2))
+	g.Expect(instance.Networks[0].IPAddress).ToNot(BeEmpty())
+	g.Expect(instance.Networks[1].IPAddress).To(Equal("10.0.0.10"))
+	g.Expect(*instance.PlacementGroup).To(Equal(*placementGroup.ID))
+	placementGroup, err = client.GetPlacementGroup(*placementGroup.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(placementGroup.Members).To(ConsistOf(id))
+	g.Expect(client.DeletePlacementGroup(*placementGroup.ID)).ToNot(Succeed(), "a placement group with members cannot be deleted")
+	g.Expect(instance.SharedProcessorPoolID).To(Equal(*pool.ID))
+
+	_, err = client.UpdateInstance(id, &models.PVMInstanceUpdate{Memory: 32})
+	g.Expect(err).To(HaveOccurred(), "a running instance cannot be resized beyond its maximum memory")
+	_, err = client.UpdateInstance(id, &models.PVMInstanceUpdate{Processors: 0.5, Memory: 8})
+	g.Expect(err).ToNot(HaveOccurred())
+	instance, err = client.GetInstance(id)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*instance.Status).To(Equal("RESIZE"))
+	instance, err = client.GetInstance(id)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*instance.Status).To(Equal("ACTIVE"))
+	g.Expect(*instance.Processors).To(Equal(0.5))
+	g.Expect(*instance.Memory).To(Equal(float64(8)))
+	poolDetail, err := client.GetSharedProcessorPool(*pool.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(poolDetail.Servers).To(HaveLen(1))
+	g.Expect(client.DeleteSharedProcessorPool(*pool.ID)).ToNot(Succeed(), "a shared processor pool with instances cannot be deleted")
+
+	events, err := client.GetEvents(workspace, time.Now().Add(-time.Minute))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(events.Events).To(HaveLen(1))
+	g.Expect(*events.Events[0].Message).To(ContainSubstring(id))
+	events, err = client.GetEvents(workspace, time.Now().Add(time.Minute))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(events.Events).To(BeEmpty())
+
+	volume, err := client.CreateVolume(&models.CreateDataVolume{Name: ptr.To("volume"), Size: ptr.To(float64(10))})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(client.AttachVolume(id, *volume.VolumeID)).ToNot(Succeed(), "a volume being created cannot be attached")
+	for range 2 {
+		volume, err = client.GetVolume(*volume.VolumeID)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+	g.Expect(volume.State).To(Equal("available"))
+	g.Expect(client.AttachVolume(id, *volume.VolumeID)).To(Succeed())
+	for range 2 {
+		volume, err = client.GetVolume(*volume.VolumeID)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(volume.State).To(Equal("in-use"))
+	g.Expect(volume.PvmInstanceIDs).To(ConsistOf(id))
+	g.Expect(client.DeleteVolume(*volume.VolumeID)).ToNot(Succeed(), "an attached volume cannot be deleted")
+
+	g.Expect(client.DeleteInstance(id)).To(Succeed())
+	for range 2 {
+		_, err = client.GetInstance(id)
+	}
+	g.Expect(err).To(HaveOccurred())
+	volume, err = client.GetVolume(*volume.VolumeID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(volume.State).To(Equal("available"), "the volumes of a deleted instance are detached")
+	g.Expect(client.DeleteVolume(*volume.VolumeID)).To(Succeed())
+	g.Expect(client.DeletePlacementGroup(*placementGroup.ID)).To(Succeed())
+	_, err = client.GetPlacementGroup(*placementGroup.ID)
+	g.Expect(err).To(MatchError(ContainSubstring("placement group does not exist")))
+	g.Expect(client.DeleteSharedProcessorPool(*pool.ID)).To(Succeed())
+	_, err = client.GetSharedProcessorPool(*pool.ID)
+	g.Expect(err).To(MatchError(ContainSubstring("shared processor pool does not exist")))
+
+	_, err = client.GetSAPProfile("ush1-1x1")
+	g.Expect(err).To(HaveOccurred())
+	profile, err := client.GetSAPProfile("ush1-4x128")
+	g.Expect(err).ToNot(HaveOccurred())
+	instances, err = client.CreateSAPInstance(&models.SAPCreate{
+		Name:      ptr.To("sap"),
+		ImageID:   &imageID,
+		ProfileID: profile.ProfileID,
+		Networks:  []*models.PVMInstanceAddNetwork{{NetworkID: &networkID}},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	instance, err = client.GetInstance(*(*instances)[0].PvmInstanceID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*instance.Processors).To(Equal(float64(4)))
+	g.Expect(*instance.Memory).To(Equal(float64(128)))
+	g.Expect(*instance.ProcType).To(Equal("dedicated"))
+
+	systemPools, err := client.GetSystemPools()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(systemPools).To(HaveKey("s922"))
+	g.Expect(*systemPools["s922"].MaxCoresAvailable.Cores).To(BeNumerically(">", 0))
+	storageTiers, err := client.GetStorageTiers()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(storageTiers).ToNot(BeEmpty())
+	cloud.SetPowerVSWorkspaceLimits(workspace, map[string]float64{"instances": 2})
+	cloudInstance, err := client.GetCloudInstance(workspace)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*cloudInstance.Limits.Instances).To(Equal(float64(2)))
+	g.Expect(*cloudInstance.Usage.Instances).To(Equal(float64(1)))
+	g.Expect(*cloudInstance.Usage.Memory).To(Equal(float64(128)))
+
+	sapID := *(*instances)[0].PvmInstanceID
+	volume, err = client.CreateVolume(&models.CreateDataVolume{Name: ptr.To("data"), Size: ptr.To(float64(10))})
+	g.Expect(err).ToNot(HaveOccurred())
+	for range 2 {
+		_, err = client.GetVolume(*volume.VolumeID)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+	g.Expect(client.AttachVolume(sapID, *volume.VolumeID)).To(Succeed())
+	for range 2 {
+		_, err = client.GetVolume(*volume.VolumeID)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+	_, err = client.CreateInstanceSnapshot(sapID, &models.SnapshotCreate{Name: ptr.To("snapshot"), VolumeIDs: []string{"missing"}})
+	g.Expect(err).To(HaveOccurred(), "only the volumes attached to the instance can be snapshotted")
+	snapshotResponse, err := client.CreateInstanceSnapshot(sapID, &models.SnapshotCreate{Name: ptr.To("snapshot")})
+	g.Expect(err).ToNot(HaveOccurred())
+	snapshot, err := client.GetSnapshot(*snapshotResponse.SnapshotID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(snapshot.Status).To(Equal("creating"))
+	snapshot, err = client.GetSnapshot(*snapshotResponse.SnapshotID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(snapshot.Status).To(Equal("available"))
+	g.Expect(snapshot.PercentComplete).To(Equal(int64(100)))
+	g.Expect(snapshot.VolumeSnapshots).To(HaveKey(*volume.VolumeID))
+	_, err = client.RestoreInstanceSnapshot(sapID, *snapshot.SnapshotID, false)
+	g.Expect(err).To(HaveOccurred(), "a running instance cannot be restored without force")
+	snapshot, err = client.RestoreInstanceSnapshot(sapID, *snapshot.SnapshotID, true)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(snapshot.Status).To(Equal("restoring"))
+	g.Expect(client.DeleteSnapshot(*snapshot.SnapshotID)).ToNot(Succeed(), "a snapshot being restored cannot be deleted")
+	snapshots, err := client.GetAllSnapshots()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(snapshots.Snapshots).To(HaveLen(1))
+
+	job, err := client.CaptureInstance(sapID, &models.PVMInstanceCapture{CaptureName: ptr.To("captured"), CaptureDestination: ptr.To("image-catalog")})
+	g.Expect(err).ToNot(HaveOccurred())
+	for range 2 {
+		_, err = client.GetJob(*job.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+	images, err := client.GetAllImage()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(images.Images).To(ContainElement(HaveField("Name", HaveValue(Equal("captured")))))
+
+	for range 2 {
+		_, err = client.GetSnapshot(*snapshot.SnapshotID)
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+	g.Expect(client.DeleteSnapshot(*snapshot.SnapshotID)).To(Succeed())
+	for range 2 {
+		_, err = client.GetSnapshot(*snapshot.SnapshotID)
+	}
+	g.Expect(err).To(MatchError(ContainSubstring("snapshot does not exist")))
+
+	client.WithClients(powervs.ServiceOptions{CloudInstanceID: "missing"})
+	_, err = client.GetAllInstance()
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestTransitGateway(t *testing.T) {
+	g := NewWithT(t)
+	cloud := New(Options{})
+	client, err := cloud.TransitGateway()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	gateway, _, err := client.CreateTransitGateway(&transitgatewayapisv1.CreateTransitGatewayOptions{
+		Name:     ptr.To("tg"),
+		Location: ptr.To(DefaultRegion),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*gateway.Status).To(Equal("pending"))
+
+	connection := &transitgatewayapisv1.CreateTransitGatewayConnectionOptions{
+		TransitGatewayID: gateway.ID,
+		NetworkType:      ptr.To("vpc"),
+		NetworkID:        ptr.To("crn:v1:bluemix:public:is:us-south:a/fakeaccount::vpc:r006-vpc"),
+	}
+	_, _, err = client.CreateTransitGatewayConnection(connection)
+	g.Expect(err).To(HaveOccurred(), "connections need an available gateway")
+
+	byName, err := client.GetTransitGatewayByName("tg")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*byName.Status).To(Equal("available"))
+
+	created, _, err := client.CreateTransitGatewayConnection(connection)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*created.Status).To(Equal("pending"))
+	g.Expect(*created.PrefixFiltersDefault).To(Equal("permit"))
+
+	filtered, _, err := client.CreateTransitGatewayConnection(&transitgatewayapisv1.CreateTransitGatewayConnectionOptions{
+		TransitGatewayID: gateway.ID,
+		NetworkType:      ptr.To("power_virtual_server"),
+		NetworkID:        ptr.To("crn:v1:bluemix:public:power-iaas:dal10:a/fakeaccount:pvs::"),
+		Name:             ptr.To("pvs-con"),
+		PrefixFilters: []transitgatewayapisv1.TransitGatewayConnectionPrefixFilter{
+			{Action: ptr.To("deny"), Prefix: ptr.To("10.0.0.0/8"), Le: ptr.To(int64(24))},
+		},
+		PrefixFiltersDefault: ptr.To("permit"),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(filtered.PrefixFilters).To(HaveLen(1))
+	g.Expect(*filtered.PrefixFilters[0].Action).To(Equal("deny"))
+	g.Expect(*filtered.PrefixFilters[0].Le).To(Equal(int64(24)))
+
+	_, err = client.DeleteTransitGateway(&transitgatewayapisv1.DeleteTransitGatewayOptions{ID: gateway.ID})
+	g.Expect(err).To(HaveOccurred(), "a gateway with connections cannot be deleted")
+}
+
+func TestDNSServices(t *testing.T) {
+	g := NewWithT(t)
+	cloud := New(Options{})
+	client, err := cloud.DNSServices()
+	g.Expect(err).ToNot(HaveOccurred())
+	instanceID := "dns-instance"
+
+	zone, _, err := client.CreateDnszone(&dnssvcsv1.CreateDnszoneOptions{
+		InstanceID: ptr.To(instanceID),
+		Name:       ptr.To("capi.example.com"),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*zone.State).To(Equal(dnssvcsv1.Dnszone_State_PendingNetworkAdd))
+
+	vpcCRN := "crn:v1:bluemix:public:is:us-south:a/fakeaccount::vpc:r006-vpc"
+	network, _, err := client.CreatePermittedNetwork(&dnssvcsv1.CreatePermittedNetworkOptions{
+		InstanceID:       ptr.To(instanceID),
+		DnszoneID:        zone.ID,
+		Type:             ptr.To(dnssvcsv1.CreatePermittedNetworkOptions_Type_Vpc),
+		PermittedNetwork: &dnssvcsv1.PermittedNetworkVpc{VpcCrn: ptr.To(vpcCRN)},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	byCRN, err := client.GetPermittedNetworkByVPCCRN(instanceID, *zone.ID, vpcCRN)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*byCRN.ID).To(Equal(*network.ID))
+
+	byName, err := client.GetDnszoneByName(instanceID, "capi.example.com")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*byName.State).To(Equal(dnssvcsv1.Dnszone_State_Active))
+
+	record, _, err := client.CreateResourceRecord(&dnssvcsv1.CreateResourceRecordOptions{
+		InstanceID: ptr.To(instanceID),
+		DnszoneID:  zone.ID,
+		Type:       ptr.To(dnssvcsv1.CreateResourceRecordOptions_Type_Cname),
+		Name:       ptr.To("api"),
+		Rdata:      &dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: ptr.To("lb.example.com")},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*record.Name).To(Equal("api.capi.example.com"))
+
+	_, _, err = client.UpdateResourceRecord(&dnssvcsv1.UpdateResourceRecordOptions{
+		InstanceID: ptr.To(instanceID),
+		DnszoneID:  zone.ID,
+		RecordID:   record.ID,
+		Name:       ptr.To("api.capi.example.com"),
+		Rdata:      &dnssvcsv1.ResourceRecordUpdateInputRdataRdataCnameRecord{Cname: ptr.To("lb2.example.com")},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	recordByName, err := client.GetResourceRecordByName(instanceID, *zone.ID, "api.capi.example.com")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(recordByName.Rdata["cname"]).To(Equal("lb2.example.com"))
+
+	_, err = client.DeleteDnszone(&dnssvcsv1.DeleteDnszoneOptions{InstanceID: ptr.To(instanceID), DnszoneID: zone.ID})
+	g.Expect(err).To(HaveOccurred(), "a zone with permitted networks cannot be deleted")
+
+	removing, _, err := client.DeletePermittedNetwork(&dnssvcsv1.DeletePermittedNetworkOptions{
+		InstanceID:         ptr.To(instanceID),
+		DnszoneID:          zone.ID,
+		PermittedNetworkID: network.ID,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*removing.State).To(Equal(dnssvcsv1.PermittedNetwork_State_RemovalInProgress))
+	_, resp, err := client.GetPermittedNetwork(&dnssvcsv1.GetPermittedNetworkOptions{
+		InstanceID:         ptr.To(instanceID),
+		DnszoneID:          zone.ID,
+		PermittedNetworkID: network.ID,
+	})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	_, err = client.DeleteDnszone(&dnssvcsv1.DeleteDnszoneOptions{InstanceID: ptr.To(instanceID), DnszoneID: zone.ID})
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestCIS(t *testing.T) {
+	g := NewWithT(t)
+	cloud := New(Options{})
+	crn := "crn:v1:bluemix:public:internet-svcs:global:a/fakeaccount:cis-instance::"
+	zoneID := cloud.AddCISZone(crn, "example.com")
+	client, err := cloud.CIS(crn)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	zone, err := client.GetZoneByName("example.com")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*zone.ID).To(Equal(zoneID))
+	missing, err := client.GetZoneByName("example.org")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(missing).To(BeNil())
+
+	record, _, err := client.CreateDNSRecord(zoneID, &dnsrecordsv1.CreateDnsRecordOptions{
+		Name:    ptr.To("api.capi"),
+		Type:    ptr.To(dnsrecordsv1.CreateDnsRecordOptions_Type_Cname),
+		Content: ptr.To("lb.example.com"),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*record.Result.Name).To(Equal("api.capi.example.com"))
+
+	_, _, err = client.CreateDNSRecord(zoneID, &dnsrecordsv1.CreateDnsRecordOptions{
+		Name:    ptr.To("api.capi.example.com"),
+		Type:    ptr.To(dnsrecordsv1.CreateDnsRecordOptions_Type_A),
+		Content: ptr.To("10.0.0.1"),
+	})
+	g.Expect(err).To(HaveOccurred(), "a CNAME record cannot coexist with another record of the same name")
+
+	_, _, err = client.UpdateDNSRecord(zoneID, &dnsrecordsv1.UpdateDnsRecordOptions{
+		DnsrecordIdentifier: record.Result.ID,
+		Content:             ptr.To("lb2.example.com"),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	byName, err := client.GetDNSRecordByName(zoneID, "api.capi.example.com")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*byName.Content).To(Equal("lb2.example.com"))
+
+	_, _, err = client.DeleteDNSRecord(zoneID, &dnsrecordsv1.DeleteDnsRecordOptions{DnsrecordIdentifier: record.Result.ID})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, resp, err := client.GetDNSRecord(zoneID, &dnsrecordsv1.GetDnsRecordOptions{DnsrecordIdentifier: record.Result.ID})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+}
+
+func TestResourceControllerAndCOS(t *testing.T) {
+	g := NewWithT(t)
+	cloud := New(Options{})
+	rc, err := cloud.ResourceController()
+	g.Expect(err).ToNot(HaveOccurred())
+	rm, err := cloud.ResourceManager()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	group, err := rm.GetResourceGroupByName(DefaultResourceGroupName)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*group.ID).To(Equal(cloud.DefaultResourceGroupID()))
+
+	instance, _, err := rc.CreateResourceInstance(&resourcecontrollerv2.CreateResourceInstanceOptions{
+		Name:           ptr.To("cos"),
+		Target:         ptr.To("Global"),
+		ResourceGroup:  group.ID,
+		ResourcePlanID: ptr.To(resourcecontroller.CosResourcePlanID),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*instance.State).To(Equal("provisioning"))
+
+	client, err := cloud.COS(*instance.GUID)
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = client.CreateBucket(&s3.CreateBucketInput{Bucket: ptr.To("bucket")})
+	g.Expect(err).To(HaveOccurred(), "buckets need an active COS instance")
+
+	byName, err := rc.GetInstanceByName("cos", resourcecontroller.CosResourceID, resourcecontroller.CosResourcePlanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*byName.State).To(Equal("active"))
+
+	_, err = client.CreateBucket(&s3.CreateBucketInput{Bucket: ptr.To("bucket")})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = client.GetBucketByName("bucket")
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = client.PutObject(&s3.PutObjectInput{
+		Bucket: ptr.To("bucket"),
+		Key:    ptr.To("images/image.ova.gz"),
+		Body:   bytes.NewReader([]byte("image")),
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	objects, err := client.ListObjects(&s3.ListObjectsInput{Bucket: ptr.To("bucket"), Prefix: ptr.To("images/")})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(objects.Contents).To(HaveLen(1))
+	g.Expect(aws.StringValue(objects.Contents[0].Key)).To(Equal("images/image.ova.gz"))
+	data, ok := cloud.Object("bucket", "images/image.ova.gz")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(string(data)).To(Equal("image"))
+
+	_, err = rc.DeleteResourceInstance(&resourcecontrollerv2.DeleteResourceInstanceOptions{ID: instance.ID})
+	g.Expect(err).ToNot(HaveOccurred())
+	deleted, _, err := rc.GetResourceInstance(&resourcecontrollerv2.GetResourceInstanceOptions{ID: instance.ID})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*deleted.State).To(Equal("removed"))
+	_, err = client.GetBucketByName("bucket")
+	g.Expect(err).To(HaveOccurred(), "the buckets are deleted with their COS instance")
+}
+
+func TestServer(t *testing.T) {
+	g := NewWithT(t)
+	cloud := New(Options{})
+	server := cloud.NewServer()
+	defer server.Close()
+
+	g.Expect(cloud.ServiceEndpoints(server.URL)).To(HavePrefix(DefaultRegion + ":vpc=" + server.URL + vpcPrefix))
+
+	env := cloud.Environment(server.URL)
+	authenticator, err := core.NewIamAuthenticatorBuilder().
+		SetApiKey(env["IBMCLOUD_APIKEY"]).
+		SetURL(env["IBMCLOUD_AUTH_URL"]).
+		Build()
+	g.Expect(err).ToNot(HaveOccurred())
+	service, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{URL: server.URL + vpcPrefix, Authenticator: authenticator})
+	g.Expect(err).ToNot(HaveOccurred())
+	_, _, err = service.CreateVPC(&vpcv1.CreateVPCOptions{Name: ptr.To("vpc")})
+	g.Expect(err).ToNot(HaveOccurred())
+	vpcs, _, err := service.ListVpcs(&vpcv1.ListVpcsOptions{})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(vpcs.Vpcs).To(HaveLen(1))
+
+	// The services of the manager get the account from the token of the authenticator configured by the environment.
+	for name, value := range env {
+		t.Setenv(name, value)
+	}
+	workspace := cloud.AddPowerVSWorkspace("workspace", DefaultPowerVSZone)
+	powerVS, err := powervs.NewService(powervs.ServiceOptions{
+		IBMPIOptions: &ibmpisession.IBMPIOptions{URL: server.URL, Zone: DefaultPowerVSZone},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	powerVS.WithClients(powervs.ServiceOptions{CloudInstanceID: workspace})
+	instances, err := powerVS.GetAllInstance()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(instances.PvmInstances).To(BeEmpty())
+}
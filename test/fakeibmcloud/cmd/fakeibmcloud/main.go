@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// main serves an in-memory IBM Cloud to run the manager against, e.g.
+//
+//	$ go run ./test/fakeibmcloud/cmd/fakeibmcloud --vpc-image capibm-vpc-image --ssh-key capibm-key
+//
+// prints the --service-endpoint flag and the environment variables to start the manager with.
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/test/fakeibmcloud"
+)
+
+func main() {
+	var (
+		listenAddress     string
+		options           fakeibmcloud.Options
+		vpcImages         []string
+		sshKeys           []string
+		powerVSWorkspaces []string
+		powerVSZone       string
+	)
+	pflag.StringVar(&listenAddress, "listen-address", "127.0.0.1:8080", "The address to serve the cloud on.")
+	pflag.StringVar(&options.Region, "region", fakeibmcloud.DefaultRegion, "The VPC region of the cloud.")
+	pflag.StringVar(&options.AccountID, "account-id", fakeibmcloud.DefaultAccountID, "The ID of the account owning the resources.")
+	pflag.IntVar(&options.Reads, "reads", 1, "The number of reads before an asynchronous operation completes, negative to complete them synchronously.")
+	pflag.StringSliceVar(&vpcImages, "vpc-image", nil, "The names of the VPC images to create.")
+	pflag.StringSliceVar(&sshKeys, "ssh-key", nil, "The names of the VPC SSH keys to create.")
+	pflag.StringSliceVar(&powerVSWorkspaces, "powervs-workspace", nil, "The names of the Power VS workspaces to create.")
+	pflag.StringVar(&powerVSZone, "powervs-zone", fakeibmcloud.DefaultPowerVSZone, "The zone of the Power VS workspaces.")
+	pflag.Parse()
+
+	cloud := fakeibmcloud.New(options)
+	for _, name := range vpcImages {
+		cloud.AddVPCImage(name)
+	}
+	for _, name := range sshKeys {
+		cloud.AddSSHKey(name)
+	}
+	for _, name := range powerVSWorkspaces {
+		fmt.Printf("Power VS workspace %s: %s\n", name, cloud.AddPowerVSWorkspace(name, powerVSZone))
+	}
+
+	listener, err := net.Listen("tcp", listenAddress)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to listen on %s: %v\n", listenAddress, err)
+		os.Exit(1)
+	}
+	serverURL := "http://" + listener.Addr().String()
+
+	fmt.Printf("--service-endpoint=%s\n", cloud.ServiceEndpoints(serverURL))
+	environment := cloud.Environment(serverURL)
+	names := make([]string, 0, len(environment))
+	for name := range environment {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Printf("export %s=%s\n", name, environment[name])
+	}
+
+	server := &http.Server{Handler: cloud, ReadHeaderTimeout: 10 * time.Second}
+	if err := server.Serve(listener); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to serve: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeibmcloud
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+)
+
+const cosPrefix = "/cos"
+
+// bucket is a COS bucket with its objects.
+type bucket struct {
+	serviceInstanceID string
+	created           time.Time
+	publicAccessBlock bool
+	objects           map[string]object
+}
+
+// object is a COS object.
+type object struct {
+	data        []byte
+	contentType string
+	modified    time.Time
+}
+
+type cosError struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	Resource  string   `xml:"Resource"`
+	RequestID string   `xml:"RequestId"`
+}
+
+type listBucketResult struct {
+	XMLName     xml.Name     `xml:"ListBucketResult"`
+	Name        string       `xml:"Name"`
+	Prefix      string       `xml:"Prefix"`
+	Marker      string       `xml:"Marker"`
+	MaxKeys     int          `xml:"MaxKeys"`
+	IsTruncated bool         `xml:"IsTruncated"`
+	Contents    []objectInfo `xml:"Contents"`
+}
+
+type objectInfo struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int    `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+// cosHandlerFunc serves a COS request, writing the response unless it returns an error.
+type cosHandlerFunc func(w http.ResponseWriter, r *http.Request) *cosError
+
+func (c *Cloud) registerCOS() {
+	routes := map[string]cosHandlerFunc{
+		"HEAD /{bucket}":            c.headBucket,
+		"PUT /{bucket}":             c.putBucket,
+		"GET /{bucket}":             c.listObjects,
+		"PUT /{bucket}/{key...}":    c.putObject,
+		"GET /{bucket}/{key...}":    c.getObject,
+		"DELETE /{bucket}/{key...}": c.deleteObject,
+	}
+	for pattern, fn := range routes {
+		method, path, _ := strings.Cut(pattern, " ")
+		c.mux.HandleFunc(method+" "+cosPrefix+path, func(w http.ResponseWriter, r *http.Request) {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			if err := fn(w, r); err != nil {
+				writeCOSError(w, r, err)
+			}
+		})
+	}
+}
+
+func writeCOSError(w http.ResponseWriter, r *http.Request, err *cosError) {
+	err.Resource = r.URL.Path
+	err.RequestID = uuid.NewString()
+	status := http.StatusBadRequest
+	switch err.Code {
+	case "NoSuchBucket", "NoSuchKey":
+		status = http.StatusNotFound
+	case "BucketAlreadyExists":
+		status = http.StatusConflict
+	case "AccessDenied":
+		status = http.StatusForbidden
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	if r.Method != http.MethodHead {
+		_ = xml.NewEncoder(w).Encode(err)
+	}
+}
+
+func noSuchBucket(name string) *cosError {
+	return &cosError{Code: "NoSuchBucket", Message: "The specified bucket does not exist: " + name}
+}
+
+func (c *Cloud) headBucket(w http.ResponseWriter, r *http.Request) *cosError {
+	if _, ok := c.buckets[r.PathValue("bucket")]; !ok {
+		return noSuchBucket(r.PathValue("bucket"))
+	}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (c *Cloud) putBucket(w http.ResponseWriter, r *http.Request) *cosError {
+	name := r.PathValue("bucket")
+	if _, ok := r.URL.Query()["publicAccessBlock"]; ok {
+		b, ok := c.buckets[name]
+		if !ok {
+			return noSuchBucket(name)
+		}
+		b.publicAccessBlock = true
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+	// Like the real service, the bucket is created in the COS instance the request is authorized for.
+	serviceInstanceID := r.Header.Get("ibm-service-instance-id")
+	instance, ok := c.store.peek(kindResourceInstance, serviceInstanceID)
+	if !ok || str(instance, "resource_id") != resourcecontroller.CosResourceID || str(instance, "state") != "active" {
+		return &cosError{Code: "AccessDenied", Message: "Access Denied, no active COS instance " + serviceInstanceID}
+	}
+	if _, ok := c.buckets[name]; ok {
+		return &cosError{Code: "BucketAlreadyExists", Message: "The requested bucket name is not available: " + name}
+	}
+	c.buckets[name] = &bucket{serviceInstanceID: serviceInstanceID, created: time.Now(), objects: map[string]object{}}
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (c *Cloud) listObjects(w http.ResponseWriter, r *http.Request) *cosError {
+	name := r.PathValue("bucket")
+	b, ok := c.buckets[name]
+	if !ok {
+		return noSuchBucket(name)
+	}
+	prefix := r.URL.Query().Get("prefix")
+	result := listBucketResult{Name: name, Prefix: prefix, MaxKeys: 1000}
+	for _, key := range sortedKeys(b.objects) {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		obj := b.objects[key]
+		result.Contents = append(result.Contents, objectInfo{
+			Key:          key,
+			LastModified: obj.modified.UTC().Format(time.RFC3339),
+			ETag:         strconv.Quote(strconv.Itoa(len(obj.data))),
+			Size:         len(obj.data),
+			StorageClass: "STANDARD",
+		})
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_ = xml.NewEncoder(w).Encode(result)
+	return nil
+}
+
+func (c *Cloud) putObject(w http.ResponseWriter, r *http.Request) *cosError {
+	b, ok := c.buckets[r.PathValue("bucket")]
+	if !ok {
+		return noSuchBucket(r.PathValue("bucket"))
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return &cosError{Code: "IncompleteBody", Message: err.Error()}
+	}
+	b.objects[r.PathValue("key")] = object{data: data, contentType: r.Header.Get("Content-Type"), modified: time.Now()}
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(len(data))))
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (c *Cloud) getObject(w http.ResponseWriter, r *http.Request) *cosError {
+	b, ok := c.buckets[r.PathValue("bucket")]
+	if !ok {
+		return noSuchBucket(r.PathValue("bucket"))
+	}
+	obj, ok := b.objects[r.PathValue("key")]
+	if !ok {
+		return &cosError{Code: "NoSuchKey", Message: "The specified key does not exist."}
+	}
+	if obj.contentType != "" {
+		w.Header().Set("Content-Type", obj.contentType)
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(obj.data)))
+	w.Header().Set("Last-Modified", obj.modified.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(obj.data)
+	return nil
+}
+
+func (c *Cloud) deleteObject(w http.ResponseWriter, r *http.Request) *cosError {
+	b, ok := c.buckets[r.PathValue("bucket")]
+	if !ok {
+		return noSuchBucket(r.PathValue("bucket"))
+	}
+	delete(b.objects, r.PathValue("key"))
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// Object returns the content of a COS object, for the tests to check what the controllers uploaded.
+func (c *Cloud) Object(bucketName, key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	b, ok := c.buckets[bucketName]
+	if !ok {
+		return nil, false
+	}
+	obj, ok := b.objects[key]
+	return obj.data, ok
+}
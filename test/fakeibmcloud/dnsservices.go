@@ -0,0 +1,322 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeibmcloud
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	dnsPrefix = "/dnssvcs/v1"
+
+	kindDNSZone          = "dnszones"
+	kindPermittedNetwork = "permitted_networks"
+	kindResourceRecord   = "resource_records"
+)
+
+// The zones of any DNS Services instance are served, the instance itself isn't checked.
+// Zones are keyed by <instance ID>/<zone ID>, their permitted networks and records by <instance ID>/<zone ID>/<ID>.
+
+func (c *Cloud) registerDNSServices() {
+	zones := dnsPrefix + "/instances/{instance}/dnszones"
+	c.handle("POST "+zones, writePlatformError, c.createDNSZone)
+	c.handle("GET "+zones, writePlatformError, c.listDNSZones)
+	c.handle("GET "+zones+"/{zone}", writePlatformError, c.getDNSZone)
+	c.handle("DELETE "+zones+"/{zone}", writePlatformError, c.deleteDNSZone)
+	c.handle("POST "+zones+"/{zone}/permitted_networks", writePlatformError, c.createPermittedNetwork)
+	c.handle("GET "+zones+"/{zone}/permitted_networks", writePlatformError, c.listPermittedNetworks)
+	c.handle("GET "+zones+"/{zone}/permitted_networks/{id}", writePlatformError, c.getPermittedNetwork)
+	c.handle("DELETE "+zones+"/{zone}/permitted_networks/{id}", writePlatformError, c.deletePermittedNetwork)
+	c.handle("POST "+zones+"/{zone}/resource_records", writePlatformError, c.createResourceRecord)
+	c.handle("GET "+zones+"/{zone}/resource_records", writePlatformError, c.listResourceRecords)
+	c.handle("GET "+zones+"/{zone}/resource_records/{id}", writePlatformError, c.getResourceRecord)
+	c.handle("PUT "+zones+"/{zone}/resource_records/{id}", writePlatformError, c.updateResourceRecord)
+	c.handle("DELETE "+zones+"/{zone}/resource_records/{id}", writePlatformError, c.deleteResourceRecord)
+}
+
+// dnsPage returns a DNS Services list response holding all the resources, the cloud never pages.
+func dnsPage(field string, resources []resource) resource {
+	return resource{
+		field:         resources,
+		"offset":      0,
+		"limit":       len(resources),
+		"count":       len(resources),
+		"total_count": len(resources),
+		"first":       resource{"href": ""},
+		"last":        resource{"href": ""},
+	}
+}
+
+// dnsZone returns the key and the zone of a request, without counting a read.
+func (c *Cloud) dnsZone(r *http.Request) (string, resource, *apiError) {
+	key := r.PathValue("instance") + "/" + r.PathValue("zone")
+	zone, ok := c.store.peek(kindDNSZone, key)
+	if !ok {
+		return "", nil, notFound("DNS zone", r.PathValue("zone"))
+	}
+	return key, zone, nil
+}
+
+// recordName returns the fully qualified name of a record, the API accepts names relative to the zone.
+func recordName(name, zone string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == zone || strings.HasSuffix(name, "."+zone) {
+		return name
+	}
+	return name + "." + zone
+}
+
+func (c *Cloud) createDNSZone(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	instanceID := r.PathValue("instance")
+	name := strings.ToLower(str(body, "name"))
+	if name == "" {
+		return 0, nil, badRequest("the name of the DNS zone is required")
+	}
+	for _, zone := range c.store.all(kindDNSZone, instanceID+"/") {
+		if str(zone, "name") == name {
+			return 0, nil, conflict("zone_already_exists", "the DNS zone %s already exists", name)
+		}
+	}
+	id := newID("")
+	zone := resource{
+		"id":          id,
+		"instance_id": instanceID,
+		"name":        name,
+		"description": str(body, "description"),
+		"label":       str(body, "label"),
+		"state":       "pending_network_add",
+		"created_on":  now(),
+		"modified_on": now(),
+	}
+	c.store.insert(kindDNSZone, instanceID+"/"+id, zone, nil)
+	return http.StatusOK, deepCopy(zone), nil
+}
+
+func (c *Cloud) listDNSZones(r *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, dnsPage("dnszones", c.store.list(kindDNSZone, r.PathValue("instance")+"/")), nil
+}
+
+func (c *Cloud) getDNSZone(r *http.Request) (int, interface{}, *apiError) {
+	zone, ok := c.store.get(kindDNSZone, r.PathValue("instance")+"/"+r.PathValue("zone"))
+	if !ok {
+		return 0, nil, notFound("DNS zone", r.PathValue("zone"))
+	}
+	return http.StatusOK, zone, nil
+}
+
+func (c *Cloud) deleteDNSZone(r *http.Request) (int, interface{}, *apiError) {
+	key, zone, err := c.dnsZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(c.store.keys(kindPermittedNetwork, key+"/")) > 0 {
+		return 0, nil, conflict("zone_has_permitted_networks", "the DNS zone %s still has permitted networks", str(zone, "name"))
+	}
+	c.store.remove(kindDNSZone, key, resource{"state": "pending_delete"})
+	for _, recordKey := range c.store.keys(kindResourceRecord, key+"/") {
+		c.store.drop(kindResourceRecord, recordKey)
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) createPermittedNetwork(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	key, zone, err := c.dnsZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	vpcCRN := str(body, "permitted_network", "vpc_crn")
+	if str(body, "type") != "vpc" || vpcCRN == "" {
+		return 0, nil, badRequest("a permitted network needs the vpc type and a VPC CRN")
+	}
+	for _, network := range c.store.all(kindPermittedNetwork, key+"/") {
+		if str(network, "permitted_network", "vpc_crn") == vpcCRN {
+			return 0, nil, conflict("network_already_permitted", "the VPC %s is already permitted in the DNS zone %s", vpcCRN, str(zone, "name"))
+		}
+	}
+	id := newID("")
+	network := resource{
+		"id":                id,
+		"type":              "vpc",
+		"permitted_network": resource{"vpc_crn": vpcCRN},
+		"state":             "ACTIVE",
+		"created_on":        now(),
+		"modified_on":       now(),
+	}
+	c.store.insert(kindPermittedNetwork, key+"/"+id, network, nil)
+	zone["state"] = "active"
+	return http.StatusOK, deepCopy(network), nil
+}
+
+func (c *Cloud) listPermittedNetworks(r *http.Request) (int, interface{}, *apiError) {
+	key, _, err := c.dnsZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, dnsPage("permitted_networks", c.store.list(kindPermittedNetwork, key+"/")), nil
+}
+
+func (c *Cloud) getPermittedNetwork(r *http.Request) (int, interface{}, *apiError) {
+	key, _, err := c.dnsZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	network, ok := c.store.get(kindPermittedNetwork, key+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, notFound("permitted network", r.PathValue("id"))
+	}
+	return http.StatusOK, network, nil
+}
+
+func (c *Cloud) deletePermittedNetwork(r *http.Request) (int, interface{}, *apiError) {
+	key, zone, err := c.dnsZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	network, ok := c.store.peek(kindPermittedNetwork, key+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, notFound("permitted network", r.PathValue("id"))
+	}
+	c.store.merge(kindPermittedNetwork, key+"/"+r.PathValue("id"), resource{"state": "REMOVAL_IN_PROGRESS"})
+	c.store.schedule(kindPermittedNetwork, key+"/"+r.PathValue("id"), &transition{
+		remove: true,
+		done: func() {
+			if len(c.store.keys(kindPermittedNetwork, key+"/")) == 0 {
+				zone["state"] = "pending_network_add"
+			}
+		},
+	})
+	return http.StatusAccepted, deepCopy(network), nil
+}
+
+func (c *Cloud) createResourceRecord(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	key, zone, err := c.dnsZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if str(body, "name") == "" || str(body, "type") == "" {
+		return 0, nil, badRequest("the name and the type of the resource record are required")
+	}
+	rdata, _ := lookup(body, "rdata").(map[string]interface{})
+	if len(rdata) == 0 {
+		return 0, nil, badRequest("the rdata of the resource record is required")
+	}
+	name := recordName(str(body, "name"), str(zone, "name"))
+	recordType := strings.ToUpper(str(body, "type"))
+	for _, record := range c.store.all(kindResourceRecord, key+"/") {
+		// Like the real API, a CNAME record can't coexist with another record of the same name.
+		if str(record, "name") == name && (recordType == "CNAME" || str(record, "type") == "CNAME") {
+			return 0, nil, conflict("record_already_exists", "a resource record named %s already exists", name)
+		}
+	}
+	ttl := num(body, "ttl")
+	if ttl == 0 {
+		ttl = 900
+	}
+	id := newID("")
+	record := resource{
+		"id":          id,
+		"name":        name,
+		"type":        recordType,
+		"ttl":         ttl,
+		"rdata":       rdata,
+		"created_on":  now(),
+		"modified_on": now(),
+	}
+	c.store.insert(kindResourceRecord, key+"/"+id, record, nil)
+	return http.StatusOK, deepCopy(record), nil
+}
+
+func (c *Cloud) listResourceRecords(r *http.Request) (int, interface{}, *apiError) {
+	key, zone, err := c.dnsZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	records := c.store.list(kindResourceRecord, key+"/")
+	if name := r.URL.Query().Get("name"); name != "" {
+		matching := []resource{}
+		for _, record := range records {
+			if str(record, "name") == recordName(name, str(zone, "name")) {
+				matching = append(matching, record)
+			}
+		}
+		records = matching
+	}
+	return http.StatusOK, dnsPage("resource_records", records), nil
+}
+
+func (c *Cloud) getResourceRecord(r *http.Request) (int, interface{}, *apiError) {
+	key, _, err := c.dnsZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	record, ok := c.store.get(kindResourceRecord, key+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, notFound("resource record", r.PathValue("id"))
+	}
+	return http.StatusOK, record, nil
+}
+
+func (c *Cloud) updateResourceRecord(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	key, zone, err := c.dnsZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	record, ok := c.store.peek(kindResourceRecord, key+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, notFound("resource record", r.PathValue("id"))
+	}
+	if name := str(body, "name"); name != "" {
+		record["name"] = recordName(name, str(zone, "name"))
+	}
+	if rdata, ok := lookup(body, "rdata").(map[string]interface{}); ok && len(rdata) > 0 {
+		record["rdata"] = rdata
+	}
+	if ttl := num(body, "ttl"); ttl != 0 {
+		record["ttl"] = ttl
+	}
+	record["modified_on"] = now()
+	return http.StatusOK, deepCopy(record), nil
+}
+
+func (c *Cloud) deleteResourceRecord(r *http.Request) (int, interface{}, *apiError) {
+	key, _, err := c.dnsZone(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if _, ok := c.store.peek(kindResourceRecord, key+"/"+r.PathValue("id")); !ok {
+		return 0, nil, notFound("resource record", r.PathValue("id"))
+	}
+	c.store.drop(kindResourceRecord, key+"/"+r.PathValue("id"))
+	return http.StatusNoContent, nil, nil
+}
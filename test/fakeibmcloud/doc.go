@@ -0,0 +1,28 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fakeibmcloud implements a stateful, in-memory IBM Cloud backend for testing.
+//
+// A Cloud serves the subset of the VPC, Power VS, Transit Gateway, Resource Controller, Resource Manager,
+// Global Tagging, Cloud Object Storage, DNS Services, Cloud Internet Services and IAM APIs used by the provider.
+// The asynchronous operations of the real APIs are emulated: a created resource starts in a transitional state,
+// e.g. pending, and reaches its final state, e.g. available, after it has been read a configurable number of times.
+//
+// The Cloud can be used in-process through the clients returned by its VPC, PowerVS, TransitGateway,
+// ResourceController, ResourceManager, GlobalTagging, COS, DNSServices and CIS methods, which implement the
+// interfaces of the pkg/cloud/services packages, or served over HTTP with NewServer, or the cmd/fakeibmcloud
+// command, to run the manager end-to-end with the --service-endpoint flag.
+package fakeibmcloud
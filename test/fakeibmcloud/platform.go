@@ -0,0 +1,512 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeibmcloud
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+)
+
+const (
+	tgPrefix            = "/transitgateway/v1"
+	rcPrefix            = "/rc"
+	rmPrefix            = "/rm"
+	globalTaggingPrefix = "/globaltagging"
+	iamPrefix           = "/iam"
+
+	kindTransitGateway    = "transit_gateways"
+	kindConnection        = "transit_gateway_connections"
+	kindResourceInstance  = "resource_instances"
+	kindResourceKey       = "resource_keys"
+	kindResourceGroup     = "resource_groups"
+	kindTag               = "tags"
+	tokenLifetime         = time.Hour
+	tokenSigningKey       = "fakeibmcloud"
+	resourceControllerURL = "https://resource-controller.cloud.ibm.com"
+)
+
+// resourceIDs are the resource IDs of the service plans, the resource ID of an instance derives from its plan.
+var resourceIDs = map[string]string{
+	resourcecontroller.PowerVSResourcePlanID: resourcecontroller.PowerVSResourceID,
+	resourcecontroller.CosResourcePlanID:     resourcecontroller.CosResourceID,
+}
+
+func (c *Cloud) registerTransitGateway() {
+	c.handle("POST "+tgPrefix+"/transit_gateways", writePlatformError, c.createTransitGateway)
+	c.handle("GET "+tgPrefix+"/transit_gateways", writePlatformError, c.listTransitGateways)
+	c.handle("GET "+tgPrefix+"/transit_gateways/{id}", writePlatformError, c.getTransitGateway)
+	c.handle("DELETE "+tgPrefix+"/transit_gateways/{id}", writePlatformError, c.deleteTransitGateway)
+	c.handle("POST "+tgPrefix+"/transit_gateways/{id}/connections", writePlatformError, c.createConnection)
+	c.handle("GET "+tgPrefix+"/transit_gateways/{id}/connections", writePlatformError, c.listConnections)
+	c.handle("GET "+tgPrefix+"/transit_gateways/{id}/connections/{connection}", writePlatformError, c.getConnection)
+	c.handle("DELETE "+tgPrefix+"/transit_gateways/{id}/connections/{connection}", writePlatformError, c.deleteConnection)
+}
+
+func (c *Cloud) registerResourceController() {
+	c.handle("POST "+rcPrefix+"/v2/resource_instances", writePlatformError, c.createResourceInstance)
+	c.handle("GET "+rcPrefix+"/v2/resource_instances", writePlatformError, c.listResourceInstances)
+	c.handle("GET "+rcPrefix+"/v2/resource_instances/{id}", writePlatformError, c.getResourceInstance)
+	c.handle("DELETE "+rcPrefix+"/v2/resource_instances/{id}", writePlatformError, c.deleteResourceInstance)
+	c.handle("POST "+rcPrefix+"/v2/resource_keys", writePlatformError, c.createResourceKey)
+}
+
+func (c *Cloud) registerResourceManager() {
+	c.handle("GET "+rmPrefix+"/v2/resource_groups", writePlatformError, c.listResourceGroups)
+	c.handle("GET "+rmPrefix+"/v2/resource_groups/{id}", writePlatformError, c.getResourceGroup)
+}
+
+func (c *Cloud) registerGlobalTagging() {
+	c.handle("GET "+globalTaggingPrefix+"/v3/tags", writePlatformError, c.listTags)
+	c.handle("POST "+globalTaggingPrefix+"/v3/tags", writePlatformError, c.createTags)
+	c.handle("POST "+globalTaggingPrefix+"/v3/tags/attach", writePlatformError, c.attachTags)
+}
+
+func (c *Cloud) registerIAM() {
+	c.mux.HandleFunc("POST "+iamPrefix+"/identity/token", c.createToken)
+}
+
+// AddResourceGroup adds a resource group to the account and returns its ID.
+func (c *Cloud) AddResourceGroup(name string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := newID("")[:32]
+	c.store.insert(kindResourceGroup, id, resource{
+		"id":         id,
+		"crn":        fmt.Sprintf("crn:v1:bluemix:public:resource-controller::a/%s::resource-group:%s", c.accountID, id),
+		"account_id": c.accountID,
+		"name":       name,
+		"state":      "ACTIVE",
+		"default":    name == DefaultResourceGroupName,
+		"created_at": now(),
+	}, nil)
+	return id
+}
+
+// Tags returns the user tags attached to a resource, by CRN.
+func (c *Cloud) Tags(crn string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.tags[crn]...)
+}
+
+// resourceGroupReference returns the reference to a resource group embedded in VPC resources, the default one when id is empty.
+func (c *Cloud) resourceGroupReference(id string) resource {
+	if id == "" {
+		id = c.defaultResourceGroupID
+	}
+	ref := resource{"id": id, "href": resourceControllerURL + "/v2/resource_groups/" + id}
+	if group, ok := c.store.peek(kindResourceGroup, id); ok {
+		ref["name"] = group["name"]
+	}
+	return ref
+}
+
+func (c *Cloud) createTransitGateway(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	name := str(body, "name")
+	if name == "" || str(body, "location") == "" {
+		return 0, nil, badRequest("the name and the location of the transit gateway are required")
+	}
+	for _, gateway := range c.store.all(kindTransitGateway, "") {
+		if str(gateway, "name") == name {
+			return 0, nil, conflict("name_already_in_use", "the transit gateway name %s is already in use", name)
+		}
+	}
+	global, _ := lookup(body, "global").(bool)
+	id := newID("")
+	groupID := str(body, "resource_group", "id")
+	if groupID == "" {
+		groupID = c.defaultResourceGroupID
+	}
+	gateway := resource{
+		"id":             id,
+		"crn":            fmt.Sprintf("crn:v1:bluemix:public:transit:%s:a/%s::gateway:%s", str(body, "location"), c.accountID, id),
+		"name":           name,
+		"location":       str(body, "location"),
+		"global":         global,
+		"status":         "pending",
+		"resource_group": resource{"id": groupID, "href": resourceControllerURL + "/v2/resource_groups/" + groupID},
+		"created_at":     now(),
+	}
+	c.store.insert(kindTransitGateway, id, gateway, resource{"status": "available"})
+	return http.StatusCreated, deepCopy(gateway), nil
+}
+
+func (c *Cloud) listTransitGateways(_ *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, resource{"transit_gateways": c.store.list(kindTransitGateway, ""), "limit": 50}, nil
+}
+
+func (c *Cloud) getTransitGateway(r *http.Request) (int, interface{}, *apiError) {
+	gateway, ok := c.store.get(kindTransitGateway, r.PathValue("id"))
+	if !ok {
+		return 0, nil, notFound("transit gateway", r.PathValue("id"))
+	}
+	return http.StatusOK, gateway, nil
+}
+
+func (c *Cloud) deleteTransitGateway(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	if _, ok := c.store.peek(kindTransitGateway, id); !ok {
+		return 0, nil, notFound("transit gateway", id)
+	}
+	if len(c.store.keys(kindConnection, id+"/")) > 0 {
+		return 0, nil, conflict("gateway_has_connections", "the transit gateway %s still has connections", id)
+	}
+	c.store.remove(kindTransitGateway, id, resource{"status": "deleting"})
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) createConnection(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	gatewayID := r.PathValue("id")
+	gateway, ok := c.store.peek(kindTransitGateway, gatewayID)
+	if !ok {
+		return 0, nil, notFound("transit gateway", gatewayID)
+	}
+	if str(gateway, "status") != "available" {
+		return 0, nil, conflict("gateway_not_available", "the transit gateway %s is %s", gatewayID, str(gateway, "status"))
+	}
+	networkID := str(body, "network_id")
+	for _, connection := range c.store.all(kindConnection, gatewayID+"/") {
+		if networkID != "" && str(connection, "network_id") == networkID {
+			return 0, nil, conflict("network_already_connected", "the network %s is already connected to the transit gateway %s", networkID, gatewayID)
+		}
+		if str(connection, "name") == str(body, "name") {
+			return 0, nil, conflict("name_already_in_use", "the connection name %s is already in use", str(body, "name"))
+		}
+	}
+	prefixFilters := []resource{}
+	for _, filter := range items(body, "prefix_filters") {
+		if action := str(filter, "action"); (action != "permit" && action != "deny") || str(filter, "prefix") == "" {
+			return 0, nil, badRequest("a prefix filter needs a permit or deny action and a prefix")
+		}
+		filter["id"] = newID("")
+		filter["created_at"] = now()
+		prefixFilters = append(prefixFilters, filter)
+	}
+	prefixFiltersDefault := str(body, "prefix_filters_default")
+	if prefixFiltersDefault == "" {
+		prefixFiltersDefault = "permit"
+	}
+	id := newID("")
+	connection := resource{
+		"id":                     id,
+		"name":                   str(body, "name"),
+		"network_type":           str(body, "network_type"),
+		"network_id":             networkID,
+		"prefix_filters":         prefixFilters,
+		"prefix_filters_default": prefixFiltersDefault,
+		"status":                 "pending",
+		"created_at":             now(),
+		"updated_at":             now(),
+	}
+	c.store.insert(kindConnection, gatewayID+"/"+id, connection, resource{"status": "attached"})
+	return http.StatusCreated, deepCopy(connection), nil
+}
+
+func (c *Cloud) listConnections(r *http.Request) (int, interface{}, *apiError) {
+	if _, ok := c.store.peek(kindTransitGateway, r.PathValue("id")); !ok {
+		return 0, nil, notFound("transit gateway", r.PathValue("id"))
+	}
+	return http.StatusOK, resource{"connections": c.store.list(kindConnection, r.PathValue("id")+"/"), "limit": 50}, nil
+}
+
+func (c *Cloud) getConnection(r *http.Request) (int, interface{}, *apiError) {
+	connection, ok := c.store.get(kindConnection, r.PathValue("id")+"/"+r.PathValue("connection"))
+	if !ok {
+		return 0, nil, notFound("transit gateway connection", r.PathValue("connection"))
+	}
+	return http.StatusOK, connection, nil
+}
+
+func (c *Cloud) deleteConnection(r *http.Request) (int, interface{}, *apiError) {
+	key := r.PathValue("id") + "/" + r.PathValue("connection")
+	if _, ok := c.store.peek(kindConnection, key); !ok {
+		return 0, nil, notFound("transit gateway connection", r.PathValue("connection"))
+	}
+	c.store.remove(kindConnection, key, resource{"status": "deleting"})
+	return http.StatusNoContent, nil, nil
+}
+
+// newResourceInstance returns a resource instance in the provisioning state.
+func (c *Cloud) newResourceInstance(body resource) resource {
+	guid := newID("")
+	planID := str(body, "resource_plan_id")
+	resourceID, ok := resourceIDs[planID]
+	if !ok {
+		resourceID = planID
+	}
+	serviceName := "power-iaas"
+	if resourceID == resourcecontroller.CosResourceID {
+		serviceName = "cloud-object-storage"
+	}
+	target := str(body, "target")
+	groupID := str(body, "resource_group")
+	if groupID == "" {
+		groupID = c.defaultResourceGroupID
+	}
+	crn := fmt.Sprintf("crn:v1:bluemix:public:%s:%s:a/%s:%s::", serviceName, target, c.accountID, guid)
+	return resource{
+		"id":                crn,
+		"guid":              guid,
+		"crn":               crn,
+		"url":               "/v2/resource_instances/" + guid,
+		"name":              str(body, "name"),
+		"region_id":         target,
+		"account_id":        c.accountID,
+		"resource_group_id": groupID,
+		"resource_id":       resourceID,
+		"resource_plan_id":  planID,
+		"target_crn":        fmt.Sprintf("crn:v1:bluemix:public:globalcatalog::::deployment:%s", planID),
+		"state":             "provisioning",
+		"type":              "service_instance",
+		"allow_cleanup":     false,
+		"locked":            false,
+		"last_operation":    resource{"type": "create", "state": "in progress", "async": true},
+		"created_at":        now(),
+		"updated_at":        now(),
+	}
+}
+
+func (c *Cloud) createResourceInstance(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if str(body, "name") == "" || str(body, "target") == "" || str(body, "resource_plan_id") == "" {
+		return 0, nil, badRequest("the name, the target and the resource plan of the instance are required")
+	}
+	if groupID := str(body, "resource_group"); groupID != "" {
+		if _, ok := c.store.peek(kindResourceGroup, groupID); !ok {
+			return 0, nil, badRequest("the resource group %s doesn't exist", groupID)
+		}
+	}
+	instance := c.newResourceInstance(body)
+	c.store.insert(kindResourceInstance, str(instance, "guid"), instance, resource{
+		"state":          "active",
+		"last_operation": resource{"type": "create", "state": "succeeded", "async": true},
+	})
+	return http.StatusCreated, deepCopy(instance), nil
+}
+
+func (c *Cloud) listResourceInstances(r *http.Request) (int, interface{}, *apiError) {
+	query := r.URL.Query()
+	instances := filter(c.store.list(kindResourceInstance, ""), query, "guid", "name", "resource_group_id", "resource_id", "resource_plan_id", "type", "state")
+	if query.Get("state") == "" {
+		// Like the real API, the removed instances are only listed when filtering on the state.
+		active := []resource{}
+		for _, instance := range instances {
+			if str(instance, "state") != "removed" {
+				active = append(active, instance)
+			}
+		}
+		instances = active
+	}
+	return http.StatusOK, resource{"rows_count": len(instances), "resources": instances}, nil
+}
+
+// resourceInstance returns the resource instance with a GUID or a CRN.
+func (c *Cloud) resourceInstance(id string) (string, bool) {
+	if _, ok := c.store.peek(kindResourceInstance, id); ok {
+		return id, true
+	}
+	for _, instance := range c.store.all(kindResourceInstance, "") {
+		if str(instance, "crn") == id {
+			return str(instance, "guid"), true
+		}
+	}
+	return "", false
+}
+
+func (c *Cloud) getResourceInstance(r *http.Request) (int, interface{}, *apiError) {
+	guid, ok := c.resourceInstance(r.PathValue("id"))
+	if !ok {
+		return 0, nil, notFound("resource instance", r.PathValue("id"))
+	}
+	instance, _ := c.store.get(kindResourceInstance, guid)
+	return http.StatusOK, instance, nil
+}
+
+func (c *Cloud) deleteResourceInstance(r *http.Request) (int, interface{}, *apiError) {
+	guid, ok := c.resourceInstance(r.PathValue("id"))
+	if !ok {
+		return 0, nil, notFound("resource instance", r.PathValue("id"))
+	}
+	instance, _ := c.store.peek(kindResourceInstance, guid)
+	if str(instance, "state") == "removed" {
+		return 0, nil, &apiError{status: http.StatusGone, code: "gone", message: fmt.Sprintf("the resource instance %s is already removed", guid)}
+	}
+	instance["last_operation"] = resource{"type": "delete", "state": "in progress", "async": true}
+	// The removed instances are kept, like the real API does until they are reclaimed.
+	c.store.schedule(kindResourceInstance, guid, &transition{
+		fields: resource{
+			"state":          "removed",
+			"last_operation": resource{"type": "delete", "state": "succeeded", "async": true},
+			"deleted_at":     now(),
+		},
+		done: func() {
+			for _, kind := range []string{kindPVMInstance, kindPowerImage, kindPowerJob, kindNetwork, kindDHCPServer} {
+				for _, key := range c.store.keys(kind, guid+"/") {
+					c.store.drop(kind, key)
+				}
+			}
+			for name, bucket := range c.buckets {
+				if bucket.serviceInstanceID == guid {
+					delete(c.buckets, name)
+				}
+			}
+		},
+	})
+	return http.StatusAccepted, nil, nil
+}
+
+func (c *Cloud) createResourceKey(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	guid, ok := c.resourceInstance(str(body, "source"))
+	if !ok {
+		return 0, nil, badRequest("the source resource instance %s doesn't exist", str(body, "source"))
+	}
+	instance, _ := c.store.peek(kindResourceInstance, guid)
+	id := newID("")
+	credentials := resource{"apikey": newID("")}
+	if hmac, _ := lookup(body, "parameters", "HMAC").(bool); hmac {
+		credentials["cos_hmac_keys"] = resource{"access_key_id": newID("")[:32], "secret_access_key": newID("")}
+	}
+	key := resource{
+		"id":          id,
+		"guid":        id,
+		"crn":         fmt.Sprintf("crn:v1:bluemix:public:resource-controller::a/%s::resource-key:%s", c.accountID, id),
+		"name":        str(body, "name"),
+		"account_id":  c.accountID,
+		"source_crn":  instance["crn"],
+		"state":       "active",
+		"credentials": credentials,
+		"created_at":  now(),
+	}
+	c.store.insert(kindResourceKey, id, key, nil)
+	return http.StatusCreated, deepCopy(key), nil
+}
+
+func (c *Cloud) listResourceGroups(r *http.Request) (int, interface{}, *apiError) {
+	groups := filter(c.store.list(kindResourceGroup, ""), r.URL.Query(), "account_id", "name")
+	return http.StatusOK, resource{"resources": groups}, nil
+}
+
+func (c *Cloud) getResourceGroup(r *http.Request) (int, interface{}, *apiError) {
+	group, ok := c.store.get(kindResourceGroup, r.PathValue("id"))
+	if !ok {
+		return 0, nil, notFound("resource group", r.PathValue("id"))
+	}
+	return http.StatusOK, group, nil
+}
+
+func (c *Cloud) listTags(_ *http.Request) (int, interface{}, *apiError) {
+	tags := c.store.list(kindTag, "")
+	return http.StatusOK, resource{"items": tags, "total_count": len(tags), "offset": 0, "limit": len(tags)}, nil
+}
+
+func (c *Cloud) createTags(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	results := []resource{}
+	for _, name := range stringList(lookup(body, "tag_names")) {
+		c.store.insert(kindTag, name, resource{"name": name}, nil)
+		results = append(results, resource{"tag_name": name, "is_error": false})
+	}
+	return http.StatusOK, resource{"results": results}, nil
+}
+
+func (c *Cloud) attachTags(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	names := stringList(lookup(body, "tag_names"))
+	if name := str(body, "tag_name"); name != "" {
+		names = append(names, name)
+	}
+	results := []resource{}
+	for _, target := range items(body, "resources") {
+		id := str(target, "resource_id")
+		for _, name := range names {
+			if _, ok := c.store.peek(kindTag, name); !ok {
+				c.store.insert(kindTag, name, resource{"name": name}, nil)
+			}
+			if !slices.Contains(c.tags[id], name) {
+				c.tags[id] = append(c.tags[id], name)
+			}
+		}
+		results = append(results, resource{"resource_id": id, "is_error": false})
+	}
+	return http.StatusOK, resource{"results": results}, nil
+}
+
+// createToken issues an IAM access token for the account of the cloud, whatever the API key.
+func (c *Cloud) createToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") == "" {
+		writePlatformError(w, badRequest("invalid token request"))
+		return
+	}
+	issuedAt := time.Now()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iam_id":  "IBMid-" + c.accountID,
+		"sub":     "fake@ibm.com",
+		"account": map[string]interface{}{"bss": c.accountID},
+		"iat":     issuedAt.Unix(),
+		"exp":     issuedAt.Add(tokenLifetime).Unix(),
+	}).SignedString([]byte(tokenSigningKey))
+	if err != nil {
+		writePlatformError(w, &apiError{status: http.StatusInternalServerError, code: "internal_error", message: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, resource{
+		"access_token":  token,
+		"refresh_token": "not_supported",
+		"token_type":    "Bearer",
+		"expires_in":    int64(tokenLifetime.Seconds()),
+		"expiration":    issuedAt.Add(tokenLifetime).Unix(),
+	})
+}
+
+// stringList returns the strings of a JSON list.
+func stringList(v interface{}) []string {
+	var out []string
+	list, _ := v.([]interface{})
+	for _, e := range list {
+		if s, ok := e.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
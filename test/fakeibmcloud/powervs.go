@@ -0,0 +1,1327 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeibmcloud
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
+)
+
+const (
+	powerVSPrefix = "/pcloud/v1/cloud-instances/{ci}"
+
+	kindPVMInstance = "pvm_instances"
+	kindPowerImage  = "power_images"
+	kindPowerJob    = "power_jobs"
+	kindNetwork     = "networks"
+	kindDHCPServer  = "dhcp_servers"
+	kindPowerVolume = "power_volumes"
+	kindDatacenter  = "datacenters"
+	kindSnapshot    = "power_snapshots"
+
+	kindPlacementGroup      = "placement_groups"
+	kindSharedProcessorPool = "shared_processor_pools"
+	kindPowerVSEvent        = "powervs_events"
+
+	// powerVSGatewayAddresses is the number of addresses at the start of a Power VS network before the allocated ones.
+	powerVSGatewayAddresses = 1
+)
+
+// sapProfiles are the SAP profiles available in the Power VS zones.
+var sapProfiles = []resource{
+	{"profileID": "ush1-4x128", "type": "ultra-memory", "cores": 4, "memory": 128, "certified": true, "smtMode": 8, "supportedSystems": []string{"e980", "e1080"}, "workloadTypes": []string{}},
+	{"profileID": "bh1-16x1600", "type": "balanced", "cores": 16, "memory": 1600, "certified": true, "smtMode": 8, "supportedSystems": []string{"e980", "e1080"}, "workloadTypes": []string{}},
+	{"profileID": "cnp-2x16", "type": "small", "cores": 2, "memory": 16, "certified": false, "smtMode": 8, "supportedSystems": []string{"s922", "s1022"}, "workloadTypes": []string{}},
+}
+
+// defaultSystemPools are the system types and their available cores and memory in the Power VS zones.
+var defaultSystemPools = map[string]resource{
+	"s922":  systemPool("s922", 15, 944),
+	"e980":  systemPool("e980", 40, 7680),
+	"s1022": systemPool("s1022", 20, 1024),
+}
+
+// defaultStorageTiers are the storage tiers of the Power VS zones.
+var defaultStorageTiers = []string{"tier0", "tier1", "tier3", "tier5k"}
+
+// defaultWorkspaceLimits are the limits of a Power VS workspace, unless set with SetPowerVSWorkspaceLimits.
+var defaultWorkspaceLimits = resource{
+	"instances":  100.0,
+	"memory":     4096.0,
+	"processors": 128.0,
+	"procUnits":  128.0,
+	"storage":    100000.0,
+}
+
+// defaultDatacenterCapabilities are the capabilities of the Power VS zones, unless set with SetDatacenterCapabilities.
+var defaultDatacenterCapabilities = map[string]bool{
+	"cloud-connections":          false,
+	"power-edge-router":          true,
+	"shared-processor-pool":      true,
+	"transit-gateway-connection": true,
+	"vpn-connections":            false,
+}
+
+func (c *Cloud) registerPowerVS() {
+	routes := map[string]handlerFunc{
+		"POST /pvm-instances":        c.createPVMInstance,
+		"GET /pvm-instances":         c.listPVMInstances,
+		"GET /pvm-instances/{id}":    c.getPVMInstance,
+		"PUT /pvm-instances/{id}":    c.updatePVMInstance,
+		"DELETE /pvm-instances/{id}": c.deletePVMInstance,
+		"GET /images":                c.listPowerImages,
+		"GET /images/{id}":           c.getPowerImage,
+		"DELETE /images/{id}":        c.deletePowerImage,
+		"POST /cos-images":           c.createCOSImage,
+		"GET /cos-images":            c.getCOSImage,
+		"GET /jobs/{id}":             c.getJob,
+		"DELETE /jobs/{id}":          c.deleteJob,
+		"GET /networks":              c.listNetworks,
+		"GET /networks/{id}":         c.getNetwork,
+		"POST /networks":             c.createNetwork,
+		"DELETE /networks/{id}":      c.deleteNetwork,
+		"POST /services/dhcp":        c.createDHCPServer,
+		"GET /services/dhcp":         c.listDHCPServers,
+		"GET /services/dhcp/{id}":    c.getDHCPServer,
+		"DELETE /services/dhcp/{id}": c.deleteDHCPServer,
+		"POST /volumes":              c.createPowerVolume,
+		"GET /volumes":               c.listPowerVolumes,
+		"GET /volumes/{id}":          c.getPowerVolume,
+		"DELETE /volumes/{id}":       c.deletePowerVolume,
+
+		"POST /placement-groups":        c.createPlacementGroup,
+		"GET /placement-groups":         c.listPlacementGroups,
+		"GET /placement-groups/{id}":    c.getPlacementGroup,
+		"DELETE /placement-groups/{id}": c.deletePlacementGroup,
+
+		"POST /shared-processor-pools":        c.createSharedProcessorPool,
+		"GET /shared-processor-pools":         c.listSharedProcessorPools,
+		"GET /shared-processor-pools/{id}":    c.getSharedProcessorPool,
+		"DELETE /shared-processor-pools/{id}": c.deleteSharedProcessorPool,
+
+		"POST /sap":     c.createSAPInstance,
+		"GET /sap":      c.listSAPProfiles,
+		"GET /sap/{id}": c.getSAPProfile,
+		"GET /events":   c.listPowerVSEvents,
+
+		"POST /pvm-instances/{id}/volumes/{volume}":   c.attachPowerVolume,
+		"DELETE /pvm-instances/{id}/volumes/{volume}": c.detachPowerVolume,
+
+		"POST /pvm-instances/{id}/snapshots":                    c.createSnapshot,
+		"POST /pvm-instances/{id}/snapshots/{snapshot}/restore": c.restoreSnapshot,
+		"GET /snapshots":         c.listSnapshots,
+		"GET /snapshots/{id}":    c.getSnapshot,
+		"DELETE /snapshots/{id}": c.deleteSnapshot,
+	}
+	for pattern, fn := range routes {
+		method, path, _ := strings.Cut(pattern, " ")
+		c.handle(method+" "+powerVSPrefix+path, writePowerVSError, c.inWorkspace(fn))
+	}
+	c.handle("GET "+powerVSPrefix, writePowerVSError, c.inWorkspace(c.getCloudInstance))
+	c.handle("GET "+powerVSPrefix+"/system-pools", writePowerVSError, c.inWorkspace(c.listSystemPools))
+	c.handle("GET "+powerVSPrefix+"/storage-tiers", writePowerVSError, c.inWorkspace(c.listStorageTiers))
+	c.handle("POST /pcloud/v2/cloud-instances/{ci}/pvm-instances/{id}/capture", writePowerVSError, c.inWorkspace(c.captureInstance))
+	c.handle("GET /v1/datacenters/{zone}", writePowerVSError, c.getDatacenter)
+}
+
+// writePowerVSError writes an error in the format of the Power VS API.
+func writePowerVSError(w http.ResponseWriter, err *apiError) {
+	writeJSON(w, err.status, resource{
+		"code":        err.status,
+		"error":       err.code,
+		"description": err.message,
+		"message":     err.message,
+	})
+}
+
+// powerVSNotFound returns the not found error of the Power VS API, e.g. "dhcp server does not exist".
+func powerVSNotFound(kind, id string) *apiError {
+	return &apiError{status: http.StatusNotFound, code: "not found", message: fmt.Sprintf("%s does not exist. ID: %s", kind, id)}
+}
+
+// inWorkspace fails the requests for a cloud instance which isn't an active Power VS workspace.
+func (c *Cloud) inWorkspace(fn handlerFunc) handlerFunc {
+	return func(r *http.Request) (int, interface{}, *apiError) {
+		id := r.PathValue("ci")
+		workspace, ok := c.store.peek(kindResourceInstance, id)
+		if !ok || str(workspace, "resource_id") != resourcecontroller.PowerVSResourceID || str(workspace, "state") != "active" {
+			return 0, nil, powerVSNotFound("cloud instance", id)
+		}
+		return fn(r)
+	}
+}
+
+// AddPowerVSWorkspace adds an active Power VS workspace in a zone and returns its GUID, the cloud instance ID.
+func (c *Cloud) AddPowerVSWorkspace(name, zone string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	instance := c.newResourceInstance(resource{
+		"name":             name,
+		"target":           zone,
+		"resource_plan_id": resourcecontroller.PowerVSResourcePlanID,
+	})
+	c.store.insert(kindResourceInstance, str(instance, "guid"), instance, nil)
+	merge(instance, resource{"state": "active"})
+	return str(instance, "guid")
+}
+
+// AddPowerVSImage adds an active image to a Power VS workspace and returns its ID.
+func (c *Cloud) AddPowerVSImage(cloudInstanceID, name string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return str(c.addPowerImage(cloudInstanceID, name), "imageID")
+}
+
+// AddPowerVSNetwork adds a network with static IP addresses to a Power VS workspace and returns its ID.
+func (c *Cloud) AddPowerVSNetwork(cloudInstanceID, name, cidr string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return str(c.addNetwork(cloudInstanceID, name, cidr, "vlan"), "networkID")
+}
+
+// SetDatacenterCapabilities sets the capabilities of a Power VS zone, e.g. power-edge-router.
+func (c *Cloud) SetDatacenterCapabilities(zone string, capabilities map[string]bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	caps := resource{}
+	for k, v := range capabilities {
+		caps[k] = v
+	}
+	c.store.insert(kindDatacenter, zone, resource{"capabilities": caps}, nil)
+}
+
+// SetPowerVSWorkspaceLimits sets the limits of a Power VS workspace, e.g. instances, memory or procUnits.
+func (c *Cloud) SetPowerVSWorkspaceLimits(cloudInstanceID string, limits map[string]float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	workspace, ok := c.store.peek(kindResourceInstance, cloudInstanceID)
+	if !ok {
+		return
+	}
+	workspaceLimits := resource{}
+	for k, v := range defaultWorkspaceLimits {
+		workspaceLimits[k] = v
+	}
+	for k, v := range limits {
+		workspaceLimits[k] = v
+	}
+	merge(workspace, resource{"limits": workspaceLimits})
+}
+
+func (c *Cloud) addPowerImage(cloudInstanceID, name string) resource {
+	id := newID("")
+	image := resource{
+		"imageID":        id,
+		"name":           name,
+		"href":           "/pcloud/v1/cloud-instances/" + cloudInstanceID + "/images/" + id,
+		"state":          "active",
+		"description":    "",
+		"storageType":    "tier1",
+		"storagePool":    "Tier1-Flash-1",
+		"size":           120,
+		"creationDate":   now(),
+		"lastUpdateDate": now(),
+		"specifications": resource{"architecture": "ppc64", "operatingSystem": "rhcos", "imageType": "stock"},
+		"servers":        []string{},
+		"volumes":        []resource{},
+	}
+	c.store.insert(kindPowerImage, cloudInstanceID+"/"+id, image, nil)
+	return image
+}
+
+func (c *Cloud) addNetwork(cloudInstanceID, name, cidr, networkType string) resource {
+	id := newID("")
+	gateway := cidrAddress(cidr, powerVSGatewayAddresses)
+	network := resource{
+		"networkID":       id,
+		"name":            name,
+		"href":            "/pcloud/v1/cloud-instances/" + cloudInstanceID + "/networks/" + id,
+		"type":            networkType,
+		"cidr":            cidr,
+		"gateway":         gateway,
+		"vlanID":          100 + len(c.store.keys(kindNetwork, cloudInstanceID+"/")),
+		"mtu":             1450,
+		"dnsServers":      []string{"127.0.0.1"},
+		"ipAddressRanges": []resource{},
+		"ipAddressMetrics": resource{
+			"available": 253, "total": 253, "used": 0, "utilization": 0,
+		},
+		"dhcpManaged": networkType == "dhcp-vlan",
+	}
+	c.store.insert(kindNetwork, cloudInstanceID+"/"+id, network, nil)
+	return network
+}
+
+func (c *Cloud) createPVMInstance(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	return c.newPVMInstance(r.PathValue("ci"), body)
+}
+
+// createSAPInstance creates an instance with the dedicated cores and memory of a SAP profile.
+func (c *Cloud) createSAPInstance(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	profile := findSAPProfile(str(body, "profileID"))
+	if profile == nil {
+		return 0, nil, badRequest("sap profile %s does not exist", str(body, "profileID"))
+	}
+	body["serverName"] = str(body, "name")
+	body["processors"] = profile["cores"]
+	body["memory"] = profile["memory"]
+	body["procType"] = "dedicated"
+	return c.newPVMInstance(r.PathValue("ci"), body)
+}
+
+func (c *Cloud) listSAPProfiles(_ *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, resource{"profiles": sapProfiles}, nil
+}
+
+func (c *Cloud) getSAPProfile(r *http.Request) (int, interface{}, *apiError) {
+	profile := findSAPProfile(r.PathValue("id"))
+	if profile == nil {
+		return 0, nil, powerVSNotFound("sap profile", r.PathValue("id"))
+	}
+	return http.StatusOK, profile, nil
+}
+
+func findSAPProfile(id string) resource {
+	for _, profile := range sapProfiles {
+		if str(profile, "profileID") == id {
+			return profile
+		}
+	}
+	return nil
+}
+
+// newPVMInstance creates an instance in the workspace from the body of a create request.
+func (c *Cloud) newPVMInstance(ci string, body resource) (int, interface{}, *apiError) {
+	name := str(body, "serverName")
+	if name == "" {
+		return 0, nil, badRequest("serverName is required")
+	}
+	for _, instance := range c.store.all(kindPVMInstance, ci+"/") {
+		if str(instance, "serverName") == name {
+			return 0, nil, conflict("conflict", "a pvm-instance with the name %s already exists", name)
+		}
+	}
+	image, ok := c.store.peek(kindPowerImage, ci+"/"+str(body, "imageID"))
+	if !ok {
+		return 0, nil, badRequest("image %s does not exist", str(body, "imageID"))
+	}
+	if str(image, "state") != "active" {
+		return 0, nil, badRequest("image %s is not active", str(body, "imageID"))
+	}
+	placementGroupID := str(body, "placementGroup")
+	if placementGroupID != "" {
+		if _, ok := c.store.peek(kindPlacementGroup, ci+"/"+placementGroupID); !ok {
+			return 0, nil, badRequest("placement group %s does not exist", placementGroupID)
+		}
+	}
+	poolID := str(body, "sharedProcessorPool")
+	if poolID != "" {
+		pool := c.findSharedProcessorPool(ci, poolID)
+		if pool == nil {
+			return 0, nil, badRequest("shared processor pool %s does not exist", poolID)
+		}
+		if str(body, "procType") == "dedicated" {
+			return 0, nil, badRequest("an instance with dedicated processors cannot be deployed in a shared processor pool")
+		}
+		poolID = str(pool, "id")
+	}
+	id := newID("")
+	networks := []resource{}
+	addresses := []resource{}
+	for i, prototype := range items(body, "networks") {
+		networkID := str(prototype, "networkID")
+		network, ok := c.store.peek(kindNetwork, ci+"/"+networkID)
+		if !ok {
+			return 0, nil, badRequest("network %s does not exist", networkID)
+		}
+		mac := fmt.Sprintf("fa:16:3e:%02x:%02x:%02x", len(c.store.keys(kindPVMInstance, ""))%256, i, c.ipAllocations[networkID]%256)
+		pvmNetwork := resource{
+			"networkID":   networkID,
+			"networkName": str(network, "name"),
+			"macAddress":  mac,
+			"type":        "fixed",
+			"version":     4,
+			"href":        "/pcloud/v1/cloud-instances/" + ci + "/pvm-instances/" + id + "/networks/" + networkID,
+		}
+		if network["dhcpManaged"] == true {
+			// The address of an instance on a DHCP network is only known from the leases of the DHCP server.
+			pvmNetwork["type"] = "dynamic"
+			address := str(prototype, "ipAddress")
+			if address == "" {
+				address = c.nextAddress(networkID, str(network, "cidr"), powerVSGatewayAddresses+1)
+			}
+			c.addLease(ci, networkID, mac, address)
+		} else {
+			address := str(prototype, "ipAddress")
+			if address == "" {
+				address = c.nextAddress(networkID, str(network, "cidr"), powerVSGatewayAddresses)
+			}
+			pvmNetwork["ip"] = address
+			pvmNetwork["ipAddress"] = address
+		}
+		networks = append(networks, pvmNetwork)
+		addresses = append(addresses, pvmNetwork)
+	}
+	instance := resource{
+		"pvmInstanceID": id,
+		"serverName":    name,
+		"href":          "/pcloud/v1/cloud-instances/" + ci + "/pvm-instances/" + id,
+		"status":        "BUILD",
+		"health":        resource{"status": "PENDING", "lastUpdate": now()},
+		"imageID":       str(image, "imageID"),
+		"memory":        lookup(body, "memory"),
+		"processors":    lookup(body, "processors"),
+		"minmem":        2,
+		"maxmem":        4 * float(body, "memory"),
+		"minproc":       0.25,
+		"maxproc":       4 * float(body, "processors"),
+		"procType":      str(body, "procType"),
+		"sysType":       str(body, "sysType"),
+		"storageType":   str(body, "storageType"),
+		"storagePool":   str(body, "storagePool"),
+		"osType":        "rhcos",
+		"diskSize":      120,
+		"networks":      networks,
+		"addresses":     addresses,
+		"networkIDs":    []string{},
+		"volumeIDs":     []string{},
+		"srcs":          []interface{}{},
+		"creationDate":  now(),
+		"updatedDate":   now(),
+		"progress":      0,
+	}
+	if instance["storageType"] == "" {
+		instance["storageType"] = str(image, "storageType")
+	}
+	if instance["storagePool"] == "" {
+		instance["storagePool"] = str(image, "storagePool")
+	}
+	if placementGroupID != "" {
+		instance["placementGroup"] = placementGroupID
+		placementGroup, _ := c.store.peek(kindPlacementGroup, ci+"/"+placementGroupID)
+		placementGroup["members"] = append(placementGroupMembers(placementGroup), id)
+	}
+	if poolID != "" {
+		instance["sharedProcessorPoolID"] = poolID
+		pool, _ := c.store.peek(kindSharedProcessorPool, ci+"/"+poolID)
+		pool["servers"] = append(items(pool, "servers"), resource{"id": id, "name": name})
+	}
+	c.store.insert(kindPVMInstance, ci+"/"+id, instance, resource{
+		"status":   "ACTIVE",
+		"health":   resource{"status": "OK", "lastUpdate": now()},
+		"progress": 100,
+	})
+	c.addPowerVSEvent(ci, "create", fmt.Sprintf("Virtual server instance %s (%s) was created", name, id), id)
+	return http.StatusAccepted, []interface{}{resource{"pvmInstanceID": id, "serverName": name, "status": "BUILD"}}, nil
+}
+
+func (c *Cloud) listPVMInstances(r *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, resource{"pvmInstances": c.store.list(kindPVMInstance, r.PathValue("ci")+"/")}, nil
+}
+
+func (c *Cloud) getPVMInstance(r *http.Request) (int, interface{}, *apiError) {
+	instance, ok := c.store.get(kindPVMInstance, r.PathValue("ci")+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, powerVSNotFound("pvm-instance", r.PathValue("id"))
+	}
+	return http.StatusOK, instance, nil
+}
+
+// addPowerVSEvent records an event of an instance in the workspace.
+func (c *Cloud) addPowerVSEvent(ci, action, message, pvmInstanceID string) {
+	id := newID("")
+	timestamp := time.Now().UTC()
+	c.store.insert(kindPowerVSEvent, ci+"/"+id, resource{
+		"eventID":   id,
+		"action":    action,
+		"level":     "info",
+		"message":   message,
+		"resource":  "pvm-instance",
+		"time":      timestamp.Format(time.RFC3339),
+		"timestamp": timestamp.Unix(),
+		"metadata":  resource{"pvmInstanceID": pvmInstanceID},
+	}, nil)
+}
+
+// listPowerVSEvents lists the events of the workspace since from_time, in unix epoch.
+func (c *Cloud) listPowerVSEvents(r *http.Request) (int, interface{}, *apiError) {
+	var fromTime int64
+	if value := r.URL.Query().Get("from_time"); value != "" {
+		var err error
+		if fromTime, err = strconv.ParseInt(value, 10, 64); err != nil {
+			return 0, nil, badRequest("invalid from_time %s", value)
+		}
+	}
+	events := []resource{}
+	for _, event := range c.store.list(kindPowerVSEvent, r.PathValue("ci")+"/") {
+		if num(event, "timestamp") >= fromTime {
+			events = append(events, event)
+		}
+	}
+	return http.StatusOK, resource{"events": events}, nil
+}
+
+// updatePVMInstance resizes the processors and memory of an instance, within the maximum it was deployed with while it is active.
+func (c *Cloud) updatePVMInstance(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	ci, id := r.PathValue("ci"), r.PathValue("id")
+	instance, ok := c.store.peek(kindPVMInstance, ci+"/"+id)
+	if !ok {
+		return 0, nil, powerVSNotFound("pvm-instance", id)
+	}
+	if str(instance, "status") != "ACTIVE" {
+		return 0, nil, conflict("conflict", "pvm-instance %s is %s", id, str(instance, "status"))
+	}
+	resized := resource{"status": "ACTIVE"}
+	if memory := float(body, "memory"); memory != 0 {
+		if memory > float(instance, "maxmem") {
+			return 0, nil, badRequest("memory %v exceeds the maximum memory %v of the running pvm-instance", memory, float(instance, "maxmem"))
+		}
+		resized["memory"] = memory
+	}
+	if processors := float(body, "processors"); processors != 0 {
+		if processors > float(instance, "maxproc") {
+			return 0, nil, badRequest("processors %v exceed the maximum processors %v of the running pvm-instance", processors, float(instance, "maxproc"))
+		}
+		resized["processors"] = processors
+	}
+	c.store.merge(kindPVMInstance, ci+"/"+id, resource{"status": "RESIZE"})
+	c.store.schedule(kindPVMInstance, ci+"/"+id, &transition{fields: resized})
+	return http.StatusAccepted, resource{
+		"memory":     lookup(resized, "memory"),
+		"processors": lookup(resized, "processors"),
+		"serverName": str(instance, "serverName"),
+		"statusUrl":  "/pcloud/v1/cloud-instances/" + ci + "/pvm-instances/" + id,
+	}, nil
+}
+
+func (c *Cloud) deletePVMInstance(r *http.Request) (int, interface{}, *apiError) {
+	ci, id := r.PathValue("ci"), r.PathValue("id")
+	instance, ok := c.store.peek(kindPVMInstance, ci+"/"+id)
+	if !ok {
+		return 0, nil, powerVSNotFound("pvm-instance", id)
+	}
+	macs := map[string]bool{}
+	for _, network := range items(instance, "networks") {
+		macs[str(network, "macAddress")] = true
+	}
+	c.store.merge(kindPVMInstance, ci+"/"+id, resource{"status": "DELETING"})
+	c.addPowerVSEvent(ci, "delete", fmt.Sprintf("Virtual server instance %s (%s) was deleted", str(instance, "serverName"), id), id)
+	c.store.schedule(kindPVMInstance, ci+"/"+id, &transition{remove: true, done: func() {
+		// The data volumes of a deleted instance are detached, not deleted.
+		for _, volume := range c.store.all(kindPowerVolume, ci+"/") {
+			detachFromInstance(volume, id)
+		}
+		if placementGroup, ok := c.store.peek(kindPlacementGroup, ci+"/"+str(instance, "placementGroup")); ok {
+			members := []string{}
+			for _, member := range placementGroupMembers(placementGroup) {
+				if member != id {
+					members = append(members, member)
+				}
+			}
+			placementGroup["members"] = members
+		}
+		if pool, ok := c.store.peek(kindSharedProcessorPool, ci+"/"+str(instance, "sharedProcessorPoolID")); ok {
+			servers := []resource{}
+			for _, server := range items(pool, "servers") {
+				if str(server, "id") != id {
+					servers = append(servers, server)
+				}
+			}
+			pool["servers"] = servers
+		}
+		for _, server := range c.store.all(kindDHCPServer, ci+"/") {
+			leases := []resource{}
+			for _, lease := range items(server, "leases") {
+				if !macs[str(lease, "instanceMacAddress")] {
+					leases = append(leases, lease)
+				}
+			}
+			server["leases"] = leases
+		}
+	}})
+	return http.StatusOK, resource{}, nil
+}
+
+func (c *Cloud) listPowerImages(r *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, resource{"images": c.store.list(kindPowerImage, r.PathValue("ci")+"/")}, nil
+}
+
+func (c *Cloud) getPowerImage(r *http.Request) (int, interface{}, *apiError) {
+	image, ok := c.store.get(kindPowerImage, r.PathValue("ci")+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, powerVSNotFound("image", r.PathValue("id"))
+	}
+	return http.StatusOK, image, nil
+}
+
+func (c *Cloud) deletePowerImage(r *http.Request) (int, interface{}, *apiError) {
+	key := r.PathValue("ci") + "/" + r.PathValue("id")
+	if _, ok := c.store.peek(kindPowerImage, key); !ok {
+		return 0, nil, powerVSNotFound("image", r.PathValue("id"))
+	}
+	for _, instance := range c.store.all(kindPVMInstance, r.PathValue("ci")+"/") {
+		if str(instance, "imageID") == r.PathValue("id") && str(instance, "status") == "BUILD" {
+			return 0, nil, conflict("conflict", "image %s is in use by the pvm-instance %s", r.PathValue("id"), str(instance, "pvmInstanceID"))
+		}
+	}
+	c.store.drop(kindPowerImage, key)
+	return http.StatusOK, resource{}, nil
+}
+
+func (c *Cloud) createCOSImage(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	ci := r.PathValue("ci")
+	for _, job := range c.store.all(kindPowerJob, ci+"/") {
+		if state := str(job, "status", "state"); state != "completed" && state != "failed" {
+			return 0, nil, conflict("conflict", "an image import job is already in progress: %s", str(job, "id"))
+		}
+	}
+	imageName := str(body, "imageName")
+	if imageName == "" || str(body, "bucketName") == "" || str(body, "imageFilename") == "" {
+		return 0, nil, badRequest("imageName, bucketName and imageFilename are required")
+	}
+	id := newID("")
+	job := resource{
+		"id":              id,
+		"createTimestamp": now(),
+		"operation":       resource{"action": "imageImport", "id": imageName, "target": "cloudInstance"},
+		"status":          resource{"state": "queued", "progress": "0", "message": ""},
+	}
+	c.store.insert(kindPowerJob, ci+"/"+id, job, nil)
+	c.store.schedule(kindPowerJob, ci+"/"+id, &transition{
+		fields: resource{"status": resource{"state": "completed", "progress": "100", "message": ""}},
+		done: func() {
+			image := c.addPowerImage(ci, imageName)
+			if storageType := str(body, "storageType"); storageType != "" {
+				image["storageType"] = storageType
+			}
+		},
+	})
+	return http.StatusAccepted, resource{"id": id, "href": "/pcloud/v1/cloud-instances/" + ci + "/jobs/" + id}, nil
+}
+
+func (c *Cloud) getCOSImage(r *http.Request) (int, interface{}, *apiError) {
+	keys := c.store.keys(kindPowerJob, r.PathValue("ci")+"/")
+	if len(keys) == 0 {
+		return 0, nil, powerVSNotFound("image import job", r.PathValue("ci"))
+	}
+	job, _ := c.store.get(kindPowerJob, keys[len(keys)-1])
+	return http.StatusOK, job, nil
+}
+
+func (c *Cloud) getJob(r *http.Request) (int, interface{}, *apiError) {
+	job, ok := c.store.get(kindPowerJob, r.PathValue("ci")+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, powerVSNotFound("job", r.PathValue("id"))
+	}
+	return http.StatusOK, job, nil
+}
+
+func (c *Cloud) deleteJob(r *http.Request) (int, interface{}, *apiError) {
+	key := r.PathValue("ci") + "/" + r.PathValue("id")
+	if _, ok := c.store.peek(kindPowerJob, key); !ok {
+		return 0, nil, powerVSNotFound("job", r.PathValue("id"))
+	}
+	c.store.drop(kindPowerJob, key)
+	return http.StatusOK, resource{}, nil
+}
+
+func (c *Cloud) listNetworks(r *http.Request) (int, interface{}, *apiError) {
+	networks := []resource{}
+	for _, network := range c.store.list(kindNetwork, r.PathValue("ci")+"/") {
+		networks = append(networks, resource{
+			"networkID":   network["networkID"],
+			"name":        network["name"],
+			"href":        network["href"],
+			"type":        network["type"],
+			"vlanID":      network["vlanID"],
+			"mtu":         network["mtu"],
+			"dhcpManaged": network["dhcpManaged"],
+		})
+	}
+	return http.StatusOK, resource{"networks": networks}, nil
+}
+
+func (c *Cloud) getNetwork(r *http.Request) (int, interface{}, *apiError) {
+	network, ok := c.store.get(kindNetwork, r.PathValue("ci")+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, powerVSNotFound("network", r.PathValue("id"))
+	}
+	return http.StatusOK, network, nil
+}
+
+func (c *Cloud) createNetwork(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	ci := r.PathValue("ci")
+	name, networkType, cidr := str(body, "name"), str(body, "type"), str(body, "cidr")
+	switch networkType {
+	case "vlan":
+		if cidr == "" {
+			return 0, nil, badRequest("cidr is required for a vlan network")
+		}
+	case "pub-vlan":
+		if cidr != "" {
+			return 0, nil, badRequest("cidr is not supported for a pub-vlan network")
+		}
+		cidr = "172.16.0.0/28"
+	default:
+		return 0, nil, badRequest("network type %s is not supported", networkType)
+	}
+	for _, network := range c.store.all(kindNetwork, ci+"/") {
+		if str(network, "name") == name {
+			return 0, nil, conflict("conflict", "a network with the name %s already exists", name)
+		}
+	}
+	network := c.addNetwork(ci, name, cidr, networkType)
+	fields := resource{}
+	if mtu := lookup(body, "mtu"); mtu != nil {
+		fields["mtu"] = mtu
+	}
+	if dnsServers := lookup(body, "dnsServers"); dnsServers != nil {
+		fields["dnsServers"] = dnsServers
+	}
+	c.store.merge(kindNetwork, ci+"/"+str(network, "networkID"), fields)
+	created, _ := c.store.peek(kindNetwork, ci+"/"+str(network, "networkID"))
+	return http.StatusCreated, created, nil
+}
+
+func (c *Cloud) deleteNetwork(r *http.Request) (int, interface{}, *apiError) {
+	ci, id := r.PathValue("ci"), r.PathValue("id")
+	network, ok := c.store.peek(kindNetwork, ci+"/"+id)
+	if !ok {
+		return 0, nil, powerVSNotFound("network", id)
+	}
+	if network["dhcpManaged"] == true {
+		return 0, nil, badRequest("the network %s is managed by a dhcp server", id)
+	}
+	for _, instance := range c.store.all(kindPVMInstance, ci+"/") {
+		for _, attached := range items(instance, "networks") {
+			if str(attached, "networkID") == id {
+				return 0, nil, conflict("conflict", "the network %s is in use by the pvm-instance %s", id, str(instance, "pvmInstanceID"))
+			}
+		}
+	}
+	c.store.drop(kindNetwork, ci+"/"+id)
+	return http.StatusOK, resource{}, nil
+}
+
+func (c *Cloud) createDHCPServer(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	ci := r.PathValue("ci")
+	cidr := str(body, "cidr")
+	if cidr == "" {
+		cidr = "192.168.0.0/24"
+	}
+	name := str(body, "name")
+	if name == "" {
+		name = ci[:8]
+	}
+	networkName := "DHCPSERVER" + name + "_Private"
+	for _, network := range c.store.all(kindNetwork, ci+"/") {
+		if str(network, "name") == networkName {
+			return 0, nil, conflict("conflict", "a network with the name %s already exists", networkName)
+		}
+	}
+	network := c.addNetwork(ci, networkName, cidr, "dhcp-vlan")
+	if dnsServer := str(body, "dnsServer"); dnsServer != "" {
+		c.store.merge(kindNetwork, ci+"/"+str(network, "networkID"), resource{"dnsServers": []string{dnsServer}})
+	}
+	id := newID("")
+	server := resource{
+		"id":      id,
+		"status":  "BUILD",
+		"network": resource{"id": network["networkID"], "name": networkName},
+		"leases":  []resource{},
+	}
+	c.store.insert(kindDHCPServer, ci+"/"+id, server, resource{"status": "ACTIVE"})
+	return http.StatusAccepted, resource{"id": id, "status": "BUILD", "network": server["network"]}, nil
+}
+
+// addLease records the address leased by the DHCP server of a network to a MAC address.
+func (c *Cloud) addLease(ci, networkID, mac, address string) {
+	for _, server := range c.store.all(kindDHCPServer, ci+"/") {
+		if str(server, "network", "id") == networkID {
+			server["leases"] = append(items(server, "leases"), resource{"instanceIP": address, "instanceMacAddress": mac})
+		}
+	}
+}
+
+func (c *Cloud) listDHCPServers(r *http.Request) (int, interface{}, *apiError) {
+	servers := []resource{}
+	for _, server := range c.store.list(kindDHCPServer, r.PathValue("ci")+"/") {
+		delete(server, "leases")
+		servers = append(servers, server)
+	}
+	return http.StatusOK, servers, nil
+}
+
+func (c *Cloud) getDHCPServer(r *http.Request) (int, interface{}, *apiError) {
+	server, ok := c.store.get(kindDHCPServer, r.PathValue("ci")+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, powerVSNotFound("dhcp server", r.PathValue("id"))
+	}
+	return http.StatusOK, server, nil
+}
+
+func (c *Cloud) deleteDHCPServer(r *http.Request) (int, interface{}, *apiError) {
+	ci, id := r.PathValue("ci"), r.PathValue("id")
+	server, ok := c.store.peek(kindDHCPServer, ci+"/"+id)
+	if !ok {
+		return 0, nil, powerVSNotFound("dhcp server", id)
+	}
+	networkID := str(server, "network", "id")
+	for _, instance := range c.store.all(kindPVMInstance, ci+"/") {
+		for _, network := range items(instance, "networks") {
+			if str(network, "networkID") == networkID {
+				return 0, nil, conflict("conflict", "the network of the dhcp server %s is in use by the pvm-instance %s", id, str(instance, "pvmInstanceID"))
+			}
+		}
+	}
+	c.store.merge(kindDHCPServer, ci+"/"+id, resource{"status": "DELETING"})
+	c.store.schedule(kindDHCPServer, ci+"/"+id, &transition{remove: true, done: func() {
+		c.store.drop(kindNetwork, ci+"/"+networkID)
+	}})
+	return http.StatusAccepted, resource{}, nil
+}
+
+func (c *Cloud) createPowerVolume(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	ci := r.PathValue("ci")
+	name := str(body, "name")
+	if name == "" || lookup(body, "size") == nil {
+		return 0, nil, badRequest("name and size are required")
+	}
+	for _, volume := range c.store.all(kindPowerVolume, ci+"/") {
+		if str(volume, "name") == name {
+			return 0, nil, conflict("conflict", "a volume with the name %s already exists", name)
+		}
+	}
+	diskType := str(body, "diskType")
+	if diskType == "" {
+		diskType = "tier3"
+	}
+	id := newID("")
+	volume := resource{
+		"volumeID":       id,
+		"name":           name,
+		"href":           "/pcloud/v1/cloud-instances/" + ci + "/volumes/" + id,
+		"size":           lookup(body, "size"),
+		"diskType":       diskType,
+		"volumePool":     str(body, "volumePool"),
+		"shareable":      body["shareable"] == true,
+		"bootable":       false,
+		"state":          "creating",
+		"pvmInstanceIDs": []string{},
+		"creationDate":   now(),
+		"lastUpdateDate": now(),
+	}
+	c.store.insert(kindPowerVolume, ci+"/"+id, volume, resource{"state": "available"})
+	return http.StatusAccepted, volume, nil
+}
+
+func (c *Cloud) listPowerVolumes(r *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, resource{"volumes": c.store.list(kindPowerVolume, r.PathValue("ci")+"/")}, nil
+}
+
+func (c *Cloud) getPowerVolume(r *http.Request) (int, interface{}, *apiError) {
+	volume, ok := c.store.get(kindPowerVolume, r.PathValue("ci")+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, powerVSNotFound("volume", r.PathValue("id"))
+	}
+	return http.StatusOK, volume, nil
+}
+
+func (c *Cloud) deletePowerVolume(r *http.Request) (int, interface{}, *apiError) {
+	key := r.PathValue("ci") + "/" + r.PathValue("id")
+	volume, ok := c.store.peek(kindPowerVolume, key)
+	if !ok {
+		return 0, nil, powerVSNotFound("volume", r.PathValue("id"))
+	}
+	if len(attachedInstances(volume)) > 0 {
+		return 0, nil, conflict("conflict", "volume %s is attached to a pvm-instance", r.PathValue("id"))
+	}
+	c.store.drop(kindPowerVolume, key)
+	return http.StatusOK, resource{}, nil
+}
+
+func (c *Cloud) attachPowerVolume(r *http.Request) (int, interface{}, *apiError) {
+	ci, id, volumeID := r.PathValue("ci"), r.PathValue("id"), r.PathValue("volume")
+	if _, ok := c.store.peek(kindPVMInstance, ci+"/"+id); !ok {
+		return 0, nil, powerVSNotFound("pvm-instance", id)
+	}
+	volume, ok := c.store.peek(kindPowerVolume, ci+"/"+volumeID)
+	if !ok {
+		return 0, nil, powerVSNotFound("volume", volumeID)
+	}
+	instanceIDs := attachedInstances(volume)
+	if str(volume, "state") != "available" && (volume["shareable"] != true || str(volume, "state") != "in-use") {
+		return 0, nil, badRequest("volume %s is %s", volumeID, str(volume, "state"))
+	}
+	for _, instanceID := range instanceIDs {
+		if instanceID == id {
+			return 0, nil, badRequest("volume %s is already attached to the pvm-instance %s", volumeID, id)
+		}
+	}
+	c.store.schedule(kindPowerVolume, ci+"/"+volumeID, &transition{fields: resource{
+		"state":          "in-use",
+		"pvmInstanceIDs": append(append([]string{}, instanceIDs...), id),
+	}})
+	return http.StatusOK, resource{"description": "attaching volume " + volumeID}, nil
+}
+
+func (c *Cloud) detachPowerVolume(r *http.Request) (int, interface{}, *apiError) {
+	ci, id, volumeID := r.PathValue("ci"), r.PathValue("id"), r.PathValue("volume")
+	volume, ok := c.store.peek(kindPowerVolume, ci+"/"+volumeID)
+	if !ok {
+		return 0, nil, powerVSNotFound("volume", volumeID)
+	}
+	attached := false
+	for _, instanceID := range attachedInstances(volume) {
+		attached = attached || instanceID == id
+	}
+	if !attached {
+		return 0, nil, badRequest("volume %s is not attached to the pvm-instance %s", volumeID, id)
+	}
+	c.store.schedule(kindPowerVolume, ci+"/"+volumeID, &transition{done: func() {
+		detachFromInstance(volume, id)
+	}})
+	return http.StatusAccepted, resource{"description": "detaching volume " + volumeID}, nil
+}
+
+func (c *Cloud) createSnapshot(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	ci, id := r.PathValue("ci"), r.PathValue("id")
+	if _, ok := c.store.peek(kindPVMInstance, ci+"/"+id); !ok {
+		return 0, nil, powerVSNotFound("pvm-instance", id)
+	}
+	name := str(body, "name")
+	if name == "" {
+		return 0, nil, badRequest("name is required")
+	}
+	for _, snapshot := range c.store.all(kindSnapshot, ci+"/") {
+		if str(snapshot, "name") == name {
+			return 0, nil, conflict("conflict", "a snapshot with the name %s already exists", name)
+		}
+		if str(snapshot, "pvmInstanceID") == id && str(snapshot, "status") != "available" && str(snapshot, "status") != "error" {
+			return 0, nil, conflict("conflict", "a snapshot of the pvm-instance %s is already in progress", id)
+		}
+	}
+	attached := []string{}
+	for _, volume := range c.store.all(kindPowerVolume, ci+"/") {
+		for _, instanceID := range attachedInstances(volume) {
+			if instanceID == id {
+				attached = append(attached, str(volume, "volumeID"))
+			}
+		}
+	}
+	volumeIDs := attached
+	if requested, ok := body["volumeIDs"].([]interface{}); ok && len(requested) > 0 {
+		volumeIDs = []string{}
+		for _, volumeID := range requested {
+			if !slices.Contains(attached, fmt.Sprint(volumeID)) {
+				return 0, nil, badRequest("volume %v is not attached to the pvm-instance %s", volumeID, id)
+			}
+			volumeIDs = append(volumeIDs, fmt.Sprint(volumeID))
+		}
+	}
+	volumeSnapshots := resource{}
+	for _, volumeID := range volumeIDs {
+		volumeSnapshots[volumeID] = newID("")
+	}
+	snapshotID := newID("")
+	snapshot := resource{
+		"snapshotID":      snapshotID,
+		"name":            name,
+		"description":     str(body, "description"),
+		"pvmInstanceID":   id,
+		"action":          "snapshot",
+		"status":          "creating",
+		"percentComplete": 0,
+		"volumeSnapshots": volumeSnapshots,
+		"creationDate":    now(),
+		"lastUpdateDate":  now(),
+	}
+	c.store.insert(kindSnapshot, ci+"/"+snapshotID, snapshot, resource{"status": "available", "percentComplete": 100})
+	return http.StatusAccepted, resource{"snapshotID": snapshotID}, nil
+}
+
+func (c *Cloud) restoreSnapshot(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	ci, id, snapshotID := r.PathValue("ci"), r.PathValue("id"), r.PathValue("snapshot")
+	instance, ok := c.store.peek(kindPVMInstance, ci+"/"+id)
+	if !ok {
+		return 0, nil, powerVSNotFound("pvm-instance", id)
+	}
+	snapshot, ok := c.store.peek(kindSnapshot, ci+"/"+snapshotID)
+	if !ok || str(snapshot, "pvmInstanceID") != id {
+		return 0, nil, powerVSNotFound("snapshot", snapshotID)
+	}
+	if str(snapshot, "status") != "available" {
+		return 0, nil, conflict("conflict", "snapshot %s is %s", snapshotID, str(snapshot, "status"))
+	}
+	if str(instance, "status") != "SHUTOFF" && body["force"] != true {
+		return 0, nil, badRequest("pvm-instance %s must be shut off to restore a snapshot, use force to restore it while running", id)
+	}
+	snapshot["status"] = "restoring"
+	snapshot["action"] = "restore"
+	c.store.schedule(kindSnapshot, ci+"/"+snapshotID, &transition{fields: resource{"status": "available", "lastUpdateDate": now()}})
+	c.addPowerVSEvent(ci, "restore", fmt.Sprintf("Snapshot %s was restored to the virtual server instance %s", snapshotID, id), id)
+	return http.StatusAccepted, snapshot, nil
+}
+
+func (c *Cloud) listSnapshots(r *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, resource{"snapshots": c.store.list(kindSnapshot, r.PathValue("ci")+"/")}, nil
+}
+
+func (c *Cloud) getSnapshot(r *http.Request) (int, interface{}, *apiError) {
+	snapshot, ok := c.store.get(kindSnapshot, r.PathValue("ci")+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, powerVSNotFound("snapshot", r.PathValue("id"))
+	}
+	return http.StatusOK, snapshot, nil
+}
+
+func (c *Cloud) deleteSnapshot(r *http.Request) (int, interface{}, *apiError) {
+	key := r.PathValue("ci") + "/" + r.PathValue("id")
+	snapshot, ok := c.store.peek(kindSnapshot, key)
+	if !ok {
+		return 0, nil, powerVSNotFound("snapshot", r.PathValue("id"))
+	}
+	if str(snapshot, "status") == "restoring" {
+		return 0, nil, conflict("conflict", "snapshot %s is being restored", r.PathValue("id"))
+	}
+	c.store.remove(kindSnapshot, key, resource{"status": "deleting"})
+	return http.StatusAccepted, resource{}, nil
+}
+
+func (c *Cloud) captureInstance(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	ci, id := r.PathValue("ci"), r.PathValue("id")
+	if _, ok := c.store.peek(kindPVMInstance, ci+"/"+id); !ok {
+		return 0, nil, powerVSNotFound("pvm-instance", id)
+	}
+	captureName := str(body, "captureName")
+	if captureName == "" || str(body, "captureDestination") == "" {
+		return 0, nil, badRequest("captureName and captureDestination are required")
+	}
+	if str(body, "captureDestination") != "image-catalog" {
+		return 0, nil, badRequest("only the image-catalog capture destination is supported")
+	}
+	for _, image := range c.store.all(kindPowerImage, ci+"/") {
+		if str(image, "name") == captureName {
+			return 0, nil, conflict("conflict", "an image with the name %s already exists", captureName)
+		}
+	}
+	jobID := newID("")
+	job := resource{
+		"id":              jobID,
+		"createTimestamp": now(),
+		"operation":       resource{"action": "vmCapture", "id": id, "target": "pvmInstance"},
+		"status":          resource{"state": "running", "progress": "0", "message": ""},
+	}
+	c.store.insert(kindPowerJob, ci+"/"+jobID, job, nil)
+	c.store.schedule(kindPowerJob, ci+"/"+jobID, &transition{
+		fields: resource{"status": resource{"state": "completed", "progress": "100", "message": ""}},
+		done: func() {
+			image := c.addPowerImage(ci, captureName)
+			image["specifications"] = resource{"architecture": "ppc64", "operatingSystem": "rhcos", "imageType": "capture"}
+		},
+	})
+	return http.StatusAccepted, resource{"id": jobID, "href": "/pcloud/v1/cloud-instances/" + ci + "/jobs/" + jobID}, nil
+}
+
+// attachedInstances returns the IDs of the instances a volume is attached to.
+func attachedInstances(volume resource) []string {
+	instanceIDs, _ := volume["pvmInstanceIDs"].([]string)
+	return instanceIDs
+}
+
+// detachFromInstance removes an instance from the instances a volume is attached to.
+func detachFromInstance(volume resource, instanceID string) {
+	instanceIDs := []string{}
+	for _, id := range attachedInstances(volume) {
+		if id != instanceID {
+			instanceIDs = append(instanceIDs, id)
+		}
+	}
+	volume["pvmInstanceIDs"] = instanceIDs
+	if len(instanceIDs) == 0 {
+		volume["state"] = "available"
+	}
+}
+
+// systemPool returns a system pool with a single host with the given cores and memory available.
+func systemPool(systemType string, cores float64, memory int64) resource {
+	host := resource{"cores": cores, "memory": memory, "availableCores": cores, "availableMemory": memory}
+	return resource{
+		"type":               systemType,
+		"capacity":           host,
+		"maxAvailable":       host,
+		"maxCoresAvailable":  host,
+		"maxMemoryAvailable": host,
+		"coreMemoryRatio":    float64(memory) / cores,
+		"systems":            []resource{host},
+	}
+}
+
+func (c *Cloud) listSystemPools(_ *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, defaultSystemPools, nil
+}
+
+func (c *Cloud) listStorageTiers(_ *http.Request) (int, interface{}, *apiError) {
+	tiers := []resource{}
+	for _, name := range defaultStorageTiers {
+		tiers = append(tiers, resource{"name": name, "description": name + " storage tier", "state": "active"})
+	}
+	return http.StatusOK, tiers, nil
+}
+
+// getCloudInstance returns the workspace with its limits and its usage by the instances and volumes in it.
+func (c *Cloud) getCloudInstance(r *http.Request) (int, interface{}, *apiError) {
+	ci := r.PathValue("ci")
+	workspace, _ := c.store.peek(kindResourceInstance, ci)
+	limits, ok := workspace["limits"].(resource)
+	if !ok {
+		limits = defaultWorkspaceLimits
+	}
+	var instances, memory, processors, storage float64
+	for _, instance := range c.store.all(kindPVMInstance, ci+"/") {
+		instances++
+		memory += float(instance, "memory")
+		processors += float(instance, "processors")
+	}
+	for _, volume := range c.store.all(kindPowerVolume, ci+"/") {
+		storage += float(volume, "size")
+	}
+	return http.StatusOK, resource{
+		"cloudInstanceID": ci,
+		"name":            str(workspace, "name"),
+		"region":          str(workspace, "target"),
+		"tenantID":        str(workspace, "account_id"),
+		"openstackID":     ci,
+		"enabled":         true,
+		"initialized":     true,
+		"capabilities":    []string{},
+		"pvmInstances":    []resource{},
+		"limits":          limits,
+		"usage": resource{
+			"instances":  instances,
+			"memory":     memory,
+			"processors": processors,
+			"procUnits":  processors,
+			"storage":    storage,
+		},
+	}, nil
+}
+
+func (c *Cloud) getDatacenter(r *http.Request) (int, interface{}, *apiError) {
+	zone := r.PathValue("zone")
+	capabilities := resource{}
+	for k, v := range defaultDatacenterCapabilities {
+		capabilities[k] = v
+	}
+	if datacenter, ok := c.store.get(kindDatacenter, zone); ok {
+		capabilities = datacenter["capabilities"].(resource)
+	}
+	return http.StatusOK, resource{
+		"capabilities": capabilities,
+		"location":     resource{"region": zone, "type": "data-center", "url": "https://" + zone + ".power-iaas.cloud.ibm.com"},
+		"status":       "active",
+		"type":         "off-premises",
+	}, nil
+}
+
+func (c *Cloud) createPlacementGroup(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	ci := r.PathValue("ci")
+	name, policy := str(body, "name"), str(body, "policy")
+	if name == "" {
+		return 0, nil, badRequest("name is required")
+	}
+	if policy != "affinity" && policy != "anti-affinity" {
+		return 0, nil, badRequest("policy %q is not one of affinity, anti-affinity", policy)
+	}
+	for _, placementGroup := range c.store.all(kindPlacementGroup, ci+"/") {
+		if str(placementGroup, "name") == name {
+			return 0, nil, conflict("conflict", "a placement group with the name %s already exists", name)
+		}
+	}
+	id := newID("")
+	placementGroup := resource{
+		"id":      id,
+		"name":    name,
+		"policy":  policy,
+		"members": []string{},
+	}
+	c.store.insert(kindPlacementGroup, ci+"/"+id, placementGroup, nil)
+	return http.StatusOK, placementGroup, nil
+}
+
+func (c *Cloud) listPlacementGroups(r *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, resource{"placementGroups": c.store.list(kindPlacementGroup, r.PathValue("ci")+"/")}, nil
+}
+
+func (c *Cloud) getPlacementGroup(r *http.Request) (int, interface{}, *apiError) {
+	placementGroup, ok := c.store.get(kindPlacementGroup, r.PathValue("ci")+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, powerVSNotFound("placement group", r.PathValue("id"))
+	}
+	return http.StatusOK, placementGroup, nil
+}
+
+func (c *Cloud) deletePlacementGroup(r *http.Request) (int, interface{}, *apiError) {
+	key := r.PathValue("ci") + "/" + r.PathValue("id")
+	placementGroup, ok := c.store.peek(kindPlacementGroup, key)
+	if !ok {
+		return 0, nil, powerVSNotFound("placement group", r.PathValue("id"))
+	}
+	if len(placementGroupMembers(placementGroup)) > 0 {
+		return 0, nil, conflict("conflict", "placement group %s has pvm-instance members", r.PathValue("id"))
+	}
+	c.store.drop(kindPlacementGroup, key)
+	return http.StatusOK, resource{}, nil
+}
+
+// placementGroupMembers returns the IDs of the instances of a placement group.
+func placementGroupMembers(placementGroup resource) []string {
+	members, _ := placementGroup["members"].([]string)
+	return members
+}
+
+func (c *Cloud) createSharedProcessorPool(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	ci := r.PathValue("ci")
+	name, hostGroup := str(body, "name"), str(body, "hostGroup")
+	if name == "" || hostGroup == "" || lookup(body, "reservedCores") == nil {
+		return 0, nil, badRequest("name, hostGroup and reservedCores are required")
+	}
+	if c.findSharedProcessorPool(ci, name) != nil {
+		return 0, nil, conflict("conflict", "a shared processor pool with the name %s already exists", name)
+	}
+	id := newID("")
+	pool := resource{
+		"id":             id,
+		"name":           name,
+		"hostGroup":      hostGroup,
+		"reservedCores":  lookup(body, "reservedCores"),
+		"allocatedCores": 0,
+		"availableCores": lookup(body, "reservedCores"),
+		"status":         "configuring",
+		"servers":        []resource{},
+		"creationDate":   now(),
+	}
+	c.store.insert(kindSharedProcessorPool, ci+"/"+id, pool, resource{"status": "active"})
+	return http.StatusAccepted, sharedProcessorPool(pool), nil
+}
+
+func (c *Cloud) listSharedProcessorPools(r *http.Request) (int, interface{}, *apiError) {
+	pools := []resource{}
+	for _, pool := range c.store.list(kindSharedProcessorPool, r.PathValue("ci")+"/") {
+		pools = append(pools, sharedProcessorPool(pool))
+	}
+	return http.StatusOK, resource{"sharedProcessorPools": pools}, nil
+}
+
+func (c *Cloud) getSharedProcessorPool(r *http.Request) (int, interface{}, *apiError) {
+	pool, ok := c.store.get(kindSharedProcessorPool, r.PathValue("ci")+"/"+r.PathValue("id"))
+	if !ok {
+		return 0, nil, powerVSNotFound("shared processor pool", r.PathValue("id"))
+	}
+	return http.StatusOK, resource{"sharedProcessorPool": sharedProcessorPool(pool), "servers": items(pool, "servers")}, nil
+}
+
+func (c *Cloud) deleteSharedProcessorPool(r *http.Request) (int, interface{}, *apiError) {
+	key := r.PathValue("ci") + "/" + r.PathValue("id")
+	pool, ok := c.store.peek(kindSharedProcessorPool, key)
+	if !ok {
+		return 0, nil, powerVSNotFound("shared processor pool", r.PathValue("id"))
+	}
+	if len(items(pool, "servers")) > 0 {
+		return 0, nil, conflict("conflict", "shared processor pool %s has pvm-instances", r.PathValue("id"))
+	}
+	c.store.drop(kindSharedProcessorPool, key)
+	return http.StatusOK, resource{}, nil
+}
+
+// findSharedProcessorPool returns the shared processor pool of a workspace with an ID or name, nil if it doesn't exist.
+func (c *Cloud) findSharedProcessorPool(ci, idOrName string) resource {
+	if pool, ok := c.store.peek(kindSharedProcessorPool, ci+"/"+idOrName); ok {
+		return pool
+	}
+	for _, pool := range c.store.all(kindSharedProcessorPool, ci+"/") {
+		if str(pool, "name") == idOrName {
+			return pool
+		}
+	}
+	return nil
+}
+
+// sharedProcessorPool returns a shared processor pool without its servers, which are only returned with its details.
+func sharedProcessorPool(pool resource) resource {
+	summary := resource{}
+	for key, value := range pool {
+		if key != "servers" {
+			summary[key] = value
+		}
+	}
+	return summary
+}
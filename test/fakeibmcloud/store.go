@@ -0,0 +1,240 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeibmcloud
+
+import (
+	"strings"
+)
+
+// resource is the JSON representation of a cloud resource, as returned by the API.
+type resource = map[string]interface{}
+
+// transition is a pending asynchronous operation on a resource.
+type transition struct {
+	// reads is the number of reads of the resource left before the transition completes.
+	reads int
+	// fields are set on the resource when the transition completes.
+	fields resource
+	// remove deletes the resource when the transition completes.
+	remove bool
+	// done is called once the transition completed.
+	done func()
+}
+
+type record struct {
+	resource resource
+	pending  *transition
+}
+
+type collection struct {
+	keys    []string
+	records map[string]*record
+}
+
+// store holds the resources of the cloud, by kind and key.
+// Nested resources, e.g. the pools of a load balancer, are keyed by the key of their parent followed by their own ID.
+// The store is not safe for concurrent use, the Cloud serializes the access to it.
+type store struct {
+	// reads is the number of reads it takes for a transition to complete. A negative value completes them synchronously.
+	reads       int
+	collections map[string]*collection
+}
+
+func newStore(reads int) *store {
+	return &store{
+		reads:       reads,
+		collections: map[string]*collection{},
+	}
+}
+
+func (s *store) collection(kind string) *collection {
+	c, ok := s.collections[kind]
+	if !ok {
+		c = &collection{records: map[string]*record{}}
+		s.collections[kind] = c
+	}
+	return c
+}
+
+// insert adds a resource to the store. When settled isn't nil, the resource reaches the settled state asynchronously.
+func (s *store) insert(kind, key string, r resource, settled resource) {
+	c := s.collection(kind)
+	if _, ok := c.records[key]; !ok {
+		c.keys = append(c.keys, key)
+	}
+	c.records[key] = &record{resource: r}
+	if settled != nil {
+		s.schedule(kind, key, &transition{fields: settled})
+	}
+}
+
+// schedule starts an asynchronous operation on a resource, replacing the pending one.
+func (s *store) schedule(kind, key string, t *transition) {
+	rec, ok := s.collection(kind).records[key]
+	if !ok {
+		return
+	}
+	rec.pending = t
+	if s.reads < 0 {
+		s.complete(kind, key, rec)
+		return
+	}
+	t.reads = s.reads
+}
+
+// remove deletes a resource. When deleting isn't nil, the fields are set on the resource and it is removed asynchronously.
+func (s *store) remove(kind, key string, deleting resource) {
+	rec, ok := s.collection(kind).records[key]
+	if !ok {
+		return
+	}
+	if deleting == nil {
+		s.drop(kind, key)
+		return
+	}
+	merge(rec.resource, deleting)
+	s.schedule(kind, key, &transition{remove: true})
+}
+
+// merge sets fields on a stored resource, e.g. to start a transition with schedule.
+func (s *store) merge(kind, key string, fields resource) {
+	if rec, ok := s.collection(kind).records[key]; ok {
+		merge(rec.resource, fields)
+	}
+}
+
+// drop deletes a resource synchronously.
+func (s *store) drop(kind, key string) {
+	c := s.collection(kind)
+	if _, ok := c.records[key]; !ok {
+		return
+	}
+	delete(c.records, key)
+	for i, k := range c.keys {
+		if k == key {
+			c.keys = append(c.keys[:i], c.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// observe counts a read of a resource, completing its pending operation if it is due.
+func (s *store) observe(kind, key string) {
+	rec, ok := s.collection(kind).records[key]
+	if !ok || rec.pending == nil {
+		return
+	}
+	rec.pending.reads--
+	if rec.pending.reads <= 0 {
+		s.complete(kind, key, rec)
+	}
+}
+
+func (s *store) complete(kind, key string, rec *record) {
+	t := rec.pending
+	rec.pending = nil
+	merge(rec.resource, t.fields)
+	if t.remove {
+		s.drop(kind, key)
+	}
+	if t.done != nil {
+		t.done()
+	}
+}
+
+// get returns a copy of a resource, as seen by an API client.
+func (s *store) get(kind, key string) (resource, bool) {
+	s.observe(kind, key)
+	rec, ok := s.collection(kind).records[key]
+	if !ok {
+		return nil, false
+	}
+	return deepCopy(rec.resource).(resource), true
+}
+
+// list returns a copy of the resources of a kind whose key starts with prefix, as seen by an API client.
+func (s *store) list(kind, prefix string) []resource {
+	for _, key := range s.keys(kind, prefix) {
+		s.observe(kind, key)
+	}
+	c := s.collection(kind)
+	resources := []resource{}
+	for _, key := range s.keys(kind, prefix) {
+		resources = append(resources, deepCopy(c.records[key].resource).(resource))
+	}
+	return resources
+}
+
+// peek returns a resource without counting a read, for the fake to modify it.
+func (s *store) peek(kind, key string) (resource, bool) {
+	rec, ok := s.collection(kind).records[key]
+	if !ok {
+		return nil, false
+	}
+	return rec.resource, true
+}
+
+// all returns the resources of a kind whose key starts with prefix without counting a read, for the fake to modify them.
+func (s *store) all(kind, prefix string) []resource {
+	c := s.collection(kind)
+	resources := []resource{}
+	for _, key := range s.keys(kind, prefix) {
+		resources = append(resources, c.records[key].resource)
+	}
+	return resources
+}
+
+func (s *store) keys(kind, prefix string) []string {
+	keys := []string{}
+	for _, key := range s.collection(kind).keys {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// merge sets the fields on a resource.
+func merge(r resource, fields resource) {
+	for k, v := range fields {
+		r[k] = v
+	}
+}
+
+func deepCopy(in interface{}) interface{} {
+	switch v := in.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			out[k] = deepCopy(e)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			out[i] = deepCopy(e)
+		}
+		return out
+	case []resource:
+		out := make([]interface{}, len(v))
+		for i, e := range v {
+			out[i] = deepCopy(e)
+		}
+		return out
+	}
+	return in
+}
@@ -0,0 +1,1896 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakeibmcloud
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	vpcPrefix = "/vpc/v1"
+
+	kindVPC                  = "vpcs"
+	kindAddressPrefix        = "address_prefixes"
+	kindSubnet               = "subnets"
+	kindPublicGateway        = "public_gateways"
+	kindSecurityGroup        = "security_groups"
+	kindLoadBalancer         = "load_balancers"
+	kindLoadBalancerPool     = "load_balancer_pools"
+	kindLoadBalancerMember   = "load_balancer_pool_members"
+	kindLoadBalancerListener = "load_balancer_listeners"
+	kindKey                  = "keys"
+	kindImage                = "images"
+	kindInstance             = "instances"
+	kindVolume               = "volumes"
+	kindVolumeAttachment     = "volume_attachments"
+	kindInstanceTemplate     = "instance_templates"
+	kindInstanceGroup        = "instance_groups"
+	kindMembership           = "instance_group_memberships"
+	kindDedicatedHost        = "dedicated_hosts"
+	kindEndpointGateway      = "endpoint_gateways"
+
+	// reservedSubnetAddresses is the number of addresses IBM Cloud reserves at the start of each subnet.
+	reservedSubnetAddresses = 4
+)
+
+// instanceProfileRegex matches the instance profile names, e.g. bx2-2x8 for 2 vCPUs and 8 GiB of memory.
+var instanceProfileRegex = regexp.MustCompile(`^[a-z0-9]+-([0-9]+)x([0-9]+)$`)
+
+func (c *Cloud) registerVPC() {
+	routes := map[string]handlerFunc{
+		"GET /regions/{region}/zones":                               c.listZones,
+		"POST /vpcs":                                                c.createVPC,
+		"GET /vpcs":                                                 c.listVPCs,
+		"GET /vpcs/{id}":                                            c.getVPC,
+		"DELETE /vpcs/{id}":                                         c.deleteVPC,
+		"GET /vpcs/{id}/address_prefixes":                           c.listAddressPrefixes,
+		"POST /subnets":                                             c.createSubnet,
+		"GET /subnets":                                              c.listSubnets,
+		"GET /subnets/{id}":                                         c.getSubnet,
+		"DELETE /subnets/{id}":                                      c.deleteSubnet,
+		"GET /subnets/{id}/public_gateway":                          c.getSubnetPublicGateway,
+		"PUT /subnets/{id}/public_gateway":                          c.setSubnetPublicGateway,
+		"DELETE /subnets/{id}/public_gateway":                       c.unsetSubnetPublicGateway,
+		"POST /public_gateways":                                     c.createPublicGateway,
+		"GET /public_gateways":                                      c.listPublicGateways,
+		"GET /public_gateways/{id}":                                 c.getPublicGateway,
+		"DELETE /public_gateways/{id}":                              c.deletePublicGateway,
+		"POST /security_groups":                                     c.createSecurityGroup,
+		"GET /security_groups":                                      c.listSecurityGroups,
+		"GET /security_groups/{id}":                                 c.getSecurityGroup,
+		"DELETE /security_groups/{id}":                              c.deleteSecurityGroup,
+		"POST /security_groups/{id}/rules":                          c.createSecurityGroupRule,
+		"GET /security_groups/{id}/rules":                           c.listSecurityGroupRules,
+		"GET /security_groups/{id}/rules/{rule}":                    c.getSecurityGroupRule,
+		"DELETE /security_groups/{id}/rules/{rule}":                 c.deleteSecurityGroupRule,
+		"POST /load_balancers":                                      c.createLoadBalancer,
+		"GET /load_balancers":                                       c.listLoadBalancers,
+		"GET /load_balancers/{id}":                                  c.getLoadBalancer,
+		"DELETE /load_balancers/{id}":                               c.deleteLoadBalancer,
+		"GET /load_balancers/{id}/listeners/{listener}":             c.getLoadBalancerListener,
+		"GET /load_balancers/{id}/pools":                            c.listLoadBalancerPools,
+		"GET /load_balancers/{id}/pools/{pool}":                     c.getLoadBalancerPool,
+		"POST /load_balancers/{id}/pools/{pool}/members":            c.createLoadBalancerPoolMember,
+		"GET /load_balancers/{id}/pools/{pool}/members":             c.listLoadBalancerPoolMembers,
+		"DELETE /load_balancers/{id}/pools/{pool}/members/{member}": c.deleteLoadBalancerPoolMember,
+		"GET /keys":                                                 c.listKeys,
+		"POST /images":                                              c.createImage,
+		"GET /images":                                               c.listImages,
+		"GET /images/{id}":                                          c.getImage,
+		"DELETE /images/{id}":                                       c.deleteImage,
+		"GET /instance/profiles/{name}":                             c.getInstanceProfile,
+		"POST /instances":                                           c.createInstanceHandler,
+		"GET /instances":                                            c.listInstances,
+		"GET /instances/{id}":                                       c.getInstance,
+		"DELETE /instances/{id}":                                    c.deleteInstanceHandler,
+		"POST /instances/{id}/volume_attachments":                   c.createVolumeAttachment,
+		"GET /instances/{id}/volume_attachments":                    c.listVolumeAttachments,
+		"POST /volumes":                                             c.createVolumeHandler,
+		"GET /volumes/{id}":                                         c.getVolume,
+		"GET /dedicated_hosts":                                      c.listDedicatedHosts,
+		"POST /instance/templates":                                  c.createInstanceTemplate,
+		"GET /instance/templates":                                   c.listInstanceTemplates,
+		"GET /instance/templates/{id}":                              c.getInstanceTemplate,
+		"DELETE /instance/templates/{id}":                           c.deleteInstanceTemplate,
+		"POST /instance_groups":                                     c.createInstanceGroup,
+		"GET /instance_groups/{id}":                                 c.getInstanceGroup,
+		"PATCH /instance_groups/{id}":                               c.updateInstanceGroup,
+		"DELETE /instance_groups/{id}":                              c.deleteInstanceGroup,
+		"GET /instance_groups/{id}/memberships":                     c.listMemberships,
+		"DELETE /instance_groups/{id}/memberships/{membership}":     c.deleteMembership,
+		"POST /endpoint_gateways":                                   c.createEndpointGateway,
+		"GET /endpoint_gateways":                                    c.listEndpointGateways,
+		"GET /endpoint_gateways/{id}":                               c.getEndpointGateway,
+		"DELETE /endpoint_gateways/{id}":                            c.deleteEndpointGateway,
+	}
+	for pattern, fn := range routes {
+		method, path, _ := strings.Cut(pattern, " ")
+		c.handle(method+" "+vpcPrefix+path, writePlatformError, fn)
+	}
+}
+
+// AddVPCImage adds an available custom image to the VPC region and returns its ID.
+func (c *Cloud) AddVPCImage(name string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	image := c.newImage(resource{"name": name})
+	c.store.insert(kindImage, str(image, "id"), image, nil)
+	merge(image, resource{"status": "available"})
+	return str(image, "id")
+}
+
+// AddSSHKey adds an SSH key to the VPC region and returns its ID.
+func (c *Cloud) AddSSHKey(name string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := newID("r006")
+	c.store.insert(kindKey, id, resource{
+		"id":             id,
+		"crn":            c.vpcCRN("key", id),
+		"href":           c.vpcHref("keys", id),
+		"name":           name,
+		"type":           "rsa",
+		"length":         2048,
+		"fingerprint":    "SHA256:" + id,
+		"public_key":     "ssh-rsa AAAA" + id,
+		"created_at":     now(),
+		"resource_group": c.resourceGroupReference(""),
+	}, nil)
+	return id
+}
+
+// AddDedicatedHost adds a dedicated host to a zone of the VPC region and returns its ID.
+func (c *Cloud) AddDedicatedHost(name, zone string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	id := newID("0717")
+	c.store.insert(kindDedicatedHost, id, resource{
+		"id":              id,
+		"crn":             c.vpcCRN("dedicated-host", id),
+		"href":            c.vpcHref("dedicated_hosts", id),
+		"name":            name,
+		"state":           "available",
+		"lifecycle_state": "stable",
+		"zone":            c.zoneReference(zone),
+		"created_at":      now(),
+		"resource_group":  c.resourceGroupReference(""),
+	}, nil)
+	return id
+}
+
+func (c *Cloud) vpcHref(path ...string) string {
+	return "https://" + c.region + ".iaas.cloud.ibm.com/v1/" + strings.Join(path, "/")
+}
+
+func (c *Cloud) vpcCRN(kind, id string) string {
+	return fmt.Sprintf("crn:v1:bluemix:public:is:%s:a/%s::%s:%s", c.region, c.accountID, kind, id)
+}
+
+func (c *Cloud) zoneReference(zone string) resource {
+	return resource{"name": zone, "href": c.vpcHref("regions", c.region, "zones", zone)}
+}
+
+// reference returns the reference to a resource embedded in other resources.
+func reference(r resource) resource {
+	ref := resource{}
+	for _, field := range []string{"id", "crn", "href", "name", "resource_type"} {
+		if v, ok := r[field]; ok {
+			ref[field] = v
+		}
+	}
+	return ref
+}
+
+func vpcCollection(name string, resources []resource) resource {
+	return resource{name: resources, "limit": 50, "total_count": len(resources)}
+}
+
+// vpcResource returns a copy of a VPC resource, counting the read.
+func (c *Cloud) vpcResource(kind, key string) (resource, *apiError) {
+	r, ok := c.store.get(kind, key)
+	if !ok {
+		return nil, notFound(strings.TrimSuffix(kind, "s"), key)
+	}
+	return r, nil
+}
+
+// existing returns a VPC resource referenced by a request, without counting a read.
+func (c *Cloud) existing(kind, key string) (resource, *apiError) {
+	r, ok := c.store.peek(kind, key)
+	if !ok {
+		return nil, notFound(strings.TrimSuffix(kind, "s"), key)
+	}
+	return r, nil
+}
+
+// checkUniqueName fails when a resource of the kind already has the name within the scope of the given field, e.g. vpc.id.
+func (c *Cloud) checkUniqueName(kind, name string, scope ...string) *apiError {
+	for _, r := range c.store.all(kind, "") {
+		if str(r, "name") != name {
+			continue
+		}
+		if len(scope) == 2 && str(r, scope[0]) != scope[1] {
+			continue
+		}
+		return conflict("validation_unique_failed", "the %s name %s is already in use", strings.TrimSuffix(kind, "s"), name)
+	}
+	return nil
+}
+
+func (c *Cloud) listZones(r *http.Request) (int, interface{}, *apiError) {
+	if r.PathValue("region") != c.region {
+		return 0, nil, notFound("region", r.PathValue("region"))
+	}
+	zones := []resource{}
+	for _, zone := range c.Zones() {
+		z := c.zoneReference(zone)
+		z["status"] = "available"
+		z["region"] = resource{"name": c.region, "href": c.vpcHref("regions", c.region)}
+		zones = append(zones, z)
+	}
+	return http.StatusOK, resource{"zones": zones}, nil
+}
+
+func (c *Cloud) createVPC(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	id := newID("r006")
+	name := str(body, "name")
+	if name == "" {
+		name = "vpc-" + id[5:13]
+	}
+	if err := c.checkUniqueName(kindVPC, name); err != nil {
+		return 0, nil, err
+	}
+	vpc := resource{
+		"id":                    id,
+		"crn":                   c.vpcCRN("vpc", id),
+		"href":                  c.vpcHref("vpcs", id),
+		"name":                  name,
+		"resource_type":         "vpc",
+		"status":                "pending",
+		"classic_access":        false,
+		"health_state":          "inapplicable",
+		"health_reasons":        []resource{},
+		"cse_source_ips":        []resource{},
+		"created_at":            now(),
+		"resource_group":        c.resourceGroupReference(str(body, "resource_group", "id")),
+		"default_network_acl":   resource{"id": newID("r006"), "name": name + "-acl", "href": c.vpcHref("network_acls", id)},
+		"default_routing_table": resource{"id": newID("r006"), "name": name + "-routing-table", "href": c.vpcHref("vpcs", id, "routing_tables")},
+	}
+	sg := c.newSecurityGroup(vpc, resource{"name": name + "-default-sg", "resource_group": vpc["resource_group"]})
+	c.store.insert(kindSecurityGroup, str(sg, "id"), sg, nil)
+	vpc["default_security_group"] = reference(sg)
+	if str(body, "address_prefix_management") != "manual" {
+		for i, zone := range c.Zones() {
+			prefixID := newID("r006")
+			c.store.insert(kindAddressPrefix, id+"/"+prefixID, resource{
+				"id":          prefixID,
+				"href":        c.vpcHref("vpcs", id, "address_prefixes", prefixID),
+				"name":        fmt.Sprintf("%s-prefix-%d", name, i+1),
+				"cidr":        fmt.Sprintf("10.%d.%d.0/18", 240+i/4, (i%4)*64),
+				"zone":        c.zoneReference(zone),
+				"is_default":  true,
+				"has_subnets": false,
+				"created_at":  now(),
+			}, nil)
+		}
+	}
+	c.store.insert(kindVPC, id, vpc, resource{"status": "available"})
+	return http.StatusCreated, deepCopy(vpc), nil
+}
+
+func (c *Cloud) listVPCs(r *http.Request) (int, interface{}, *apiError) {
+	vpcs := filter(c.store.list(kindVPC, ""), r.URL.Query(), "resource_group.id")
+	return http.StatusOK, vpcCollection("vpcs", vpcs), nil
+}
+
+func (c *Cloud) getVPC(r *http.Request) (int, interface{}, *apiError) {
+	vpc, err := c.vpcResource(kindVPC, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, vpc, nil
+}
+
+func (c *Cloud) deleteVPC(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	if _, err := c.existing(kindVPC, id); err != nil {
+		return 0, nil, err
+	}
+	for _, kind := range []string{kindSubnet, kindPublicGateway, kindInstance} {
+		if len(filter(c.store.all(kind, ""), map[string][]string{"vpc.id": {id}}, "vpc.id")) > 0 {
+			return 0, nil, conflict("vpc_in_use", "the VPC %s still has %s", id, kind)
+		}
+	}
+	c.store.remove(kindVPC, id, resource{"status": "deleting"})
+	for _, key := range c.store.keys(kindAddressPrefix, id+"/") {
+		c.store.drop(kindAddressPrefix, key)
+	}
+	for _, sg := range filter(c.store.all(kindSecurityGroup, ""), map[string][]string{"vpc.id": {id}}, "vpc.id") {
+		c.store.drop(kindSecurityGroup, str(sg, "id"))
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) listAddressPrefixes(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	if _, err := c.existing(kindVPC, id); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, vpcCollection("address_prefixes", c.store.list(kindAddressPrefix, id+"/")), nil
+}
+
+func (c *Cloud) createSubnet(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	vpc, err := c.existing(kindVPC, str(body, "vpc", "id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	id := newID("0717")
+	name := str(body, "name")
+	if name == "" {
+		name = "subnet-" + id[5:13]
+	}
+	if err := c.checkUniqueName(kindSubnet, name, "vpc.id", str(vpc, "id")); err != nil {
+		return 0, nil, err
+	}
+	zone := str(body, "zone", "name")
+	cidr := str(body, "ipv4_cidr_block")
+	if cidr == "" {
+		size := num(body, "total_ipv4_address_count")
+		if size == 0 {
+			size = 256
+		}
+		if cidr, err = c.allocateCIDR(str(vpc, "id"), zone, size); err != nil {
+			return 0, nil, err
+		}
+	} else if err := c.checkCIDROverlap(str(vpc, "id"), cidr); err != nil {
+		return 0, nil, err
+	}
+	if zone == "" {
+		zone = c.zoneOfCIDR(str(vpc, "id"), cidr)
+	}
+	_, network, parseErr := net.ParseCIDR(cidr)
+	if parseErr != nil {
+		return 0, nil, badRequest("invalid CIDR block %s", cidr)
+	}
+	ones, addressBits := network.Mask.Size()
+	total := int64(1) << (addressBits - ones)
+	subnet := resource{
+		"id":                           id,
+		"crn":                          c.vpcCRN("subnet", id),
+		"href":                         c.vpcHref("subnets", id),
+		"name":                         name,
+		"resource_type":                "subnet",
+		"status":                       "pending",
+		"ip_version":                   "ipv4",
+		"ipv4_cidr_block":              network.String(),
+		"total_ipv4_address_count":     total,
+		"available_ipv4_address_count": total - reservedSubnetAddresses - 1,
+		"vpc":                          reference(vpc),
+		"zone":                         c.zoneReference(zone),
+		"network_acl":                  vpc["default_network_acl"],
+		"routing_table":                vpc["default_routing_table"],
+		"resource_group":               c.resourceGroupReference(str(body, "resource_group", "id")),
+		"created_at":                   now(),
+	}
+	if gatewayID := str(body, "public_gateway", "id"); gatewayID != "" {
+		gateway, err := c.existing(kindPublicGateway, gatewayID)
+		if err != nil {
+			return 0, nil, err
+		}
+		subnet["public_gateway"] = reference(gateway)
+	}
+	c.store.insert(kindSubnet, id, subnet, resource{"status": "available"})
+	return http.StatusCreated, deepCopy(subnet), nil
+}
+
+func (c *Cloud) listSubnets(r *http.Request) (int, interface{}, *apiError) {
+	subnets := filter(c.store.list(kindSubnet, ""), r.URL.Query(), "resource_group.id", "vpc.id", "zone.name")
+	return http.StatusOK, vpcCollection("subnets", subnets), nil
+}
+
+func (c *Cloud) getSubnet(r *http.Request) (int, interface{}, *apiError) {
+	subnet, err := c.vpcResource(kindSubnet, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, subnet, nil
+}
+
+func (c *Cloud) deleteSubnet(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	if _, err := c.existing(kindSubnet, id); err != nil {
+		return 0, nil, err
+	}
+	for _, instance := range c.store.all(kindInstance, "") {
+		if str(instance, "primary_network_interface", "subnet", "id") == id {
+			return 0, nil, conflict("subnet_in_use", "the subnet %s is used by the instance %s", id, str(instance, "id"))
+		}
+	}
+	for _, lb := range c.store.all(kindLoadBalancer, "") {
+		for _, subnet := range items(lb, "subnets") {
+			if str(subnet, "id") == id {
+				return 0, nil, conflict("subnet_in_use", "the subnet %s is used by the load balancer %s", id, str(lb, "id"))
+			}
+		}
+	}
+	for _, gateway := range c.store.all(kindEndpointGateway, "") {
+		for _, ip := range items(gateway, "ips") {
+			if str(ip, "subnet", "id") == id {
+				return 0, nil, conflict("subnet_in_use", "the subnet %s is used by the endpoint gateway %s", id, str(gateway, "id"))
+			}
+		}
+	}
+	c.store.remove(kindSubnet, id, resource{"status": "deleting"})
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) getSubnetPublicGateway(r *http.Request) (int, interface{}, *apiError) {
+	subnet, err := c.existing(kindSubnet, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	gatewayID := str(subnet, "public_gateway", "id")
+	if gatewayID == "" {
+		return 0, nil, notFound("public gateway of subnet", r.PathValue("id"))
+	}
+	gateway, err := c.vpcResource(kindPublicGateway, gatewayID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, gateway, nil
+}
+
+func (c *Cloud) setSubnetPublicGateway(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	subnet, err := c.existing(kindSubnet, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	gateway, err := c.existing(kindPublicGateway, str(body, "id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	if str(gateway, "zone", "name") != str(subnet, "zone", "name") {
+		return 0, nil, badRequest("the public gateway %s isn't in the zone of the subnet %s", str(gateway, "id"), str(subnet, "id"))
+	}
+	subnet["public_gateway"] = reference(gateway)
+	return http.StatusCreated, deepCopy(gateway), nil
+}
+
+func (c *Cloud) unsetSubnetPublicGateway(r *http.Request) (int, interface{}, *apiError) {
+	subnet, err := c.existing(kindSubnet, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	delete(subnet, "public_gateway")
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) createPublicGateway(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	vpc, err := c.existing(kindVPC, str(body, "vpc", "id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	id := newID("r006")
+	name := str(body, "name")
+	if name == "" {
+		name = "gateway-" + id[5:13]
+	}
+	if err := c.checkUniqueName(kindPublicGateway, name); err != nil {
+		return 0, nil, err
+	}
+	floatingIPID := newID("r006")
+	gateway := resource{
+		"id":             id,
+		"crn":            c.vpcCRN("public-gateway", id),
+		"href":           c.vpcHref("public_gateways", id),
+		"name":           name,
+		"resource_type":  "public_gateway",
+		"status":         "pending",
+		"vpc":            reference(vpc),
+		"zone":           c.zoneReference(str(body, "zone", "name")),
+		"resource_group": c.resourceGroupReference(str(body, "resource_group", "id")),
+		"floating_ip": resource{
+			"id":      floatingIPID,
+			"crn":     c.vpcCRN("floating-ip", floatingIPID),
+			"href":    c.vpcHref("floating_ips", floatingIPID),
+			"name":    name + "-ip",
+			"address": c.publicAddress(),
+		},
+		"created_at": now(),
+	}
+	c.store.insert(kindPublicGateway, id, gateway, resource{"status": "available"})
+	return http.StatusCreated, deepCopy(gateway), nil
+}
+
+func (c *Cloud) listPublicGateways(r *http.Request) (int, interface{}, *apiError) {
+	gateways := filter(c.store.list(kindPublicGateway, ""), r.URL.Query(), "resource_group.id")
+	return http.StatusOK, vpcCollection("public_gateways", gateways), nil
+}
+
+func (c *Cloud) getPublicGateway(r *http.Request) (int, interface{}, *apiError) {
+	gateway, err := c.vpcResource(kindPublicGateway, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, gateway, nil
+}
+
+func (c *Cloud) deletePublicGateway(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	if _, err := c.existing(kindPublicGateway, id); err != nil {
+		return 0, nil, err
+	}
+	for _, subnet := range c.store.all(kindSubnet, "") {
+		if str(subnet, "public_gateway", "id") == id {
+			return 0, nil, conflict("public_gateway_in_use", "the public gateway %s is attached to the subnet %s", id, str(subnet, "id"))
+		}
+	}
+	c.store.remove(kindPublicGateway, id, resource{"status": "deleting"})
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) newSecurityGroup(vpc, body resource) resource {
+	id := newID("r006")
+	sg := resource{
+		"id":             id,
+		"crn":            c.vpcCRN("security-group", id),
+		"href":           c.vpcHref("security_groups", id),
+		"name":           str(body, "name"),
+		"resource_type":  "security_group",
+		"vpc":            reference(vpc),
+		"resource_group": c.resourceGroupReference(str(body, "resource_group", "id")),
+		"targets":        []resource{},
+		"created_at":     now(),
+	}
+	if sg["name"] == "" {
+		sg["name"] = "sg-" + id[5:13]
+	}
+	rules := []resource{}
+	for _, rule := range items(body, "rules") {
+		rules = append(rules, c.newSecurityGroupRule(sg, rule))
+	}
+	sg["rules"] = rules
+	return sg
+}
+
+func (c *Cloud) newSecurityGroupRule(sg, body resource) resource {
+	id := newID("r006")
+	rule := resource{
+		"id":         id,
+		"href":       c.vpcHref("security_groups", str(sg, "id"), "rules", id),
+		"direction":  str(body, "direction"),
+		"ip_version": "ipv4",
+		"protocol":   "all",
+		"local":      resource{"cidr_block": "0.0.0.0/0"},
+		"remote":     resource{"cidr_block": "0.0.0.0/0"},
+	}
+	if protocol := str(body, "protocol"); protocol != "" {
+		rule["protocol"] = protocol
+	}
+	switch rule["protocol"] {
+	case "tcp", "udp":
+		rule["port_min"], rule["port_max"] = int64(1), int64(65535)
+		if _, ok := body["port_min"]; ok {
+			rule["port_min"] = num(body, "port_min")
+		}
+		if _, ok := body["port_max"]; ok {
+			rule["port_max"] = num(body, "port_max")
+		}
+	case "icmp":
+		for _, field := range []string{"type", "code"} {
+			if _, ok := body[field]; ok {
+				rule[field] = num(body, field)
+			}
+		}
+	}
+	switch remote := lookup(body, "remote").(type) {
+	case map[string]interface{}:
+		if remoteID := str(remote, "id"); remoteID != "" {
+			remoteSG := sg
+			if remoteID != str(sg, "id") {
+				if existing, ok := c.store.peek(kindSecurityGroup, remoteID); ok {
+					remoteSG = existing
+				}
+			}
+			rule["remote"] = reference(remoteSG)
+		} else if len(remote) > 0 {
+			rule["remote"] = remote
+		}
+	}
+	return rule
+}
+
+func (c *Cloud) createSecurityGroup(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	vpc, err := c.existing(kindVPC, str(body, "vpc", "id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	if name := str(body, "name"); name != "" {
+		if err := c.checkUniqueName(kindSecurityGroup, name, "vpc.id", str(vpc, "id")); err != nil {
+			return 0, nil, err
+		}
+	}
+	for _, rule := range items(body, "rules") {
+		if err := validateRule(rule); err != nil {
+			return 0, nil, err
+		}
+	}
+	sg := c.newSecurityGroup(vpc, body)
+	c.store.insert(kindSecurityGroup, str(sg, "id"), sg, nil)
+	return http.StatusCreated, deepCopy(sg), nil
+}
+
+func validateRule(rule resource) *apiError {
+	if direction := str(rule, "direction"); direction != "inbound" && direction != "outbound" {
+		return badRequest("invalid security group rule direction %q", direction)
+	}
+	return nil
+}
+
+func (c *Cloud) listSecurityGroups(r *http.Request) (int, interface{}, *apiError) {
+	sgs := filter(c.store.list(kindSecurityGroup, ""), r.URL.Query(), "resource_group.id", "vpc.id", "vpc.name")
+	return http.StatusOK, vpcCollection("security_groups", sgs), nil
+}
+
+func (c *Cloud) getSecurityGroup(r *http.Request) (int, interface{}, *apiError) {
+	sg, err := c.vpcResource(kindSecurityGroup, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, sg, nil
+}
+
+func (c *Cloud) deleteSecurityGroup(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	if _, err := c.existing(kindSecurityGroup, id); err != nil {
+		return 0, nil, err
+	}
+	for _, vpc := range c.store.all(kindVPC, "") {
+		if str(vpc, "default_security_group", "id") == id {
+			return 0, nil, conflict("security_group_in_use", "the security group %s is the default security group of the VPC %s", id, str(vpc, "id"))
+		}
+	}
+	for _, gateway := range c.store.all(kindEndpointGateway, "") {
+		for _, sg := range items(gateway, "security_groups") {
+			if str(sg, "id") == id {
+				return 0, nil, conflict("security_group_in_use", "the security group %s is used by the endpoint gateway %s", id, str(gateway, "id"))
+			}
+		}
+	}
+	c.store.remove(kindSecurityGroup, id, nil)
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) createSecurityGroupRule(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	sg, err := c.existing(kindSecurityGroup, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	if err := validateRule(body); err != nil {
+		return 0, nil, err
+	}
+	rule := c.newSecurityGroupRule(sg, body)
+	sg["rules"] = append(items(sg, "rules"), rule)
+	return http.StatusCreated, deepCopy(rule), nil
+}
+
+func (c *Cloud) listSecurityGroupRules(r *http.Request) (int, interface{}, *apiError) {
+	sg, err := c.vpcResource(kindSecurityGroup, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, resource{"rules": items(sg, "rules")}, nil
+}
+
+func (c *Cloud) getSecurityGroupRule(r *http.Request) (int, interface{}, *apiError) {
+	sg, err := c.vpcResource(kindSecurityGroup, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	for _, rule := range items(sg, "rules") {
+		if str(rule, "id") == r.PathValue("rule") {
+			return http.StatusOK, rule, nil
+		}
+	}
+	return 0, nil, notFound("security group rule", r.PathValue("rule"))
+}
+
+func (c *Cloud) deleteSecurityGroupRule(r *http.Request) (int, interface{}, *apiError) {
+	sg, err := c.existing(kindSecurityGroup, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	rules := items(sg, "rules")
+	for i, rule := range rules {
+		if str(rule, "id") == r.PathValue("rule") {
+			sg["rules"] = append(rules[:i:i], rules[i+1:]...)
+			return http.StatusNoContent, nil, nil
+		}
+	}
+	return 0, nil, notFound("security group rule", r.PathValue("rule"))
+}
+
+func (c *Cloud) createLoadBalancer(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	id := newID("r006")
+	name := str(body, "name")
+	if name == "" {
+		name = "lb-" + id[5:13]
+	}
+	if err := c.checkUniqueName(kindLoadBalancer, name); err != nil {
+		return 0, nil, err
+	}
+	isPublic, _ := lookup(body, "is_public").(bool)
+	lb := resource{
+		"id":                        id,
+		"crn":                       c.vpcCRN("load-balancer", id),
+		"href":                      c.vpcHref("load_balancers", id),
+		"name":                      name,
+		"resource_type":             "load_balancer",
+		"hostname":                  fmt.Sprintf("%s-%s.%s.lb.appdomain.cloud", id[5:13], c.accountID, c.region),
+		"is_public":                 isPublic,
+		"is_private_path":           false,
+		"access_mode":               "private",
+		"availability":              "subnet",
+		"route_mode":                false,
+		"udp_supported":             true,
+		"instance_groups_supported": true,
+		"security_groups_supported": true,
+		"source_ip_session_persistence_supported": true,
+		"provisioning_status":                     "create_pending",
+		"operating_status":                        "offline",
+		"profile":                                 resource{"name": "network-fixed", "family": "network", "href": c.vpcHref("load_balancer/profiles/network-fixed")},
+		"resource_group":                          c.resourceGroupReference(str(body, "resource_group", "id")),
+		"failsafe_policy_actions":                 []string{"forward"},
+		"attached_load_balancer_pool_members":     []resource{},
+		"logging":                                 resource{"datapath": resource{"active": false}},
+		"created_at":                              now(),
+	}
+	if isPublic {
+		lb["access_mode"] = "public"
+		lb["public_ips"] = []resource{{"address": c.publicAddress()}}
+	} else {
+		lb["public_ips"] = []resource{}
+	}
+	if profile := str(body, "profile", "name"); profile != "" {
+		lb["profile"] = resource{"name": profile, "family": strings.SplitN(profile, "-", 2)[0], "href": c.vpcHref("load_balancer/profiles", profile)}
+	}
+	subnets := []resource{}
+	privateIPs := []resource{}
+	for _, identity := range items(body, "subnets") {
+		subnet, err := c.existing(kindSubnet, str(identity, "id"))
+		if err != nil {
+			return 0, nil, err
+		}
+		subnets = append(subnets, reference(subnet))
+		ipID := newID("0717")
+		privateIPs = append(privateIPs, resource{"id": ipID, "address": c.allocateIP(subnet), "href": c.vpcHref("subnets", str(subnet, "id"), "reserved_ips", ipID), "resource_type": "subnet_reserved_ip"})
+	}
+	if len(subnets) == 0 {
+		return 0, nil, badRequest("a load balancer requires at least one subnet")
+	}
+	lb["subnets"] = subnets
+	lb["private_ips"] = privateIPs
+	securityGroups := []resource{}
+	for _, identity := range items(body, "security_groups") {
+		sg, err := c.existing(kindSecurityGroup, str(identity, "id"))
+		if err != nil {
+			return 0, nil, err
+		}
+		securityGroups = append(securityGroups, reference(sg))
+	}
+	lb["security_groups"] = securityGroups
+
+	pools := []resource{}
+	poolIDs := map[string]string{}
+	for _, prototype := range items(body, "pools") {
+		pool := c.newLoadBalancerPool(lb, prototype)
+		poolIDs[str(pool, "name")] = str(pool, "id")
+		c.store.insert(kindLoadBalancerPool, id+"/"+str(pool, "id"), pool, nil)
+		for _, member := range items(prototype, "members") {
+			c.addLoadBalancerPoolMember(lb, pool, member)
+		}
+		pools = append(pools, reference(pool))
+	}
+	lb["pools"] = pools
+	listeners := []resource{}
+	for _, prototype := range items(body, "listeners") {
+		listenerID := newID("r006")
+		listener := resource{
+			"id":                    listenerID,
+			"href":                  c.vpcHref("load_balancers", id, "listeners", listenerID),
+			"port":                  num(prototype, "port"),
+			"protocol":              str(prototype, "protocol"),
+			"accept_proxy_protocol": false,
+			"provisioning_status":   "active",
+			"created_at":            now(),
+		}
+		if poolName := str(prototype, "default_pool", "name"); poolName != "" {
+			poolID, ok := poolIDs[poolName]
+			if !ok {
+				return 0, nil, badRequest("the default pool %s of the listener doesn't exist", poolName)
+			}
+			listener["default_pool"] = resource{"id": poolID, "name": poolName, "href": c.vpcHref("load_balancers", id, "pools", poolID)}
+		}
+		c.store.insert(kindLoadBalancerListener, id+"/"+listenerID, listener, nil)
+		listeners = append(listeners, resource{"id": listenerID, "href": listener["href"]})
+	}
+	lb["listeners"] = listeners
+	c.store.insert(kindLoadBalancer, id, lb, resource{"provisioning_status": "active", "operating_status": "online"})
+	return http.StatusCreated, deepCopy(lb), nil
+}
+
+func (c *Cloud) newLoadBalancerPool(lb, prototype resource) resource {
+	id := newID("r006")
+	pool := resource{
+		"id":                  id,
+		"href":                c.vpcHref("load_balancers", str(lb, "id"), "pools", id),
+		"name":                str(prototype, "name"),
+		"algorithm":           str(prototype, "algorithm"),
+		"protocol":            str(prototype, "protocol"),
+		"proxy_protocol":      "disabled",
+		"provisioning_status": "active",
+		"health_monitor":      lookup(prototype, "health_monitor"),
+		"failsafe_policy":     resource{"action": "fail"},
+		"members":             []resource{},
+		"created_at":          now(),
+	}
+	if pool["name"] == "" {
+		pool["name"] = "pool-" + id[5:13]
+	}
+	if proxyProtocol := str(prototype, "proxy_protocol"); proxyProtocol != "" {
+		pool["proxy_protocol"] = proxyProtocol
+	}
+	return pool
+}
+
+func (c *Cloud) addLoadBalancerPoolMember(lb, pool, prototype resource) resource {
+	id := newID("r006")
+	target := resource{"address": str(prototype, "target", "address")}
+	if instanceID := str(prototype, "target", "id"); instanceID != "" {
+		target = resource{"id": instanceID}
+		if instance, ok := c.store.peek(kindInstance, instanceID); ok {
+			target = reference(instance)
+		}
+	}
+	member := resource{
+		"id":                  id,
+		"href":                c.vpcHref("load_balancers", str(lb, "id"), "pools", str(pool, "id"), "members", id),
+		"port":                num(prototype, "port"),
+		"target":              target,
+		"health":              "unknown",
+		"provisioning_status": "create_pending",
+		"created_at":          now(),
+	}
+	if _, ok := prototype["weight"]; ok {
+		member["weight"] = num(prototype, "weight")
+	}
+	pool["members"] = append(items(pool, "members"), resource{"id": id, "href": member["href"]})
+	c.store.insert(kindLoadBalancerMember, str(lb, "id")+"/"+str(pool, "id")+"/"+id, member, resource{"health": "ok", "provisioning_status": "active"})
+	return member
+}
+
+func (c *Cloud) listLoadBalancers(_ *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, vpcCollection("load_balancers", c.store.list(kindLoadBalancer, "")), nil
+}
+
+func (c *Cloud) getLoadBalancer(r *http.Request) (int, interface{}, *apiError) {
+	lb, err := c.vpcResource(kindLoadBalancer, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, lb, nil
+}
+
+func (c *Cloud) deleteLoadBalancer(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	lb, err := c.existing(kindLoadBalancer, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	if status := str(lb, "provisioning_status"); status != "active" && status != "failed" {
+		return 0, nil, conflict("load_balancer_update_conflict", "the load balancer %s is %s", id, status)
+	}
+	c.store.merge(kindLoadBalancer, id, resource{"provisioning_status": "delete_pending"})
+	c.store.schedule(kindLoadBalancer, id, &transition{remove: true, done: func() {
+		for _, kind := range []string{kindLoadBalancerPool, kindLoadBalancerMember, kindLoadBalancerListener} {
+			for _, key := range c.store.keys(kind, id+"/") {
+				c.store.drop(kind, key)
+			}
+		}
+	}})
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) getLoadBalancerListener(r *http.Request) (int, interface{}, *apiError) {
+	if _, err := c.existing(kindLoadBalancer, r.PathValue("id")); err != nil {
+		return 0, nil, err
+	}
+	listener, err := c.vpcResource(kindLoadBalancerListener, r.PathValue("id")+"/"+r.PathValue("listener"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, listener, nil
+}
+
+func (c *Cloud) listLoadBalancerPools(r *http.Request) (int, interface{}, *apiError) {
+	if _, err := c.existing(kindLoadBalancer, r.PathValue("id")); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, resource{"pools": c.store.list(kindLoadBalancerPool, r.PathValue("id")+"/")}, nil
+}
+
+func (c *Cloud) getLoadBalancerPool(r *http.Request) (int, interface{}, *apiError) {
+	if _, err := c.existing(kindLoadBalancer, r.PathValue("id")); err != nil {
+		return 0, nil, err
+	}
+	pool, err := c.vpcResource(kindLoadBalancerPool, r.PathValue("id")+"/"+r.PathValue("pool"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, pool, nil
+}
+
+// updatableLoadBalancer returns a load balancer and its pool, failing when the load balancer has a pending update like the real API.
+func (c *Cloud) updatableLoadBalancer(r *http.Request) (resource, resource, *apiError) {
+	lb, err := c.existing(kindLoadBalancer, r.PathValue("id"))
+	if err != nil {
+		return nil, nil, err
+	}
+	pool, err := c.existing(kindLoadBalancerPool, r.PathValue("id")+"/"+r.PathValue("pool"))
+	if err != nil {
+		return nil, nil, err
+	}
+	if status := str(lb, "provisioning_status"); status != "active" {
+		return nil, nil, conflict("load_balancer_update_conflict", "the load balancer %s is %s", str(lb, "id"), status)
+	}
+	return lb, pool, nil
+}
+
+func (c *Cloud) createLoadBalancerPoolMember(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	lb, pool, err := c.updatableLoadBalancer(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	member := c.addLoadBalancerPoolMember(lb, pool, body)
+	c.store.merge(kindLoadBalancer, str(lb, "id"), resource{"provisioning_status": "update_pending"})
+	c.store.schedule(kindLoadBalancer, str(lb, "id"), &transition{fields: resource{"provisioning_status": "active"}})
+	return http.StatusCreated, deepCopy(member), nil
+}
+
+func (c *Cloud) listLoadBalancerPoolMembers(r *http.Request) (int, interface{}, *apiError) {
+	if _, err := c.existing(kindLoadBalancerPool, r.PathValue("id")+"/"+r.PathValue("pool")); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, resource{"members": c.store.list(kindLoadBalancerMember, r.PathValue("id")+"/"+r.PathValue("pool")+"/")}, nil
+}
+
+func (c *Cloud) deleteLoadBalancerPoolMember(r *http.Request) (int, interface{}, *apiError) {
+	lb, pool, err := c.updatableLoadBalancer(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	memberID := r.PathValue("member")
+	key := str(lb, "id") + "/" + str(pool, "id") + "/" + memberID
+	if _, err := c.existing(kindLoadBalancerMember, key); err != nil {
+		return 0, nil, err
+	}
+	c.store.drop(kindLoadBalancerMember, key)
+	members := []resource{}
+	for _, member := range items(pool, "members") {
+		if str(member, "id") != memberID {
+			members = append(members, member)
+		}
+	}
+	pool["members"] = members
+	c.store.merge(kindLoadBalancer, str(lb, "id"), resource{"provisioning_status": "update_pending"})
+	c.store.schedule(kindLoadBalancer, str(lb, "id"), &transition{fields: resource{"provisioning_status": "active"}})
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) listKeys(r *http.Request) (int, interface{}, *apiError) {
+	keys := filter(c.store.list(kindKey, ""), r.URL.Query(), "resource_group.id")
+	return http.StatusOK, vpcCollection("keys", keys), nil
+}
+
+func (c *Cloud) newImage(body resource) resource {
+	id := newID("r006")
+	os := str(body, "operating_system", "name")
+	if os == "" {
+		os = "rhel-coreos-stable-amd64"
+	}
+	image := resource{
+		"id":               id,
+		"crn":              c.vpcCRN("image", id),
+		"href":             c.vpcHref("images", id),
+		"name":             str(body, "name"),
+		"resource_type":    "image",
+		"status":           "pending",
+		"status_reasons":   []resource{},
+		"visibility":       "private",
+		"encryption":       "none",
+		"owner_type":       "user",
+		"user_data_format": "cloud_init",
+		"file":             resource{"size": 1},
+		"operating_system": resource{"name": os, "architecture": "amd64", "family": os, "vendor": "fake", "version": "1", "display_name": os, "href": c.vpcHref("operating_systems", os), "dedicated_host_only": false},
+		"resource_group":   c.resourceGroupReference(str(body, "resource_group", "id")),
+		"created_at":       now(),
+	}
+	if image["name"] == "" {
+		image["name"] = "image-" + id[5:13]
+	}
+	return image
+}
+
+func (c *Cloud) createImage(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if name := str(body, "name"); name != "" {
+		if err := c.checkUniqueName(kindImage, name); err != nil {
+			return 0, nil, err
+		}
+	}
+	image := c.newImage(body)
+	c.store.insert(kindImage, str(image, "id"), image, resource{"status": "available"})
+	return http.StatusCreated, deepCopy(image), nil
+}
+
+func (c *Cloud) listImages(r *http.Request) (int, interface{}, *apiError) {
+	images := filter(c.store.list(kindImage, ""), r.URL.Query(), "name", "resource_group.id", "visibility", "status")
+	return http.StatusOK, vpcCollection("images", images), nil
+}
+
+func (c *Cloud) getImage(r *http.Request) (int, interface{}, *apiError) {
+	image, err := c.vpcResource(kindImage, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, image, nil
+}
+
+func (c *Cloud) deleteImage(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	if _, err := c.existing(kindImage, id); err != nil {
+		return 0, nil, err
+	}
+	c.store.remove(kindImage, id, resource{"status": "deleting"})
+	return http.StatusAccepted, nil, nil
+}
+
+// instanceProfile returns the vCPU and memory of an instance profile from its name.
+func instanceProfile(name string) (int64, int64, bool) {
+	match := instanceProfileRegex.FindStringSubmatch(name)
+	if match == nil {
+		return 0, 0, false
+	}
+	vcpu, _ := strconv.ParseInt(match[1], 10, 64)
+	memory, _ := strconv.ParseInt(match[2], 10, 64)
+	return vcpu, memory, true
+}
+
+func (c *Cloud) getInstanceProfile(r *http.Request) (int, interface{}, *apiError) {
+	name := r.PathValue("name")
+	vcpu, memory, ok := instanceProfile(name)
+	if !ok {
+		return 0, nil, notFound("instance profile", name)
+	}
+	return http.StatusOK, resource{
+		"name":              name,
+		"href":              c.vpcHref("instance/profiles", name),
+		"family":            "balanced",
+		"status":            "current",
+		"resource_type":     "instance_profile",
+		"vcpu_count":        resource{"type": "fixed", "value": vcpu},
+		"memory":            resource{"type": "fixed", "value": memory},
+		"vcpu_architecture": resource{"type": "fixed", "value": "amd64"},
+		"vcpu_manufacturer": resource{"type": "fixed", "value": "intel"},
+	}, nil
+}
+
+func (c *Cloud) createInstanceHandler(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	instance, err := c.createInstance(body)
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, deepCopy(instance), nil
+}
+
+// createInstance creates an instance from an instance prototype.
+func (c *Cloud) createInstance(body resource) (resource, *apiError) {
+	if templateID := str(body, "source_template", "id"); templateID != "" {
+		template, err := c.existing(kindInstanceTemplate, templateID)
+		if err != nil {
+			return nil, err
+		}
+		prototype := deepCopy(template).(resource)
+		for _, field := range []string{"id", "crn", "href", "name", "created_at"} {
+			delete(prototype, field)
+		}
+		delete(body, "source_template")
+		merge(prototype, body)
+		body = prototype
+	}
+
+	subnetID := str(body, "primary_network_interface", "subnet", "id")
+	if subnetID == "" {
+		subnetID = str(body, "primary_network_attachment", "virtual_network_interface", "subnet", "id")
+	}
+	subnet, err := c.existing(kindSubnet, subnetID)
+	if err != nil {
+		return nil, err
+	}
+	zone := str(body, "zone", "name")
+	if zone == "" {
+		zone = str(subnet, "zone", "name")
+	}
+	if zone != str(subnet, "zone", "name") {
+		return nil, badRequest("the subnet %s isn't in the zone %s", subnetID, zone)
+	}
+	vpc, err := c.existing(kindVPC, str(subnet, "vpc", "id"))
+	if err != nil {
+		return nil, err
+	}
+	profile := str(body, "profile", "name")
+	vcpu, memory, ok := instanceProfile(profile)
+	if !ok {
+		return nil, badRequest("invalid instance profile %q", profile)
+	}
+	id := newID("0717")
+	name := str(body, "name")
+	if name == "" {
+		name = "instance-" + id[5:13]
+	}
+	if err := c.checkUniqueName(kindInstance, name); err != nil {
+		return nil, err
+	}
+	instance := resource{
+		"id":                   id,
+		"crn":                  c.vpcCRN("instance", id),
+		"href":                 c.vpcHref("instances", id),
+		"name":                 name,
+		"resource_type":        "instance",
+		"status":               "pending",
+		"status_reasons":       []resource{},
+		"lifecycle_state":      "pending",
+		"lifecycle_reasons":    []resource{},
+		"health_state":         "ok",
+		"health_reasons":       []resource{},
+		"startable":            true,
+		"vcpu":                 resource{"architecture": "amd64", "count": vcpu, "manufacturer": "intel"},
+		"memory":               memory,
+		"bandwidth":            vcpu * 2000,
+		"profile":              resource{"name": profile, "href": c.vpcHref("instance/profiles", profile)},
+		"zone":                 c.zoneReference(zone),
+		"vpc":                  reference(vpc),
+		"resource_group":       c.resourceGroupReference(str(body, "resource_group", "id")),
+		"availability_policy":  resource{"host_failure": "restart"},
+		"metadata_service":     resource{"enabled": false, "protocol": "http", "response_hop_limit": 1},
+		"reservation_affinity": resource{"policy": "disabled", "pool": []resource{}},
+		"disks":                []resource{},
+		"network_attachments":  []resource{},
+		"created_at":           now(),
+	}
+	if imageID := str(body, "image", "id"); imageID != "" {
+		image, err := c.existing(kindImage, imageID)
+		if err != nil {
+			return nil, err
+		}
+		instance["image"] = reference(image)
+	}
+	if placement := lookup(body, "placement_target"); placement != nil {
+		if hostID := str(body, "placement_target", "id"); hostID != "" {
+			if host, ok := c.store.peek(kindDedicatedHost, hostID); ok {
+				instance["dedicated_host"] = reference(host)
+			}
+		}
+		instance["placement_target"] = placement
+	}
+
+	address := c.allocateIP(subnet)
+	nicID := newID("0717")
+	nic := resource{
+		"id":            nicID,
+		"href":          c.vpcHref("instances", id, "network_interfaces", nicID),
+		"name":          "eth0",
+		"resource_type": "network_interface",
+		"subnet":        reference(subnet),
+		"primary_ip":    resource{"id": newID("0717"), "address": address, "name": name + "-ip", "resource_type": "subnet_reserved_ip"},
+	}
+	if nicName := str(body, "primary_network_interface", "name"); nicName != "" {
+		nic["name"] = nicName
+	}
+	instance["primary_network_interface"] = nic
+	instance["network_interfaces"] = []resource{nic}
+	if _, ok := body["primary_network_attachment"]; ok {
+		attachment := deepCopy(nic).(resource)
+		attachment["resource_type"] = "instance_network_attachment"
+		instance["primary_network_attachment"] = attachment
+		instance["network_attachments"] = []resource{attachment}
+	}
+
+	bootVolume := c.newVolume(resource{
+		"name":           name + "-boot",
+		"capacity":       int64(100),
+		"profile":        resource{"name": "general-purpose"},
+		"zone":           resource{"name": zone},
+		"resource_group": lookup(body, "resource_group"),
+	})
+	if prototype, ok := lookup(body, "boot_volume_attachment", "volume").(map[string]interface{}); ok {
+		for _, field := range []string{"name", "capacity", "profile"} {
+			if v, ok := prototype[field]; ok {
+				bootVolume[field] = v
+			}
+		}
+	}
+	c.store.insert(kindVolume, str(bootVolume, "id"), bootVolume, resource{"status": "available"})
+	bootAttachment := c.attachVolume(instance, bootVolume, "boot", true)
+	instance["boot_volume_attachment"] = bootAttachment
+	attachments := []resource{bootAttachment}
+	for _, prototype := range items(body, "volume_attachments") {
+		volume, err := c.volumeFromPrototype(lookup(prototype, "volume"), zone)
+		if err != nil {
+			return nil, err
+		}
+		deleteVolume, _ := lookup(prototype, "delete_volume_on_instance_delete").(bool)
+		attachments = append(attachments, c.attachVolume(instance, volume, "data", deleteVolume))
+	}
+	instance["volume_attachments"] = attachments
+
+	c.store.insert(kindInstance, id, instance, resource{"status": "running", "lifecycle_state": "stable"})
+	return instance, nil
+}
+
+// volumeFromPrototype returns an existing volume from its identity or creates it from a volume prototype.
+func (c *Cloud) volumeFromPrototype(prototype interface{}, zone string) (resource, *apiError) {
+	body, ok := prototype.(map[string]interface{})
+	if !ok {
+		return nil, badRequest("invalid volume attachment")
+	}
+	if id := str(body, "id"); id != "" {
+		return c.existing(kindVolume, id)
+	}
+	if str(body, "zone", "name") == "" {
+		body["zone"] = resource{"name": zone}
+	}
+	volume := c.newVolume(body)
+	c.store.insert(kindVolume, str(volume, "id"), volume, resource{"status": "available"})
+	return volume, nil
+}
+
+// attachVolume attaches a volume to an instance and returns the volume attachment reference of the instance.
+func (c *Cloud) attachVolume(instance, volume resource, attachmentType string, deleteVolume bool) resource {
+	id := newID("0717")
+	attachment := resource{
+		"id":                               id,
+		"href":                             c.vpcHref("instances", str(instance, "id"), "volume_attachments", id),
+		"name":                             str(volume, "name") + "-attachment",
+		"type":                             attachmentType,
+		"status":                           "attaching",
+		"bandwidth":                        1000,
+		"delete_volume_on_instance_delete": deleteVolume,
+		"device":                           resource{"id": newID("0717")},
+		"volume":                           reference(volume),
+		"created_at":                       now(),
+	}
+	c.store.insert(kindVolumeAttachment, str(instance, "id")+"/"+id, attachment, resource{"status": "attached"})
+	volume["attachment_state"] = "attached"
+	return resource{"id": id, "href": attachment["href"], "name": attachment["name"], "device": attachment["device"], "volume": attachment["volume"]}
+}
+
+func (c *Cloud) listInstances(r *http.Request) (int, interface{}, *apiError) {
+	instances := filter(c.store.list(kindInstance, ""), r.URL.Query(), "name", "resource_group.id", "vpc.id", "vpc.name")
+	return http.StatusOK, vpcCollection("instances", instances), nil
+}
+
+func (c *Cloud) getInstance(r *http.Request) (int, interface{}, *apiError) {
+	instance, err := c.vpcResource(kindInstance, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, instance, nil
+}
+
+func (c *Cloud) deleteInstanceHandler(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	if _, err := c.existing(kindInstance, id); err != nil {
+		return 0, nil, err
+	}
+	c.deleteInstance(id)
+	return http.StatusNoContent, nil, nil
+}
+
+// deleteInstance deletes an instance along with the volumes to delete on instance deletion.
+func (c *Cloud) deleteInstance(id string) {
+	instance, ok := c.store.peek(kindInstance, id)
+	if !ok || str(instance, "status") == "deleting" {
+		return
+	}
+	c.store.merge(kindInstance, id, resource{"status": "deleting", "lifecycle_state": "deleting"})
+	c.store.schedule(kindInstance, id, &transition{remove: true, done: func() {
+		for _, key := range c.store.keys(kindVolumeAttachment, id+"/") {
+			attachment, _ := c.store.peek(kindVolumeAttachment, key)
+			if deleteVolume, _ := attachment["delete_volume_on_instance_delete"].(bool); deleteVolume {
+				c.store.drop(kindVolume, str(attachment, "volume", "id"))
+			} else if volume, ok := c.store.peek(kindVolume, str(attachment, "volume", "id")); ok {
+				volume["attachment_state"] = "unattached"
+			}
+			c.store.drop(kindVolumeAttachment, key)
+		}
+	}})
+}
+
+func (c *Cloud) createVolumeAttachment(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	instance, err := c.existing(kindInstance, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	volume, err := c.volumeFromPrototype(lookup(body, "volume"), str(instance, "zone", "name"))
+	if err != nil {
+		return 0, nil, err
+	}
+	if str(volume, "attachment_state") == "attached" {
+		return 0, nil, conflict("volume_in_use", "the volume %s is already attached", str(volume, "id"))
+	}
+	deleteVolume, _ := lookup(body, "delete_volume_on_instance_delete").(bool)
+	ref := c.attachVolume(instance, volume, "data", deleteVolume)
+	if name := str(body, "name"); name != "" {
+		ref["name"] = name
+		c.store.merge(kindVolumeAttachment, str(instance, "id")+"/"+str(ref, "id"), resource{"name": name})
+	}
+	instance["volume_attachments"] = append(items(instance, "volume_attachments"), ref)
+	attachment, _ := c.store.peek(kindVolumeAttachment, str(instance, "id")+"/"+str(ref, "id"))
+	return http.StatusCreated, deepCopy(attachment), nil
+}
+
+func (c *Cloud) listVolumeAttachments(r *http.Request) (int, interface{}, *apiError) {
+	if _, err := c.existing(kindInstance, r.PathValue("id")); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, resource{"volume_attachments": c.store.list(kindVolumeAttachment, r.PathValue("id")+"/")}, nil
+}
+
+func (c *Cloud) newVolume(body resource) resource {
+	id := newID("r006")
+	volume := resource{
+		"id":                 id,
+		"crn":                c.vpcCRN("volume", id),
+		"href":               c.vpcHref("volumes", id),
+		"name":               str(body, "name"),
+		"resource_type":      "volume",
+		"status":             "pending",
+		"status_reasons":     []resource{},
+		"attachment_state":   "unattached",
+		"encryption":         "provider_managed",
+		"capacity":           num(body, "capacity"),
+		"iops":               num(body, "iops"),
+		"profile":            resource{"name": str(body, "profile", "name"), "href": c.vpcHref("volume/profiles", str(body, "profile", "name"))},
+		"zone":               c.zoneReference(str(body, "zone", "name")),
+		"resource_group":     c.resourceGroupReference(str(body, "resource_group", "id")),
+		"volume_attachments": []resource{},
+		"created_at":         now(),
+	}
+	if volume["name"] == "" {
+		volume["name"] = "volume-" + id[5:13]
+	}
+	if volume["capacity"] == int64(0) {
+		volume["capacity"] = int64(100)
+	}
+	if volume["iops"] == int64(0) {
+		volume["iops"] = int64(3000)
+	}
+	return volume
+}
+
+func (c *Cloud) createVolumeHandler(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	volume := c.newVolume(body)
+	c.store.insert(kindVolume, str(volume, "id"), volume, resource{"status": "available"})
+	return http.StatusCreated, deepCopy(volume), nil
+}
+
+func (c *Cloud) getVolume(r *http.Request) (int, interface{}, *apiError) {
+	volume, err := c.vpcResource(kindVolume, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, volume, nil
+}
+
+func (c *Cloud) listDedicatedHosts(r *http.Request) (int, interface{}, *apiError) {
+	hosts := filter(c.store.list(kindDedicatedHost, ""), r.URL.Query(), "name", "resource_group.id", "zone.name")
+	return http.StatusOK, vpcCollection("dedicated_hosts", hosts), nil
+}
+
+func (c *Cloud) createInstanceTemplate(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	id := newID("0717")
+	if str(body, "name") == "" {
+		body["name"] = "template-" + id[5:13]
+	}
+	if err := c.checkUniqueName(kindInstanceTemplate, str(body, "name")); err != nil {
+		return 0, nil, err
+	}
+	if _, _, ok := instanceProfile(str(body, "profile", "name")); !ok {
+		return 0, nil, badRequest("invalid instance profile %q", str(body, "profile", "name"))
+	}
+	template := deepCopy(body).(resource)
+	merge(template, resource{
+		"id":             id,
+		"crn":            c.vpcCRN("instance-template", id),
+		"href":           c.vpcHref("instance/templates", id),
+		"resource_group": c.resourceGroupReference(str(body, "resource_group", "id")),
+		"created_at":     now(),
+	})
+	c.store.insert(kindInstanceTemplate, id, template, nil)
+	return http.StatusCreated, deepCopy(template), nil
+}
+
+func (c *Cloud) listInstanceTemplates(_ *http.Request) (int, interface{}, *apiError) {
+	return http.StatusOK, vpcCollection("templates", c.store.list(kindInstanceTemplate, "")), nil
+}
+
+func (c *Cloud) getInstanceTemplate(r *http.Request) (int, interface{}, *apiError) {
+	template, err := c.vpcResource(kindInstanceTemplate, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, template, nil
+}
+
+func (c *Cloud) deleteInstanceTemplate(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	if _, err := c.existing(kindInstanceTemplate, id); err != nil {
+		return 0, nil, err
+	}
+	for _, group := range c.store.all(kindInstanceGroup, "") {
+		if str(group, "instance_template", "id") == id {
+			return 0, nil, conflict("instance_template_in_use", "the instance template %s is used by the instance group %s", id, str(group, "id"))
+		}
+	}
+	c.store.remove(kindInstanceTemplate, id, nil)
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) createInstanceGroup(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	template, err := c.existing(kindInstanceTemplate, str(body, "instance_template", "id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	id := newID("r006")
+	name := str(body, "name")
+	if name == "" {
+		name = "group-" + id[5:13]
+	}
+	if err := c.checkUniqueName(kindInstanceGroup, name); err != nil {
+		return 0, nil, err
+	}
+	subnets := []resource{}
+	var vpc interface{}
+	for _, identity := range items(body, "subnets") {
+		subnet, err := c.existing(kindSubnet, str(identity, "id"))
+		if err != nil {
+			return 0, nil, err
+		}
+		subnets = append(subnets, reference(subnet))
+		vpc = subnet["vpc"]
+	}
+	if len(subnets) == 0 {
+		return 0, nil, badRequest("an instance group requires at least one subnet")
+	}
+	group := resource{
+		"id":                id,
+		"crn":               c.vpcCRN("instance-group", id),
+		"href":              c.vpcHref("instance_groups", id),
+		"name":              name,
+		"status":            "scaling",
+		"membership_count":  num(body, "membership_count"),
+		"instance_template": reference(template),
+		"subnets":           subnets,
+		"vpc":               vpc,
+		"managers":          []resource{},
+		"resource_group":    c.resourceGroupReference(str(body, "resource_group", "id")),
+		"created_at":        now(),
+		"updated_at":        now(),
+	}
+	for _, field := range []string{"application_port", "load_balancer", "load_balancer_pool"} {
+		if v, ok := body[field]; ok {
+			group[field] = v
+		}
+	}
+	c.store.insert(kindInstanceGroup, id, group, nil)
+	if err := c.scaleInstanceGroup(group); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, deepCopy(group), nil
+}
+
+// scaleInstanceGroup creates or deletes the instances of a group to match its membership count.
+func (c *Cloud) scaleInstanceGroup(group resource) *apiError {
+	id := str(group, "id")
+	memberships := c.store.all(kindMembership, id+"/")
+	want := int(num(group, "membership_count"))
+	subnets := items(group, "subnets")
+	for i := len(memberships); i < want; i++ {
+		membershipID := newID("r006")
+		instance, err := c.createInstance(resource{
+			"name":            fmt.Sprintf("%s-%s", str(group, "name"), membershipID[5:13]),
+			"source_template": resource{"id": str(group, "instance_template", "id")},
+			"primary_network_interface": resource{
+				"subnet": resource{"id": str(subnets[i%len(subnets)], "id")},
+			},
+			"zone": nil,
+		})
+		if err != nil {
+			return err
+		}
+		c.store.insert(kindMembership, id+"/"+membershipID, resource{
+			"id":                                   membershipID,
+			"href":                                 c.vpcHref("instance_groups", id, "memberships", membershipID),
+			"name":                                 str(instance, "name"),
+			"status":                               "pending",
+			"delete_instance_on_membership_delete": true,
+			"instance":                             reference(instance),
+			"instance_template":                    group["instance_template"],
+			"created_at":                           now(),
+			"updated_at":                           now(),
+		}, resource{"status": "healthy"})
+	}
+	for i := len(memberships) - 1; i >= want; i-- {
+		c.deleteInstance(str(memberships[i], "instance", "id"))
+		c.store.drop(kindMembership, id+"/"+str(memberships[i], "id"))
+	}
+	if len(memberships) != want {
+		c.store.merge(kindInstanceGroup, id, resource{"status": "scaling", "updated_at": now()})
+		c.store.schedule(kindInstanceGroup, id, &transition{fields: resource{"status": "healthy"}})
+	}
+	return nil
+}
+
+func (c *Cloud) getInstanceGroup(r *http.Request) (int, interface{}, *apiError) {
+	group, err := c.vpcResource(kindInstanceGroup, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, group, nil
+}
+
+func (c *Cloud) updateInstanceGroup(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	group, err := c.existing(kindInstanceGroup, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	if templateID := str(body, "instance_template", "id"); templateID != "" {
+		template, err := c.existing(kindInstanceTemplate, templateID)
+		if err != nil {
+			return 0, nil, err
+		}
+		group["instance_template"] = reference(template)
+	}
+	for _, field := range []string{"name", "application_port", "load_balancer", "load_balancer_pool"} {
+		if v, ok := body[field]; ok {
+			group[field] = v
+		}
+	}
+	if _, ok := body["membership_count"]; ok {
+		group["membership_count"] = num(body, "membership_count")
+	}
+	group["updated_at"] = now()
+	if err := c.scaleInstanceGroup(group); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, deepCopy(group), nil
+}
+
+func (c *Cloud) deleteInstanceGroup(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	group, err := c.existing(kindInstanceGroup, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	if num(group, "membership_count") > 0 || len(c.store.keys(kindMembership, id+"/")) > 0 {
+		return 0, nil, conflict("instance_group_has_memberships", "the instance group %s still has memberships", id)
+	}
+	c.store.remove(kindInstanceGroup, id, resource{"status": "deleting"})
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) listMemberships(r *http.Request) (int, interface{}, *apiError) {
+	if _, err := c.existing(kindInstanceGroup, r.PathValue("id")); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, vpcCollection("memberships", c.store.list(kindMembership, r.PathValue("id")+"/")), nil
+}
+
+func (c *Cloud) deleteMembership(r *http.Request) (int, interface{}, *apiError) {
+	group, err := c.existing(kindInstanceGroup, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	key := r.PathValue("id") + "/" + r.PathValue("membership")
+	membership, err := c.existing(kindMembership, key)
+	if err != nil {
+		return 0, nil, err
+	}
+	c.deleteInstance(str(membership, "instance", "id"))
+	c.store.drop(kindMembership, key)
+	// Like the real instance group, recreate the membership from the current template to keep the membership count.
+	if err := c.scaleInstanceGroup(group); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+func (c *Cloud) createEndpointGateway(r *http.Request) (int, interface{}, *apiError) {
+	body, err := decode(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	vpc, err := c.existing(kindVPC, str(body, "vpc", "id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	if str(body, "target", "resource_type") == "" || (str(body, "target", "crn") == "" && str(body, "target", "name") == "") {
+		return 0, nil, badRequest("the endpoint gateway target requires a resource_type and a crn or name")
+	}
+	id := newID("r006")
+	name := str(body, "name")
+	if name == "" {
+		name = "egw-" + id[5:13]
+	}
+	if err := c.checkUniqueName(kindEndpointGateway, name); err != nil {
+		return 0, nil, err
+	}
+	// Like the real endpoint gateway, it can only be bound to one reserved IP per zone.
+	zones := map[string]bool{}
+	ips := []resource{}
+	for _, prototype := range items(body, "ips") {
+		subnet, err := c.existing(kindSubnet, str(prototype, "subnet", "id"))
+		if err != nil {
+			return 0, nil, err
+		}
+		zone := str(subnet, "zone", "name")
+		if zones[zone] {
+			return 0, nil, badRequest("the endpoint gateway can only have one reserved IP in the zone %s", zone)
+		}
+		zones[zone] = true
+		ipID := newID("0717")
+		ips = append(ips, resource{
+			"id":            ipID,
+			"address":       c.allocateIP(subnet),
+			"href":          c.vpcHref("subnets", str(subnet, "id"), "reserved_ips", ipID),
+			"name":          name + "-" + zone,
+			"resource_type": "subnet_reserved_ip",
+			"subnet":        reference(subnet),
+		})
+	}
+	securityGroups := []resource{}
+	for _, ref := range items(body, "security_groups") {
+		sg, err := c.existing(kindSecurityGroup, str(ref, "id"))
+		if err != nil {
+			return 0, nil, err
+		}
+		securityGroups = append(securityGroups, reference(sg))
+	}
+	if sg, ok := vpc["default_security_group"].(resource); ok && len(securityGroups) == 0 {
+		securityGroups = append(securityGroups, sg)
+	}
+	gateway := resource{
+		"id":                           id,
+		"crn":                          c.vpcCRN("endpoint-gateway", id),
+		"href":                         c.vpcHref("endpoint_gateways", id),
+		"name":                         name,
+		"resource_type":                "endpoint_gateway",
+		"lifecycle_state":              "pending",
+		"health_state":                 "ok",
+		"allow_dns_resolution_binding": true,
+		"allow_resource_binding":       false,
+		"ips":                          ips,
+		"security_groups":              securityGroups,
+		"service_endpoints":            []string{},
+		"target":                       resource{"resource_type": str(body, "target", "resource_type"), "crn": str(body, "target", "crn"), "name": str(body, "target", "name")},
+		"vpc":                          reference(vpc),
+		"resource_group":               c.resourceGroupReference(str(body, "resource_group", "id")),
+		"lifecycle_reasons":            []resource{},
+		"created_at":                   now(),
+	}
+	c.store.insert(kindEndpointGateway, id, gateway, resource{"lifecycle_state": "stable"})
+	return http.StatusCreated, deepCopy(gateway), nil
+}
+
+func (c *Cloud) listEndpointGateways(r *http.Request) (int, interface{}, *apiError) {
+	gateways := filter(c.store.list(kindEndpointGateway, ""), r.URL.Query(), "name", "resource_group.id", "vpc.id")
+	return http.StatusOK, vpcCollection("endpoint_gateways", gateways), nil
+}
+
+func (c *Cloud) getEndpointGateway(r *http.Request) (int, interface{}, *apiError) {
+	gateway, err := c.vpcResource(kindEndpointGateway, r.PathValue("id"))
+	if err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, gateway, nil
+}
+
+func (c *Cloud) deleteEndpointGateway(r *http.Request) (int, interface{}, *apiError) {
+	id := r.PathValue("id")
+	if _, err := c.existing(kindEndpointGateway, id); err != nil {
+		return 0, nil, err
+	}
+	c.store.remove(kindEndpointGateway, id, resource{"lifecycle_state": "deleting"})
+	return http.StatusNoContent, nil, nil
+}
+
+// allocateCIDR returns the first free block of at least size addresses in the address prefixes of a VPC zone.
+func (c *Cloud) allocateCIDR(vpcID, zone string, size int64) (string, *apiError) {
+	prefixLength := 32 - bits.Len64(uint64(size-1))
+	for _, prefix := range c.store.all(kindAddressPrefix, vpcID+"/") {
+		if str(prefix, "zone", "name") != zone {
+			continue
+		}
+		_, network, err := net.ParseCIDR(str(prefix, "cidr"))
+		if err != nil {
+			continue
+		}
+		ones, _ := network.Mask.Size()
+		if prefixLength < ones {
+			continue
+		}
+		start := ipToUint(network.IP)
+		blockSize := uint32(1) << (32 - prefixLength)
+		for offset := uint32(0); offset < uint32(1)<<(32-ones); offset += blockSize {
+			candidate := fmt.Sprintf("%s/%d", uintToIP(start+offset), prefixLength)
+			if c.checkCIDROverlap(vpcID, candidate) == nil {
+				prefix["has_subnets"] = true
+				return candidate, nil
+			}
+		}
+	}
+	return "", badRequest("no free address range of %d addresses in the zone %s of the VPC %s", size, zone, vpcID)
+}
+
+// checkCIDROverlap fails when a CIDR block overlaps a subnet of a VPC.
+func (c *Cloud) checkCIDROverlap(vpcID, cidr string) *apiError {
+	_, candidate, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return badRequest("invalid CIDR block %s", cidr)
+	}
+	for _, subnet := range c.store.all(kindSubnet, "") {
+		if str(subnet, "vpc", "id") != vpcID {
+			continue
+		}
+		_, existing, err := net.ParseCIDR(str(subnet, "ipv4_cidr_block"))
+		if err == nil && (existing.Contains(candidate.IP) || candidate.Contains(existing.IP)) {
+			return conflict("subnet_ipv4_cidr_block_overlaps", "the CIDR block %s overlaps the subnet %s", cidr, str(subnet, "id"))
+		}
+	}
+	return nil
+}
+
+// zoneOfCIDR returns the zone of the VPC address prefix containing a CIDR block.
+func (c *Cloud) zoneOfCIDR(vpcID, cidr string) string {
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return ""
+	}
+	for _, prefix := range c.store.all(kindAddressPrefix, vpcID+"/") {
+		if _, network, err := net.ParseCIDR(str(prefix, "cidr")); err == nil && network.Contains(ip) {
+			return str(prefix, "zone", "name")
+		}
+	}
+	return c.Zones()[0]
+}
+
+// allocateIP returns the next free address of a subnet.
+func (c *Cloud) allocateIP(subnet resource) string {
+	id := str(subnet, "id")
+	address := c.nextAddress(id, str(subnet, "ipv4_cidr_block"), reservedSubnetAddresses)
+	if available := num(subnet, "available_ipv4_address_count"); available > 0 {
+		subnet["available_ipv4_address_count"] = available - 1
+	}
+	return address
+}
+
+// nextAddress returns the next address allocated in a network, after the reserved ones.
+func (c *Cloud) nextAddress(networkID, cidr string, reserved int) string {
+	c.ipAllocations[networkID]++
+	return cidrAddress(cidr, reserved+c.ipAllocations[networkID])
+}
+
+// cidrAddress returns the address at an offset in a CIDR block.
+func cidrAddress(cidr string, offset int) string {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return ""
+	}
+	return uintToIP(ipToUint(network.IP) + uint32(offset)).String()
+}
+
+// publicAddress returns a new public IP address.
+func (c *Cloud) publicAddress() string {
+	return c.nextAddress("public", "169.48.0.0/16", 0)
+}
+
+func ipToUint(ip net.IP) uint32 {
+	return binary.BigEndian.Uint32(ip.To4())
+}
+
+func uintToIP(n uint32) net.IP {
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, n)
+	return ip
+}